@@ -0,0 +1,143 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	epochsmoduletypes "github.com/Stride-Labs/stride/x/epochs/types"
+	icacallbacksmoduletypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
+	recordsmodulekeeper "github.com/Stride-Labs/stride/x/records/keeper"
+	recordsmoduletypes "github.com/Stride-Labs/stride/x/records/types"
+	stakeibcmodulekeeper "github.com/Stride-Labs/stride/x/stakeibc/keeper"
+	stakeibcmoduletypes "github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+// The callback arg type for each registered ICA callback id, taken from the callbacks each module registers
+var CallbackArgTypes = mergeCallbackArgTypes(
+	stakeibcmodulekeeper.ICACallbackArgTypes(),
+	recordsmodulekeeper.ICACallbackArgTypes(),
+)
+
+func mergeCallbackArgTypes(moduleArgTypes ...map[string]func() codec.ProtoMarshaler) map[string]func() codec.ProtoMarshaler {
+	argTypes := make(map[string]func() codec.ProtoMarshaler)
+	for _, moduleArgType := range moduleArgTypes {
+		for callbackId, newArgs := range moduleArgType {
+			argTypes[callbackId] = newArgs
+		}
+	}
+	return argTypes
+}
+
+// ValidateCrossModuleGenesis checks the references between the stakeibc, records, icacallbacks and epochs
+// genesis states. Each module's own genesis validation only checks its state in isolation, so this
+// should be run after the module basic manager's ValidateGenesis
+// A module missing from the genesis is treated as having its default genesis
+func ValidateCrossModuleGenesis(cdc codec.JSONCodec, genesis GenesisState) error {
+	stakeibcGenesis := stakeibcmoduletypes.DefaultGenesis()
+	if err := unmarshalModuleGenesis(cdc, genesis, stakeibcmoduletypes.ModuleName, stakeibcGenesis); err != nil {
+		return err
+	}
+	recordsGenesis := recordsmoduletypes.DefaultGenesis()
+	if err := unmarshalModuleGenesis(cdc, genesis, recordsmoduletypes.ModuleName, recordsGenesis); err != nil {
+		return err
+	}
+	icacallbacksGenesis := icacallbacksmoduletypes.DefaultGenesis()
+	if err := unmarshalModuleGenesis(cdc, genesis, icacallbacksmoduletypes.ModuleName, icacallbacksGenesis); err != nil {
+		return err
+	}
+	epochsGenesis := epochsmoduletypes.DefaultGenesis()
+	if err := unmarshalModuleGenesis(cdc, genesis, epochsmoduletypes.ModuleName, epochsGenesis); err != nil {
+		return err
+	}
+
+	if err := validateRecordsReferences(*stakeibcGenesis, *recordsGenesis); err != nil {
+		return err
+	}
+	if err := validateCallbackData(*icacallbacksGenesis); err != nil {
+		return err
+	}
+	return validateEpochIdentifiers(*stakeibcGenesis, *epochsGenesis)
+}
+
+// unmarshalModuleGenesis decodes a module's genesis into state, leaving state unchanged if the module isn't present
+func unmarshalModuleGenesis(cdc codec.JSONCodec, genesis GenesisState, moduleName string, state codec.ProtoMarshaler) error {
+	moduleGenesis, found := genesis[moduleName]
+	if !found || len(moduleGenesis) == 0 || string(moduleGenesis) == "null" {
+		return nil
+	}
+	if err := cdc.UnmarshalJSON(json.RawMessage(moduleGenesis), state); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %s", moduleName, err.Error())
+	}
+	return nil
+}
+
+// validateRecordsReferences checks that every deposit record and host zone unbonding references an existing host zone,
+// and that every user redemption record referenced by a host zone unbonding exists
+func validateRecordsReferences(stakeibcGenesis stakeibcmoduletypes.GenesisState, recordsGenesis recordsmoduletypes.GenesisState) error {
+	hostZones := make(map[string]bool)
+	for _, hostZone := range stakeibcGenesis.HostZoneList {
+		hostZones[hostZone.ChainId] = true
+	}
+	userRedemptionRecords := make(map[string]bool)
+	for _, userRedemptionRecord := range recordsGenesis.UserRedemptionRecordList {
+		userRedemptionRecords[userRedemptionRecord.Id] = true
+	}
+
+	for _, depositRecord := range recordsGenesis.DepositRecordList {
+		if !hostZones[depositRecord.HostZoneId] {
+			return fmt.Errorf("deposit record %d references host zone %s, which does not exist", depositRecord.Id, depositRecord.HostZoneId)
+		}
+	}
+
+	for _, epochUnbondingRecord := range recordsGenesis.EpochUnbondingRecordList {
+		for _, hostZoneUnbonding := range epochUnbondingRecord.HostZoneUnbondings {
+			if hostZoneUnbonding == nil {
+				return fmt.Errorf("epoch unbonding record %d has an empty host zone unbonding", epochUnbondingRecord.EpochNumber)
+			}
+			if !hostZones[hostZoneUnbonding.HostZoneId] {
+				return fmt.Errorf("host zone unbonding in epoch unbonding record %d references host zone %s, which does not exist",
+					epochUnbondingRecord.EpochNumber, hostZoneUnbonding.HostZoneId)
+			}
+			for _, userRedemptionRecordId := range hostZoneUnbonding.UserRedemptionRecords {
+				if !userRedemptionRecords[userRedemptionRecordId] {
+					return fmt.Errorf("host zone unbonding for %s in epoch unbonding record %d references user redemption record %s, which does not exist",
+						hostZoneUnbonding.HostZoneId, epochUnbondingRecord.EpochNumber, userRedemptionRecordId)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateCallbackData checks that every callback is registered and that its args decode into the callback's arg type
+func validateCallbackData(icacallbacksGenesis icacallbacksmoduletypes.GenesisState) error {
+	for _, callbackData := range icacallbacksGenesis.CallbackDataList {
+		newCallbackArgs, found := CallbackArgTypes[callbackData.CallbackId]
+		if !found {
+			return fmt.Errorf("callback data %s has unregistered callback id %s", callbackData.CallbackKey, callbackData.CallbackId)
+		}
+		if err := newCallbackArgs().Unmarshal(callbackData.CallbackArgs); err != nil {
+			return fmt.Errorf("callback data %s has invalid args for callback %s: %s", callbackData.CallbackKey, callbackData.CallbackId, err.Error())
+		}
+	}
+	return nil
+}
+
+// validateEpochIdentifiers checks that the epochs driving the stakeibc pipelines are present in the epochs genesis
+func validateEpochIdentifiers(stakeibcGenesis stakeibcmoduletypes.GenesisState, epochsGenesis epochsmoduletypes.GenesisState) error {
+	epochIdentifiers := make(map[string]bool)
+	for _, epoch := range epochsGenesis.Epochs {
+		epochIdentifiers[epoch.Identifier] = true
+	}
+	// the ICA txs and queries that aren't part of a pipeline (e.g. setting the withdrawal address) are timed out by the stride epoch
+	requiredEpochIdentifiers := append(stakeibcGenesis.Params.EpochIdentifiers(), epochsmoduletypes.STRIDE_EPOCH)
+	for _, epochIdentifier := range requiredEpochIdentifiers {
+		if !epochIdentifiers[epochIdentifier] {
+			return fmt.Errorf("epoch %s is required by stakeibc but is missing from the epochs genesis", epochIdentifier)
+		}
+	}
+	return nil
+}
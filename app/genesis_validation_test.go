@@ -0,0 +1,197 @@
+package app_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/app"
+	epochstypes "github.com/Stride-Labs/stride/x/epochs/types"
+	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
+	recordskeeper "github.com/Stride-Labs/stride/x/records/keeper"
+	recordstypes "github.com/Stride-Labs/stride/x/records/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+type crossModuleGenesis struct {
+	stakeibc     *stakeibctypes.GenesisState
+	records      *recordstypes.GenesisState
+	icacallbacks *icacallbackstypes.GenesisState
+	epochs       *epochstypes.GenesisState
+}
+
+// Returns a genesis with a host zone that's referenced by each of the other modules
+func validCrossModuleGenesis(t *testing.T) crossModuleGenesis {
+	stakeibcGenesis := stakeibctypes.DefaultGenesis()
+	stakeibcGenesis.HostZoneList = []stakeibctypes.HostZone{{ChainId: "GAIA"}}
+	stakeibcGenesis.HostZoneCount = 1
+
+	recordsGenesis := recordstypes.DefaultGenesis()
+	recordsGenesis.UserRedemptionRecordList = []recordstypes.UserRedemptionRecord{{Id: "GAIA.1.stride_user", HostZoneId: "GAIA"}}
+	recordsGenesis.UserRedemptionRecordCount = 1
+	recordsGenesis.DepositRecordList = []recordstypes.DepositRecord{{Id: 0, HostZoneId: "GAIA"}}
+	recordsGenesis.DepositRecordCount = 1
+	recordsGenesis.EpochUnbondingRecordList = []recordstypes.EpochUnbondingRecord{{
+		EpochNumber: 1,
+		HostZoneUnbondings: []*recordstypes.HostZoneUnbonding{
+			{HostZoneId: "GAIA", UserRedemptionRecords: []string{"GAIA.1.stride_user"}},
+		},
+	}}
+
+	delegateArgs, err := (&stakeibctypes.DelegateCallback{HostZoneId: "GAIA"}).Marshal()
+	require.NoError(t, err)
+	transferArgs, err := (&recordstypes.TransferCallback{DepositRecordId: 0}).Marshal()
+	require.NoError(t, err)
+	batchArgs, err := (&stakeibctypes.BatchCallback{
+		HostZoneId: "GAIA",
+		Callbacks:  []*stakeibctypes.BatchedCallback{{CallbackId: stakeibckeeper.DELEGATE, CallbackArgs: delegateArgs, NumMsgs: 1}},
+	}).Marshal()
+	require.NoError(t, err)
+
+	icacallbacksGenesis := icacallbackstypes.DefaultGenesis()
+	icacallbacksGenesis.CallbackDataList = []icacallbackstypes.CallbackData{
+		{CallbackKey: "key1", CallbackId: stakeibckeeper.DELEGATE, CallbackArgs: delegateArgs},
+		{CallbackKey: "key2", CallbackId: recordskeeper.TRANSFER, CallbackArgs: transferArgs},
+		{CallbackKey: "key3", CallbackId: stakeibckeeper.BATCH, CallbackArgs: batchArgs},
+	}
+
+	return crossModuleGenesis{
+		stakeibc:     stakeibcGenesis,
+		records:      recordsGenesis,
+		icacallbacks: icacallbacksGenesis,
+		epochs:       epochstypes.DefaultGenesis(),
+	}
+}
+
+func (g crossModuleGenesis) toAppGenesis(cdc codec.JSONCodec) app.GenesisState {
+	genesis := app.NewDefaultGenesisState()
+	genesis[stakeibctypes.ModuleName] = cdc.MustMarshalJSON(g.stakeibc)
+	genesis[recordstypes.ModuleName] = cdc.MustMarshalJSON(g.records)
+	genesis[icacallbackstypes.ModuleName] = cdc.MustMarshalJSON(g.icacallbacks)
+	genesis[epochstypes.ModuleName] = cdc.MustMarshalJSON(g.epochs)
+	return genesis
+}
+
+func TestValidateCrossModuleGenesis(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+
+	testCases := []struct {
+		desc     string
+		malleate func(g *crossModuleGenesis)
+		errMsg   string
+	}{
+		{
+			desc:     "valid genesis",
+			malleate: func(g *crossModuleGenesis) {},
+		},
+		{
+			desc: "deposit record with missing host zone",
+			malleate: func(g *crossModuleGenesis) {
+				g.records.DepositRecordList[0].HostZoneId = "OSMO"
+			},
+			errMsg: "deposit record 0 references host zone OSMO, which does not exist",
+		},
+		{
+			desc: "host zone unbonding with missing host zone",
+			malleate: func(g *crossModuleGenesis) {
+				g.records.EpochUnbondingRecordList[0].HostZoneUnbondings[0].HostZoneId = "OSMO"
+			},
+			errMsg: "host zone unbonding in epoch unbonding record 1 references host zone OSMO, which does not exist",
+		},
+		{
+			desc: "host zone unbonding with missing user redemption record",
+			malleate: func(g *crossModuleGenesis) {
+				g.records.EpochUnbondingRecordList[0].HostZoneUnbondings[0].UserRedemptionRecords = []string{"GAIA.1.stride_user", "GAIA.1.other_user"}
+			},
+			errMsg: "references user redemption record GAIA.1.other_user, which does not exist",
+		},
+		{
+			desc: "unregistered callback id",
+			malleate: func(g *crossModuleGenesis) {
+				g.icacallbacks.CallbackDataList[0].CallbackId = "unknown"
+			},
+			errMsg: "callback data key1 has unregistered callback id unknown",
+		},
+		{
+			desc: "callback args that don't decode",
+			malleate: func(g *crossModuleGenesis) {
+				g.icacallbacks.CallbackDataList[1].CallbackArgs = []byte{0xff, 0xff, 0xff}
+			},
+			errMsg: "callback data key2 has invalid args for callback transfer",
+		},
+		{
+			desc: "missing stride epoch",
+			malleate: func(g *crossModuleGenesis) {
+				epochs := []epochstypes.EpochInfo{}
+				for _, epoch := range g.epochs.Epochs {
+					if epoch.Identifier != epochstypes.STRIDE_EPOCH {
+						epochs = append(epochs, epoch)
+					}
+				}
+				g.epochs.Epochs = epochs
+			},
+			errMsg: "epoch stride_epoch is required by stakeibc but is missing from the epochs genesis",
+		},
+		{
+			desc: "missing stride epoch with no pipeline on it",
+			malleate: func(g *crossModuleGenesis) {
+				params := &g.stakeibc.Params
+				params.DepositEpochIdentifier = epochstypes.DAY_EPOCH
+				params.DelegateEpochIdentifier = epochstypes.DAY_EPOCH
+				params.ReinvestEpochIdentifier = epochstypes.DAY_EPOCH
+				params.RedemptionRateEpochIdentifier = epochstypes.DAY_EPOCH
+				params.RebalanceEpochIdentifier = epochstypes.DAY_EPOCH
+				params.IcaRecoveryEpochIdentifier = epochstypes.DAY_EPOCH
+
+				epochs := []epochstypes.EpochInfo{}
+				for _, epoch := range g.epochs.Epochs {
+					if epoch.Identifier != epochstypes.STRIDE_EPOCH {
+						epochs = append(epochs, epoch)
+					}
+				}
+				g.epochs.Epochs = epochs
+			},
+			errMsg: "epoch stride_epoch is required by stakeibc but is missing from the epochs genesis",
+		},
+		{
+			desc: "missing unbonding epoch",
+			malleate: func(g *crossModuleGenesis) {
+				g.stakeibc.Params.UnbondingEpochIdentifier = "fortnight"
+			},
+			errMsg: "epoch fortnight is required by stakeibc but is missing from the epochs genesis",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			g := validCrossModuleGenesis(t)
+			tc.malleate(&g)
+			err := app.ValidateCrossModuleGenesis(cdc, g.toAppGenesis(cdc))
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func TestValidateCrossModuleGenesis_DefaultGenesis(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+	require.NoError(t, app.ValidateCrossModuleGenesis(cdc, app.NewDefaultGenesisState()))
+}
+
+func TestCallbackArgTypes_RegisteredCallbacks(t *testing.T) {
+	stakeibcCallbacks := stakeibckeeper.Keeper{}.ICACallbackHandler().RegisterICACallbacks()
+	recordsCallbacks := recordskeeper.Keeper{}.ICACallbackHandler().RegisterICACallbacks()
+
+	for callbackId := range app.CallbackArgTypes {
+		registered := stakeibcCallbacks.HasICACallback(callbackId) || recordsCallbacks.HasICACallback(callbackId)
+		require.True(t, registered, "callback %s has an args type but isn't registered", callbackId)
+	}
+	for _, callbackId := range []string{stakeibckeeper.BATCH, stakeibckeeper.WIND_DOWN_SWEEP, recordskeeper.TRANSFER} {
+		require.Contains(t, app.CallbackArgTypes, callbackId, "args type of callback %s", callbackId)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	v2 "github.com/Stride-Labs/stride/app/upgrades/v2"
+	v3 "github.com/Stride-Labs/stride/app/upgrades/v3"
 )
 
 func (app *StrideApp) setupUpgradeHandlers() {
@@ -16,6 +17,12 @@ func (app *StrideApp) setupUpgradeHandlers() {
 		v2.CreateUpgradeHandler(app.mm, app.configurator),
 	)
 
+	// v3 upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v3.UpgradeName,
+		v3.CreateUpgradeHandler(app.mm, app.configurator),
+	)
+
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
 		panic(fmt.Errorf("Failed to read upgrade info from disk: %w", err))
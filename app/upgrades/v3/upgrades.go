@@ -0,0 +1,24 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+const (
+	UpgradeName = "v3"
+)
+
+// CreateUpgradeHandler creates an SDK upgrade handler for v3
+// The stakeibc migrations remove the stored epoch trackers, which are now derived from x/epochs,
+// and convert the staked balances, delegation amounts, and callback args to sdk.Int.
+// The records migrations build the secondary indexes of the records and convert the record amounts to sdk.Int
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return mm.RunMigrations(ctx, configurator, vm)
+	}
+}
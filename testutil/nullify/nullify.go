@@ -11,6 +11,7 @@ import (
 var (
 	coinType  = reflect.TypeOf(sdk.Coin{})
 	coinsType = reflect.TypeOf(sdk.Coins{})
+	intType   = reflect.TypeOf(sdk.Int{})
 )
 
 // Fill analyze all struct fields and slices with
@@ -45,6 +46,10 @@ func Fill(x interface{}) interface{} {
 					coins := reflect.New(coinsType).Interface()
 					s := reflect.ValueOf(coins).Elem()
 					f.Set(s)
+				case intType:
+					if f.Interface().(sdk.Int).IsNil() {
+						f.Set(reflect.ValueOf(sdk.ZeroInt()))
+					}
 				default:
 					objPt := reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Interface()
 					s := Fill(objPt)
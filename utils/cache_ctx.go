@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ApplyFuncIfNoError runs f with a cached context and only commits its state changes and events if f succeeds
+// If f returns an error or panics, everything it wrote is discarded and the error is returned
+// Out of gas panics are re-raised so that the sdk's gas accounting still applies
+// Loops over host zones or channels wrap each iteration in it, so that one failure doesn't block the others
+func ApplyFuncIfNoError(ctx sdk.Context, f func(ctx sdk.Context) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, isOutOfGas := r.(sdk.ErrorOutOfGas); isOutOfGas {
+				panic(r)
+			}
+			err = fmt.Errorf("recovered from panic: %v", r)
+		}
+	}()
+
+	cacheCtx, write := ctx.CacheContext()
+	if err = f(cacheCtx); err != nil {
+		return err
+	}
+	write()
+	// the cached context has its own event manager, so the events need to be passed up to the parent
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 
@@ -51,7 +52,7 @@ func HostZoneUnbondingKeys(m map[string]*recordstypes.HostZoneUnbonding) []strin
 	return keys
 }
 
-func StringToIntMapKeys(m map[string]int64) []string {
+func StringToIntMapKeys(m map[string]sdk.Int) []string {
 	keys := make([]string, 0, len(m))
 	for k := range m {
 		keys = append(keys, k)
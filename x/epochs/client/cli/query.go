@@ -30,6 +30,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdEpochsInfos(),
 		GetCmdCurrentEpoch(),
 		GetCmdSecondsRemaining(),
+		GetCmdUpcomingEpochs(),
 	)
 
 	return cmd
@@ -157,3 +158,36 @@ func GetCmdSecondsRemaining() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdUpcomingEpochs provides the next start time and estimated start height of every epoch
+func GetCmdUpcomingEpochs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upcoming-epochs",
+		Short: "Query the next start time and estimated start height of every epoch",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query epochs upcoming-epochs`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.UpcomingEpochs(cmd.Context(), &types.QueryUpcomingEpochsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,168 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/x/epochs/types"
+)
+
+const FlagStartTime = "start-time"
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdAddEpoch(),
+		CmdUpdateEpochDuration(),
+		CmdPauseEpoch(),
+		CmdResumeEpoch(),
+		CmdAdvanceEpoch(),
+	)
+
+	return cmd
+}
+
+// broadcastEpochMsg validates and broadcasts a msg built from the sender's address
+func broadcastEpochMsg(cmd *cobra.Command, newMsg func(creator string) sdk.Msg) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	msg := newMsg(clientCtx.GetFromAddress().String())
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+func CmdAddEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-epoch [identifier] [duration]",
+		Short: "Broadcast message add-epoch",
+		Long: `Adds a new epoch type with the given duration (e.g. 1h). The epoch starts counting at the
+first block after --start-time (RFC3339), or at the next block if it's not provided.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argIdentifier := args[0]
+			argDuration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			startTime := time.Time{}
+			startTimeString, err := cmd.Flags().GetString(FlagStartTime)
+			if err != nil {
+				return err
+			}
+			if startTimeString != "" {
+				startTime, err = time.Parse(time.RFC3339, startTimeString)
+				if err != nil {
+					return err
+				}
+			}
+
+			return broadcastEpochMsg(cmd, func(creator string) sdk.Msg {
+				return types.NewMsgAddEpoch(creator, argIdentifier, argDuration, startTime)
+			})
+		},
+	}
+
+	cmd.Flags().String(FlagStartTime, "", "time the epoch starts counting from, in RFC3339 format")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUpdateEpochDuration() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-epoch-duration [identifier] [duration]",
+		Short: "Broadcast message update-epoch-duration",
+		Long:  `Changes an epoch's duration (e.g. 1h), starting from its next epoch boundary.`,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argIdentifier := args[0]
+			argDuration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			return broadcastEpochMsg(cmd, func(creator string) sdk.Msg {
+				return types.NewMsgUpdateEpochDuration(creator, argIdentifier, argDuration)
+			})
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPauseEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-epoch [identifier]",
+		Short: "Broadcast message pause-epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argIdentifier := args[0]
+			return broadcastEpochMsg(cmd, func(creator string) sdk.Msg {
+				return types.NewMsgPauseEpoch(creator, argIdentifier)
+			})
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdResumeEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-epoch [identifier]",
+		Short: "Broadcast message resume-epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argIdentifier := args[0]
+			return broadcastEpochMsg(cmd, func(creator string) sdk.Msg {
+				return types.NewMsgResumeEpoch(creator, argIdentifier)
+			})
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdAdvanceEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "advance-epoch [identifier]",
+		Short: "Broadcast message advance-epoch",
+		Long:  `Ends the current epoch at the next block and starts the next one. Not available in mainnet builds.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argIdentifier := args[0]
+			return broadcastEpochMsg(cmd, func(creator string) sdk.Msg {
+				return types.NewMsgAdvanceEpoch(creator, argIdentifier)
+			})
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -15,6 +15,11 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 	logger := k.Logger(ctx)
 	k.IterateEpochInfo(ctx, func(_ int64, epochInfo types.EpochInfo) (stop bool) {
+		// Paused epochs only advance when they're resumed or manually advanced
+		if epochInfo.Paused {
+			return false
+		}
+
 		// Has it not started, and is the block time > initial epoch start time
 		shouldInitialEpochStart := !epochInfo.EpochCountingStarted && !epochInfo.StartTime.After(ctx.BlockTime())
 
@@ -27,35 +32,68 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 		case shouldInitialEpochStart:
 			epochInfo = startInitialEpoch(epochInfo)
 			logger.Info("starting epoch", "identifier", epochInfo.Identifier)
+			k.startEpoch(ctx, epochInfo)
 		case shouldEpochStart:
 			epochInfo = endEpoch(epochInfo)
 			logger.Info("ending epoch", "identifier", epochInfo.Identifier)
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(
-					types.EventTypeEpochEnd,
-					sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochInfo.CurrentEpoch, 10)),
-				),
-			)
-			k.AfterEpochEnd(ctx, epochInfo)
-		default:
-			// continue
-			return false
+			k.endAndStartEpoch(ctx, epochInfo)
 		}
 
-		k.SetEpochInfo(ctx, epochInfo)
+		return false
+	})
+}
 
-		k.BeforeEpochStart(ctx, epochInfo)
+// ForceAdvanceEpoch ends the current epoch immediately and starts the next one at the current block,
+// running the same hooks as a scheduled epoch boundary
+func (k Keeper) ForceAdvanceEpoch(ctx sdk.Context, epochInfo types.EpochInfo) types.EpochInfo {
+	epochInfo.CurrentEpochStartHeight = ctx.BlockHeight()
 
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeEpochStart,
-				sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochInfo.CurrentEpoch, 10)),
-				sdk.NewAttribute(types.AttributeEpochStartTime, strconv.FormatInt(epochInfo.CurrentEpochStartTime.Unix(), 10)),
-			),
-		)
+	// an epoch that hasn't started counting yet is started instead
+	if !epochInfo.EpochCountingStarted {
+		epochInfo = startInitialEpoch(epochInfo)
+		epochInfo.CurrentEpochStartTime = ctx.BlockTime()
+		k.startEpoch(ctx, epochInfo)
+		return epochInfo
+	}
 
-		return false
-	})
+	epochInfo.CurrentEpoch++
+	epochInfo.CurrentEpochStartTime = ctx.BlockTime()
+	epochInfo = applyPendingDuration(epochInfo)
+	// the new epoch hasn't spent any time paused yet
+	if epochInfo.Paused {
+		epochInfo.PausedTime = ctx.BlockTime()
+	}
+	k.endAndStartEpoch(ctx, epochInfo)
+	return epochInfo
+}
+
+// endAndStartEpoch runs the hooks and emits the events for the end of the previous epoch
+// and the start of the epoch in epochInfo
+func (k Keeper) endAndStartEpoch(ctx sdk.Context, epochInfo types.EpochInfo) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochEnd,
+			sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochInfo.CurrentEpoch, 10)),
+		),
+	)
+	k.AfterEpochEnd(ctx, epochInfo)
+
+	k.startEpoch(ctx, epochInfo)
+}
+
+// startEpoch stores the new epoch, then runs the hooks and emits the events for its start
+func (k Keeper) startEpoch(ctx sdk.Context, epochInfo types.EpochInfo) {
+	k.SetEpochInfo(ctx, epochInfo)
+
+	k.BeforeEpochStart(ctx, epochInfo)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochStart,
+			sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochInfo.CurrentEpoch, 10)),
+			sdk.NewAttribute(types.AttributeEpochStartTime, strconv.FormatInt(epochInfo.CurrentEpochStartTime.Unix(), 10)),
+		),
+	)
 }
 
 func startInitialEpoch(epochInfo types.EpochInfo) types.EpochInfo {
@@ -68,5 +106,14 @@ func startInitialEpoch(epochInfo types.EpochInfo) types.EpochInfo {
 func endEpoch(epochInfo types.EpochInfo) types.EpochInfo {
 	epochInfo.CurrentEpoch++
 	epochInfo.CurrentEpochStartTime = epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
+	return applyPendingDuration(epochInfo)
+}
+
+// applyPendingDuration switches to the pending duration, if there is one, at an epoch boundary
+func applyPendingDuration(epochInfo types.EpochInfo) types.EpochInfo {
+	if epochInfo.PendingDuration > 0 {
+		epochInfo.Duration = epochInfo.PendingDuration
+		epochInfo.PendingDuration = 0
+	}
 	return epochInfo
 }
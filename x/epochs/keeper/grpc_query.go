@@ -75,3 +75,19 @@ func (k Keeper) EpochInfo(c context.Context, req *types.QueryEpochInfoRequest) (
 		Epoch: info,
 	}, nil
 }
+
+// UpcomingEpochs provides the next start time and estimated start height of every epoch
+func (k Keeper) UpcomingEpochs(c context.Context, req *types.QueryUpcomingEpochsRequest) (*types.QueryUpcomingEpochsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	upcomingEpochs, blockTime := k.GetUpcomingEpochs(ctx)
+
+	return &types.QueryUpcomingEpochsResponse{
+		UpcomingEpochs:     upcomingEpochs,
+		EstimatedBlockTime: blockTime,
+	}, nil
+}
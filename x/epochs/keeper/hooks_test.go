@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/x/epochs/keeper"
+	"github.com/Stride-Labs/stride/x/epochs/types"
+)
+
+// testEpochHook stores an epoch info with its marker identifier each time it's called, and panics afterwards if configured to
+type testEpochHook struct {
+	keeper      *keeper.Keeper
+	marker      string
+	shouldPanic bool
+}
+
+func (h testEpochHook) run(ctx sdk.Context) {
+	h.keeper.SetEpochInfo(ctx, types.EpochInfo{Identifier: h.marker, Duration: time.Hour})
+	if h.shouldPanic {
+		panic("hook failure")
+	}
+}
+
+func (h testEpochHook) AfterEpochEnd(ctx sdk.Context, epochInfo types.EpochInfo)    { h.run(ctx) }
+func (h testEpochHook) BeforeEpochStart(ctx sdk.Context, epochInfo types.EpochInfo) { h.run(ctx) }
+
+func (suite *KeeperTestSuite) TestMultiEpochHooks_FailingHookIsolated() {
+	epochInfo := types.EpochInfo{Identifier: "day", CurrentEpoch: 3}
+
+	for _, method := range []string{types.AttributeValueAfterEpochEnd, types.AttributeValueBeforeEpochStart} {
+		suite.Run(method, func() {
+			suite.SetupTest()
+			ctx := suite.Ctx().WithEventManager(sdk.NewEventManager())
+			epochsKeeper := &suite.App.EpochsKeeper
+
+			hooks := types.NewMultiEpochHooks(
+				testEpochHook{keeper: epochsKeeper, marker: "failing", shouldPanic: true},
+				testEpochHook{keeper: epochsKeeper, marker: "succeeding"},
+			)
+			suite.Require().NotPanics(func() {
+				if method == types.AttributeValueAfterEpochEnd {
+					hooks.AfterEpochEnd(ctx, epochInfo)
+				} else {
+					hooks.BeforeEpochStart(ctx, epochInfo)
+				}
+			})
+
+			// the failing hook's writes should be discarded, while the next hook's are committed
+			_, found := epochsKeeper.GetEpochInfo(ctx, "failing")
+			suite.Require().False(found, "failing hook state discarded")
+			_, found = epochsKeeper.GetEpochInfo(ctx, "succeeding")
+			suite.Require().True(found, "succeeding hook state committed")
+
+			// a single failure event should be emitted for the failing hook
+			failureEvents := []sdk.Event{}
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeEpochHookFailed {
+					failureEvents = append(failureEvents, event)
+				}
+			}
+			suite.Require().Len(failureEvents, 1, "number of failure events")
+
+			attributes := map[string]string{}
+			for _, attribute := range failureEvents[0].Attributes {
+				attributes[string(attribute.Key)] = string(attribute.Value)
+			}
+			suite.Require().Equal("keeper_test.testEpochHook", attributes[types.AttributeHook], "hook")
+			suite.Require().Equal(method, attributes[types.AttributeHookMethod], "hook method")
+			suite.Require().Equal("day", attributes[types.AttributeEpochIdentifier], "epoch identifier")
+			suite.Require().Equal("3", attributes[types.AttributeEpochNumber], "epoch number")
+			suite.Require().Contains(attributes[types.AttributeError], "hook failure", "error")
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/x/epochs/types"
+)
+
+// AddEpoch registers a new epoch type, so that new epochs don't require an upgrade
+func (k msgServer) AddEpoch(goCtx context.Context, msg *types.MsgAddEpoch) (*types.MsgAddEpochResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetEpochInfo(ctx, msg.Identifier); found {
+		errMsg := fmt.Sprintf("epoch %s already exists", msg.Identifier)
+		k.Logger(ctx).Error(errMsg)
+		return nil, sdkerrors.Wrapf(types.ErrEpochAlreadyExists, errMsg)
+	}
+
+	// as in genesis, an epoch without a start time starts at the current block
+	startTime := msg.StartTime
+	if startTime.Equal(time.Time{}) {
+		startTime = ctx.BlockTime()
+	}
+
+	k.SetEpochInfo(ctx, types.EpochInfo{
+		Identifier:              msg.Identifier,
+		StartTime:               startTime,
+		Duration:                msg.Duration,
+		CurrentEpoch:            0,
+		CurrentEpochStartHeight: ctx.BlockHeight(),
+		CurrentEpochStartTime:   time.Time{},
+		EpochCountingStarted:    false,
+	})
+	k.Logger(ctx).Info(fmt.Sprintf("Added epoch %s with duration %s starting at %s", msg.Identifier, msg.Duration, startTime))
+
+	return &types.MsgAddEpochResponse{}, nil
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/x/epochs/types"
+)
+
+// AdvanceEpoch forces an epoch to end at the current block, which is only permitted in non-mainnet builds
+// A paused epoch can still be advanced, which allows stepping through epochs one at a time
+func (k msgServer) AdvanceEpoch(goCtx context.Context, msg *types.MsgAdvanceEpoch) (*types.MsgAdvanceEpochResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !types.ManualAdvanceEnabled {
+		errMsg := fmt.Sprintf("unable to advance epoch %s", msg.Identifier)
+		k.Logger(ctx).Error(errMsg)
+		return nil, sdkerrors.Wrapf(types.ErrManualAdvanceDisabled, errMsg)
+	}
+
+	epochInfo, found := k.GetEpochInfo(ctx, msg.Identifier)
+	if !found {
+		errMsg := fmt.Sprintf("epoch %s not found", msg.Identifier)
+		k.Logger(ctx).Error(errMsg)
+		return nil, sdkerrors.Wrapf(types.ErrEpochNotFound, errMsg)
+	}
+
+	epochInfo = k.ForceAdvanceEpoch(ctx, epochInfo)
+	k.Logger(ctx).Info(fmt.Sprintf("Manually advanced epoch %s to epoch number %d", msg.Identifier, epochInfo.CurrentEpoch))
+
+	return &types.MsgAdvanceEpochResponse{}, nil
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/x/epochs/types"
+)
+
+// PauseEpoch stops an epoch from advancing, and with it the hooks that run at its boundaries
+func (k msgServer) PauseEpoch(goCtx context.Context, msg *types.MsgPauseEpoch) (*types.MsgPauseEpochResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	epochInfo, found := k.GetEpochInfo(ctx, msg.Identifier)
+	if !found {
+		errMsg := fmt.Sprintf("epoch %s not found", msg.Identifier)
+		k.Logger(ctx).Error(errMsg)
+		return nil, sdkerrors.Wrapf(types.ErrEpochNotFound, errMsg)
+	}
+	if epochInfo.Paused {
+		errMsg := fmt.Sprintf("epoch %s is already paused", msg.Identifier)
+		k.Logger(ctx).Error(errMsg)
+		return nil, sdkerrors.Wrapf(types.ErrEpochPaused, errMsg)
+	}
+
+	epochInfo.Paused = true
+	epochInfo.PausedTime = ctx.BlockTime()
+	k.SetEpochInfo(ctx, epochInfo)
+	k.Logger(ctx).Info(fmt.Sprintf("Paused epoch %s at epoch number %d", msg.Identifier, epochInfo.CurrentEpoch))
+
+	return &types.MsgPauseEpochResponse{}, nil
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/x/epochs/types"
+)
+
+// ResumeEpoch lets a paused epoch advance again
+// The current epoch is extended by the time spent paused, so that resuming doesn't trigger a burst of
+// epochs catching up to the block time
+func (k msgServer) ResumeEpoch(goCtx context.Context, msg *types.MsgResumeEpoch) (*types.MsgResumeEpochResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	epochInfo, found := k.GetEpochInfo(ctx, msg.Identifier)
+	if !found {
+		errMsg := fmt.Sprintf("epoch %s not found", msg.Identifier)
+		k.Logger(ctx).Error(errMsg)
+		return nil, sdkerrors.Wrapf(types.ErrEpochNotFound, errMsg)
+	}
+	if !epochInfo.Paused {
+		errMsg := fmt.Sprintf("epoch %s is not paused", msg.Identifier)
+		k.Logger(ctx).Error(errMsg)
+		return nil, sdkerrors.Wrapf(types.ErrEpochNotPaused, errMsg)
+	}
+
+	if epochInfo.EpochCountingStarted {
+		timePaused := ctx.BlockTime().Sub(epochInfo.PausedTime)
+		epochInfo.CurrentEpochStartTime = epochInfo.CurrentEpochStartTime.Add(timePaused)
+	}
+	epochInfo.Paused = false
+	epochInfo.PausedTime = time.Time{}
+	k.SetEpochInfo(ctx, epochInfo)
+	k.Logger(ctx).Info(fmt.Sprintf("Resumed epoch %s at epoch number %d", msg.Identifier, epochInfo.CurrentEpoch))
+
+	return &types.MsgResumeEpochResponse{}, nil
+}
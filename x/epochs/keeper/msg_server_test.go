@@ -0,0 +1,234 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/x/epochs/keeper"
+	"github.com/Stride-Labs/stride/x/epochs/types"
+)
+
+const (
+	testEpochAdmin      = "stride1k8c2m5cn322akk5wy8lpt87dd2f4yh9azg7jlh"
+	testEpochIdentifier = "hour"
+)
+
+type EpochMsgTestCase struct {
+	ctx       sdk.Context
+	msgServer types.MsgServer
+	startTime time.Time
+}
+
+// Adds an hourly epoch that starts counting in the first block
+func (suite *KeeperTestSuite) SetupEpochMsgs() EpochMsgTestCase {
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := suite.Ctx().WithBlockHeight(1).WithBlockTime(startTime)
+	msgServer := keeper.NewMsgServerImpl(suite.App.EpochsKeeper)
+
+	_, err := msgServer.AddEpoch(sdk.WrapSDKContext(ctx), types.NewMsgAddEpoch(testEpochAdmin, testEpochIdentifier, time.Hour, time.Time{}))
+	suite.Require().NoError(err, "no error expected when adding epoch")
+
+	suite.App.EpochsKeeper.BeginBlocker(ctx)
+	epochInfo, found := suite.App.EpochsKeeper.GetEpochInfo(ctx, testEpochIdentifier)
+	suite.Require().True(found, "epoch found")
+	suite.Require().True(epochInfo.EpochCountingStarted, "epoch counting started")
+	suite.Require().Equal(int64(1), epochInfo.CurrentEpoch, "initial epoch number")
+
+	return EpochMsgTestCase{
+		ctx:       ctx,
+		msgServer: msgServer,
+		startTime: startTime,
+	}
+}
+
+// Runs the BeginBlocker at the given time and returns the updated epoch
+func (suite *KeeperTestSuite) beginBlockAt(ctx sdk.Context, blockTime time.Time) types.EpochInfo {
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(blockTime)
+	suite.App.EpochsKeeper.BeginBlocker(ctx)
+	epochInfo, found := suite.App.EpochsKeeper.GetEpochInfo(ctx, testEpochIdentifier)
+	suite.Require().True(found, "epoch found")
+	return epochInfo
+}
+
+func (suite *KeeperTestSuite) TestAddEpoch_AlreadyExists() {
+	tc := suite.SetupEpochMsgs()
+
+	msg := types.NewMsgAddEpoch(testEpochAdmin, testEpochIdentifier, time.Minute, time.Time{})
+	_, err := tc.msgServer.AddEpoch(sdk.WrapSDKContext(tc.ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrEpochAlreadyExists)
+}
+
+func (suite *KeeperTestSuite) TestUpdateEpochDuration_AppliedAtNextBoundary() {
+	tc := suite.SetupEpochMsgs()
+
+	msg := types.NewMsgUpdateEpochDuration(testEpochAdmin, testEpochIdentifier, 2*time.Hour)
+	_, err := tc.msgServer.UpdateEpochDuration(sdk.WrapSDKContext(tc.ctx), msg)
+	suite.Require().NoError(err)
+
+	// the current epoch still ends after an hour
+	epochInfo := suite.beginBlockAt(tc.ctx, tc.startTime.Add(time.Hour+time.Second))
+	suite.Require().Equal(int64(2), epochInfo.CurrentEpoch, "epoch advanced with the old duration")
+	suite.Require().Equal(tc.startTime.Add(time.Hour), epochInfo.CurrentEpochStartTime, "epoch start time")
+	suite.Require().Equal(2*time.Hour, epochInfo.Duration, "new duration applied")
+	suite.Require().Equal(time.Duration(0), epochInfo.PendingDuration, "pending duration cleared")
+
+	// the next epoch lasts two hours
+	epochInfo = suite.beginBlockAt(tc.ctx, tc.startTime.Add(2*time.Hour+time.Second))
+	suite.Require().Equal(int64(2), epochInfo.CurrentEpoch, "epoch not advanced after one hour")
+	epochInfo = suite.beginBlockAt(tc.ctx, tc.startTime.Add(3*time.Hour+time.Second))
+	suite.Require().Equal(int64(3), epochInfo.CurrentEpoch, "epoch advanced after two hours")
+}
+
+func (suite *KeeperTestSuite) TestUpdateEpochDuration_NotStarted() {
+	tc := suite.SetupEpochMsgs()
+
+	// an epoch that hasn't started counting takes the new duration immediately
+	futureStart := tc.startTime.Add(24 * time.Hour)
+	_, err := tc.msgServer.AddEpoch(sdk.WrapSDKContext(tc.ctx), types.NewMsgAddEpoch(testEpochAdmin, "future", time.Hour, futureStart))
+	suite.Require().NoError(err)
+
+	_, err = tc.msgServer.UpdateEpochDuration(sdk.WrapSDKContext(tc.ctx), types.NewMsgUpdateEpochDuration(testEpochAdmin, "future", time.Minute))
+	suite.Require().NoError(err)
+
+	epochInfo, found := suite.App.EpochsKeeper.GetEpochInfo(tc.ctx, "future")
+	suite.Require().True(found, "epoch found")
+	suite.Require().Equal(time.Minute, epochInfo.Duration, "duration")
+	suite.Require().Equal(time.Duration(0), epochInfo.PendingDuration, "pending duration")
+	suite.Require().Equal(futureStart, epochInfo.StartTime, "start time")
+}
+
+func (suite *KeeperTestSuite) TestUpdateEpochDuration_NotFound() {
+	tc := suite.SetupEpochMsgs()
+
+	msg := types.NewMsgUpdateEpochDuration(testEpochAdmin, "fake_epoch", time.Hour)
+	_, err := tc.msgServer.UpdateEpochDuration(sdk.WrapSDKContext(tc.ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrEpochNotFound)
+}
+
+func (suite *KeeperTestSuite) TestPauseAndResumeEpoch() {
+	tc := suite.SetupEpochMsgs()
+
+	// pause 30 minutes into the first epoch
+	pauseCtx := tc.ctx.WithBlockTime(tc.startTime.Add(30 * time.Minute))
+	_, err := tc.msgServer.PauseEpoch(sdk.WrapSDKContext(pauseCtx), types.NewMsgPauseEpoch(testEpochAdmin, testEpochIdentifier))
+	suite.Require().NoError(err)
+
+	_, err = tc.msgServer.PauseEpoch(sdk.WrapSDKContext(pauseCtx), types.NewMsgPauseEpoch(testEpochAdmin, testEpochIdentifier))
+	suite.Require().ErrorIs(err, types.ErrEpochPaused, "pausing twice")
+
+	// the epoch doesn't advance while paused
+	epochInfo := suite.beginBlockAt(tc.ctx, tc.startTime.Add(5*time.Hour))
+	suite.Require().Equal(int64(1), epochInfo.CurrentEpoch, "epoch not advanced while paused")
+
+	// resume 5 hours in, the epoch should have 30 minutes left
+	resumeCtx := tc.ctx.WithBlockTime(tc.startTime.Add(5 * time.Hour))
+	_, err = tc.msgServer.ResumeEpoch(sdk.WrapSDKContext(resumeCtx), types.NewMsgResumeEpoch(testEpochAdmin, testEpochIdentifier))
+	suite.Require().NoError(err)
+
+	epochInfo, _ = suite.App.EpochsKeeper.GetEpochInfo(tc.ctx, testEpochIdentifier)
+	suite.Require().False(epochInfo.Paused, "epoch unpaused")
+	suite.Require().Equal(tc.startTime.Add(4*time.Hour+30*time.Minute), epochInfo.CurrentEpochStartTime, "start time extended by the time paused")
+
+	epochInfo = suite.beginBlockAt(tc.ctx, tc.startTime.Add(5*time.Hour+20*time.Minute))
+	suite.Require().Equal(int64(1), epochInfo.CurrentEpoch, "epoch not advanced before the remaining time")
+	epochInfo = suite.beginBlockAt(tc.ctx, tc.startTime.Add(5*time.Hour+31*time.Minute))
+	suite.Require().Equal(int64(2), epochInfo.CurrentEpoch, "epoch advanced after the remaining time")
+
+	_, err = tc.msgServer.ResumeEpoch(sdk.WrapSDKContext(resumeCtx), types.NewMsgResumeEpoch(testEpochAdmin, testEpochIdentifier))
+	suite.Require().ErrorIs(err, types.ErrEpochNotPaused, "resuming an unpaused epoch")
+}
+
+func (suite *KeeperTestSuite) TestAdvanceEpoch() {
+	if !types.ManualAdvanceEnabled {
+		suite.T().Skip("manual epoch advance is only enabled in testnet builds")
+	}
+	tc := suite.SetupEpochMsgs()
+
+	advanceTime := tc.startTime.Add(10 * time.Minute)
+	ctx := tc.ctx.WithBlockHeight(5).WithBlockTime(advanceTime).WithEventManager(sdk.NewEventManager())
+	_, err := tc.msgServer.AdvanceEpoch(sdk.WrapSDKContext(ctx), types.NewMsgAdvanceEpoch(testEpochAdmin, testEpochIdentifier))
+	suite.Require().NoError(err)
+
+	epochInfo, _ := suite.App.EpochsKeeper.GetEpochInfo(ctx, testEpochIdentifier)
+	suite.Require().Equal(int64(2), epochInfo.CurrentEpoch, "epoch number")
+	suite.Require().Equal(advanceTime, epochInfo.CurrentEpochStartTime, "epoch start time")
+	suite.Require().Equal(int64(5), epochInfo.CurrentEpochStartHeight, "epoch start height")
+
+	eventTypes := []string{}
+	for _, event := range ctx.EventManager().Events() {
+		eventTypes = append(eventTypes, event.Type)
+	}
+	suite.Require().Contains(eventTypes, types.EventTypeEpochEnd, "epoch end event")
+	suite.Require().Contains(eventTypes, types.EventTypeEpochStart, "epoch start event")
+
+	// the next epoch lasts a full hour from the advance
+	epochInfo = suite.beginBlockAt(tc.ctx, advanceTime.Add(59*time.Minute))
+	suite.Require().Equal(int64(2), epochInfo.CurrentEpoch, "epoch not advanced before the duration")
+	epochInfo = suite.beginBlockAt(tc.ctx, advanceTime.Add(61*time.Minute))
+	suite.Require().Equal(int64(3), epochInfo.CurrentEpoch, "epoch advanced after the duration")
+}
+
+func (suite *KeeperTestSuite) TestAdvanceEpoch_Paused() {
+	if !types.ManualAdvanceEnabled {
+		suite.T().Skip("manual epoch advance is only enabled in testnet builds")
+	}
+	tc := suite.SetupEpochMsgs()
+
+	_, err := tc.msgServer.PauseEpoch(sdk.WrapSDKContext(tc.ctx), types.NewMsgPauseEpoch(testEpochAdmin, testEpochIdentifier))
+	suite.Require().NoError(err)
+
+	// a paused epoch can be stepped manually
+	advanceTime := tc.startTime.Add(3 * time.Hour)
+	ctx := tc.ctx.WithBlockTime(advanceTime)
+	for i := 0; i < 2; i++ {
+		_, err = tc.msgServer.AdvanceEpoch(sdk.WrapSDKContext(ctx), types.NewMsgAdvanceEpoch(testEpochAdmin, testEpochIdentifier))
+		suite.Require().NoError(err)
+	}
+
+	epochInfo, _ := suite.App.EpochsKeeper.GetEpochInfo(ctx, testEpochIdentifier)
+	suite.Require().Equal(int64(3), epochInfo.CurrentEpoch, "epoch number")
+	suite.Require().True(epochInfo.Paused, "epoch still paused")
+	suite.Require().Equal(advanceTime, epochInfo.PausedTime, "paused time reset to the advance")
+}
+
+func (suite *KeeperTestSuite) TestAdvanceEpoch_Disabled() {
+	if types.ManualAdvanceEnabled {
+		suite.T().Skip("manual epoch advance is enabled in testnet builds")
+	}
+	tc := suite.SetupEpochMsgs()
+
+	_, err := tc.msgServer.AdvanceEpoch(sdk.WrapSDKContext(tc.ctx), types.NewMsgAdvanceEpoch(testEpochAdmin, testEpochIdentifier))
+	suite.Require().ErrorIs(err, types.ErrManualAdvanceDisabled)
+}
+
+func (suite *KeeperTestSuite) TestEpochMsgs_ValidateBasic() {
+	nonAdmin := suite.TestAccs[0].String()
+
+	testCases := []struct {
+		name string
+		msg  sdk.Msg
+		err  error
+	}{
+		{"valid add epoch", types.NewMsgAddEpoch(testEpochAdmin, "hour", time.Hour, time.Time{}), nil},
+		{"add epoch non-admin", types.NewMsgAddEpoch(nonAdmin, "hour", time.Hour, time.Time{}), sdkerrors.ErrInvalidAddress},
+		{"add epoch blank identifier", types.NewMsgAddEpoch(testEpochAdmin, " ", time.Hour, time.Time{}), types.ErrInvalidEpochIdentifier},
+		{"add epoch zero duration", types.NewMsgAddEpoch(testEpochAdmin, "hour", 0, time.Time{}), types.ErrInvalidEpochDuration},
+		{"update duration negative", types.NewMsgUpdateEpochDuration(testEpochAdmin, "hour", -time.Hour), types.ErrInvalidEpochDuration},
+		{"valid pause", types.NewMsgPauseEpoch(testEpochAdmin, "hour"), nil},
+		{"resume non-admin", types.NewMsgResumeEpoch(nonAdmin, "hour"), sdkerrors.ErrInvalidAddress},
+		{"advance blank identifier", types.NewMsgAdvanceEpoch(testEpochAdmin, ""), types.ErrInvalidEpochIdentifier},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.err == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/x/epochs/types"
+)
+
+// UpdateEpochDuration changes an epoch's duration starting from its next boundary,
+// so that the current epoch keeps the length it started with
+func (k msgServer) UpdateEpochDuration(goCtx context.Context, msg *types.MsgUpdateEpochDuration) (*types.MsgUpdateEpochDurationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	epochInfo, found := k.GetEpochInfo(ctx, msg.Identifier)
+	if !found {
+		errMsg := fmt.Sprintf("epoch %s not found", msg.Identifier)
+		k.Logger(ctx).Error(errMsg)
+		return nil, sdkerrors.Wrapf(types.ErrEpochNotFound, errMsg)
+	}
+
+	// an epoch that hasn't started counting has no current epoch to finish, so the duration can be applied directly
+	if epochInfo.EpochCountingStarted {
+		epochInfo.PendingDuration = msg.Duration
+	} else {
+		epochInfo.Duration = msg.Duration
+		epochInfo.PendingDuration = 0
+	}
+	k.SetEpochInfo(ctx, epochInfo)
+	k.Logger(ctx).Info(fmt.Sprintf("Updated duration of epoch %s to %s", msg.Identifier, msg.Duration))
+
+	return &types.MsgUpdateEpochDurationResponse{}, nil
+}
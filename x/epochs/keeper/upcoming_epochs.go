@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/x/epochs/types"
+)
+
+// EstimateBlockTime estimates the average block time over the running epoch that started the most blocks ago
+// Falls back to the default block time if no epoch has been running for long enough to measure it
+func (k Keeper) EstimateBlockTime(ctx sdk.Context) time.Duration {
+	blockTime := types.DefaultEstimatedBlockTime
+	longestBlocksElapsed := int64(0)
+	for _, epochInfo := range k.AllEpochInfos(ctx) {
+		// paused and resumed epochs have a shifted start time, so only running epochs give a fair measurement
+		if !epochInfo.EpochCountingStarted || epochInfo.Paused {
+			continue
+		}
+		blocksElapsed := ctx.BlockHeight() - epochInfo.CurrentEpochStartHeight
+		timeElapsed := ctx.BlockTime().Sub(epochInfo.CurrentEpochStartTime)
+		if blocksElapsed <= longestBlocksElapsed || timeElapsed <= 0 {
+			continue
+		}
+		longestBlocksElapsed = blocksElapsed
+		blockTime = timeElapsed / time.Duration(blocksElapsed)
+	}
+	return blockTime
+}
+
+// GetUpcomingEpoch returns when the next epoch of epochInfo starts, with the start height estimated from the block time
+func (k Keeper) GetUpcomingEpoch(ctx sdk.Context, epochInfo types.EpochInfo, blockTime time.Duration) types.UpcomingEpoch {
+	upcomingEpoch := types.UpcomingEpoch{
+		Identifier: epochInfo.Identifier,
+		NextEpoch:  epochInfo.CurrentEpoch + 1,
+		Paused:     epochInfo.Paused,
+	}
+	if epochInfo.Paused {
+		return upcomingEpoch
+	}
+
+	// the first epoch starts at the start time, and each epoch after that starts a duration after the previous one
+	// (a pending duration change only applies to the epoch after next)
+	nextEpochStartTime := epochInfo.StartTime
+	if epochInfo.EpochCountingStarted {
+		nextEpochStartTime = epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
+	}
+	upcomingEpoch.NextEpochStartTime = nextEpochStartTime
+
+	// the epoch starts in the first block after the start time, which is the next block if it's already passed
+	blocksRemaining := int64(1)
+	if nextEpochStartTime.After(ctx.BlockTime()) && blockTime > 0 {
+		blocksRemaining += int64(nextEpochStartTime.Sub(ctx.BlockTime()) / blockTime)
+	}
+	upcomingEpoch.EstimatedNextEpochHeight = ctx.BlockHeight() + blocksRemaining
+
+	return upcomingEpoch
+}
+
+// GetUpcomingEpochs returns the next epoch of every epoch identifier, along with the block time used to estimate their heights
+func (k Keeper) GetUpcomingEpochs(ctx sdk.Context) (upcomingEpochs []types.UpcomingEpoch, blockTime time.Duration) {
+	blockTime = k.EstimateBlockTime(ctx)
+	upcomingEpochs = []types.UpcomingEpoch{}
+	for _, epochInfo := range k.AllEpochInfos(ctx) {
+		upcomingEpochs = append(upcomingEpochs, k.GetUpcomingEpoch(ctx, epochInfo, blockTime))
+	}
+	return upcomingEpochs, blockTime
+}
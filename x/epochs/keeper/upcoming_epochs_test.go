@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/x/epochs/types"
+)
+
+// Replaces the default epochs with a started "hour" epoch, an unstarted "day" epoch and a paused "week" epoch
+func (suite *KeeperTestSuite) setupUpcomingEpochs(now time.Time) sdk.Context {
+	ctx := suite.Ctx().WithBlockHeight(100).WithBlockTime(now)
+	for _, epochInfo := range suite.App.EpochsKeeper.AllEpochInfos(ctx) {
+		suite.App.EpochsKeeper.DeleteEpochInfo(ctx, epochInfo.Identifier)
+	}
+
+	// the hour epoch started 60 blocks and 5 minutes ago, for a 5 second block time
+	suite.App.EpochsKeeper.SetEpochInfo(ctx, types.EpochInfo{
+		Identifier:              "hour",
+		StartTime:               now.Add(-time.Hour * 10),
+		Duration:                time.Hour,
+		CurrentEpoch:            10,
+		CurrentEpochStartTime:   now.Add(-time.Minute * 5),
+		EpochCountingStarted:    true,
+		CurrentEpochStartHeight: 40,
+	})
+	suite.App.EpochsKeeper.SetEpochInfo(ctx, types.EpochInfo{
+		Identifier: "day",
+		StartTime:  now.Add(time.Minute * 10),
+		Duration:   time.Hour * 24,
+	})
+	suite.App.EpochsKeeper.SetEpochInfo(ctx, types.EpochInfo{
+		Identifier:              "week",
+		StartTime:               now.Add(-time.Hour * 24 * 14),
+		Duration:                time.Hour * 24 * 7,
+		CurrentEpoch:            2,
+		CurrentEpochStartTime:   now.Add(-time.Hour * 24),
+		EpochCountingStarted:    true,
+		CurrentEpochStartHeight: 10,
+		Paused:                  true,
+		PausedTime:              now.Add(-time.Hour),
+	})
+	return ctx
+}
+
+func (suite *KeeperTestSuite) TestEstimateBlockTime() {
+	now := time.Unix(1_000_000, 0).UTC()
+	ctx := suite.setupUpcomingEpochs(now)
+
+	// The paused week epoch is skipped even though it started more blocks ago
+	suite.Require().Equal(time.Second*5, suite.App.EpochsKeeper.EstimateBlockTime(ctx))
+
+	// Without a running epoch, the default block time is used
+	suite.App.EpochsKeeper.DeleteEpochInfo(ctx, "hour")
+	suite.Require().Equal(types.DefaultEstimatedBlockTime, suite.App.EpochsKeeper.EstimateBlockTime(ctx))
+}
+
+func (suite *KeeperTestSuite) TestGetUpcomingEpoch_StartTimePassed() {
+	now := time.Unix(1_000_000, 0).UTC()
+	ctx := suite.setupUpcomingEpochs(now)
+
+	// If the next epoch's start time has already passed, it starts in the next block
+	epochInfo, _ := suite.App.EpochsKeeper.GetEpochInfo(ctx, "hour")
+	epochInfo.CurrentEpochStartTime = now.Add(-time.Hour * 2)
+
+	upcomingEpoch := suite.App.EpochsKeeper.GetUpcomingEpoch(ctx, epochInfo, time.Second*5)
+	suite.Require().Equal(now.Add(-time.Hour), upcomingEpoch.NextEpochStartTime)
+	suite.Require().Equal(int64(101), upcomingEpoch.EstimatedNextEpochHeight)
+}
+
+func (suite *KeeperTestSuite) TestQueryUpcomingEpochs() {
+	now := time.Unix(1_000_000, 0).UTC()
+	ctx := suite.setupUpcomingEpochs(now)
+
+	res, err := suite.App.EpochsKeeper.UpcomingEpochs(sdk.WrapSDKContext(ctx), &types.QueryUpcomingEpochsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(time.Second*5, res.EstimatedBlockTime)
+
+	// Epochs are returned in identifier order
+	suite.Require().Equal([]types.UpcomingEpoch{
+		{
+			// 10 minutes out at 5 second blocks is 120 blocks
+			Identifier:               "day",
+			NextEpoch:                1,
+			NextEpochStartTime:       now.Add(time.Minute * 10),
+			EstimatedNextEpochHeight: 221,
+		},
+		{
+			// 55 minutes out at 5 second blocks is 660 blocks
+			Identifier:               "hour",
+			NextEpoch:                11,
+			NextEpochStartTime:       now.Add(time.Minute * 55),
+			EstimatedNextEpochHeight: 761,
+		},
+		{
+			// Paused epochs don't have a next start time
+			Identifier: "week",
+			NextEpoch:  3,
+			Paused:     true,
+		},
+	}, res.UpcomingEpochs)
+}
+
+func (suite *KeeperTestSuite) TestQueryUpcomingEpochs_InvalidRequest() {
+	_, err := suite.App.EpochsKeeper.UpcomingEpochs(sdk.WrapSDKContext(suite.Ctx()), nil)
+	suite.Require().ErrorContains(err, "empty request")
+}
@@ -2,7 +2,39 @@ package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
-	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
-var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgAddEpoch{}, "epochs/AddEpoch", nil)
+	cdc.RegisterConcrete(&MsgUpdateEpochDuration{}, "epochs/UpdateEpochDuration", nil)
+	cdc.RegisterConcrete(&MsgPauseEpoch{}, "epochs/PauseEpoch", nil)
+	cdc.RegisterConcrete(&MsgResumeEpoch{}, "epochs/ResumeEpoch", nil)
+	cdc.RegisterConcrete(&MsgAdvanceEpoch{}, "epochs/AdvanceEpoch", nil)
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAddEpoch{},
+		&MsgUpdateEpochDuration{},
+		&MsgPauseEpoch{},
+		&MsgResumeEpoch{},
+		&MsgAdvanceEpoch{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	Amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(Amino)
+)
+
+func init() {
+	RegisterCodec(Amino)
+	cryptocodec.RegisterCrypto(Amino)
+	sdk.RegisterLegacyAminoCodec(Amino)
+}
@@ -0,0 +1,18 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/epochs module sentinel errors
+var (
+	ErrEpochNotFound          = sdkerrors.Register(ModuleName, 1501, "epoch not found")
+	ErrEpochAlreadyExists     = sdkerrors.Register(ModuleName, 1502, "epoch already exists")
+	ErrInvalidEpochDuration   = sdkerrors.Register(ModuleName, 1503, "invalid epoch duration")
+	ErrEpochPaused            = sdkerrors.Register(ModuleName, 1504, "epoch is paused")
+	ErrEpochNotPaused         = sdkerrors.Register(ModuleName, 1505, "epoch is not paused")
+	ErrManualAdvanceDisabled  = sdkerrors.Register(ModuleName, 1506, "manual epoch advance is disabled in this build")
+	ErrInvalidEpochIdentifier = sdkerrors.Register(ModuleName, 1507, "invalid epoch identifier")
+)
@@ -1,9 +1,17 @@
 package types
 
 const (
-	EventTypeEpochEnd   = "epoch_end"
-	EventTypeEpochStart = "epoch_start"
+	EventTypeEpochEnd        = "epoch_end"
+	EventTypeEpochStart      = "epoch_start"
+	EventTypeEpochHookFailed = "epoch_hook_failed"
 
-	AttributeEpochNumber    = "epoch_number"
-	AttributeEpochStartTime = "start_time"
+	AttributeEpochNumber     = "epoch_number"
+	AttributeEpochStartTime  = "start_time"
+	AttributeEpochIdentifier = "epoch_identifier"
+	AttributeHook            = "hook"
+	AttributeHookMethod      = "hook_method"
+	AttributeError           = "error"
+
+	AttributeValueAfterEpochEnd    = "after_epoch_end"
+	AttributeValueBeforeEpochStart = "before_epoch_start"
 )
@@ -9,6 +9,9 @@ func NewGenesisState(epochs []EpochInfo) *GenesisState {
 	return &GenesisState{Epochs: epochs}
 }
 
+// DefaultEstimatedBlockTime is used to estimate epoch start heights until the block time can be measured
+const DefaultEstimatedBlockTime = 6 * time.Second
+
 var (
 	STRIDE_EPOCH = "stride_epoch"
 	DAY_EPOCH    = "day"
@@ -72,6 +75,9 @@ func (gs GenesisState) Validate() error {
 		if epoch.Duration == 0 {
 			return errors.New("epoch duration should NOT be 0")
 		}
+		if epoch.PendingDuration < 0 {
+			return errors.New("epoch pending duration should NOT be negative")
+		}
 		// enforce EpochCountingStarted is false for all epochs
 		if epoch.EpochCountingStarted {
 			return errors.New("epoch counting should NOT be started at genesis")
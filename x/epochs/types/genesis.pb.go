@@ -36,6 +36,13 @@ type EpochInfo struct {
 	CurrentEpochStartTime   time.Time     `protobuf:"bytes,5,opt,name=current_epoch_start_time,json=currentEpochStartTime,proto3,stdtime" json:"current_epoch_start_time" yaml:"current_epoch_start_time"`
 	EpochCountingStarted    bool          `protobuf:"varint,6,opt,name=epoch_counting_started,json=epochCountingStarted,proto3" json:"epoch_counting_started,omitempty"`
 	CurrentEpochStartHeight int64         `protobuf:"varint,7,opt,name=current_epoch_start_height,json=currentEpochStartHeight,proto3" json:"current_epoch_start_height,omitempty"`
+	// paused epochs don't advance in the BeginBlocker
+	Paused bool `protobuf:"varint,8,opt,name=paused,proto3" json:"paused,omitempty"`
+	// the block time at which the epoch was paused, used to extend the current
+	// epoch by the time spent paused when it's resumed
+	PausedTime time.Time `protobuf:"bytes,9,opt,name=paused_time,json=pausedTime,proto3,stdtime" json:"paused_time" yaml:"paused_time"`
+	// a duration change that takes effect at the next epoch boundary
+	PendingDuration time.Duration `protobuf:"bytes,10,opt,name=pending_duration,json=pendingDuration,proto3,stdduration" json:"pending_duration,omitempty" yaml:"pending_duration"`
 }
 
 func (m *EpochInfo) Reset()         { *m = EpochInfo{} }
@@ -120,6 +127,27 @@ func (m *EpochInfo) GetCurrentEpochStartHeight() int64 {
 	return 0
 }
 
+func (m *EpochInfo) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *EpochInfo) GetPausedTime() time.Time {
+	if m != nil {
+		return m.PausedTime
+	}
+	return time.Time{}
+}
+
+func (m *EpochInfo) GetPendingDuration() time.Duration {
+	if m != nil {
+		return m.PendingDuration
+	}
+	return 0
+}
+
 // GenesisState defines the epochs module's genesis state.
 type GenesisState struct {
 	Epochs []EpochInfo `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
@@ -173,37 +201,41 @@ func init() {
 func init() { proto.RegisterFile("epochs/genesis.proto", fileDescriptor_b167152c9528ab6c) }
 
 var fileDescriptor_b167152c9528ab6c = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
-	0x18, 0xcd, 0x91, 0x10, 0x9a, 0x6b, 0x11, 0xe2, 0x54, 0xe0, 0x88, 0x84, 0x6d, 0xb9, 0x8b, 0x25,
-	0xca, 0x59, 0x14, 0x26, 0x98, 0x08, 0xbf, 0x25, 0x16, 0x1c, 0x06, 0xc4, 0x12, 0xd9, 0xc9, 0xc5,
-	0x3e, 0x29, 0xf6, 0x59, 0xbe, 0xcf, 0x12, 0xd9, 0x98, 0x99, 0x3a, 0xf2, 0x27, 0x75, 0xec, 0xc8,
-	0x64, 0x50, 0xb2, 0x31, 0xf6, 0x2f, 0x40, 0xbe, 0xb3, 0x43, 0xa0, 0x54, 0xdd, 0xce, 0xf7, 0xde,
-	0xf7, 0xde, 0x7d, 0x4f, 0xcf, 0x78, 0x9f, 0xe7, 0x72, 0x9a, 0x28, 0x3f, 0xe6, 0x19, 0x57, 0x42,
-	0xb1, 0xbc, 0x90, 0x20, 0x09, 0x1d, 0x43, 0x21, 0x66, 0x7c, 0x11, 0x46, 0x8a, 0x29, 0x7d, 0x64,
-	0x86, 0x37, 0xdc, 0x8f, 0x65, 0x2c, 0x35, 0xc9, 0xaf, 0x4f, 0x86, 0x3f, 0xb4, 0x62, 0x29, 0xe3,
-	0x05, 0xf7, 0xf5, 0x57, 0x54, 0xce, 0xfd, 0x59, 0x59, 0x84, 0x20, 0x64, 0xd6, 0xe0, 0xf6, 0xbf,
-	0x38, 0x88, 0x94, 0x2b, 0x08, 0xd3, 0xdc, 0x10, 0xdc, 0xaf, 0x3d, 0x3c, 0x78, 0x59, 0x3b, 0xbc,
-	0xcd, 0xe6, 0x92, 0x58, 0x18, 0x8b, 0x19, 0xcf, 0x40, 0xcc, 0x05, 0x2f, 0x28, 0x72, 0x90, 0x37,
-	0x08, 0xb6, 0x6e, 0xc8, 0x47, 0x8c, 0x15, 0x84, 0x05, 0x4c, 0x6a, 0x19, 0x7a, 0xc5, 0x41, 0xde,
-	0xee, 0xd1, 0x90, 0x19, 0x0f, 0xd6, 0x7a, 0xb0, 0x0f, 0xad, 0xc7, 0xe8, 0xde, 0x49, 0x65, 0x77,
-	0xce, 0x2a, 0xfb, 0xe6, 0x32, 0x4c, 0x17, 0x4f, 0xdc, 0x3f, 0xb3, 0xee, 0xf1, 0x0f, 0x1b, 0x05,
-	0x03, 0x7d, 0x51, 0xd3, 0x49, 0x82, 0x77, 0xda, 0xa7, 0xd3, 0xae, 0xd6, 0xbd, 0x7b, 0x4e, 0xf7,
-	0x45, 0x43, 0x18, 0x3d, 0xac, 0x65, 0x7f, 0x55, 0x36, 0x69, 0x47, 0x0e, 0x65, 0x2a, 0x80, 0xa7,
-	0x39, 0x2c, 0xcf, 0x2a, 0xfb, 0x86, 0x31, 0x6b, 0x31, 0xf7, 0x5b, 0x6d, 0xb5, 0x51, 0x27, 0x07,
-	0xf8, 0xfa, 0xb4, 0x2c, 0x0a, 0x9e, 0xc1, 0x44, 0x47, 0x4b, 0x7b, 0x0e, 0xf2, 0xba, 0xc1, 0x5e,
-	0x73, 0xa9, 0xc3, 0x20, 0x5f, 0x10, 0xa6, 0x7f, 0xb1, 0x26, 0x5b, 0x7b, 0x5f, 0xbd, 0x74, 0xef,
-	0xfb, 0xcd, 0xde, 0xb6, 0x79, 0xca, 0x45, 0x4a, 0x26, 0x85, 0x5b, 0xdb, 0xce, 0xe3, 0x4d, 0x22,
-	0x8f, 0xf1, 0x6d, 0xc3, 0x9f, 0xca, 0x32, 0x03, 0x91, 0xc5, 0x66, 0x90, 0xcf, 0x68, 0xdf, 0x41,
-	0xde, 0x4e, 0x60, 0x0a, 0xf4, 0xbc, 0x01, 0xc7, 0x06, 0x23, 0x4f, 0xf1, 0xf0, 0x7f, 0x6e, 0x09,
-	0x17, 0x71, 0x02, 0xf4, 0x9a, 0x5e, 0xf5, 0xce, 0x39, 0xc3, 0x37, 0x1a, 0x76, 0xdf, 0xe3, 0xbd,
-	0xd7, 0xa6, 0x8e, 0x63, 0x08, 0x81, 0x93, 0x67, 0xb8, 0x6f, 0xda, 0x47, 0x91, 0xd3, 0xf5, 0x76,
-	0x8f, 0x0e, 0xd8, 0x45, 0xf5, 0x64, 0x9b, 0x0e, 0x8d, 0x7a, 0xf5, 0xee, 0x41, 0x33, 0x38, 0x7a,
-	0x75, 0xb2, 0xb2, 0xd0, 0xe9, 0xca, 0x42, 0x3f, 0x57, 0x16, 0x3a, 0x5e, 0x5b, 0x9d, 0xd3, 0xb5,
-	0xd5, 0xf9, 0xbe, 0xb6, 0x3a, 0x9f, 0x0e, 0x63, 0x01, 0x49, 0x19, 0xb1, 0xa9, 0x4c, 0x7d, 0x23,
-	0xfb, 0xe0, 0x5d, 0x18, 0x29, 0xdf, 0xe8, 0xfa, 0x9f, 0xfd, 0xe6, 0x07, 0x81, 0x65, 0xce, 0x55,
-	0xd4, 0xd7, 0x29, 0x3f, 0xfa, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x00, 0x08, 0x00, 0xc6, 0x37, 0x03,
-	0x00, 0x00,
+	// 535 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0x91, 0x12, 0x92, 0x4b, 0x51, 0xe1, 0x54, 0xda, 0x23, 0x12, 0x76, 0x94, 0x2e, 0x91,
+	0x28, 0xb6, 0x28, 0x4c, 0x30, 0x35, 0xfc, 0x96, 0x58, 0x48, 0x18, 0x10, 0x0c, 0x91, 0x1d, 0x5f,
+	0xec, 0x93, 0x62, 0x9f, 0xe5, 0x3b, 0x4b, 0x64, 0x63, 0x43, 0x6c, 0x1d, 0xf9, 0x93, 0x3a, 0x76,
+	0x64, 0x0a, 0x28, 0xd9, 0x18, 0xfb, 0x17, 0x20, 0xdf, 0x3b, 0x87, 0x34, 0x6d, 0x95, 0xed, 0xfc,
+	0xde, 0xf7, 0xbe, 0xef, 0x7d, 0x4f, 0xef, 0x19, 0xef, 0xb2, 0x54, 0x8c, 0x22, 0xe9, 0x86, 0x2c,
+	0x61, 0x92, 0x4b, 0x27, 0xcd, 0x84, 0x12, 0x84, 0x0e, 0x54, 0xc6, 0x03, 0x36, 0xf1, 0x7c, 0xe9,
+	0x48, 0xfd, 0x74, 0x00, 0xd7, 0xda, 0x0d, 0x45, 0x28, 0x34, 0xc8, 0x2d, 0x5e, 0x80, 0x6f, 0x59,
+	0xa1, 0x10, 0xe1, 0x84, 0xb9, 0xfa, 0xcb, 0xcf, 0xc7, 0x6e, 0x90, 0x67, 0x9e, 0xe2, 0x22, 0x31,
+	0x79, 0x7b, 0x3d, 0xaf, 0x78, 0xcc, 0xa4, 0xf2, 0xe2, 0x14, 0x00, 0x9d, 0x1f, 0x35, 0xdc, 0x78,
+	0x55, 0x28, 0xbc, 0x4b, 0xc6, 0x82, 0x58, 0x18, 0xf3, 0x80, 0x25, 0x8a, 0x8f, 0x39, 0xcb, 0x28,
+	0x6a, 0xa3, 0x6e, 0xa3, 0xbf, 0x12, 0x21, 0x9f, 0x30, 0x96, 0xca, 0xcb, 0xd4, 0xb0, 0xa0, 0xa1,
+	0x37, 0xda, 0xa8, 0xdb, 0x3c, 0x6a, 0x39, 0xa0, 0xe1, 0x94, 0x1a, 0xce, 0xc7, 0x52, 0xa3, 0xf7,
+	0xe0, 0x74, 0x66, 0x57, 0xce, 0x67, 0xf6, 0xdd, 0xa9, 0x17, 0x4f, 0x9e, 0x75, 0xfe, 0xd7, 0x76,
+	0x4e, 0x7e, 0xdb, 0xa8, 0xdf, 0xd0, 0x81, 0x02, 0x4e, 0x22, 0x5c, 0x2f, 0x5b, 0xa7, 0x55, 0xcd,
+	0x7b, 0xff, 0x12, 0xef, 0x4b, 0x03, 0xe8, 0x3d, 0x2e, 0x68, 0xff, 0xce, 0x6c, 0x52, 0x96, 0x1c,
+	0x8a, 0x98, 0x2b, 0x16, 0xa7, 0x6a, 0x7a, 0x3e, 0xb3, 0x77, 0x40, 0xac, 0xcc, 0x75, 0x7e, 0x16,
+	0x52, 0x4b, 0x76, 0x72, 0x80, 0x6f, 0x8f, 0xf2, 0x2c, 0x63, 0x89, 0x1a, 0xea, 0xd1, 0xd2, 0xad,
+	0x36, 0xea, 0x56, 0xfb, 0xdb, 0x26, 0xa8, 0x87, 0x41, 0xbe, 0x21, 0x4c, 0x2f, 0xa0, 0x86, 0x2b,
+	0xbe, 0x6f, 0x6e, 0xf4, 0xfd, 0xd0, 0xf8, 0xb6, 0xa1, 0x95, 0xeb, 0x98, 0x60, 0x0a, 0xf7, 0x56,
+	0x95, 0x07, 0xcb, 0x89, 0x3c, 0xc5, 0x7b, 0x80, 0x1f, 0x89, 0x3c, 0x51, 0x3c, 0x09, 0xa1, 0x90,
+	0x05, 0xb4, 0xd6, 0x46, 0xdd, 0x7a, 0x1f, 0x16, 0xe8, 0x85, 0x49, 0x0e, 0x20, 0x47, 0x9e, 0xe3,
+	0xd6, 0x55, 0x6a, 0x11, 0xe3, 0x61, 0xa4, 0xe8, 0x2d, 0x6d, 0x75, 0xff, 0x92, 0xe0, 0x5b, 0x9d,
+	0x26, 0x7b, 0xb8, 0x96, 0x7a, 0xb9, 0x64, 0x01, 0xad, 0x6b, 0x09, 0xf3, 0x45, 0xbe, 0xe0, 0x26,
+	0xbc, 0xc0, 0x7f, 0x63, 0xa3, 0x7f, 0xcb, 0xf8, 0x27, 0xe0, 0x7f, 0xa5, 0x18, 0x2c, 0x63, 0x88,
+	0x68, 0x9f, 0xdf, 0x11, 0xbe, 0x93, 0xb2, 0x24, 0x28, 0x1c, 0x2e, 0x57, 0x00, 0x6f, 0x5a, 0x81,
+	0x63, 0xb3, 0x02, 0xad, 0xf5, 0xd2, 0x0b, 0xab, 0xb0, 0x6f, 0xf4, 0xd7, 0x30, 0xb0, 0x12, 0x3b,
+	0x26, 0x5c, 0x72, 0x76, 0x3e, 0xe0, 0xed, 0x37, 0x70, 0x8d, 0x03, 0xe5, 0x29, 0x46, 0x8e, 0x71,
+	0x0d, 0x8e, 0x8f, 0xa2, 0x76, 0xb5, 0xdb, 0x3c, 0x3a, 0x70, 0xae, 0xbb, 0x4e, 0x67, 0x79, 0x42,
+	0xbd, 0xad, 0xa2, 0xb1, 0xbe, 0x29, 0xec, 0xbd, 0x3e, 0x9d, 0x5b, 0xe8, 0x6c, 0x6e, 0xa1, 0x3f,
+	0x73, 0x0b, 0x9d, 0x2c, 0xac, 0xca, 0xd9, 0xc2, 0xaa, 0xfc, 0x5a, 0x58, 0x95, 0xcf, 0x87, 0x21,
+	0x57, 0x51, 0xee, 0x3b, 0x23, 0x11, 0xbb, 0x40, 0xfb, 0xe8, 0xbd, 0xe7, 0x4b, 0x17, 0x78, 0xdd,
+	0xaf, 0xae, 0xf9, 0x3f, 0xa8, 0x69, 0xca, 0xa4, 0x5f, 0xd3, 0x13, 0x78, 0xf2, 0x6f, 0x00, 0x42,
+	0xc8, 0x12, 0x23, 0x36, 0x04, 0x00, 0x00,
 }
 
 func (m *EpochInfo) Marshal() (dAtA []byte, err error) {
@@ -226,6 +258,32 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.PendingDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.PendingDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x52
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PausedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PausedTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x4a
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.CurrentEpochStartHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.CurrentEpochStartHeight))
 		i--
@@ -241,12 +299,12 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CurrentEpochStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CurrentEpochStartTime):])
-	if err1 != nil {
-		return 0, err1
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CurrentEpochStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CurrentEpochStartTime):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n1
-	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x2a
 	if m.CurrentEpoch != 0 {
@@ -254,20 +312,20 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x20
 	}
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x1a
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err3 != nil {
-		return 0, err3
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n3
-	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i -= n5
+	i = encodeVarintGenesis(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Identifier) > 0 {
@@ -353,6 +411,13 @@ func (m *EpochInfo) Size() (n int) {
 	if m.CurrentEpochStartHeight != 0 {
 		n += 1 + sovGenesis(uint64(m.CurrentEpochStartHeight))
 	}
+	if m.Paused {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PausedTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.PendingDuration)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -595,6 +660,92 @@ func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PausedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.PendingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
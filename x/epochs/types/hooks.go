@@ -1,7 +1,12 @@
 package types
 
 import (
+	"fmt"
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/utils"
 )
 
 type EpochHooks interface {
@@ -14,6 +19,8 @@ type EpochHooks interface {
 var _ EpochHooks = MultiEpochHooks{}
 
 // combine multiple gamm hooks, all hook functions are run in array sequence
+// each hook runs in its own cached context, so a hook that panics has its state changes discarded
+// without affecting the other hooks or halting the chain
 type MultiEpochHooks []EpochHooks
 
 func NewMultiEpochHooks(hooks ...EpochHooks) MultiEpochHooks {
@@ -23,13 +30,44 @@ func NewMultiEpochHooks(hooks ...EpochHooks) MultiEpochHooks {
 // AfterEpochEnd is called when epoch is going to be ended, epochNumber is the number of epoch that is ending
 func (h MultiEpochHooks) AfterEpochEnd(ctx sdk.Context, epochInfo EpochInfo) {
 	for i := range h {
-		h[i].AfterEpochEnd(ctx, epochInfo)
+		hook := h[i]
+		err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			hook.AfterEpochEnd(ctx, epochInfo)
+			return nil
+		})
+		if err != nil {
+			emitHookFailedEvent(ctx, hook, AttributeValueAfterEpochEnd, epochInfo, err)
+		}
 	}
 }
 
 // BeforeEpochStart is called when epoch is going to be started, epochNumber is the number of epoch that is starting
 func (h MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epochInfo EpochInfo) {
 	for i := range h {
-		h[i].BeforeEpochStart(ctx, epochInfo)
+		hook := h[i]
+		err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			hook.BeforeEpochStart(ctx, epochInfo)
+			return nil
+		})
+		if err != nil {
+			emitHookFailedEvent(ctx, hook, AttributeValueBeforeEpochStart, epochInfo, err)
+		}
 	}
 }
+
+// emitHookFailedEvent logs and emits an event for a hook whose state changes were discarded
+func emitHookFailedEvent(ctx sdk.Context, hook EpochHooks, method string, epochInfo EpochInfo, err error) {
+	hookName := fmt.Sprintf("%T", hook)
+	ctx.Logger().Error(fmt.Sprintf("epoch hook %s %s failed for epoch %s %d: %s",
+		hookName, method, epochInfo.Identifier, epochInfo.CurrentEpoch, err.Error()))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeEpochHookFailed,
+			sdk.NewAttribute(AttributeHook, hookName),
+			sdk.NewAttribute(AttributeHookMethod, method),
+			sdk.NewAttribute(AttributeEpochIdentifier, epochInfo.Identifier),
+			sdk.NewAttribute(AttributeEpochNumber, strconv.FormatInt(epochInfo.CurrentEpoch, 10)),
+			sdk.NewAttribute(AttributeError, err.Error()),
+		),
+	)
+}
@@ -0,0 +1,7 @@
+//go:build !testnet
+
+package types
+
+// ManualAdvanceEnabled allows admins to force an epoch to advance with MsgAdvanceEpoch
+// It's only enabled in builds with the testnet tag
+const ManualAdvanceEnabled = false
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/utils"
+)
+
+const TypeMsgAddEpoch = "add_epoch"
+
+var _ sdk.Msg = &MsgAddEpoch{}
+
+func NewMsgAddEpoch(creator string, identifier string, duration time.Duration, startTime time.Time) *MsgAddEpoch {
+	return &MsgAddEpoch{
+		Creator:    creator,
+		Identifier: identifier,
+		Duration:   duration,
+		StartTime:  startTime,
+	}
+}
+
+func (msg *MsgAddEpoch) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAddEpoch) Type() string {
+	return TypeMsgAddEpoch
+}
+
+func (msg *MsgAddEpoch) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAddEpoch) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAddEpoch) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := utils.ValidateAdminAddress(msg.Creator); err != nil {
+		return err
+	}
+	if err := ValidateEpochIdentifierString(msg.Identifier); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidEpochIdentifier, err.Error())
+	}
+	if msg.Duration <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidEpochDuration, "epoch duration must be positive (%s)", msg.Duration)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/utils"
+)
+
+const TypeMsgAdvanceEpoch = "advance_epoch"
+
+var _ sdk.Msg = &MsgAdvanceEpoch{}
+
+func NewMsgAdvanceEpoch(creator string, identifier string) *MsgAdvanceEpoch {
+	return &MsgAdvanceEpoch{
+		Creator:    creator,
+		Identifier: identifier,
+	}
+}
+
+func (msg *MsgAdvanceEpoch) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAdvanceEpoch) Type() string {
+	return TypeMsgAdvanceEpoch
+}
+
+func (msg *MsgAdvanceEpoch) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAdvanceEpoch) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAdvanceEpoch) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := utils.ValidateAdminAddress(msg.Creator); err != nil {
+		return err
+	}
+	if err := ValidateEpochIdentifierString(msg.Identifier); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidEpochIdentifier, err.Error())
+	}
+	return nil
+}
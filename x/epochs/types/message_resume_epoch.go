@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/utils"
+)
+
+const TypeMsgResumeEpoch = "resume_epoch"
+
+var _ sdk.Msg = &MsgResumeEpoch{}
+
+func NewMsgResumeEpoch(creator string, identifier string) *MsgResumeEpoch {
+	return &MsgResumeEpoch{
+		Creator:    creator,
+		Identifier: identifier,
+	}
+}
+
+func (msg *MsgResumeEpoch) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgResumeEpoch) Type() string {
+	return TypeMsgResumeEpoch
+}
+
+func (msg *MsgResumeEpoch) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgResumeEpoch) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgResumeEpoch) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := utils.ValidateAdminAddress(msg.Creator); err != nil {
+		return err
+	}
+	if err := ValidateEpochIdentifierString(msg.Identifier); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidEpochIdentifier, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/utils"
+)
+
+const TypeMsgUpdateEpochDuration = "update_epoch_duration"
+
+var _ sdk.Msg = &MsgUpdateEpochDuration{}
+
+func NewMsgUpdateEpochDuration(creator string, identifier string, duration time.Duration) *MsgUpdateEpochDuration {
+	return &MsgUpdateEpochDuration{
+		Creator:    creator,
+		Identifier: identifier,
+		Duration:   duration,
+	}
+}
+
+func (msg *MsgUpdateEpochDuration) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateEpochDuration) Type() string {
+	return TypeMsgUpdateEpochDuration
+}
+
+func (msg *MsgUpdateEpochDuration) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateEpochDuration) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateEpochDuration) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := utils.ValidateAdminAddress(msg.Creator); err != nil {
+		return err
+	}
+	if err := ValidateEpochIdentifierString(msg.Identifier); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidEpochIdentifier, err.Error())
+	}
+	if msg.Duration <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidEpochDuration, "epoch duration must be positive (%s)", msg.Duration)
+	}
+	return nil
+}
@@ -10,19 +10,24 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -302,6 +307,174 @@ func (m *QueryEpochInfoResponse) GetEpoch() EpochInfo {
 	return EpochInfo{}
 }
 
+type UpcomingEpoch struct {
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// the number of the epoch that starts next
+	NextEpoch int64 `protobuf:"varint,2,opt,name=next_epoch,json=nextEpoch,proto3" json:"next_epoch,omitempty"`
+	// the epoch starts in the first block after this time
+	NextEpochStartTime       time.Time `protobuf:"bytes,3,opt,name=next_epoch_start_time,json=nextEpochStartTime,proto3,stdtime" json:"next_epoch_start_time"`
+	EstimatedNextEpochHeight int64     `protobuf:"varint,4,opt,name=estimated_next_epoch_height,json=estimatedNextEpochHeight,proto3" json:"estimated_next_epoch_height,omitempty"`
+	// paused epochs have no next start time until they're resumed
+	Paused bool `protobuf:"varint,5,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *UpcomingEpoch) Reset()         { *m = UpcomingEpoch{} }
+func (m *UpcomingEpoch) String() string { return proto.CompactTextString(m) }
+func (*UpcomingEpoch) ProtoMessage()    {}
+func (*UpcomingEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e760c2f82b90e24, []int{6}
+}
+func (m *UpcomingEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpcomingEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpcomingEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpcomingEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpcomingEpoch.Merge(m, src)
+}
+func (m *UpcomingEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpcomingEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpcomingEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpcomingEpoch proto.InternalMessageInfo
+
+func (m *UpcomingEpoch) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *UpcomingEpoch) GetNextEpoch() int64 {
+	if m != nil {
+		return m.NextEpoch
+	}
+	return 0
+}
+
+func (m *UpcomingEpoch) GetNextEpochStartTime() time.Time {
+	if m != nil {
+		return m.NextEpochStartTime
+	}
+	return time.Time{}
+}
+
+func (m *UpcomingEpoch) GetEstimatedNextEpochHeight() int64 {
+	if m != nil {
+		return m.EstimatedNextEpochHeight
+	}
+	return 0
+}
+
+func (m *UpcomingEpoch) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+type QueryUpcomingEpochsRequest struct {
+}
+
+func (m *QueryUpcomingEpochsRequest) Reset()         { *m = QueryUpcomingEpochsRequest{} }
+func (m *QueryUpcomingEpochsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpcomingEpochsRequest) ProtoMessage()    {}
+func (*QueryUpcomingEpochsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e760c2f82b90e24, []int{7}
+}
+func (m *QueryUpcomingEpochsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpcomingEpochsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpcomingEpochsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpcomingEpochsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpcomingEpochsRequest.Merge(m, src)
+}
+func (m *QueryUpcomingEpochsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpcomingEpochsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpcomingEpochsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpcomingEpochsRequest proto.InternalMessageInfo
+
+type QueryUpcomingEpochsResponse struct {
+	UpcomingEpochs []UpcomingEpoch `protobuf:"bytes,1,rep,name=upcoming_epochs,json=upcomingEpochs,proto3" json:"upcoming_epochs"`
+	// the average block time used to estimate the start heights
+	EstimatedBlockTime time.Duration `protobuf:"bytes,2,opt,name=estimated_block_time,json=estimatedBlockTime,proto3,stdduration" json:"estimated_block_time"`
+}
+
+func (m *QueryUpcomingEpochsResponse) Reset()         { *m = QueryUpcomingEpochsResponse{} }
+func (m *QueryUpcomingEpochsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpcomingEpochsResponse) ProtoMessage()    {}
+func (*QueryUpcomingEpochsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e760c2f82b90e24, []int{8}
+}
+func (m *QueryUpcomingEpochsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpcomingEpochsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpcomingEpochsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpcomingEpochsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpcomingEpochsResponse.Merge(m, src)
+}
+func (m *QueryUpcomingEpochsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpcomingEpochsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpcomingEpochsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpcomingEpochsResponse proto.InternalMessageInfo
+
+func (m *QueryUpcomingEpochsResponse) GetUpcomingEpochs() []UpcomingEpoch {
+	if m != nil {
+		return m.UpcomingEpochs
+	}
+	return nil
+}
+
+func (m *QueryUpcomingEpochsResponse) GetEstimatedBlockTime() time.Duration {
+	if m != nil {
+		return m.EstimatedBlockTime
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryEpochsInfoRequest)(nil), "Stridelabs.stride.epochs.QueryEpochsInfoRequest")
 	proto.RegisterType((*QueryEpochsInfoResponse)(nil), "Stridelabs.stride.epochs.QueryEpochsInfoResponse")
@@ -309,44 +482,63 @@ func init() {
 	proto.RegisterType((*QueryCurrentEpochResponse)(nil), "Stridelabs.stride.epochs.QueryCurrentEpochResponse")
 	proto.RegisterType((*QueryEpochInfoRequest)(nil), "Stridelabs.stride.epochs.QueryEpochInfoRequest")
 	proto.RegisterType((*QueryEpochInfoResponse)(nil), "Stridelabs.stride.epochs.QueryEpochInfoResponse")
+	proto.RegisterType((*UpcomingEpoch)(nil), "Stridelabs.stride.epochs.UpcomingEpoch")
+	proto.RegisterType((*QueryUpcomingEpochsRequest)(nil), "Stridelabs.stride.epochs.QueryUpcomingEpochsRequest")
+	proto.RegisterType((*QueryUpcomingEpochsResponse)(nil), "Stridelabs.stride.epochs.QueryUpcomingEpochsResponse")
 }
 
 func init() { proto.RegisterFile("epochs/query.proto", fileDescriptor_2e760c2f82b90e24) }
 
 var fileDescriptor_2e760c2f82b90e24 = []byte{
-	// 512 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4f, 0x4f, 0x13, 0x41,
-	0x18, 0xc6, 0x3b, 0x54, 0x48, 0x78, 0xc1, 0xcb, 0x04, 0xb5, 0x54, 0xb3, 0xe2, 0x62, 0x00, 0x0d,
-	0xce, 0xd0, 0x72, 0x30, 0xf1, 0xe2, 0xbf, 0x88, 0x31, 0xf1, 0xa0, 0xeb, 0x49, 0x2f, 0xb8, 0xbb,
-	0x0c, 0xcb, 0x24, 0x30, 0xb3, 0xec, 0x4c, 0x8d, 0x5c, 0xfd, 0x04, 0x26, 0x7a, 0xf4, 0x6a, 0xe2,
-	0x47, 0xe1, 0x48, 0xe2, 0xc5, 0x93, 0x31, 0xad, 0x07, 0x3f, 0x86, 0xd9, 0x77, 0x06, 0xba, 0x0d,
-	0x6d, 0x4a, 0x4f, 0xdd, 0xec, 0xbc, 0xcf, 0xf3, 0xfc, 0xe6, 0x79, 0x9b, 0x05, 0x2a, 0x72, 0x9d,
-	0xee, 0x19, 0x7e, 0xd8, 0x11, 0xc5, 0x11, 0xcb, 0x0b, 0x6d, 0x35, 0x6d, 0xbc, 0xb1, 0x85, 0xdc,
-	0x11, 0xfb, 0x71, 0x62, 0x98, 0xc1, 0x47, 0xe6, 0xa6, 0x9a, 0x0b, 0x99, 0xce, 0x34, 0x0e, 0xf1,
-	0xf2, 0xc9, 0xcd, 0x37, 0x6f, 0x64, 0x5a, 0x67, 0xfb, 0x82, 0xc7, 0xb9, 0xe4, 0xb1, 0x52, 0xda,
-	0xc6, 0x56, 0x6a, 0x65, 0xfc, 0xe9, 0xdd, 0x54, 0x9b, 0x03, 0x6d, 0x78, 0x12, 0x1b, 0xe1, 0x62,
-	0xf8, 0x87, 0x56, 0x22, 0x6c, 0xdc, 0xe2, 0x79, 0x9c, 0x49, 0x85, 0xc3, 0x7e, 0x76, 0xc1, 0xd3,
-	0x64, 0x42, 0x09, 0x23, 0xbd, 0x43, 0xf8, 0x1e, 0xae, 0xbe, 0x2e, 0x75, 0xcf, 0xf0, 0xf0, 0x85,
-	0xda, 0xd5, 0x91, 0x38, 0xec, 0x08, 0x63, 0xe9, 0x16, 0x40, 0xdf, 0xa3, 0x41, 0x96, 0xc8, 0xda,
-	0x5c, 0x7b, 0x85, 0xb9, 0x40, 0x56, 0x06, 0x32, 0x77, 0x2f, 0x1f, 0xc8, 0x5e, 0xc5, 0x99, 0xf0,
-	0xda, 0xa8, 0xa2, 0x0c, 0xbf, 0x13, 0xb8, 0x76, 0x2e, 0xc2, 0xe4, 0x5a, 0x19, 0x41, 0x1f, 0xc3,
-	0x8c, 0xa3, 0x6a, 0x90, 0xa5, 0xfa, 0xda, 0x5c, 0x7b, 0x99, 0x8d, 0xaa, 0x87, 0xa1, 0xba, 0x14,
-	0x3f, 0xb9, 0x74, 0xfc, 0xfb, 0x66, 0x2d, 0xf2, 0x42, 0xfa, 0x7c, 0x00, 0x73, 0x0a, 0x31, 0x57,
-	0xc7, 0x62, 0xba, 0xfc, 0x01, 0xce, 0x07, 0xd0, 0x40, 0xcc, 0xa7, 0x9d, 0xa2, 0x10, 0xca, 0x62,
-	0xde, 0x69, 0x17, 0x01, 0x80, 0xdc, 0x11, 0xca, 0xca, 0x5d, 0x29, 0x0a, 0xec, 0x62, 0x36, 0xaa,
-	0xbc, 0x09, 0x1f, 0xc1, 0xe2, 0x10, 0xad, 0xbf, 0xe4, 0x32, 0x5c, 0x4e, 0xdd, 0xfb, 0x6d, 0x64,
-	0x46, 0x7d, 0x3d, 0x9a, 0x4f, 0x2b, 0xc3, 0xe1, 0x7d, 0xb8, 0xd2, 0x2f, 0xa9, 0xba, 0x86, 0x71,
-	0xd1, 0x6f, 0xab, 0x0b, 0x1c, 0x28, 0xf7, 0x21, 0x4c, 0xf7, 0xf3, 0x26, 0xea, 0xd6, 0xe9, 0xda,
-	0xff, 0xea, 0x30, 0x8d, 0xde, 0xf4, 0x2b, 0x01, 0x38, 0x1b, 0x32, 0x74, 0x63, 0xb4, 0xd5, 0xf0,
-	0x3f, 0x53, 0xb3, 0x35, 0x81, 0xc2, 0xe1, 0x87, 0xb7, 0x3e, 0xfd, 0xfc, 0xfb, 0x65, 0xea, 0x3a,
-	0x5d, 0xe4, 0x7d, 0x29, 0x77, 0x52, 0xee, 0x77, 0xff, 0x83, 0xc0, 0x7c, 0xb5, 0x72, 0xda, 0x1e,
-	0x13, 0x33, 0x64, 0xb7, 0xcd, 0xcd, 0x89, 0x34, 0x1e, 0x8e, 0x23, 0xdc, 0x1d, 0xba, 0x3a, 0x12,
-	0x8e, 0x0f, 0xec, 0x9c, 0x7e, 0x23, 0x30, 0x7b, 0xd6, 0x20, 0xe5, 0x17, 0xa9, 0xa3, 0xda, 0xdf,
-	0xc6, 0xc5, 0x05, 0x9e, 0x70, 0x1d, 0x09, 0x57, 0xe8, 0xed, 0xd1, 0x84, 0xf8, 0xb3, 0x2d, 0xcb,
-	0xbd, 0x6f, 0x1d, 0x77, 0x03, 0x72, 0xd2, 0x0d, 0xc8, 0x9f, 0x6e, 0x40, 0x3e, 0xf7, 0x82, 0xda,
-	0x49, 0x2f, 0xa8, 0xfd, 0xea, 0x05, 0xb5, 0x77, 0xeb, 0x99, 0xb4, 0x7b, 0x9d, 0x84, 0xa5, 0xfa,
-	0xc0, 0x3b, 0xdd, 0x7b, 0x59, 0xb1, 0xfa, 0x78, 0x6a, 0x66, 0x8f, 0x72, 0x61, 0x92, 0x19, 0xfc,
-	0xaa, 0x6c, 0xfe, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xae, 0x4f, 0xfc, 0x2e, 0xfb, 0x04, 0x00, 0x00,
+	// 759 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xc7, 0x3b, 0x40, 0x09, 0x3c, 0x7e, 0x98, 0x4c, 0x00, 0x97, 0x82, 0x0b, 0x2e, 0x86, 0x1f,
+	0x8a, 0xbb, 0xb4, 0x68, 0x4c, 0x4c, 0x8c, 0x5a, 0x15, 0x35, 0x31, 0x46, 0x8b, 0x68, 0xf4, 0x52,
+	0xb7, 0xed, 0xb0, 0xdd, 0x48, 0x77, 0x96, 0x9d, 0x59, 0x03, 0x57, 0xff, 0x02, 0x12, 0x3d, 0x98,
+	0xe8, 0xd5, 0xc4, 0xa3, 0x7f, 0x06, 0x07, 0x0f, 0x24, 0x5e, 0x3c, 0xa9, 0x01, 0xff, 0x0e, 0x63,
+	0x76, 0x66, 0xda, 0xee, 0x42, 0x9b, 0xd2, 0x53, 0xbb, 0xfb, 0xde, 0xf7, 0xbd, 0xcf, 0x7c, 0xe7,
+	0xbd, 0x05, 0x4c, 0x7c, 0x5a, 0xae, 0x32, 0x6b, 0x3b, 0x24, 0xc1, 0xae, 0xe9, 0x07, 0x94, 0x53,
+	0xac, 0xad, 0xf3, 0xc0, 0xad, 0x90, 0x2d, 0xbb, 0xc4, 0x4c, 0x26, 0xfe, 0x9a, 0x32, 0x2b, 0x33,
+	0xe6, 0x50, 0x87, 0x8a, 0x24, 0x2b, 0xfa, 0x27, 0xf3, 0x33, 0xd3, 0x0e, 0xa5, 0xce, 0x16, 0xb1,
+	0x6c, 0xdf, 0xb5, 0x6c, 0xcf, 0xa3, 0xdc, 0xe6, 0x2e, 0xf5, 0x98, 0x8a, 0xea, 0x2a, 0x2a, 0x9e,
+	0x4a, 0xe1, 0xa6, 0x55, 0x09, 0x03, 0x91, 0xa0, 0xe2, 0x33, 0xc7, 0xe3, 0xdc, 0xad, 0x11, 0xc6,
+	0xed, 0x9a, 0xaf, 0x12, 0x2e, 0x96, 0x29, 0xab, 0x51, 0x66, 0x95, 0x6c, 0x46, 0x24, 0xa7, 0xf5,
+	0x36, 0x5b, 0x22, 0xdc, 0xce, 0x5a, 0xbe, 0xed, 0xb8, 0x5e, 0xbc, 0xd8, 0x98, 0x3a, 0x8e, 0x43,
+	0x3c, 0xc2, 0x5c, 0x85, 0x60, 0xbc, 0x86, 0x89, 0xa7, 0x91, 0xee, 0x9e, 0x08, 0x3e, 0xf4, 0x36,
+	0x69, 0x81, 0x6c, 0x87, 0x84, 0x71, 0xbc, 0x06, 0xd0, 0xac, 0xa1, 0xa1, 0x59, 0xb4, 0x38, 0x94,
+	0x9b, 0x37, 0x65, 0x43, 0x33, 0x6a, 0x68, 0x4a, 0x63, 0x54, 0x43, 0xf3, 0x89, 0xed, 0x10, 0xa5,
+	0x2d, 0xc4, 0x94, 0xc6, 0x17, 0x04, 0x67, 0x4f, 0xb4, 0x60, 0x3e, 0xf5, 0x18, 0xc1, 0xb7, 0xa1,
+	0x5f, 0x52, 0x69, 0x68, 0xb6, 0x77, 0x71, 0x28, 0x37, 0x67, 0xb6, 0xf3, 0xd7, 0x14, 0xea, 0x48,
+	0x9c, 0xef, 0xdb, 0xff, 0x35, 0x93, 0x2a, 0x28, 0x21, 0xbe, 0x9f, 0xc0, 0xec, 0x11, 0x98, 0x0b,
+	0x1d, 0x31, 0x65, 0xff, 0x04, 0xe7, 0x75, 0xd0, 0x04, 0xe6, 0x9d, 0x30, 0x08, 0x88, 0xc7, 0x45,
+	0xbf, 0xba, 0x17, 0x3a, 0x80, 0x5b, 0x21, 0x1e, 0x77, 0x37, 0x5d, 0x12, 0x08, 0x2f, 0x06, 0x0b,
+	0xb1, 0x37, 0xc6, 0x2d, 0x98, 0x6c, 0xa1, 0x55, 0x87, 0x9c, 0x83, 0x91, 0xb2, 0x7c, 0x5f, 0x14,
+	0xcc, 0x42, 0xdf, 0x5b, 0x18, 0x2e, 0xc7, 0x92, 0x8d, 0x6b, 0x30, 0xde, 0x34, 0x29, 0x7e, 0x0d,
+	0x9d, 0x5a, 0xbf, 0x84, 0x89, 0xe3, 0x42, 0xd5, 0xf7, 0x26, 0xa4, 0x9b, 0xfd, 0xba, 0xf2, 0x56,
+	0xea, 0x8c, 0x7f, 0x08, 0x46, 0x36, 0xfc, 0x32, 0xad, 0xb9, 0x9e, 0x23, 0x52, 0x3a, 0xc1, 0xe0,
+	0x73, 0x00, 0x1e, 0xd9, 0xa9, 0x9f, 0xb3, 0x47, 0x9c, 0x73, 0x30, 0x7a, 0x23, 0xe5, 0x2f, 0x60,
+	0xbc, 0x19, 0x2e, 0x32, 0x6e, 0x07, 0xbc, 0x18, 0x8d, 0xb4, 0xd6, 0x2b, 0x08, 0x33, 0xa6, 0x9c,
+	0x77, 0xb3, 0x3e, 0xef, 0xe6, 0xb3, 0xfa, 0xbc, 0xe7, 0x07, 0x22, 0xb0, 0xbd, 0xdf, 0x33, 0xa8,
+	0x80, 0x1b, 0xf5, 0xd6, 0xa3, 0x02, 0x51, 0x0a, 0xbe, 0x01, 0x53, 0x84, 0x71, 0xb7, 0x66, 0x73,
+	0x52, 0x29, 0xc6, 0x5a, 0x54, 0x89, 0xeb, 0x54, 0xb9, 0xd6, 0x27, 0x40, 0xb4, 0x46, 0xca, 0xe3,
+	0x7a, 0x85, 0x07, 0x22, 0x8e, 0x27, 0xa0, 0xdf, 0xb7, 0x43, 0x46, 0x2a, 0x5a, 0x7a, 0x16, 0x2d,
+	0x0e, 0x14, 0xd4, 0x93, 0x31, 0x0d, 0x19, 0xe1, 0x6d, 0xc2, 0x04, 0xa6, 0x6e, 0xc6, 0xf8, 0x8e,
+	0x60, 0xaa, 0x65, 0x58, 0xf9, 0xff, 0x1c, 0xce, 0x84, 0x2a, 0x52, 0x4c, 0x4c, 0xf9, 0x42, 0xfb,
+	0x9b, 0x48, 0x94, 0x52, 0xb7, 0x31, 0x1a, 0x26, 0xea, 0xe3, 0x0d, 0x18, 0x6b, 0x1e, 0xb6, 0xb4,
+	0x45, 0xcb, 0x6f, 0xa4, 0x89, 0x72, 0xf6, 0x27, 0x4f, 0x98, 0x78, 0x57, 0x7d, 0x54, 0xa4, 0x87,
+	0x1f, 0x85, 0x87, 0x8d, 0x02, 0xf9, 0x48, 0x1f, 0x79, 0x98, 0xfb, 0x94, 0x86, 0xb4, 0x38, 0x0e,
+	0xfe, 0x80, 0x00, 0x1a, 0x23, 0xc1, 0xf0, 0x4a, 0x7b, 0xdc, 0xd6, 0x9f, 0x8e, 0x4c, 0xb6, 0x0b,
+	0x85, 0x34, 0xcb, 0x38, 0xff, 0xee, 0xc7, 0xdf, 0xf7, 0x3d, 0x53, 0x78, 0xd2, 0x6a, 0x4a, 0x2d,
+	0x29, 0xb5, 0xd4, 0xa6, 0x7f, 0x45, 0x30, 0x1c, 0x5f, 0x30, 0x9c, 0xeb, 0xd0, 0xa6, 0xc5, 0x26,
+	0x67, 0x56, 0xbb, 0xd2, 0x28, 0x38, 0x4b, 0xc0, 0x2d, 0xe1, 0x85, 0xb6, 0x70, 0x56, 0x62, 0xc3,
+	0xf1, 0x67, 0x04, 0x83, 0x0d, 0x07, 0xb1, 0x75, 0x1a, 0x3b, 0xe2, 0xfe, 0xad, 0x9c, 0x5e, 0xa0,
+	0x08, 0x97, 0x05, 0xe1, 0x3c, 0xbe, 0xd0, 0x9e, 0x50, 0xfc, 0x14, 0xdd, 0x08, 0xe8, 0x1b, 0x82,
+	0xd1, 0xe4, 0xd0, 0xe2, 0x2b, 0x1d, 0x5a, 0xb6, 0x5c, 0x81, 0xcc, 0xd5, 0x2e, 0x55, 0x8a, 0x36,
+	0x2b, 0x68, 0x2f, 0xe1, 0xa5, 0xf6, 0xb4, 0xc7, 0x36, 0x27, 0xbf, 0xb6, 0x7f, 0xa8, 0xa3, 0x83,
+	0x43, 0x1d, 0xfd, 0x39, 0xd4, 0xd1, 0xde, 0x91, 0x9e, 0x3a, 0x38, 0xd2, 0x53, 0x3f, 0x8f, 0xf4,
+	0xd4, 0xab, 0x65, 0xc7, 0xe5, 0xd5, 0xb0, 0x64, 0x96, 0x69, 0x4d, 0x95, 0xbb, 0xfc, 0x28, 0x56,
+	0x6f, 0xa7, 0x5e, 0x91, 0xef, 0xfa, 0x84, 0x95, 0xfa, 0xc5, 0x5a, 0xac, 0xfe, 0x1f, 0x00, 0x96,
+	0x14, 0x93, 0x33, 0xdd, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -367,6 +559,9 @@ type QueryClient interface {
 	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	EpochInfo(ctx context.Context, in *QueryEpochInfoRequest, opts ...grpc.CallOption) (*QueryEpochInfoResponse, error)
+	// UpcomingEpochs provides the next start time and estimated start height of
+	// every epoch
+	UpcomingEpochs(ctx context.Context, in *QueryUpcomingEpochsRequest, opts ...grpc.CallOption) (*QueryUpcomingEpochsResponse, error)
 }
 
 type queryClient struct {
@@ -404,6 +599,15 @@ func (c *queryClient) EpochInfo(ctx context.Context, in *QueryEpochInfoRequest,
 	return out, nil
 }
 
+func (c *queryClient) UpcomingEpochs(ctx context.Context, in *QueryUpcomingEpochsRequest, opts ...grpc.CallOption) (*QueryUpcomingEpochsResponse, error) {
+	out := new(QueryUpcomingEpochsResponse)
+	err := c.cc.Invoke(ctx, "/Stridelabs.stride.epochs.Query/UpcomingEpochs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// EpochInfos provide running epochInfos
@@ -412,6 +616,9 @@ type QueryServer interface {
 	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	EpochInfo(context.Context, *QueryEpochInfoRequest) (*QueryEpochInfoResponse, error)
+	// UpcomingEpochs provides the next start time and estimated start height of
+	// every epoch
+	UpcomingEpochs(context.Context, *QueryUpcomingEpochsRequest) (*QueryUpcomingEpochsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -427,6 +634,9 @@ func (*UnimplementedQueryServer) CurrentEpoch(ctx context.Context, req *QueryCur
 func (*UnimplementedQueryServer) EpochInfo(ctx context.Context, req *QueryEpochInfoRequest) (*QueryEpochInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EpochInfo not implemented")
 }
+func (*UnimplementedQueryServer) UpcomingEpochs(ctx context.Context, req *QueryUpcomingEpochsRequest) (*QueryUpcomingEpochsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpcomingEpochs not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -486,6 +696,24 @@ func _Query_EpochInfo_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UpcomingEpochs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpcomingEpochsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpcomingEpochs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Stridelabs.stride.epochs.Query/UpcomingEpochs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpcomingEpochs(ctx, req.(*QueryUpcomingEpochsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "Stridelabs.stride.epochs.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -502,6 +730,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EpochInfo",
 			Handler:    _Query_EpochInfo_Handler,
 		},
+		{
+			MethodName: "UpcomingEpochs",
+			Handler:    _Query_UpcomingEpochs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "epochs/query.proto",
@@ -712,6 +944,132 @@ func (m *QueryEpochInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *UpcomingEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpcomingEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpcomingEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EstimatedNextEpochHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EstimatedNextEpochHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.NextEpochStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.NextEpochStartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if m.NextEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpcomingEpochsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpcomingEpochsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpcomingEpochsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpcomingEpochsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpcomingEpochsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpcomingEpochsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.EstimatedBlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.EstimatedBlockTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if len(m.UpcomingEpochs) > 0 {
+		for iNdEx := len(m.UpcomingEpochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpcomingEpochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -804,6 +1162,56 @@ func (m *QueryEpochInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *UpcomingEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NextEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.NextEpoch))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.NextEpochStartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EstimatedNextEpochHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EstimatedNextEpochHeight))
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryUpcomingEpochsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUpcomingEpochsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UpcomingEpochs) > 0 {
+		for _, e := range m.UpcomingEpochs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.EstimatedBlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1332,6 +1740,346 @@ func (m *QueryEpochInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UpcomingEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpcomingEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpcomingEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpoch", wireType)
+			}
+			m.NextEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.NextEpochStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedNextEpochHeight", wireType)
+			}
+			m.EstimatedNextEpochHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedNextEpochHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpcomingEpochsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpcomingEpochsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpcomingEpochsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpcomingEpochsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpcomingEpochsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpcomingEpochsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpcomingEpochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpcomingEpochs = append(m.UpcomingEpochs, UpcomingEpoch{})
+			if err := m.UpcomingEpochs[len(m.UpcomingEpochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedBlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.EstimatedBlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -137,6 +137,12 @@ func (k Keeper) CallRegisteredICACallback(ctx sdk.Context, modulePacket channelt
 	// call the callback
 	if (*callbackHandler).HasICACallback(callbackData.CallbackId) {
 		k.Logger(ctx).Info(fmt.Sprintf("Calling callback for %s", callbackData.CallbackId))
+		// attach the packet, so the changes made by the callback can be traced back to it
+		ctx := types.WithPacketContext(ctx, types.PacketContext{
+			ChannelId: modulePacket.GetSourceChannel(),
+			Sequence:  modulePacket.Sequence,
+			AckResult: types.AckResult(ack),
+		})
 		// if acknowledgement is empty, then it is a timeout
 		err := (*callbackHandler).CallICACallback(ctx, callbackData.CallbackId, modulePacket, ack, callbackData.CallbackArgs)
 		if err != nil {
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+const (
+	AckResultSuccess = "success"
+	AckResultError   = "error"
+	AckResultTimeout = "timeout"
+)
+
+type packetContextKey struct{}
+
+// PacketContext identifies the packet, and the result of its acknowledgement, behind the state changes
+// made while it's attached to the context, so they can be traced back to it
+type PacketContext struct {
+	ChannelId string
+	Sequence  uint64
+	// empty when the packet is being sent
+	AckResult string
+}
+
+// WithPacketContext attaches a packet to the context
+func WithPacketContext(ctx sdk.Context, packetContext PacketContext) sdk.Context {
+	return ctx.WithValue(packetContextKey{}, packetContext)
+}
+
+// GetPacketContext returns the packet attached to the context, if any
+func GetPacketContext(ctx sdk.Context) (packetContext PacketContext, found bool) {
+	packetContext, found = ctx.Value(packetContextKey{}).(PacketContext)
+	return packetContext, found
+}
+
+// AckResult describes the result of an acknowledgement, which is nil if the packet timed out
+func AckResult(ack *channeltypes.Acknowledgement) string {
+	if ack == nil {
+		return AckResultTimeout
+	}
+	if errAck, ok := ack.Response.(*channeltypes.Acknowledgement_Error); ok {
+		return fmt.Sprintf("%s: %s", AckResultError, errAck.Error)
+	}
+	return AckResultSuccess
+}
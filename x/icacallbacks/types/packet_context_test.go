@@ -0,0 +1,34 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Stride-Labs/stride/x/icacallbacks/types"
+)
+
+func TestAckResult(t *testing.T) {
+	require.Equal(t, types.AckResultTimeout, types.AckResult(nil))
+	require.Equal(t, types.AckResultSuccess, types.AckResult(&channeltypes.Acknowledgement{
+		Response: &channeltypes.Acknowledgement_Result{Result: []byte{1}},
+	}))
+	require.Equal(t, "error: failed", types.AckResult(&channeltypes.Acknowledgement{
+		Response: &channeltypes.Acknowledgement_Error{Error: "failed"},
+	}))
+}
+
+func TestPacketContext(t *testing.T) {
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil)
+
+	_, found := types.GetPacketContext(ctx)
+	require.False(t, found)
+
+	packetContext := types.PacketContext{ChannelId: "channel-0", Sequence: 2, AckResult: types.AckResultSuccess}
+	got, found := types.GetPacketContext(types.WithPacketContext(ctx, packetContext))
+	require.True(t, found)
+	require.Equal(t, packetContext, got)
+}
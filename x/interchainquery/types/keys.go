@@ -26,8 +26,9 @@ const (
 // new chain
 
 const (
-	STAKING_STORE_QUERY_WITH_PROOF = "store/staking/key"
-	BANK_STORE_QUERY_WITH_PROOF    = "store/bank/key"
+	STAKING_STORE_QUERY_WITH_PROOF      = "store/staking/key"
+	BANK_STORE_QUERY_WITH_PROOF         = "store/bank/key"
+	DISTRIBUTION_STORE_QUERY_WITH_PROOF = "store/distribution/key"
 )
 
 var (
@@ -27,10 +27,12 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdListUserRedemptionRecord())
 	cmd.AddCommand(CmdShowUserRedemptionRecord())
+	cmd.AddCommand(CmdListUserRedemptionRecordsByAddress())
 	cmd.AddCommand(CmdListEpochUnbondingRecord())
 	cmd.AddCommand(CmdShowEpochUnbondingRecord())
 	cmd.AddCommand(CmdListDepositRecord())
 	cmd.AddCommand(CmdShowDepositRecord())
+	cmd.AddCommand(CmdShowRecordHistory())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/x/records/types"
+)
+
+func CmdShowRecordHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-record-history [record-type] [record-id]",
+		Short: "shows the status transitions of a record",
+		Long: strings.TrimSpace(`shows the status transitions of a deposit record or host zone unbonding
+record-type is DEPOSIT_RECORD or HOST_ZONE_UNBONDING, and host zone unbondings are identified by {host-zone-id}.{epoch-number}`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			recordType, found := types.RecordStatusTransition_RecordType_value[strings.ToUpper(args[0])]
+			if !found {
+				return fmt.Errorf("invalid record type %s", args[0])
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryRecordHistoryRequest{
+				RecordType: types.RecordStatusTransition_RecordType(recordType),
+				RecordId:   args[1],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.RecordHistory(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
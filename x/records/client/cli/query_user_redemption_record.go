@@ -9,6 +9,8 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const FlagClaimableOnly = "claimable-only"
+
 func CmdListUserRedemptionRecord() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-user-redemption-record",
@@ -71,3 +73,45 @@ func CmdShowUserRedemptionRecord() *cobra.Command {
 
 	return cmd
 }
+
+func CmdListUserRedemptionRecordsByAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-user-redemption-records-by-address [address]",
+		Short: "list the userRedemptionRecords of an address across all host zones",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			claimableOnly, err := cmd.Flags().GetBool(FlagClaimableOnly)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryUserRedemptionRecordsByAddressRequest{
+				Address:       args[0],
+				ClaimableOnly: claimableOnly,
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.UserRedemptionRecordsByAddress(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(FlagClaimableOnly, false, "only list the records that can be claimed now")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -30,6 +30,14 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 	// Set depositRecord count
 	k.SetDepositRecordCount(ctx, genState.DepositRecordCount)
+
+	// Set all the recordStatusTransition
+	for _, elem := range genState.RecordStatusTransitionList {
+		k.SetRecordStatusTransition(ctx, elem)
+	}
+
+	// Set recordStatusTransition count
+	k.SetRecordStatusTransitionCount(ctx, genState.RecordStatusTransitionCount)
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -41,6 +49,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	genesis.UserRedemptionRecordList = k.GetAllUserRedemptionRecord(ctx)
 	genesis.EpochUnbondingRecordList = k.GetAllEpochUnbondingRecord(ctx)
+	genesis.RecordStatusTransitionList = k.GetAllRecordStatusTransition(ctx)
+	genesis.RecordStatusTransitionCount = k.GetRecordStatusTransitionCount(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
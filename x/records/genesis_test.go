@@ -42,6 +42,19 @@ func TestGenesis(t *testing.T) {
 			},
 		},
 		DepositRecordCount: 2,
+		RecordStatusTransitionList: []types.RecordStatusTransition{
+			{
+				Id:         0,
+				RecordType: types.RecordStatusTransition_DEPOSIT_RECORD,
+				RecordId:   "0",
+			},
+			{
+				Id:         1,
+				RecordType: types.RecordStatusTransition_HOST_ZONE_UNBONDING,
+				RecordId:   "GAIA.1",
+			},
+		},
+		RecordStatusTransitionCount: 2,
 	}
 	k, ctx := keepertest.RecordsKeeper(t)
 	records.InitGenesis(ctx, *k, genesisState)
@@ -55,5 +68,8 @@ func TestGenesis(t *testing.T) {
 
 	require.ElementsMatch(t, genesisState.DepositRecordList, got.DepositRecordList)
 	require.Equal(t, genesisState.DepositRecordCount, got.DepositRecordCount)
+	require.ElementsMatch(t, genesisState.RecordStatusTransitionList, got.RecordStatusTransitionList)
+	require.Equal(t, genesisState.RecordStatusTransitionCount, got.RecordStatusTransitionCount)
+	require.Equal(t, genesisState.Params, got.Params)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
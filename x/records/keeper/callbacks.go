@@ -2,7 +2,9 @@ package keeper
 
 import (
 	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
+	"github.com/Stride-Labs/stride/x/records/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 )
@@ -37,7 +39,33 @@ func (c ICACallbacks) AddICACallback(id string, fn interface{}) icacallbackstype
 	return c
 }
 
+// icaCallbackRegistration pairs an ICA callback with the type of its args
+type icaCallbackRegistration struct {
+	id       string
+	callback ICACallback
+	newArgs  func() codec.ProtoMarshaler
+}
+
+// icaCallbackRegistrations lists every ICA callback, which is registered with the handler,
+// and whose args types are used to validate the callback data in genesis
+func icaCallbackRegistrations() []icaCallbackRegistration {
+	return []icaCallbackRegistration{
+		{TRANSFER, TransferCallback, func() codec.ProtoMarshaler { return &types.TransferCallback{} }},
+	}
+}
+
 func (c ICACallbacks) RegisterICACallbacks() icacallbackstypes.ICACallbackHandler {
-	a := c.AddICACallback(TRANSFER, ICACallback(TransferCallback))
-	return a.(ICACallbacks)
+	for _, registration := range icaCallbackRegistrations() {
+		c.AddICACallback(registration.id, registration.callback)
+	}
+	return c
+}
+
+// ICACallbackArgTypes returns a constructor for the args of each registered ICA callback
+func ICACallbackArgTypes() map[string]func() codec.ProtoMarshaler {
+	argTypes := make(map[string]func() codec.ProtoMarshaler)
+	for _, registration := range icaCallbackRegistrations() {
+		argTypes[registration.id] = registration.newArgs
+	}
+	return argTypes
 }
@@ -47,6 +47,7 @@ func (k Keeper) AppendDepositRecord(
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DepositRecordKey))
 	appendedValue := k.Cdc.MustMarshal(&depositRecord)
 	store.Set(GetDepositRecordIDBytes(depositRecord.Id), appendedValue)
+	k.setDepositRecordIndexes(ctx, depositRecord)
 
 	// Update depositRecord count
 	k.SetDepositRecordCount(ctx, count+1)
@@ -54,11 +55,18 @@ func (k Keeper) AppendDepositRecord(
 	return count
 }
 
-// SetDepositRecord set a specific depositRecord in the store
+// SetDepositRecord set a specific depositRecord in the store, moves its index entries
+// if its host zone, status or epoch changed, and records any change in its status
 func (k Keeper) SetDepositRecord(ctx sdk.Context, depositRecord types.DepositRecord) {
+	if oldDepositRecord, found := k.GetDepositRecord(ctx, depositRecord.Id); found {
+		k.removeDepositRecordIndexes(ctx, oldDepositRecord)
+		k.recordDepositRecordTransition(ctx, oldDepositRecord, &depositRecord)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DepositRecordKey))
 	b := k.Cdc.MustMarshal(&depositRecord)
 	store.Set(GetDepositRecordIDBytes(depositRecord.Id), b)
+	k.setDepositRecordIndexes(ctx, depositRecord)
 }
 
 // GetDepositRecord returns a depositRecord from its id
@@ -72,8 +80,14 @@ func (k Keeper) GetDepositRecord(ctx sdk.Context, id uint64) (val types.DepositR
 	return val, true
 }
 
-// RemoveDepositRecord removes a depositRecord from the store
+// RemoveDepositRecord removes a depositRecord, along with its index entries, from the store
+// The removal is recorded in the record's history
 func (k Keeper) RemoveDepositRecord(ctx sdk.Context, id uint64) {
+	if depositRecord, found := k.GetDepositRecord(ctx, id); found {
+		k.removeDepositRecordIndexes(ctx, depositRecord)
+		k.recordDepositRecordTransition(ctx, depositRecord, nil)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DepositRecordKey))
 	store.Delete(GetDepositRecordIDBytes(id))
 }
@@ -101,12 +115,71 @@ func GetDepositRecordIDBytes(id uint64) []byte {
 	return bz
 }
 
+// GetDepositRecordByEpochAndChain returns the first deposit record of a host zone in a given deposit epoch
 func (k Keeper) GetDepositRecordByEpochAndChain(ctx sdk.Context, epochNumber uint64, chainId string) (val *types.DepositRecord, found bool) {
-	records := k.GetAllDepositRecord(ctx)
-	for _, depositRecord := range records {
-		if depositRecord.DepositEpochNumber == epochNumber && depositRecord.HostZoneId == chainId {
+	ids := k.getIndexedDepositRecordIds(ctx, types.DepositRecordEpochHostZoneIndexKey, types.DepositRecordEpochHostZonePrefix(epochNumber, chainId))
+	for _, id := range ids {
+		if depositRecord, found := k.GetDepositRecord(ctx, id); found {
 			return &depositRecord, true
 		}
 	}
 	return nil, false
 }
+
+// GetDepositRecordsByEpochAndChain returns the deposit records of a host zone in a given deposit epoch
+func (k Keeper) GetDepositRecordsByEpochAndChain(ctx sdk.Context, epochNumber uint64, chainId string) []types.DepositRecord {
+	ids := k.getIndexedDepositRecordIds(ctx, types.DepositRecordEpochHostZoneIndexKey, types.DepositRecordEpochHostZonePrefix(epochNumber, chainId))
+	return k.getDepositRecordsByIds(ctx, ids)
+}
+
+// GetDepositRecordsByHostZoneAndStatus returns the deposit records of a host zone with a given status, ordered by id
+func (k Keeper) GetDepositRecordsByHostZoneAndStatus(ctx sdk.Context, chainId string, status types.DepositRecord_Status) []types.DepositRecord {
+	ids := k.getIndexedDepositRecordIds(ctx, types.DepositRecordHostZoneStatusIndexKey, types.DepositRecordHostZoneStatusPrefix(chainId, status))
+	return k.getDepositRecordsByIds(ctx, ids)
+}
+
+// setDepositRecordIndexes adds a deposit record's id to the secondary indexes
+func (k Keeper) setDepositRecordIndexes(ctx sdk.Context, depositRecord types.DepositRecord) {
+	idBz := GetDepositRecordIDBytes(depositRecord.Id)
+
+	statusStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DepositRecordHostZoneStatusIndexKey))
+	statusStore.Set(append(types.DepositRecordHostZoneStatusPrefix(depositRecord.HostZoneId, depositRecord.Status), idBz...), []byte{})
+
+	epochStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DepositRecordEpochHostZoneIndexKey))
+	epochStore.Set(append(types.DepositRecordEpochHostZonePrefix(depositRecord.DepositEpochNumber, depositRecord.HostZoneId), idBz...), []byte{})
+}
+
+// removeDepositRecordIndexes removes a deposit record's id from the secondary indexes
+func (k Keeper) removeDepositRecordIndexes(ctx sdk.Context, depositRecord types.DepositRecord) {
+	idBz := GetDepositRecordIDBytes(depositRecord.Id)
+
+	statusStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DepositRecordHostZoneStatusIndexKey))
+	statusStore.Delete(append(types.DepositRecordHostZoneStatusPrefix(depositRecord.HostZoneId, depositRecord.Status), idBz...))
+
+	epochStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DepositRecordEpochHostZoneIndexKey))
+	epochStore.Delete(append(types.DepositRecordEpochHostZonePrefix(depositRecord.DepositEpochNumber, depositRecord.HostZoneId), idBz...))
+}
+
+// getIndexedDepositRecordIds returns the deposit record ids under a prefix of one of the secondary indexes
+// The id is the trailing 8 bytes of each index key
+func (k Keeper) getIndexedDepositRecordIds(ctx sdk.Context, indexKey string, indexPrefix []byte) (ids []uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(indexKey))
+	iterator := sdk.KVStorePrefixIterator(store, indexPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		ids = append(ids, binary.BigEndian.Uint64(key[len(key)-8:]))
+	}
+	return ids
+}
+
+func (k Keeper) getDepositRecordsByIds(ctx sdk.Context, ids []uint64) (list []types.DepositRecord) {
+	for _, id := range ids {
+		if depositRecord, found := k.GetDepositRecord(ctx, id); found {
+			list = append(list, depositRecord)
+		}
+	}
+	return list
+}
@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Stride-Labs/stride/testutil/keeper"
+	"github.com/Stride-Labs/stride/testutil/sample"
+	"github.com/Stride-Labs/stride/x/records/keeper"
+	v2 "github.com/Stride-Labs/stride/x/records/migrations/v2"
+	v3 "github.com/Stride-Labs/stride/x/records/migrations/v3"
+	"github.com/Stride-Labs/stride/x/records/types"
+)
+
+// Creates deposit records across two host zones, three epochs and two statuses
+func createDepositRecordsForIndexes(keeper *keeper.Keeper, ctx sdk.Context) []types.DepositRecord {
+	items := []types.DepositRecord{}
+	for _, hostZoneId := range []string{"GAIA", "OSMO"} {
+		for epoch := uint64(1); epoch <= 3; epoch++ {
+			status := types.DepositRecord_TRANSFER_QUEUE
+			if epoch == 1 {
+				status = types.DepositRecord_DELEGATION_QUEUE
+			}
+			depositRecord := types.DepositRecord{
+				HostZoneId:         hostZoneId,
+				DepositEpochNumber: epoch,
+				Status:             status,
+				Amount:             sdk.NewInt(int64(epoch)),
+			}
+			depositRecord.Id = keeper.AppendDepositRecord(ctx, depositRecord)
+			items = append(items, depositRecord)
+		}
+	}
+	return items
+}
+
+func depositRecordIds(depositRecords []types.DepositRecord) (ids []uint64) {
+	for _, depositRecord := range depositRecords {
+		ids = append(ids, depositRecord.Id)
+	}
+	return ids
+}
+
+func TestGetDepositRecordsByHostZoneAndStatus(t *testing.T) {
+	keeper, ctx := keepertest.RecordsKeeper(t)
+	createDepositRecordsForIndexes(keeper, ctx)
+
+	require.Equal(t, []uint64{1, 2}, depositRecordIds(keeper.GetDepositRecordsByHostZoneAndStatus(ctx, "GAIA", types.DepositRecord_TRANSFER_QUEUE)))
+	require.Equal(t, []uint64{3}, depositRecordIds(keeper.GetDepositRecordsByHostZoneAndStatus(ctx, "OSMO", types.DepositRecord_DELEGATION_QUEUE)))
+	require.Empty(t, keeper.GetDepositRecordsByHostZoneAndStatus(ctx, "GAIA", types.DepositRecord_TRANSFER_IN_PROGRESS))
+	require.Empty(t, keeper.GetDepositRecordsByHostZoneAndStatus(ctx, "JUNO", types.DepositRecord_TRANSFER_QUEUE))
+}
+
+func TestGetDepositRecordByEpochAndChain(t *testing.T) {
+	keeper, ctx := keepertest.RecordsKeeper(t)
+	createDepositRecordsForIndexes(keeper, ctx)
+
+	depositRecord, found := keeper.GetDepositRecordByEpochAndChain(ctx, 2, "OSMO")
+	require.True(t, found)
+	require.Equal(t, uint64(4), depositRecord.Id)
+	require.Equal(t, "OSMO", depositRecord.HostZoneId)
+	require.Equal(t, uint64(2), depositRecord.DepositEpochNumber)
+
+	_, found = keeper.GetDepositRecordByEpochAndChain(ctx, 4, "OSMO")
+	require.False(t, found)
+	_, found = keeper.GetDepositRecordByEpochAndChain(ctx, 2, "JUNO")
+	require.False(t, found)
+
+	require.Equal(t, []uint64{1}, depositRecordIds(keeper.GetDepositRecordsByEpochAndChain(ctx, 2, "GAIA")))
+}
+
+func TestSetDepositRecordUpdatesIndexes(t *testing.T) {
+	keeper, ctx := keepertest.RecordsKeeper(t)
+	items := createDepositRecordsForIndexes(keeper, ctx)
+
+	// move a record to a different status and epoch
+	depositRecord := items[1]
+	depositRecord.Status = types.DepositRecord_DELEGATION_QUEUE
+	depositRecord.DepositEpochNumber = 5
+	keeper.SetDepositRecord(ctx, depositRecord)
+
+	require.Equal(t, []uint64{2}, depositRecordIds(keeper.GetDepositRecordsByHostZoneAndStatus(ctx, "GAIA", types.DepositRecord_TRANSFER_QUEUE)))
+	require.Equal(t, []uint64{0, 1}, depositRecordIds(keeper.GetDepositRecordsByHostZoneAndStatus(ctx, "GAIA", types.DepositRecord_DELEGATION_QUEUE)))
+
+	_, found := keeper.GetDepositRecordByEpochAndChain(ctx, 2, "GAIA")
+	require.False(t, found, "record should be removed from its old epoch")
+	got, found := keeper.GetDepositRecordByEpochAndChain(ctx, 5, "GAIA")
+	require.True(t, found, "record should be indexed under its new epoch")
+	require.Equal(t, depositRecord, *got)
+}
+
+func TestRemoveDepositRecordRemovesIndexes(t *testing.T) {
+	keeper, ctx := keepertest.RecordsKeeper(t)
+	items := createDepositRecordsForIndexes(keeper, ctx)
+
+	for _, item := range items {
+		keeper.RemoveDepositRecord(ctx, item.Id)
+	}
+
+	for _, hostZoneId := range []string{"GAIA", "OSMO"} {
+		for _, status := range []types.DepositRecord_Status{types.DepositRecord_TRANSFER_QUEUE, types.DepositRecord_DELEGATION_QUEUE} {
+			require.Empty(t, keeper.GetDepositRecordsByHostZoneAndStatus(ctx, hostZoneId, status))
+		}
+		for epoch := uint64(1); epoch <= 3; epoch++ {
+			_, found := keeper.GetDepositRecordByEpochAndChain(ctx, epoch, hostZoneId)
+			require.False(t, found)
+		}
+	}
+}
+
+func (s *KeeperTestSuite) TestMigrate1to2_BuildsDepositRecordIndexes() {
+	items := createDepositRecordsForIndexes(&s.App.RecordsKeeper, s.Ctx())
+	s.setLegacyDepositRecords(items)
+
+	// Clear the indexes, to match the store before the migration
+	store := s.Ctx().KVStore(s.App.GetKey(types.StoreKey))
+	for _, indexKey := range []string{types.DepositRecordHostZoneStatusIndexKey, types.DepositRecordEpochHostZoneIndexKey} {
+		indexStore := prefix.NewStore(store, types.KeyPrefix(indexKey))
+		iterator := indexStore.Iterator(nil, nil)
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			indexStore.Delete(key)
+		}
+	}
+	s.Require().Empty(s.App.RecordsKeeper.GetDepositRecordsByHostZoneAndStatus(s.Ctx(), "GAIA", types.DepositRecord_TRANSFER_QUEUE))
+
+	// The records can only be read back once their amounts are also migrated
+	err := v2.MigrateStore(s.Ctx(), s.App.GetKey(types.StoreKey), s.App.AppCodec(), s.App.GetSubspace(types.ModuleName))
+	s.Require().NoError(err)
+	err = v3.MigrateStore(s.Ctx(), s.App.GetKey(types.StoreKey), s.App.AppCodec())
+	s.Require().NoError(err)
+
+	s.Require().Equal([]uint64{1, 2}, depositRecordIds(s.App.RecordsKeeper.GetDepositRecordsByHostZoneAndStatus(s.Ctx(), "GAIA", types.DepositRecord_TRANSFER_QUEUE)))
+	s.Require().Equal([]uint64{3}, depositRecordIds(s.App.RecordsKeeper.GetDepositRecordsByHostZoneAndStatus(s.Ctx(), "OSMO", types.DepositRecord_DELEGATION_QUEUE)))
+	for _, item := range items {
+		got, found := s.App.RecordsKeeper.GetDepositRecordByEpochAndChain(s.Ctx(), item.DepositEpochNumber, item.HostZoneId)
+		s.Require().True(found)
+		s.Require().Equal(item, *got)
+	}
+}
+
+func (s *KeeperTestSuite) TestMigrate1to2_BuildsUserRedemptionRecordIndex() {
+	address := sample.AccAddress()
+	items := createUserRedemptionRecordsForAddress(&s.App.RecordsKeeper, s.Ctx(), address)
+	s.setLegacyUserRedemptionRecords(s.App.RecordsKeeper.GetAllUserRedemptionRecord(s.Ctx()))
+	s.setLegacyEpochUnbondingRecords(s.App.RecordsKeeper.GetAllEpochUnbondingRecord(s.Ctx()))
+
+	// Clear the index, to match the store before the migration
+	store := s.Ctx().KVStore(s.App.GetKey(types.StoreKey))
+	indexStore := prefix.NewStore(store, types.KeyPrefix(types.UserRedemptionRecordAddressIndexKey))
+	for _, item := range items {
+		indexStore.Delete(types.UserRedemptionRecordAddressIndex(item.Sender, item.Id))
+	}
+	s.Require().Empty(s.App.RecordsKeeper.GetUserRedemptionRecordsByAddress(s.Ctx(), address))
+
+	err := v2.MigrateStore(s.Ctx(), s.App.GetKey(types.StoreKey), s.App.AppCodec(), s.App.GetSubspace(types.ModuleName))
+	s.Require().NoError(err)
+	err = v3.MigrateStore(s.Ctx(), s.App.GetKey(types.StoreKey), s.App.AppCodec())
+	s.Require().NoError(err)
+
+	s.Require().ElementsMatch(items, s.App.RecordsKeeper.GetUserRedemptionRecordsByAddress(s.Ctx(), address))
+}
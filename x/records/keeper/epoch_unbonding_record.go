@@ -13,8 +13,13 @@ import (
 	"github.com/Stride-Labs/stride/x/records/types"
 )
 
-// SetEpochUnbondingRecord set a specific epochUnbondingRecord in the store
+// SetEpochUnbondingRecord set a specific epochUnbondingRecord in the store, and records any change
+// in the status of its host zone unbondings
 func (k Keeper) SetEpochUnbondingRecord(ctx sdk.Context, epochUnbondingRecord types.EpochUnbondingRecord) {
+	if oldEpochUnbondingRecord, found := k.GetEpochUnbondingRecord(ctx, epochUnbondingRecord.EpochNumber); found {
+		k.recordHostZoneUnbondingTransitions(ctx, oldEpochUnbondingRecord, &epochUnbondingRecord)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EpochUnbondingRecordKey))
 	b := k.Cdc.MustMarshal(&epochUnbondingRecord)
 	store.Set(GetEpochUnbondingRecordIDBytes(epochUnbondingRecord.EpochNumber), b)
@@ -32,7 +37,12 @@ func (k Keeper) GetEpochUnbondingRecord(ctx sdk.Context, epochNumber uint64) (va
 }
 
 // RemoveEpochUnbondingRecord removes a epochUnbondingRecord from the store
+// The removal of its host zone unbondings is recorded in their history
 func (k Keeper) RemoveEpochUnbondingRecord(ctx sdk.Context, epochNumber uint64) {
+	if epochUnbondingRecord, found := k.GetEpochUnbondingRecord(ctx, epochNumber); found {
+		k.recordHostZoneUnbondingTransitions(ctx, epochUnbondingRecord, nil)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EpochUnbondingRecordKey))
 	store.Delete(GetEpochUnbondingRecordIDBytes(epochNumber))
 }
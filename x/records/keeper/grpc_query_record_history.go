@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Stride-Labs/stride/x/records/types"
+)
+
+func (k Keeper) RecordHistory(c context.Context, req *types.QueryRecordHistoryRequest) (*types.QueryRecordHistoryResponse, error) {
+	if req == nil || req.RecordId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var transitions []types.RecordStatusTransition
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// page through the record index, so the history of other records is never read
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, types.KeyPrefix(types.RecordStatusTransitionRecordIndexKey))
+	recordStore := prefix.NewStore(indexStore, types.RecordStatusTransitionRecordPrefix(req.RecordType, req.RecordId))
+
+	pageRes, err := query.Paginate(recordStore, req.Pagination, func(key []byte, _ []byte) error {
+		if transition, found := k.GetRecordStatusTransition(ctx, binary.BigEndian.Uint64(key)); found {
+			transitions = append(transitions, transition)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRecordHistoryResponse{RecordStatusTransitions: transitions, Pagination: pageRes}, nil
+}
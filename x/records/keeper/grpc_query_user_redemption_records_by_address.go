@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Stride-Labs/stride/x/records/types"
+)
+
+func (k Keeper) UserRedemptionRecordsByAddress(c context.Context, req *types.QueryUserRedemptionRecordsByAddressRequest) (*types.QueryUserRedemptionRecordsByAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	// validate the address
+	_, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, req.Address)
+	}
+
+	var userRedemptionRecords []types.UserRedemptionRecordWithStatus
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// page through the address index, so the records of other addresses are never read
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, types.KeyPrefix(types.UserRedemptionRecordAddressIndexKey))
+	addressStore := prefix.NewStore(indexStore, types.UserRedemptionRecordAddressPrefix(req.Address))
+
+	pageRes, err := query.FilteredPaginate(addressStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		userRedemptionRecord, found := k.GetUserRedemptionRecord(ctx, string(key))
+		if !found {
+			return false, nil
+		}
+
+		recordWithStatus := k.GetUserRedemptionRecordWithStatus(ctx, userRedemptionRecord)
+		if req.ClaimableOnly && !recordWithStatus.Claimable {
+			return false, nil
+		}
+
+		if accumulate {
+			userRedemptionRecords = append(userRedemptionRecords, recordWithStatus)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUserRedemptionRecordsByAddressResponse{UserRedemptionRecords: userRedemptionRecords, Pagination: pageRes}, nil
+}
@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Stride-Labs/stride/testutil/keeper"
+	"github.com/Stride-Labs/stride/testutil/sample"
+	"github.com/Stride-Labs/stride/x/records/keeper"
+	"github.com/Stride-Labs/stride/x/records/types"
+)
+
+// Creates redemption records for a user on two host zones and two epochs, along with one for another user
+// The GAIA unbonding of epoch 1 is claimable, and the OSMO unbonding of epoch 2 is in progress
+func createUserRedemptionRecordsForAddress(keeper *keeper.Keeper, ctx sdk.Context, address string) []types.UserRedemptionRecord {
+	keeper.SetEpochUnbondingRecord(ctx, types.EpochUnbondingRecord{
+		EpochNumber: 1,
+		HostZoneUnbondings: []*types.HostZoneUnbonding{
+			{HostZoneId: "GAIA", Status: types.HostZoneUnbonding_CLAIMABLE, UnbondingTime: 100},
+			{HostZoneId: "OSMO", Status: types.HostZoneUnbonding_CLAIMABLE, UnbondingTime: 100},
+		},
+	})
+	keeper.SetEpochUnbondingRecord(ctx, types.EpochUnbondingRecord{
+		EpochNumber: 2,
+		HostZoneUnbondings: []*types.HostZoneUnbonding{
+			{HostZoneId: "GAIA", Status: types.HostZoneUnbonding_UNBONDING_QUEUE},
+			{HostZoneId: "OSMO", Status: types.HostZoneUnbonding_UNBONDING_IN_PROGRESS, UnbondingTime: 200},
+		},
+	})
+
+	items := []types.UserRedemptionRecord{}
+	for _, hostZoneId := range []string{"GAIA", "OSMO"} {
+		for epoch := uint64(1); epoch <= 2; epoch++ {
+			item := types.UserRedemptionRecord{
+				Id:          types.UserRedemptionRecordKeyFormatter(hostZoneId, epoch, address),
+				Sender:      address,
+				HostZoneId:  hostZoneId,
+				EpochNumber: epoch,
+				Amount:      sdk.NewIntFromUint64(epoch),
+			}
+			// the OSMO claim of epoch 1 is already pending
+			item.ClaimIsPending = hostZoneId == "OSMO" && epoch == 1
+			keeper.SetUserRedemptionRecord(ctx, item)
+			items = append(items, item)
+		}
+	}
+
+	otherAddress := sample.AccAddress()
+	keeper.SetUserRedemptionRecord(ctx, types.UserRedemptionRecord{
+		Id:          types.UserRedemptionRecordKeyFormatter("GAIA", 1, otherAddress),
+		Sender:      otherAddress,
+		HostZoneId:  "GAIA",
+		EpochNumber: 1,
+	})
+	return items
+}
+
+func TestUserRedemptionRecordsByAddressIndex(t *testing.T) {
+	keeper, ctx := keepertest.RecordsKeeper(t)
+	address := sample.AccAddress()
+	items := createUserRedemptionRecordsForAddress(keeper, ctx, address)
+
+	require.ElementsMatch(t, items, keeper.GetUserRedemptionRecordsByAddress(ctx, address))
+
+	keeper.RemoveUserRedemptionRecord(ctx, items[0].Id)
+	require.ElementsMatch(t, items[1:], keeper.GetUserRedemptionRecordsByAddress(ctx, address))
+}
+
+func TestUserRedemptionRecordsByAddressQuery(t *testing.T) {
+	keeper, ctx := keepertest.RecordsKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	address := sample.AccAddress()
+	items := createUserRedemptionRecordsForAddress(keeper, ctx, address)
+
+	response, err := keeper.UserRedemptionRecordsByAddress(wctx, &types.QueryUserRedemptionRecordsByAddressRequest{Address: address})
+	require.NoError(t, err)
+	require.Len(t, response.UserRedemptionRecords, len(items))
+
+	expected := map[string]types.UserRedemptionRecordWithStatus{
+		items[0].Id: {UserRedemptionRecord: items[0], UnbondingStatus: types.HostZoneUnbonding_CLAIMABLE, EstimatedCompletionTime: 100, Claimable: true},
+		items[1].Id: {UserRedemptionRecord: items[1], UnbondingStatus: types.HostZoneUnbonding_UNBONDING_QUEUE},
+		items[2].Id: {UserRedemptionRecord: items[2], UnbondingStatus: types.HostZoneUnbonding_CLAIMABLE, EstimatedCompletionTime: 100},
+		items[3].Id: {UserRedemptionRecord: items[3], UnbondingStatus: types.HostZoneUnbonding_UNBONDING_IN_PROGRESS, EstimatedCompletionTime: 200},
+	}
+	for _, record := range response.UserRedemptionRecords {
+		require.Equal(t, expected[record.UserRedemptionRecord.Id], record, "record %s", record.UserRedemptionRecord.Id)
+	}
+
+	// only the GAIA record of epoch 1 can be claimed, the OSMO claim is already pending
+	response, err = keeper.UserRedemptionRecordsByAddress(wctx, &types.QueryUserRedemptionRecordsByAddressRequest{Address: address, ClaimableOnly: true})
+	require.NoError(t, err)
+	require.Equal(t, []types.UserRedemptionRecordWithStatus{expected[items[0].Id]}, response.UserRedemptionRecords)
+}
+
+func TestUserRedemptionRecordsByAddressQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.RecordsKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	address := sample.AccAddress()
+	items := createUserRedemptionRecordsForAddress(keeper, ctx, address)
+
+	var next []byte
+	ids := []string{}
+	for i := 0; i < len(items); i += 3 {
+		response, err := keeper.UserRedemptionRecordsByAddress(wctx, &types.QueryUserRedemptionRecordsByAddressRequest{
+			Address:    address,
+			Pagination: &query.PageRequest{Key: next, Limit: 3, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(response.UserRedemptionRecords), 3)
+		if i == 0 {
+			// the total is only counted on the first page
+			require.Equal(t, uint64(len(items)), response.Pagination.Total)
+		}
+		for _, record := range response.UserRedemptionRecords {
+			ids = append(ids, record.UserRedemptionRecord.Id)
+		}
+		next = response.Pagination.NextKey
+	}
+	require.Nil(t, next)
+
+	expectedIds := []string{}
+	for _, item := range items {
+		expectedIds = append(expectedIds, item.Id)
+	}
+	require.ElementsMatch(t, expectedIds, ids)
+}
+
+func TestUserRedemptionRecordsByAddressQueryInvalidAddress(t *testing.T) {
+	keeper, ctx := keepertest.RecordsKeeper(t)
+	_, err := keeper.UserRedemptionRecordsByAddress(sdk.WrapSDKContext(ctx), &types.QueryUserRedemptionRecordsByAddressRequest{Address: "invalid_address"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+}
@@ -118,9 +118,13 @@ func (k Keeper) Transfer(ctx sdk.Context, msg *ibctypes.MsgTransfer, depositReco
 	k.Logger(ctx).Info(fmt.Sprintf("Storing callback data: %v", callback))
 	k.ICACallbacksKeeper.SetCallbackData(ctx, callback)
 
-	// update the record state to TRANSFER_IN_PROGRESS
+	// update the record state to TRANSFER_IN_PROGRESS, tracing it back to the transfer packet
 	depositRecord.Status = types.DepositRecord_TRANSFER_IN_PROGRESS
-	k.SetDepositRecord(ctx, depositRecord)
+	packetCtx := icacallbackstypes.WithPacketContext(ctx, icacallbackstypes.PacketContext{
+		ChannelId: msg.SourceChannel,
+		Sequence:  sequence,
+	})
+	k.SetDepositRecord(packetCtx, depositRecord)
 
 	return nil
 }
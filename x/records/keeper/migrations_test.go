@@ -0,0 +1,175 @@
+package keeper_test
+
+import (
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/x/records/keeper"
+	oldrecordstypes "github.com/Stride-Labs/stride/x/records/migrations/v2/types"
+	"github.com/Stride-Labs/stride/x/records/types"
+)
+
+// Overwrites the given deposit records with their encoding from before amounts were migrated to sdk.Int
+func (s *KeeperTestSuite) setLegacyDepositRecords(depositRecords []types.DepositRecord) {
+	store := prefix.NewStore(s.Ctx().KVStore(s.App.GetKey(types.StoreKey)), types.KeyPrefix(types.DepositRecordKey))
+	for _, depositRecord := range depositRecords {
+		legacyDepositRecord := oldrecordstypes.DepositRecord{
+			Id:                 depositRecord.Id,
+			Amount:             depositRecord.Amount.Int64(),
+			Denom:              depositRecord.Denom,
+			HostZoneId:         depositRecord.HostZoneId,
+			Status:             oldrecordstypes.DepositRecord_Status(depositRecord.Status),
+			DepositEpochNumber: depositRecord.DepositEpochNumber,
+			Source:             oldrecordstypes.DepositRecord_Source(depositRecord.Source),
+		}
+		store.Set(keeper.GetDepositRecordIDBytes(depositRecord.Id), s.App.AppCodec().MustMarshal(&legacyDepositRecord))
+	}
+}
+
+// Overwrites the given user redemption records with their encoding from before amounts were migrated to sdk.Int
+func (s *KeeperTestSuite) setLegacyUserRedemptionRecords(userRedemptionRecords []types.UserRedemptionRecord) {
+	store := prefix.NewStore(s.Ctx().KVStore(s.App.GetKey(types.StoreKey)), types.KeyPrefix(types.UserRedemptionRecordKey))
+	for _, userRedemptionRecord := range userRedemptionRecords {
+		legacyUserRedemptionRecord := oldrecordstypes.UserRedemptionRecord{
+			Id:             userRedemptionRecord.Id,
+			Sender:         userRedemptionRecord.Sender,
+			Receiver:       userRedemptionRecord.Receiver,
+			Amount:         userRedemptionRecord.Amount.Uint64(),
+			Denom:          userRedemptionRecord.Denom,
+			HostZoneId:     userRedemptionRecord.HostZoneId,
+			EpochNumber:    userRedemptionRecord.EpochNumber,
+			ClaimIsPending: userRedemptionRecord.ClaimIsPending,
+		}
+		store.Set([]byte(userRedemptionRecord.Id), s.App.AppCodec().MustMarshal(&legacyUserRedemptionRecord))
+	}
+}
+
+// Overwrites the given epoch unbonding records with their encoding from before amounts were migrated to sdk.Int
+func (s *KeeperTestSuite) setLegacyEpochUnbondingRecords(epochUnbondingRecords []types.EpochUnbondingRecord) {
+	store := prefix.NewStore(s.Ctx().KVStore(s.App.GetKey(types.StoreKey)), types.KeyPrefix(types.EpochUnbondingRecordKey))
+	for _, epochUnbondingRecord := range epochUnbondingRecords {
+		legacyEpochUnbondingRecord := oldrecordstypes.EpochUnbondingRecord{EpochNumber: epochUnbondingRecord.EpochNumber}
+		for _, hostZoneUnbonding := range epochUnbondingRecord.HostZoneUnbondings {
+			legacyEpochUnbondingRecord.HostZoneUnbondings = append(legacyEpochUnbondingRecord.HostZoneUnbondings, &oldrecordstypes.HostZoneUnbonding{
+				StTokenAmount:         hostZoneUnbonding.StTokenAmount.Uint64(),
+				NativeTokenAmount:     hostZoneUnbonding.NativeTokenAmount.Uint64(),
+				Denom:                 hostZoneUnbonding.Denom,
+				HostZoneId:            hostZoneUnbonding.HostZoneId,
+				UnbondingTime:         hostZoneUnbonding.UnbondingTime,
+				Status:                oldrecordstypes.HostZoneUnbonding_Status(hostZoneUnbonding.Status),
+				UserRedemptionRecords: hostZoneUnbonding.UserRedemptionRecords,
+			})
+		}
+		store.Set(keeper.GetEpochUnbondingRecordIDBytes(epochUnbondingRecord.EpochNumber), s.App.AppCodec().MustMarshal(&legacyEpochUnbondingRecord))
+	}
+}
+
+func (s *KeeperTestSuite) TestMigrate2to3_ConvertsRecordAmounts() {
+	ctx := s.Ctx()
+	store := ctx.KVStore(s.App.GetKey(types.StoreKey))
+
+	// Store records the way they were stored before the migration
+	legacyUserRedemptionRecord := oldrecordstypes.UserRedemptionRecord{
+		Id:          "GAIA.1.stride_SENDER",
+		Sender:      "stride_SENDER",
+		Receiver:    "cosmos_RECEIVER",
+		Amount:      math.MaxUint64,
+		Denom:       "uatom",
+		HostZoneId:  "GAIA",
+		EpochNumber: 1,
+	}
+	userRedemptionRecordStore := prefix.NewStore(store, types.KeyPrefix(types.UserRedemptionRecordKey))
+	userRedemptionRecordStore.Set([]byte(legacyUserRedemptionRecord.Id), s.App.AppCodec().MustMarshal(&legacyUserRedemptionRecord))
+
+	legacyDepositRecord := oldrecordstypes.DepositRecord{
+		Id:                 3,
+		Amount:             math.MaxInt64,
+		Denom:              "uatom",
+		HostZoneId:         "GAIA",
+		Status:             oldrecordstypes.DepositRecord_DELEGATION_QUEUE,
+		DepositEpochNumber: 2,
+		Source:             oldrecordstypes.DepositRecord_WITHDRAWAL_ICA,
+	}
+	depositRecordStore := prefix.NewStore(store, types.KeyPrefix(types.DepositRecordKey))
+	depositRecordStore.Set(keeper.GetDepositRecordIDBytes(legacyDepositRecord.Id), s.App.AppCodec().MustMarshal(&legacyDepositRecord))
+
+	legacyEpochUnbondingRecord := oldrecordstypes.EpochUnbondingRecord{
+		EpochNumber: 1,
+		HostZoneUnbondings: []*oldrecordstypes.HostZoneUnbonding{
+			{
+				StTokenAmount:         100,
+				NativeTokenAmount:     math.MaxUint64,
+				Denom:                 "uatom",
+				HostZoneId:            "GAIA",
+				UnbondingTime:         200,
+				Status:                oldrecordstypes.HostZoneUnbonding_CLAIMABLE,
+				UserRedemptionRecords: []string{legacyUserRedemptionRecord.Id},
+			},
+			{HostZoneId: "OSMO", Status: oldrecordstypes.HostZoneUnbonding_UNBONDING_QUEUE},
+		},
+	}
+	epochUnbondingRecordStore := prefix.NewStore(store, types.KeyPrefix(types.EpochUnbondingRecordKey))
+	epochUnbondingRecordStore.Set(keeper.GetEpochUnbondingRecordIDBytes(legacyEpochUnbondingRecord.EpochNumber), s.App.AppCodec().MustMarshal(&legacyEpochUnbondingRecord))
+
+	err := keeper.NewMigrator(s.App.RecordsKeeper).Migrate2to3(ctx)
+	s.Require().NoError(err)
+
+	// Each record should be readable with the new types, with the same amounts
+	userRedemptionRecord, found := s.App.RecordsKeeper.GetUserRedemptionRecord(ctx, legacyUserRedemptionRecord.Id)
+	s.Require().True(found, "user redemption record should be found")
+	s.Require().Equal(types.UserRedemptionRecord{
+		Id:          "GAIA.1.stride_SENDER",
+		Sender:      "stride_SENDER",
+		Receiver:    "cosmos_RECEIVER",
+		Amount:      sdk.NewIntFromUint64(math.MaxUint64),
+		Denom:       "uatom",
+		HostZoneId:  "GAIA",
+		EpochNumber: 1,
+	}, userRedemptionRecord)
+
+	depositRecord, found := s.App.RecordsKeeper.GetDepositRecord(ctx, legacyDepositRecord.Id)
+	s.Require().True(found, "deposit record should be found")
+	s.Require().Equal(types.DepositRecord{
+		Id:                 3,
+		Amount:             sdk.NewInt(math.MaxInt64),
+		Denom:              "uatom",
+		HostZoneId:         "GAIA",
+		Status:             types.DepositRecord_DELEGATION_QUEUE,
+		DepositEpochNumber: 2,
+		Source:             types.DepositRecord_WITHDRAWAL_ICA,
+	}, depositRecord)
+
+	epochUnbondingRecord, found := s.App.RecordsKeeper.GetEpochUnbondingRecord(ctx, legacyEpochUnbondingRecord.EpochNumber)
+	s.Require().True(found, "epoch unbonding record should be found")
+	s.Require().Equal(types.EpochUnbondingRecord{
+		EpochNumber: 1,
+		HostZoneUnbondings: []*types.HostZoneUnbonding{
+			{
+				StTokenAmount:         sdk.NewInt(100),
+				NativeTokenAmount:     sdk.NewIntFromUint64(math.MaxUint64),
+				Denom:                 "uatom",
+				HostZoneId:            "GAIA",
+				UnbondingTime:         200,
+				Status:                types.HostZoneUnbonding_CLAIMABLE,
+				UserRedemptionRecords: []string{legacyUserRedemptionRecord.Id},
+			},
+			{
+				StTokenAmount:     sdk.ZeroInt(),
+				NativeTokenAmount: sdk.ZeroInt(),
+				HostZoneId:        "OSMO",
+				Status:            types.HostZoneUnbonding_UNBONDING_QUEUE,
+			},
+		},
+	}, epochUnbondingRecord)
+
+	// The records can now be updated with amounts that don't fit in 64 bits
+	hostZoneUnbonding := epochUnbondingRecord.HostZoneUnbondings[0]
+	hostZoneUnbonding.NativeTokenAmount = hostZoneUnbonding.NativeTokenAmount.Add(sdk.OneInt())
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(ctx, epochUnbondingRecord)
+
+	updatedHostZoneUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, 1, "GAIA")
+	s.Require().True(found, "host zone unbonding should be found")
+	s.Require().Equal(sdk.NewIntFromUint64(math.MaxUint64).Add(sdk.OneInt()), updatedHostZoneUnbonding.NativeTokenAmount)
+}
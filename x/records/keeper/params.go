@@ -7,7 +7,15 @@ import (
 
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+	return types.NewParams(
+		k.HistoryRetentionBlocks(ctx),
+	)
+}
+
+// HistoryRetentionBlocks returns the number of blocks the record status transitions are kept for
+func (k Keeper) HistoryRetentionBlocks(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyHistoryRetentionBlocks, &res)
+	return
 }
 
 // SetParams set the params
@@ -0,0 +1,219 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
+	"github.com/Stride-Labs/stride/x/records/types"
+)
+
+// GetRecordStatusTransitionCount get the total number of recordStatusTransition
+func (k Keeper) GetRecordStatusTransitionCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	bz := store.Get(types.KeyPrefix(types.RecordStatusTransitionCountKey))
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetRecordStatusTransitionCount set the total number of recordStatusTransition
+func (k Keeper) SetRecordStatusTransitionCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(types.KeyPrefix(types.RecordStatusTransitionCountKey), bz)
+}
+
+// SetRecordStatusTransition set a specific recordStatusTransition in the store, and indexes it by its record
+func (k Keeper) SetRecordStatusTransition(ctx sdk.Context, transition types.RecordStatusTransition) {
+	idBz := GetDepositRecordIDBytes(transition.Id)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RecordStatusTransitionKey))
+	b := k.Cdc.MustMarshal(&transition)
+	store.Set(idBz, b)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RecordStatusTransitionRecordIndexKey))
+	indexStore.Set(append(types.RecordStatusTransitionRecordPrefix(transition.RecordType, transition.RecordId), idBz...), []byte{})
+}
+
+// GetRecordStatusTransition returns a recordStatusTransition from its id
+func (k Keeper) GetRecordStatusTransition(ctx sdk.Context, id uint64) (val types.RecordStatusTransition, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RecordStatusTransitionKey))
+	b := store.Get(GetDepositRecordIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.Cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveRecordStatusTransition removes a recordStatusTransition, along with its index entry, from the store
+func (k Keeper) RemoveRecordStatusTransition(ctx sdk.Context, transition types.RecordStatusTransition) {
+	idBz := GetDepositRecordIDBytes(transition.Id)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RecordStatusTransitionKey))
+	store.Delete(idBz)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RecordStatusTransitionRecordIndexKey))
+	indexStore.Delete(append(types.RecordStatusTransitionRecordPrefix(transition.RecordType, transition.RecordId), idBz...))
+}
+
+// GetAllRecordStatusTransition returns all recordStatusTransition, oldest first
+func (k Keeper) GetAllRecordStatusTransition(ctx sdk.Context) (list []types.RecordStatusTransition) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RecordStatusTransitionKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RecordStatusTransition
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetRecordHistory returns the status transitions of a record, oldest first
+func (k Keeper) GetRecordHistory(ctx sdk.Context, recordType types.RecordStatusTransition_RecordType, recordId string) (list []types.RecordStatusTransition) {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RecordStatusTransitionRecordIndexKey))
+	iterator := sdk.KVStorePrefixIterator(indexStore, types.RecordStatusTransitionRecordPrefix(recordType, recordId))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		if transition, found := k.GetRecordStatusTransition(ctx, binary.BigEndian.Uint64(key[len(key)-8:])); found {
+			list = append(list, transition)
+		}
+	}
+	return list
+}
+
+// AppendRecordStatusTransition adds a transition to the history of a record, along with the packet behind it
+// if one is attached to the context, and emits it as an event
+// If the context defers transitions (e.g. while the packet behind them is queued), it's collected instead
+func (k Keeper) AppendRecordStatusTransition(
+	ctx sdk.Context,
+	recordType types.RecordStatusTransition_RecordType,
+	recordId string,
+	hostZoneId string,
+	fromStatus string,
+	toStatus string,
+) {
+	transition := types.RecordStatusTransition{
+		RecordType: recordType,
+		RecordId:   recordId,
+		HostZoneId: hostZoneId,
+		FromStatus: fromStatus,
+		ToStatus:   toStatus,
+	}
+	if deferredTransitions, found := types.GetDeferredTransitions(ctx); found {
+		*deferredTransitions = append(*deferredTransitions, transition)
+		return
+	}
+	k.WriteRecordStatusTransition(ctx, transition)
+}
+
+// WriteRecordStatusTransition writes a transition to the history of a record, along with the packet behind it
+// if one is attached to the context, and emits it as an event
+func (k Keeper) WriteRecordStatusTransition(ctx sdk.Context, transition types.RecordStatusTransition) {
+	count := k.GetRecordStatusTransitionCount(ctx)
+
+	transition.Id = count
+	transition.BlockHeight = ctx.BlockHeight()
+	if packetContext, found := icacallbackstypes.GetPacketContext(ctx); found {
+		transition.ChannelId = packetContext.ChannelId
+		transition.PacketSequence = packetContext.Sequence
+		transition.AckResult = packetContext.AckResult
+	}
+	k.SetRecordStatusTransition(ctx, transition)
+	k.SetRecordStatusTransitionCount(ctx, count+1)
+
+	if err := ctx.EventManager().EmitTypedEvent(&transition); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Unable to emit record status transition event: %s", err.Error()))
+	}
+}
+
+// recordDepositRecordTransition records the change in status of a deposit record, or its removal if newDepositRecord is nil
+func (k Keeper) recordDepositRecordTransition(ctx sdk.Context, oldDepositRecord types.DepositRecord, newDepositRecord *types.DepositRecord) {
+	toStatus := types.RecordStatusRemoved
+	if newDepositRecord != nil {
+		if newDepositRecord.Status == oldDepositRecord.Status {
+			return
+		}
+		toStatus = newDepositRecord.Status.String()
+	}
+	k.AppendRecordStatusTransition(
+		ctx,
+		types.RecordStatusTransition_DEPOSIT_RECORD,
+		fmt.Sprintf("%d", oldDepositRecord.Id),
+		oldDepositRecord.HostZoneId,
+		oldDepositRecord.Status.String(),
+		toStatus,
+	)
+}
+
+// recordHostZoneUnbondingTransitions records the changes in status of the host zone unbondings of an epoch unbonding record,
+// and the removal of those missing from newEpochUnbondingRecord, which is nil if the epoch unbonding record was removed
+func (k Keeper) recordHostZoneUnbondingTransitions(ctx sdk.Context, oldEpochUnbondingRecord types.EpochUnbondingRecord, newEpochUnbondingRecord *types.EpochUnbondingRecord) {
+	newStatuses := map[string]types.HostZoneUnbonding_Status{}
+	if newEpochUnbondingRecord != nil {
+		for _, hostZoneUnbonding := range newEpochUnbondingRecord.HostZoneUnbondings {
+			newStatuses[hostZoneUnbonding.HostZoneId] = hostZoneUnbonding.Status
+		}
+	}
+
+	for _, hostZoneUnbonding := range oldEpochUnbondingRecord.HostZoneUnbondings {
+		toStatus := types.RecordStatusRemoved
+		if newStatus, found := newStatuses[hostZoneUnbonding.HostZoneId]; found {
+			if newStatus == hostZoneUnbonding.Status {
+				continue
+			}
+			toStatus = newStatus.String()
+		}
+		k.AppendRecordStatusTransition(
+			ctx,
+			types.RecordStatusTransition_HOST_ZONE_UNBONDING,
+			types.HostZoneUnbondingRecordId(hostZoneUnbonding.HostZoneId, oldEpochUnbondingRecord.EpochNumber),
+			hostZoneUnbonding.HostZoneId,
+			hostZoneUnbonding.Status.String(),
+			toStatus,
+		)
+	}
+}
+
+// PruneRecordHistory removes the status transitions older than the retention period
+func (k Keeper) PruneRecordHistory(ctx sdk.Context) {
+	retentionBlocks := k.HistoryRetentionBlocks(ctx)
+	if retentionBlocks == 0 || ctx.BlockHeight() <= int64(retentionBlocks) {
+		return
+	}
+	cutoffHeight := ctx.BlockHeight() - int64(retentionBlocks)
+
+	// transitions are appended in order, so the expired ones are at the start of the store
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RecordStatusTransitionKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	var expired []types.RecordStatusTransition
+	for ; iterator.Valid(); iterator.Next() {
+		var transition types.RecordStatusTransition
+		k.Cdc.MustUnmarshal(iterator.Value(), &transition)
+		if transition.BlockHeight >= cutoffHeight {
+			break
+		}
+		expired = append(expired, transition)
+	}
+	iterator.Close()
+
+	for _, transition := range expired {
+		k.RemoveRecordStatusTransition(ctx, transition)
+	}
+}
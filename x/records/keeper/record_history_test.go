@@ -0,0 +1,230 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Stride-Labs/stride/testutil/keeper"
+	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
+	"github.com/Stride-Labs/stride/x/records/types"
+)
+
+func TestDepositRecordHistory(t *testing.T) {
+	keeper, ctx := keepertest.RecordsKeeper(t)
+
+	// creating a record isn't a transition
+	depositRecord := types.DepositRecord{HostZoneId: "GAIA", Status: types.DepositRecord_TRANSFER_QUEUE}
+	depositRecord.Id = keeper.AppendDepositRecord(ctx, depositRecord)
+	require.Empty(t, keeper.GetRecordHistory(ctx, types.RecordStatusTransition_DEPOSIT_RECORD, "0"))
+
+	// neither is updating a record without changing its status
+	depositRecord.Amount = sdk.NewInt(10)
+	keeper.SetDepositRecord(ctx, depositRecord)
+	require.Empty(t, keeper.GetRecordHistory(ctx, types.RecordStatusTransition_DEPOSIT_RECORD, "0"))
+
+	// the transfer is sent at height 5, and acknowledged at height 6
+	sendCtx := icacallbackstypes.WithPacketContext(ctx.WithBlockHeight(5), icacallbackstypes.PacketContext{ChannelId: "channel-0", Sequence: 3})
+	depositRecord.Status = types.DepositRecord_TRANSFER_IN_PROGRESS
+	keeper.SetDepositRecord(sendCtx, depositRecord)
+
+	ackCtx := icacallbackstypes.WithPacketContext(ctx.WithBlockHeight(6), icacallbackstypes.PacketContext{
+		ChannelId: "channel-0",
+		Sequence:  3,
+		AckResult: icacallbackstypes.AckResultSuccess,
+	})
+	depositRecord.Status = types.DepositRecord_DELEGATION_QUEUE
+	keeper.SetDepositRecord(ackCtx, depositRecord)
+
+	keeper.RemoveDepositRecord(ctx.WithBlockHeight(7), depositRecord.Id)
+
+	expected := []types.RecordStatusTransition{
+		{
+			Id:             0,
+			RecordType:     types.RecordStatusTransition_DEPOSIT_RECORD,
+			RecordId:       "0",
+			HostZoneId:     "GAIA",
+			FromStatus:     "TRANSFER_QUEUE",
+			ToStatus:       "TRANSFER_IN_PROGRESS",
+			BlockHeight:    5,
+			ChannelId:      "channel-0",
+			PacketSequence: 3,
+		},
+		{
+			Id:             1,
+			RecordType:     types.RecordStatusTransition_DEPOSIT_RECORD,
+			RecordId:       "0",
+			HostZoneId:     "GAIA",
+			FromStatus:     "TRANSFER_IN_PROGRESS",
+			ToStatus:       "DELEGATION_QUEUE",
+			BlockHeight:    6,
+			ChannelId:      "channel-0",
+			PacketSequence: 3,
+			AckResult:      icacallbackstypes.AckResultSuccess,
+		},
+		{
+			Id:          2,
+			RecordType:  types.RecordStatusTransition_DEPOSIT_RECORD,
+			RecordId:    "0",
+			HostZoneId:  "GAIA",
+			FromStatus:  "DELEGATION_QUEUE",
+			ToStatus:    types.RecordStatusRemoved,
+			BlockHeight: 7,
+		},
+	}
+	require.Equal(t, expected, keeper.GetRecordHistory(ctx, types.RecordStatusTransition_DEPOSIT_RECORD, "0"))
+	require.Equal(t, uint64(3), keeper.GetRecordStatusTransitionCount(ctx))
+}
+
+func TestDeferredRecordHistory(t *testing.T) {
+	keeper, ctx := keepertest.RecordsKeeper(t)
+
+	depositRecord := types.DepositRecord{HostZoneId: "GAIA", Status: types.DepositRecord_DELEGATION_QUEUE}
+	depositRecord.Id = keeper.AppendDepositRecord(ctx, depositRecord)
+
+	// the transition is collected rather than written while the packet behind it is queued
+	transitions := []types.RecordStatusTransition{}
+	depositRecord.Status = types.DepositRecord_DELEGATION_IN_PROGRESS
+	keeper.SetDepositRecord(types.WithDeferredTransitions(ctx, &transitions), depositRecord)
+	require.Empty(t, keeper.GetRecordHistory(ctx, types.RecordStatusTransition_DEPOSIT_RECORD, "0"))
+	require.Len(t, transitions, 1)
+
+	// and written along with the packet once it's sent
+	sendCtx := icacallbackstypes.WithPacketContext(ctx.WithBlockHeight(5), icacallbackstypes.PacketContext{ChannelId: "channel-1", Sequence: 4})
+	keeper.WriteRecordStatusTransition(sendCtx, transitions[0])
+
+	expected := []types.RecordStatusTransition{
+		{
+			Id:             0,
+			RecordType:     types.RecordStatusTransition_DEPOSIT_RECORD,
+			RecordId:       "0",
+			HostZoneId:     "GAIA",
+			FromStatus:     "DELEGATION_QUEUE",
+			ToStatus:       "DELEGATION_IN_PROGRESS",
+			BlockHeight:    5,
+			ChannelId:      "channel-1",
+			PacketSequence: 4,
+		},
+	}
+	require.Equal(t, expected, keeper.GetRecordHistory(ctx, types.RecordStatusTransition_DEPOSIT_RECORD, "0"))
+}
+
+func TestHostZoneUnbondingHistory(t *testing.T) {
+	keeper, ctx := keepertest.RecordsKeeper(t)
+
+	keeper.SetEpochUnbondingRecord(ctx, types.EpochUnbondingRecord{
+		EpochNumber: 1,
+		HostZoneUnbondings: []*types.HostZoneUnbonding{
+			{HostZoneId: "GAIA", Status: types.HostZoneUnbonding_UNBONDING_QUEUE},
+			{HostZoneId: "OSMO", Status: types.HostZoneUnbonding_UNBONDING_QUEUE},
+		},
+	})
+
+	// only the GAIA unbonding changes status
+	keeper.SetEpochUnbondingRecord(ctx, types.EpochUnbondingRecord{
+		EpochNumber: 1,
+		HostZoneUnbondings: []*types.HostZoneUnbonding{
+			{HostZoneId: "GAIA", Status: types.HostZoneUnbonding_UNBONDING_IN_PROGRESS},
+			{HostZoneId: "OSMO", Status: types.HostZoneUnbonding_UNBONDING_QUEUE},
+		},
+	})
+	keeper.RemoveEpochUnbondingRecord(ctx, 1)
+
+	gaiaHistory := keeper.GetRecordHistory(ctx, types.RecordStatusTransition_HOST_ZONE_UNBONDING, "GAIA.1")
+	require.Len(t, gaiaHistory, 2)
+	require.Equal(t, "UNBONDING_QUEUE", gaiaHistory[0].FromStatus)
+	require.Equal(t, "UNBONDING_IN_PROGRESS", gaiaHistory[0].ToStatus)
+	require.Equal(t, "UNBONDING_IN_PROGRESS", gaiaHistory[1].FromStatus)
+	require.Equal(t, types.RecordStatusRemoved, gaiaHistory[1].ToStatus)
+
+	osmoHistory := keeper.GetRecordHistory(ctx, types.RecordStatusTransition_HOST_ZONE_UNBONDING, "OSMO.1")
+	require.Len(t, osmoHistory, 1)
+	require.Equal(t, "UNBONDING_QUEUE", osmoHistory[0].FromStatus)
+	require.Equal(t, types.RecordStatusRemoved, osmoHistory[0].ToStatus)
+
+	// the deposit record with the same id has no history
+	require.Empty(t, keeper.GetRecordHistory(ctx, types.RecordStatusTransition_DEPOSIT_RECORD, "GAIA.1"))
+}
+
+func TestRecordHistoryEvents(t *testing.T) {
+	keeper, ctx := keepertest.RecordsKeeper(t)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	depositRecord := types.DepositRecord{HostZoneId: "GAIA", Status: types.DepositRecord_TRANSFER_QUEUE}
+	keeper.AppendDepositRecord(ctx, depositRecord)
+	depositRecord.Status = types.DepositRecord_TRANSFER_IN_PROGRESS
+	keeper.SetDepositRecord(ctx, depositRecord)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "Stridelabs.stride.records.RecordStatusTransition", events[0].Type)
+
+	parsed, err := sdk.ParseTypedEvent(events.ToABCIEvents()[0])
+	require.NoError(t, err)
+	require.Equal(t, keeper.GetRecordHistory(ctx, types.RecordStatusTransition_DEPOSIT_RECORD, "0")[0], *parsed.(*types.RecordStatusTransition))
+}
+
+func TestRecordHistoryQuery(t *testing.T) {
+	keeper, ctx := keepertest.RecordsKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	depositRecord := types.DepositRecord{HostZoneId: "GAIA"}
+	keeper.AppendDepositRecord(ctx, depositRecord)
+	for _, status := range []types.DepositRecord_Status{
+		types.DepositRecord_TRANSFER_IN_PROGRESS,
+		types.DepositRecord_TRANSFER_QUEUE,
+		types.DepositRecord_TRANSFER_IN_PROGRESS,
+		types.DepositRecord_DELEGATION_QUEUE,
+	} {
+		depositRecord.Status = status
+		keeper.SetDepositRecord(ctx, depositRecord)
+	}
+
+	request := &types.QueryRecordHistoryRequest{
+		RecordType: types.RecordStatusTransition_DEPOSIT_RECORD,
+		RecordId:   "0",
+		Pagination: &query.PageRequest{Limit: 3},
+	}
+	response, err := keeper.RecordHistory(wctx, request)
+	require.NoError(t, err)
+	require.Len(t, response.RecordStatusTransitions, 3)
+	require.Equal(t, "TRANSFER_QUEUE", response.RecordStatusTransitions[0].FromStatus)
+
+	request.Pagination = &query.PageRequest{Key: response.Pagination.NextKey, Limit: 3}
+	response, err = keeper.RecordHistory(wctx, request)
+	require.NoError(t, err)
+	require.Len(t, response.RecordStatusTransitions, 1)
+	require.Equal(t, "DELEGATION_QUEUE", response.RecordStatusTransitions[0].ToStatus)
+	require.Nil(t, response.Pagination.NextKey)
+
+	_, err = keeper.RecordHistory(wctx, &types.QueryRecordHistoryRequest{})
+	require.Error(t, err)
+}
+
+func (s *KeeperTestSuite) TestPruneRecordHistory() {
+	params := s.App.RecordsKeeper.GetParams(s.Ctx())
+	params.HistoryRetentionBlocks = 10
+	s.App.RecordsKeeper.SetParams(s.Ctx(), params)
+
+	for i, height := range []int64{1, 5, 10, 15} {
+		s.App.RecordsKeeper.AppendRecordStatusTransition(s.Ctx().WithBlockHeight(height),
+			types.RecordStatusTransition_DEPOSIT_RECORD, "0", "GAIA", "", types.DepositRecord_Status(i%2).String())
+	}
+
+	// at height 15, the transitions from before height 5 are expired
+	s.App.RecordsKeeper.PruneRecordHistory(s.Ctx().WithBlockHeight(15))
+
+	history := s.App.RecordsKeeper.GetRecordHistory(s.Ctx(), types.RecordStatusTransition_DEPOSIT_RECORD, "0")
+	s.Require().Len(history, 3)
+	s.Require().Equal(uint64(1), history[0].Id, "oldest transition kept")
+	_, found := s.App.RecordsKeeper.GetRecordStatusTransition(s.Ctx(), 0)
+	s.Require().False(found, "expired transition removed")
+
+	// a retention of 0 keeps the transitions forever
+	params.HistoryRetentionBlocks = 0
+	s.App.RecordsKeeper.SetParams(s.Ctx(), params)
+	s.App.RecordsKeeper.PruneRecordHistory(s.Ctx().WithBlockHeight(1000))
+	s.Require().Len(s.App.RecordsKeeper.GetAllRecordStatusTransition(s.Ctx()), 3)
+}
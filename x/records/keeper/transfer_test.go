@@ -23,7 +23,7 @@ func (s *KeeperTestSuite) SetupTransfer() TransferTestCase {
 		Id:                 1,
 		DepositEpochNumber: 1,
 		HostZoneId:         chainId,
-		Amount:             balanceToTransfer,
+		Amount:             sdk.NewInt(balanceToTransfer),
 		Status:             types.DepositRecord_TRANSFER_QUEUE,
 	}
 	s.App.RecordsKeeper.SetDepositRecord(s.Ctx(), depositRecord)
@@ -7,11 +7,18 @@ import (
 	"github.com/Stride-Labs/stride/x/records/types"
 )
 
-// SetUserRedemptionRecord set a specific userRedemptionRecord in the store
+// SetUserRedemptionRecord set a specific userRedemptionRecord in the store, and indexes it by its sender
 func (k Keeper) SetUserRedemptionRecord(ctx sdk.Context, userRedemptionRecord types.UserRedemptionRecord) {
+	if oldUserRedemptionRecord, found := k.GetUserRedemptionRecord(ctx, userRedemptionRecord.Id); found {
+		k.removeUserRedemptionRecordIndex(ctx, oldUserRedemptionRecord)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserRedemptionRecordKey))
 	b := k.Cdc.MustMarshal(&userRedemptionRecord)
 	store.Set([]byte(userRedemptionRecord.Id), b)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserRedemptionRecordAddressIndexKey))
+	indexStore.Set(types.UserRedemptionRecordAddressIndex(userRedemptionRecord.Sender, userRedemptionRecord.Id), []byte{})
 }
 
 // GetUserRedemptionRecord returns a userRedemptionRecord from its id
@@ -25,12 +32,53 @@ func (k Keeper) GetUserRedemptionRecord(ctx sdk.Context, id string) (val types.U
 	return val, true
 }
 
-// RemoveUserRedemptionRecord removes a userRedemptionRecord from the store
+// RemoveUserRedemptionRecord removes a userRedemptionRecord, along with its index entry, from the store
 func (k Keeper) RemoveUserRedemptionRecord(ctx sdk.Context, id string) {
+	if userRedemptionRecord, found := k.GetUserRedemptionRecord(ctx, id); found {
+		k.removeUserRedemptionRecordIndex(ctx, userRedemptionRecord)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserRedemptionRecordKey))
 	store.Delete([]byte(id))
 }
 
+func (k Keeper) removeUserRedemptionRecordIndex(ctx sdk.Context, userRedemptionRecord types.UserRedemptionRecord) {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserRedemptionRecordAddressIndexKey))
+	indexStore.Delete(types.UserRedemptionRecordAddressIndex(userRedemptionRecord.Sender, userRedemptionRecord.Id))
+}
+
+// GetUserRedemptionRecordsByAddress returns the user redemption records sent by an address, across all host zones
+func (k Keeper) GetUserRedemptionRecordsByAddress(ctx sdk.Context, address string) (list []types.UserRedemptionRecord) {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserRedemptionRecordAddressIndexKey))
+	addressPrefix := types.UserRedemptionRecordAddressPrefix(address)
+	iterator := sdk.KVStorePrefixIterator(indexStore, addressPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		id := string(iterator.Key()[len(addressPrefix):])
+		if userRedemptionRecord, found := k.GetUserRedemptionRecord(ctx, id); found {
+			list = append(list, userRedemptionRecord)
+		}
+	}
+	return list
+}
+
+// GetUserRedemptionRecordWithStatus joins a user redemption record with the host zone unbonding it's part of
+func (k Keeper) GetUserRedemptionRecordWithStatus(ctx sdk.Context, userRedemptionRecord types.UserRedemptionRecord) types.UserRedemptionRecordWithStatus {
+	recordWithStatus := types.UserRedemptionRecordWithStatus{UserRedemptionRecord: userRedemptionRecord}
+
+	hostZoneUnbonding, found := k.GetHostZoneUnbondingByChainId(ctx, userRedemptionRecord.EpochNumber, userRedemptionRecord.HostZoneId)
+	if !found {
+		return recordWithStatus
+	}
+	recordWithStatus.UnbondingStatus = hostZoneUnbonding.Status
+	recordWithStatus.EstimatedCompletionTime = hostZoneUnbonding.UnbondingTime
+	// matches the checks in stakeibc's ClaimUndelegatedTokens
+	recordWithStatus.Claimable = hostZoneUnbonding.Status == types.HostZoneUnbonding_CLAIMABLE && !userRedemptionRecord.ClaimIsPending
+	return recordWithStatus
+}
+
 // GetAllUserRedemptionRecord returns all userRedemptionRecord
 func (k Keeper) GetAllUserRedemptionRecord(ctx sdk.Context) (list []types.UserRedemptionRecord) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserRedemptionRecordKey))
@@ -0,0 +1,73 @@
+package v2
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	oldrecordstypes "github.com/Stride-Labs/stride/x/records/migrations/v2/types"
+	"github.com/Stride-Labs/stride/x/records/types"
+)
+
+// MigrateStore builds the secondary indexes of the deposit records, by host zone and status
+// and by deposit epoch and host zone, and the index of the user redemption records by sender,
+// and sets any params added since v1 to their defaults
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec, paramstore paramtypes.Subspace) error {
+	if err := migrateDepositRecordIndexes(ctx, storeKey, cdc); err != nil {
+		return err
+	}
+	if err := migrateUserRedemptionRecordIndex(ctx, storeKey, cdc); err != nil {
+		return err
+	}
+
+	defaultParams := types.DefaultParams()
+	for _, pair := range defaultParams.ParamSetPairs() {
+		if !paramstore.Has(ctx, pair.Key) {
+			paramstore.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+	return nil
+}
+
+func migrateDepositRecordIndexes(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec) error {
+	depositRecordStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.DepositRecordKey))
+	statusStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.DepositRecordHostZoneStatusIndexKey))
+	epochStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.DepositRecordEpochHostZoneIndexKey))
+
+	iterator := depositRecordStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var depositRecord oldrecordstypes.DepositRecord
+		if err := cdc.Unmarshal(iterator.Value(), &depositRecord); err != nil {
+			return err
+		}
+
+		idBz := make([]byte, 8)
+		binary.BigEndian.PutUint64(idBz, depositRecord.Id)
+
+		statusStore.Set(append(types.DepositRecordHostZoneStatusPrefix(depositRecord.HostZoneId, types.DepositRecord_Status(depositRecord.Status)), idBz...), []byte{})
+		epochStore.Set(append(types.DepositRecordEpochHostZonePrefix(depositRecord.DepositEpochNumber, depositRecord.HostZoneId), idBz...), []byte{})
+	}
+	return nil
+}
+
+func migrateUserRedemptionRecordIndex(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec) error {
+	userRedemptionRecordStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.UserRedemptionRecordKey))
+	indexStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.UserRedemptionRecordAddressIndexKey))
+
+	iterator := userRedemptionRecordStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var userRedemptionRecord oldrecordstypes.UserRedemptionRecord
+		if err := cdc.Unmarshal(iterator.Value(), &userRedemptionRecord); err != nil {
+			return err
+		}
+		indexStore.Set(types.UserRedemptionRecordAddressIndex(userRedemptionRecord.Sender, userRedemptionRecord.Id), []byte{})
+	}
+	return nil
+}
@@ -0,0 +1,1784 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: records/migrations/v2/records.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type DepositRecord_Status int32
+
+const (
+	DepositRecord_TRANSFER_QUEUE         DepositRecord_Status = 0
+	DepositRecord_TRANSFER_IN_PROGRESS   DepositRecord_Status = 2
+	DepositRecord_DELEGATION_QUEUE       DepositRecord_Status = 1
+	DepositRecord_DELEGATION_IN_PROGRESS DepositRecord_Status = 3
+)
+
+var DepositRecord_Status_name = map[int32]string{
+	0: "TRANSFER_QUEUE",
+	2: "TRANSFER_IN_PROGRESS",
+	1: "DELEGATION_QUEUE",
+	3: "DELEGATION_IN_PROGRESS",
+}
+
+var DepositRecord_Status_value = map[string]int32{
+	"TRANSFER_QUEUE":         0,
+	"TRANSFER_IN_PROGRESS":   2,
+	"DELEGATION_QUEUE":       1,
+	"DELEGATION_IN_PROGRESS": 3,
+}
+
+func (x DepositRecord_Status) String() string {
+	return proto.EnumName(DepositRecord_Status_name, int32(x))
+}
+
+func (DepositRecord_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_48213bbd8092b5ab, []int{1, 0}
+}
+
+type DepositRecord_Source int32
+
+const (
+	DepositRecord_STRIDE         DepositRecord_Source = 0
+	DepositRecord_WITHDRAWAL_ICA DepositRecord_Source = 1
+)
+
+var DepositRecord_Source_name = map[int32]string{
+	0: "STRIDE",
+	1: "WITHDRAWAL_ICA",
+}
+
+var DepositRecord_Source_value = map[string]int32{
+	"STRIDE":         0,
+	"WITHDRAWAL_ICA": 1,
+}
+
+func (x DepositRecord_Source) String() string {
+	return proto.EnumName(DepositRecord_Source_name, int32(x))
+}
+
+func (DepositRecord_Source) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_48213bbd8092b5ab, []int{1, 1}
+}
+
+type HostZoneUnbonding_Status int32
+
+const (
+	HostZoneUnbonding_UNBONDING_QUEUE           HostZoneUnbonding_Status = 0
+	HostZoneUnbonding_UNBONDING_IN_PROGRESS     HostZoneUnbonding_Status = 3
+	HostZoneUnbonding_EXIT_TRANSFER_QUEUE       HostZoneUnbonding_Status = 1
+	HostZoneUnbonding_EXIT_TRANSFER_IN_PROGRESS HostZoneUnbonding_Status = 4
+	HostZoneUnbonding_CLAIMABLE                 HostZoneUnbonding_Status = 2
+)
+
+var HostZoneUnbonding_Status_name = map[int32]string{
+	0: "UNBONDING_QUEUE",
+	3: "UNBONDING_IN_PROGRESS",
+	1: "EXIT_TRANSFER_QUEUE",
+	4: "EXIT_TRANSFER_IN_PROGRESS",
+	2: "CLAIMABLE",
+}
+
+var HostZoneUnbonding_Status_value = map[string]int32{
+	"UNBONDING_QUEUE":           0,
+	"UNBONDING_IN_PROGRESS":     3,
+	"EXIT_TRANSFER_QUEUE":       1,
+	"EXIT_TRANSFER_IN_PROGRESS": 4,
+	"CLAIMABLE":                 2,
+}
+
+func (x HostZoneUnbonding_Status) String() string {
+	return proto.EnumName(HostZoneUnbonding_Status_name, int32(x))
+}
+
+func (HostZoneUnbonding_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_48213bbd8092b5ab, []int{2, 0}
+}
+
+type UserRedemptionRecord struct {
+	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sender         string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver       string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Amount         uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Denom          string `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
+	HostZoneId     string `protobuf:"bytes,6,opt,name=hostZoneId,proto3" json:"hostZoneId,omitempty"`
+	EpochNumber    uint64 `protobuf:"varint,7,opt,name=epochNumber,proto3" json:"epochNumber,omitempty"`
+	ClaimIsPending bool   `protobuf:"varint,8,opt,name=claimIsPending,proto3" json:"claimIsPending,omitempty"`
+}
+
+func (m *UserRedemptionRecord) Reset()         { *m = UserRedemptionRecord{} }
+func (m *UserRedemptionRecord) String() string { return proto.CompactTextString(m) }
+func (*UserRedemptionRecord) ProtoMessage()    {}
+func (*UserRedemptionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48213bbd8092b5ab, []int{0}
+}
+func (m *UserRedemptionRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserRedemptionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserRedemptionRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserRedemptionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserRedemptionRecord.Merge(m, src)
+}
+func (m *UserRedemptionRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserRedemptionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserRedemptionRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserRedemptionRecord proto.InternalMessageInfo
+
+func (m *UserRedemptionRecord) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UserRedemptionRecord) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *UserRedemptionRecord) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *UserRedemptionRecord) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *UserRedemptionRecord) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *UserRedemptionRecord) GetHostZoneId() string {
+	if m != nil {
+		return m.HostZoneId
+	}
+	return ""
+}
+
+func (m *UserRedemptionRecord) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *UserRedemptionRecord) GetClaimIsPending() bool {
+	if m != nil {
+		return m.ClaimIsPending
+	}
+	return false
+}
+
+type DepositRecord struct {
+	Id                 uint64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount             int64                `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Denom              string               `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	HostZoneId         string               `protobuf:"bytes,4,opt,name=hostZoneId,proto3" json:"hostZoneId,omitempty"`
+	Status             DepositRecord_Status `protobuf:"varint,6,opt,name=status,proto3,enum=Stridelabs.stride.records.v2.DepositRecord_Status" json:"status,omitempty"`
+	DepositEpochNumber uint64               `protobuf:"varint,7,opt,name=depositEpochNumber,proto3" json:"depositEpochNumber,omitempty"`
+	Source             DepositRecord_Source `protobuf:"varint,8,opt,name=source,proto3,enum=Stridelabs.stride.records.v2.DepositRecord_Source" json:"source,omitempty"`
+}
+
+func (m *DepositRecord) Reset()         { *m = DepositRecord{} }
+func (m *DepositRecord) String() string { return proto.CompactTextString(m) }
+func (*DepositRecord) ProtoMessage()    {}
+func (*DepositRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48213bbd8092b5ab, []int{1}
+}
+func (m *DepositRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositRecord.Merge(m, src)
+}
+func (m *DepositRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositRecord proto.InternalMessageInfo
+
+func (m *DepositRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DepositRecord) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *DepositRecord) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DepositRecord) GetHostZoneId() string {
+	if m != nil {
+		return m.HostZoneId
+	}
+	return ""
+}
+
+func (m *DepositRecord) GetStatus() DepositRecord_Status {
+	if m != nil {
+		return m.Status
+	}
+	return DepositRecord_TRANSFER_QUEUE
+}
+
+func (m *DepositRecord) GetDepositEpochNumber() uint64 {
+	if m != nil {
+		return m.DepositEpochNumber
+	}
+	return 0
+}
+
+func (m *DepositRecord) GetSource() DepositRecord_Source {
+	if m != nil {
+		return m.Source
+	}
+	return DepositRecord_STRIDE
+}
+
+type HostZoneUnbonding struct {
+	StTokenAmount         uint64                   `protobuf:"varint,1,opt,name=stTokenAmount,proto3" json:"stTokenAmount,omitempty"`
+	NativeTokenAmount     uint64                   `protobuf:"varint,2,opt,name=nativeTokenAmount,proto3" json:"nativeTokenAmount,omitempty"`
+	Denom                 string                   `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	HostZoneId            string                   `protobuf:"bytes,4,opt,name=hostZoneId,proto3" json:"hostZoneId,omitempty"`
+	UnbondingTime         uint64                   `protobuf:"varint,5,opt,name=unbondingTime,proto3" json:"unbondingTime,omitempty"`
+	Status                HostZoneUnbonding_Status `protobuf:"varint,6,opt,name=status,proto3,enum=Stridelabs.stride.records.v2.HostZoneUnbonding_Status" json:"status,omitempty"`
+	UserRedemptionRecords []string                 `protobuf:"bytes,7,rep,name=userRedemptionRecords,proto3" json:"userRedemptionRecords,omitempty"`
+}
+
+func (m *HostZoneUnbonding) Reset()         { *m = HostZoneUnbonding{} }
+func (m *HostZoneUnbonding) String() string { return proto.CompactTextString(m) }
+func (*HostZoneUnbonding) ProtoMessage()    {}
+func (*HostZoneUnbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48213bbd8092b5ab, []int{2}
+}
+func (m *HostZoneUnbonding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostZoneUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostZoneUnbonding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HostZoneUnbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostZoneUnbonding.Merge(m, src)
+}
+func (m *HostZoneUnbonding) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostZoneUnbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostZoneUnbonding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostZoneUnbonding proto.InternalMessageInfo
+
+func (m *HostZoneUnbonding) GetStTokenAmount() uint64 {
+	if m != nil {
+		return m.StTokenAmount
+	}
+	return 0
+}
+
+func (m *HostZoneUnbonding) GetNativeTokenAmount() uint64 {
+	if m != nil {
+		return m.NativeTokenAmount
+	}
+	return 0
+}
+
+func (m *HostZoneUnbonding) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *HostZoneUnbonding) GetHostZoneId() string {
+	if m != nil {
+		return m.HostZoneId
+	}
+	return ""
+}
+
+func (m *HostZoneUnbonding) GetUnbondingTime() uint64 {
+	if m != nil {
+		return m.UnbondingTime
+	}
+	return 0
+}
+
+func (m *HostZoneUnbonding) GetStatus() HostZoneUnbonding_Status {
+	if m != nil {
+		return m.Status
+	}
+	return HostZoneUnbonding_UNBONDING_QUEUE
+}
+
+func (m *HostZoneUnbonding) GetUserRedemptionRecords() []string {
+	if m != nil {
+		return m.UserRedemptionRecords
+	}
+	return nil
+}
+
+type EpochUnbondingRecord struct {
+	EpochNumber        uint64               `protobuf:"varint,1,opt,name=epochNumber,proto3" json:"epochNumber,omitempty"`
+	HostZoneUnbondings []*HostZoneUnbonding `protobuf:"bytes,3,rep,name=hostZoneUnbondings,proto3" json:"hostZoneUnbondings,omitempty"`
+}
+
+func (m *EpochUnbondingRecord) Reset()         { *m = EpochUnbondingRecord{} }
+func (m *EpochUnbondingRecord) String() string { return proto.CompactTextString(m) }
+func (*EpochUnbondingRecord) ProtoMessage()    {}
+func (*EpochUnbondingRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48213bbd8092b5ab, []int{3}
+}
+func (m *EpochUnbondingRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochUnbondingRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochUnbondingRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochUnbondingRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochUnbondingRecord.Merge(m, src)
+}
+func (m *EpochUnbondingRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochUnbondingRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochUnbondingRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochUnbondingRecord proto.InternalMessageInfo
+
+func (m *EpochUnbondingRecord) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *EpochUnbondingRecord) GetHostZoneUnbondings() []*HostZoneUnbonding {
+	if m != nil {
+		return m.HostZoneUnbondings
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("Stridelabs.stride.records.v2.DepositRecord_Status", DepositRecord_Status_name, DepositRecord_Status_value)
+	proto.RegisterEnum("Stridelabs.stride.records.v2.DepositRecord_Source", DepositRecord_Source_name, DepositRecord_Source_value)
+	proto.RegisterEnum("Stridelabs.stride.records.v2.HostZoneUnbonding_Status", HostZoneUnbonding_Status_name, HostZoneUnbonding_Status_value)
+	proto.RegisterType((*UserRedemptionRecord)(nil), "Stridelabs.stride.records.v2.UserRedemptionRecord")
+	proto.RegisterType((*DepositRecord)(nil), "Stridelabs.stride.records.v2.DepositRecord")
+	proto.RegisterType((*HostZoneUnbonding)(nil), "Stridelabs.stride.records.v2.HostZoneUnbonding")
+	proto.RegisterType((*EpochUnbondingRecord)(nil), "Stridelabs.stride.records.v2.EpochUnbondingRecord")
+}
+
+func init() {
+	proto.RegisterFile("records/migrations/v2/records.proto", fileDescriptor_48213bbd8092b5ab)
+}
+
+var fileDescriptor_48213bbd8092b5ab = []byte{
+	// 672 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x6e, 0xd3, 0x40,
+	0x14, 0x8e, 0x63, 0xd7, 0x4d, 0x5f, 0x95, 0x90, 0x4e, 0xd3, 0xe2, 0x56, 0x10, 0x45, 0xa1, 0x42,
+	0x59, 0x80, 0x2d, 0x05, 0xc4, 0x86, 0x95, 0xdb, 0x98, 0xd6, 0x55, 0x70, 0xcb, 0xc4, 0x51, 0x51,
+	0x37, 0x91, 0x13, 0x8f, 0x1a, 0x8b, 0xda, 0x13, 0x79, 0xec, 0x08, 0x56, 0x5c, 0x81, 0x1b, 0x70,
+	0x1d, 0xc4, 0x86, 0x2e, 0x59, 0xa2, 0xf6, 0x06, 0x9c, 0x00, 0x65, 0xec, 0x86, 0x24, 0x0e, 0x95,
+	0xe8, 0xce, 0xf3, 0x7d, 0xef, 0xff, 0x7b, 0x7e, 0xf0, 0x24, 0x24, 0x03, 0x1a, 0xba, 0x4c, 0xf3,
+	0xbd, 0x8b, 0xd0, 0x89, 0x3c, 0x1a, 0x30, 0x6d, 0xdc, 0xd4, 0x52, 0x54, 0x1d, 0x85, 0x34, 0xa2,
+	0xe8, 0x51, 0x27, 0x0a, 0x3d, 0x97, 0x5c, 0x3a, 0x7d, 0xa6, 0x32, 0xfe, 0xa9, 0xde, 0x1a, 0x8c,
+	0x9b, 0xf5, 0xdf, 0x02, 0x54, 0xba, 0x8c, 0x84, 0x98, 0xb8, 0xc4, 0x1f, 0x4d, 0x42, 0x60, 0x4e,
+	0xa2, 0x12, 0xe4, 0x3d, 0x57, 0x11, 0x6a, 0x42, 0x63, 0x0d, 0xe7, 0x3d, 0x17, 0x6d, 0x83, 0xcc,
+	0x48, 0xe0, 0x92, 0x50, 0xc9, 0x73, 0x2c, 0x7d, 0xa1, 0x5d, 0x28, 0x84, 0x64, 0x40, 0xbc, 0x31,
+	0x09, 0x15, 0x91, 0x33, 0xd3, 0xf7, 0xc4, 0xc7, 0xf1, 0x69, 0x1c, 0x44, 0x8a, 0x54, 0x13, 0x1a,
+	0x12, 0x4e, 0x5f, 0xa8, 0x02, 0x2b, 0x2e, 0x09, 0xa8, 0xaf, 0xac, 0x70, 0x87, 0xe4, 0x81, 0xaa,
+	0x00, 0x43, 0xca, 0xa2, 0x73, 0x1a, 0x10, 0xd3, 0x55, 0x64, 0x4e, 0xcd, 0x20, 0xa8, 0x06, 0xeb,
+	0x64, 0x44, 0x07, 0x43, 0x2b, 0xf6, 0xfb, 0x24, 0x54, 0x56, 0x79, 0xc8, 0x59, 0x08, 0x3d, 0x85,
+	0xd2, 0xe0, 0xd2, 0xf1, 0x7c, 0x93, 0x9d, 0x92, 0xc0, 0xf5, 0x82, 0x0b, 0xa5, 0x50, 0x13, 0x1a,
+	0x05, 0xbc, 0x80, 0xd6, 0x7f, 0x88, 0x50, 0x6c, 0x91, 0x11, 0x65, 0x5e, 0x94, 0xe9, 0x56, 0xba,
+	0xed, 0x36, 0xad, 0x7c, 0xd2, 0xad, 0x98, 0xad, 0x5c, 0xfc, 0x77, 0xe5, 0x52, 0xa6, 0xf2, 0x63,
+	0x90, 0x59, 0xe4, 0x44, 0x31, 0xe3, 0x5d, 0x95, 0x9a, 0x4d, 0xf5, 0x2e, 0x4d, 0xd4, 0xb9, 0xd2,
+	0xd4, 0x0e, 0xf7, 0xc4, 0x69, 0x04, 0xa4, 0x02, 0x72, 0x13, 0xde, 0xc8, 0x0c, 0x63, 0x09, 0xc3,
+	0x73, 0xd3, 0x38, 0x1c, 0x10, 0xa5, 0x70, 0x8f, 0xdc, 0xdc, 0x13, 0xa7, 0x11, 0xea, 0x43, 0x90,
+	0x93, 0x6a, 0x10, 0x82, 0x92, 0x8d, 0x75, 0xab, 0xf3, 0xc6, 0xc0, 0xbd, 0x77, 0x5d, 0xa3, 0x6b,
+	0x94, 0x73, 0x48, 0x81, 0xca, 0x14, 0x33, 0xad, 0xde, 0x29, 0x3e, 0x39, 0xc4, 0x46, 0xa7, 0x53,
+	0xce, 0xa3, 0x0a, 0x94, 0x5b, 0x46, 0xdb, 0x38, 0xd4, 0x6d, 0xf3, 0xc4, 0x4a, 0xed, 0x05, 0xb4,
+	0x0b, 0xdb, 0x33, 0xe8, 0xac, 0x87, 0x58, 0x6f, 0x80, 0x9c, 0xe4, 0x46, 0x00, 0x72, 0xc7, 0xc6,
+	0x66, 0x6b, 0x92, 0x01, 0x41, 0xe9, 0xcc, 0xb4, 0x8f, 0x5a, 0x58, 0x3f, 0xd3, 0xdb, 0x3d, 0xf3,
+	0x40, 0x2f, 0x0b, 0xc7, 0x52, 0x61, 0xa5, 0x2c, 0xd7, 0xbf, 0x8b, 0xb0, 0x71, 0x94, 0x0e, 0xbc,
+	0x1b, 0xf4, 0x29, 0xd7, 0x19, 0xed, 0x41, 0x91, 0x45, 0x36, 0xfd, 0x40, 0x02, 0x3d, 0x11, 0x33,
+	0x11, 0x78, 0x1e, 0x44, 0xcf, 0x60, 0x23, 0x70, 0x22, 0x6f, 0x4c, 0x66, 0x2d, 0xf3, 0xdc, 0x32,
+	0x4b, 0xdc, 0x73, 0x03, 0xf6, 0xa0, 0x18, 0xdf, 0x96, 0x65, 0x7b, 0x3e, 0xe1, 0x9b, 0x2f, 0xe1,
+	0x79, 0x10, 0x59, 0x0b, 0x7b, 0xf2, 0xea, 0x6e, 0xad, 0x32, 0x0d, 0x2f, 0xee, 0xca, 0x4b, 0xd8,
+	0x8a, 0x97, 0xfc, 0xdb, 0x4c, 0x59, 0xad, 0x89, 0x8d, 0x35, 0xbc, 0x9c, 0xac, 0x7f, 0x9e, 0xaa,
+	0xbc, 0x09, 0x0f, 0xba, 0xd6, 0xfe, 0x89, 0xd5, 0x32, 0xad, 0xc3, 0xa9, 0xcc, 0x3b, 0xb0, 0xf5,
+	0x17, 0x9c, 0x53, 0x0d, 0x3d, 0x84, 0x4d, 0xe3, 0xbd, 0x69, 0xf7, 0x16, 0x56, 0x43, 0x40, 0x8f,
+	0x61, 0x67, 0x9e, 0x98, 0xf5, 0x93, 0x50, 0x11, 0xd6, 0x0e, 0xda, 0xba, 0xf9, 0x56, 0xdf, 0x6f,
+	0x1b, 0xe5, 0x7c, 0xfd, 0xab, 0x00, 0x15, 0xbe, 0xc2, 0xd3, 0xc6, 0xd2, 0xbf, 0x74, 0xe1, 0x02,
+	0x08, 0xd9, 0x0b, 0xd0, 0x03, 0x34, 0x5c, 0x9c, 0x0a, 0x53, 0xc4, 0x9a, 0xd8, 0x58, 0x6f, 0x6a,
+	0xff, 0x39, 0x4d, 0xbc, 0x24, 0xd4, 0xb1, 0x54, 0xc8, 0x97, 0xc5, 0xfd, 0xee, 0xb7, 0xeb, 0xaa,
+	0x70, 0x75, 0x5d, 0x15, 0x7e, 0x5d, 0x57, 0x85, 0x2f, 0x37, 0xd5, 0xdc, 0xd5, 0x4d, 0x35, 0xf7,
+	0xf3, 0xa6, 0x9a, 0x3b, 0x7f, 0x7d, 0xe1, 0x45, 0xc3, 0xb8, 0xaf, 0x0e, 0xa8, 0xaf, 0x25, 0xe9,
+	0x9e, 0xb7, 0x9d, 0x3e, 0xd3, 0x92, 0x7c, 0xda, 0x47, 0x6d, 0xf9, 0xc9, 0x8e, 0x3e, 0x8d, 0x08,
+	0xeb, 0xcb, 0xfc, 0x62, 0xbf, 0xf8, 0x33, 0x00, 0xa5, 0x61, 0xd6, 0xff, 0xd8, 0x05, 0x00, 0x00,
+}
+
+func (m *UserRedemptionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserRedemptionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserRedemptionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClaimIsPending {
+		i--
+		if m.ClaimIsPending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintRecords(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.HostZoneId) > 0 {
+		i -= len(m.HostZoneId)
+		copy(dAtA[i:], m.HostZoneId)
+		i = encodeVarintRecords(dAtA, i, uint64(len(m.HostZoneId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintRecords(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Amount != 0 {
+		i = encodeVarintRecords(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintRecords(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintRecords(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintRecords(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Source != 0 {
+		i = encodeVarintRecords(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.DepositEpochNumber != 0 {
+		i = encodeVarintRecords(dAtA, i, uint64(m.DepositEpochNumber))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Status != 0 {
+		i = encodeVarintRecords(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.HostZoneId) > 0 {
+		i -= len(m.HostZoneId)
+		copy(dAtA[i:], m.HostZoneId)
+		i = encodeVarintRecords(dAtA, i, uint64(len(m.HostZoneId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintRecords(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Amount != 0 {
+		i = encodeVarintRecords(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintRecords(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HostZoneUnbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostZoneUnbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostZoneUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UserRedemptionRecords) > 0 {
+		for iNdEx := len(m.UserRedemptionRecords) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UserRedemptionRecords[iNdEx])
+			copy(dAtA[i:], m.UserRedemptionRecords[iNdEx])
+			i = encodeVarintRecords(dAtA, i, uint64(len(m.UserRedemptionRecords[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintRecords(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UnbondingTime != 0 {
+		i = encodeVarintRecords(dAtA, i, uint64(m.UnbondingTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.HostZoneId) > 0 {
+		i -= len(m.HostZoneId)
+		copy(dAtA[i:], m.HostZoneId)
+		i = encodeVarintRecords(dAtA, i, uint64(len(m.HostZoneId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintRecords(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NativeTokenAmount != 0 {
+		i = encodeVarintRecords(dAtA, i, uint64(m.NativeTokenAmount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StTokenAmount != 0 {
+		i = encodeVarintRecords(dAtA, i, uint64(m.StTokenAmount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochUnbondingRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochUnbondingRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochUnbondingRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HostZoneUnbondings) > 0 {
+		for iNdEx := len(m.HostZoneUnbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostZoneUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRecords(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintRecords(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRecords(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRecords(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UserRedemptionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovRecords(uint64(m.Amount))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	l = len(m.HostZoneId)
+	if l > 0 {
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovRecords(uint64(m.EpochNumber))
+	}
+	if m.ClaimIsPending {
+		n += 2
+	}
+	return n
+}
+
+func (m *DepositRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRecords(uint64(m.Id))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovRecords(uint64(m.Amount))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	l = len(m.HostZoneId)
+	if l > 0 {
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovRecords(uint64(m.Status))
+	}
+	if m.DepositEpochNumber != 0 {
+		n += 1 + sovRecords(uint64(m.DepositEpochNumber))
+	}
+	if m.Source != 0 {
+		n += 1 + sovRecords(uint64(m.Source))
+	}
+	return n
+}
+
+func (m *HostZoneUnbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StTokenAmount != 0 {
+		n += 1 + sovRecords(uint64(m.StTokenAmount))
+	}
+	if m.NativeTokenAmount != 0 {
+		n += 1 + sovRecords(uint64(m.NativeTokenAmount))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	l = len(m.HostZoneId)
+	if l > 0 {
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	if m.UnbondingTime != 0 {
+		n += 1 + sovRecords(uint64(m.UnbondingTime))
+	}
+	if m.Status != 0 {
+		n += 1 + sovRecords(uint64(m.Status))
+	}
+	if len(m.UserRedemptionRecords) > 0 {
+		for _, s := range m.UserRedemptionRecords {
+			l = len(s)
+			n += 1 + l + sovRecords(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochUnbondingRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovRecords(uint64(m.EpochNumber))
+	}
+	if len(m.HostZoneUnbondings) > 0 {
+		for _, e := range m.HostZoneUnbondings {
+			l = e.Size()
+			n += 1 + l + sovRecords(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovRecords(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRecords(x uint64) (n int) {
+	return sovRecords(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UserRedemptionRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecords
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserRedemptionRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserRedemptionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimIsPending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClaimIsPending = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecords(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecords
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DepositRecord_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositEpochNumber", wireType)
+			}
+			m.DepositEpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositEpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= DepositRecord_Source(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecords(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostZoneUnbonding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecords
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostZoneUnbonding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostZoneUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StTokenAmount", wireType)
+			}
+			m.StTokenAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StTokenAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeTokenAmount", wireType)
+			}
+			m.NativeTokenAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NativeTokenAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			m.UnbondingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= HostZoneUnbonding_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserRedemptionRecords", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserRedemptionRecords = append(m.UserRedemptionRecords, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecords(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochUnbondingRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecords
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochUnbondingRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochUnbondingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneUnbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneUnbondings = append(m.HostZoneUnbondings, &HostZoneUnbonding{})
+			if err := m.HostZoneUnbondings[len(m.HostZoneUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecords(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRecords(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRecords
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRecords
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRecords
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRecords
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRecords        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRecords          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRecords = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,121 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	oldrecordstypes "github.com/Stride-Labs/stride/x/records/migrations/v2/types"
+	"github.com/Stride-Labs/stride/x/records/types"
+)
+
+// MigrateStore converts the amounts on the user redemption records, deposit records, and
+// epoch unbonding records from fixed-width integers to sdk.Int
+// Records are rewritten under their existing keys, so the secondary indexes are unaffected
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec) error {
+	userRedemptionRecordStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.UserRedemptionRecordKey))
+	err := migrateStoreValues(userRedemptionRecordStore, func(bz []byte) ([]byte, error) {
+		var oldUserRedemptionRecord oldrecordstypes.UserRedemptionRecord
+		if err := cdc.Unmarshal(bz, &oldUserRedemptionRecord); err != nil {
+			return nil, err
+		}
+		newUserRedemptionRecord := convertUserRedemptionRecord(oldUserRedemptionRecord)
+		return cdc.Marshal(&newUserRedemptionRecord)
+	})
+	if err != nil {
+		return err
+	}
+
+	depositRecordStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.DepositRecordKey))
+	err = migrateStoreValues(depositRecordStore, func(bz []byte) ([]byte, error) {
+		var oldDepositRecord oldrecordstypes.DepositRecord
+		if err := cdc.Unmarshal(bz, &oldDepositRecord); err != nil {
+			return nil, err
+		}
+		newDepositRecord := convertDepositRecord(oldDepositRecord)
+		return cdc.Marshal(&newDepositRecord)
+	})
+	if err != nil {
+		return err
+	}
+
+	epochUnbondingRecordStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.EpochUnbondingRecordKey))
+	return migrateStoreValues(epochUnbondingRecordStore, func(bz []byte) ([]byte, error) {
+		var oldEpochUnbondingRecord oldrecordstypes.EpochUnbondingRecord
+		if err := cdc.Unmarshal(bz, &oldEpochUnbondingRecord); err != nil {
+			return nil, err
+		}
+		newEpochUnbondingRecord := convertEpochUnbondingRecord(oldEpochUnbondingRecord)
+		return cdc.Marshal(&newEpochUnbondingRecord)
+	})
+}
+
+// migrateStoreValues rewrites each value in the store with the result of migrateValue
+// The new values are only written once iteration is complete
+func migrateStoreValues(store prefix.Store, migrateValue func(bz []byte) ([]byte, error)) error {
+	iterator := store.Iterator(nil, nil)
+	var keys, values [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		newValue, err := migrateValue(iterator.Value())
+		if err != nil {
+			iterator.Close()
+			return err
+		}
+		keys = append(keys, iterator.Key())
+		values = append(values, newValue)
+	}
+	iterator.Close()
+
+	for i, key := range keys {
+		store.Set(key, values[i])
+	}
+	return nil
+}
+
+func convertUserRedemptionRecord(oldRecord oldrecordstypes.UserRedemptionRecord) types.UserRedemptionRecord {
+	return types.UserRedemptionRecord{
+		Id:             oldRecord.Id,
+		Sender:         oldRecord.Sender,
+		Receiver:       oldRecord.Receiver,
+		Amount:         sdk.NewIntFromUint64(oldRecord.Amount),
+		Denom:          oldRecord.Denom,
+		HostZoneId:     oldRecord.HostZoneId,
+		EpochNumber:    oldRecord.EpochNumber,
+		ClaimIsPending: oldRecord.ClaimIsPending,
+	}
+}
+
+func convertDepositRecord(oldRecord oldrecordstypes.DepositRecord) types.DepositRecord {
+	return types.DepositRecord{
+		Id:                 oldRecord.Id,
+		Amount:             sdk.NewInt(oldRecord.Amount),
+		Denom:              oldRecord.Denom,
+		HostZoneId:         oldRecord.HostZoneId,
+		Status:             types.DepositRecord_Status(oldRecord.Status),
+		DepositEpochNumber: oldRecord.DepositEpochNumber,
+		Source:             types.DepositRecord_Source(oldRecord.Source),
+	}
+}
+
+func convertHostZoneUnbonding(oldHostZoneUnbonding oldrecordstypes.HostZoneUnbonding) types.HostZoneUnbonding {
+	return types.HostZoneUnbonding{
+		StTokenAmount:         sdk.NewIntFromUint64(oldHostZoneUnbonding.StTokenAmount),
+		NativeTokenAmount:     sdk.NewIntFromUint64(oldHostZoneUnbonding.NativeTokenAmount),
+		Denom:                 oldHostZoneUnbonding.Denom,
+		HostZoneId:            oldHostZoneUnbonding.HostZoneId,
+		UnbondingTime:         oldHostZoneUnbonding.UnbondingTime,
+		Status:                types.HostZoneUnbonding_Status(oldHostZoneUnbonding.Status),
+		UserRedemptionRecords: oldHostZoneUnbonding.UserRedemptionRecords,
+	}
+}
+
+func convertEpochUnbondingRecord(oldRecord oldrecordstypes.EpochUnbondingRecord) types.EpochUnbondingRecord {
+	newRecord := types.EpochUnbondingRecord{
+		EpochNumber: oldRecord.EpochNumber,
+	}
+	for _, oldHostZoneUnbonding := range oldRecord.HostZoneUnbondings {
+		newHostZoneUnbonding := convertHostZoneUnbonding(*oldHostZoneUnbonding)
+		newRecord.HostZoneUnbondings = append(newRecord.HostZoneUnbondings, &newHostZoneUnbonding)
+	}
+	return newRecord
+}
@@ -12,13 +12,15 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:                    DefaultParams(),
-		PortId:                    PortID,
-		UserRedemptionRecordList:  []UserRedemptionRecord{},
-		UserRedemptionRecordCount: 0,
-		EpochUnbondingRecordList:  []EpochUnbondingRecord{},
-		DepositRecordList:         []DepositRecord{},
-		DepositRecordCount:        0,
+		Params:                      DefaultParams(),
+		PortId:                      PortID,
+		UserRedemptionRecordList:    []UserRedemptionRecord{},
+		UserRedemptionRecordCount:   0,
+		EpochUnbondingRecordList:    []EpochUnbondingRecord{},
+		DepositRecordList:           []DepositRecord{},
+		DepositRecordCount:          0,
+		RecordStatusTransitionList:  []RecordStatusTransition{},
+		RecordStatusTransitionCount: 0,
 		// this line is used by starport scaffolding # genesis/types/default
 	}
 }
@@ -58,6 +60,19 @@ func (gs GenesisState) Validate() error {
 		depositRecordIdMap[elem.Id] = true
 	}
 
+	// Check for duplicated ID in recordStatusTransition
+	recordStatusTransitionIdMap := make(map[uint64]bool)
+	recordStatusTransitionCount := gs.GetRecordStatusTransitionCount()
+	for _, elem := range gs.RecordStatusTransitionList {
+		if _, ok := recordStatusTransitionIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for recordStatusTransition")
+		}
+		if elem.Id >= recordStatusTransitionCount {
+			return fmt.Errorf("recordStatusTransition id should be lower or equal than the last id")
+		}
+		recordStatusTransitionIdMap[elem.Id] = true
+	}
+
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
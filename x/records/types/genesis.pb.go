@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -91,15 +92,12 @@ type HostZoneUnbonding_Status int32
 
 const (
 	// tokens bonded on delegate account
-	// BONDED = 0;
 	HostZoneUnbonding_UNBONDING_QUEUE       HostZoneUnbonding_Status = 0
 	HostZoneUnbonding_UNBONDING_IN_PROGRESS HostZoneUnbonding_Status = 3
 	// unbonding completed on delegate account
-	// UNBONDED = 1;
 	HostZoneUnbonding_EXIT_TRANSFER_QUEUE       HostZoneUnbonding_Status = 1
 	HostZoneUnbonding_EXIT_TRANSFER_IN_PROGRESS HostZoneUnbonding_Status = 4
 	// transfer success
-	// TRANSFERRED = 2;
 	HostZoneUnbonding_CLAIMABLE HostZoneUnbonding_Status = 2
 )
 
@@ -128,14 +126,14 @@ func (HostZoneUnbonding_Status) EnumDescriptor() ([]byte, []int) {
 }
 
 type UserRedemptionRecord struct {
-	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Sender         string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	Receiver       string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
-	Amount         uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Denom          string `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
-	HostZoneId     string `protobuf:"bytes,6,opt,name=hostZoneId,proto3" json:"hostZoneId,omitempty"`
-	EpochNumber    uint64 `protobuf:"varint,7,opt,name=epochNumber,proto3" json:"epochNumber,omitempty"`
-	ClaimIsPending bool   `protobuf:"varint,8,opt,name=claimIsPending,proto3" json:"claimIsPending,omitempty"`
+	Id             string                                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sender         string                                 `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver       string                                 `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Amount         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	Denom          string                                 `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
+	HostZoneId     string                                 `protobuf:"bytes,6,opt,name=hostZoneId,proto3" json:"hostZoneId,omitempty"`
+	EpochNumber    uint64                                 `protobuf:"varint,7,opt,name=epochNumber,proto3" json:"epochNumber,omitempty"`
+	ClaimIsPending bool                                   `protobuf:"varint,8,opt,name=claimIsPending,proto3" json:"claimIsPending,omitempty"`
 }
 
 func (m *UserRedemptionRecord) Reset()         { *m = UserRedemptionRecord{} }
@@ -192,13 +190,6 @@ func (m *UserRedemptionRecord) GetReceiver() string {
 	return ""
 }
 
-func (m *UserRedemptionRecord) GetAmount() uint64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
-}
-
 func (m *UserRedemptionRecord) GetDenom() string {
 	if m != nil {
 		return m.Denom
@@ -229,6 +220,8 @@ func (m *UserRedemptionRecord) GetClaimIsPending() bool {
 
 // Params defines the parameters for the module.
 type Params struct {
+	// number of blocks the record status transitions are kept for, 0 keeps them forever
+	HistoryRetentionBlocks uint64 `protobuf:"varint,1,opt,name=history_retention_blocks,json=historyRetentionBlocks,proto3" json:"history_retention_blocks,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -263,6 +256,13 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetHistoryRetentionBlocks() uint64 {
+	if m != nil {
+		return m.HistoryRetentionBlocks
+	}
+	return 0
+}
+
 type RecordsPacketData struct {
 	// Types that are valid to be assigned to Packet:
 	//	*RecordsPacketData_NoData
@@ -372,13 +372,13 @@ func (m *NoData) XXX_DiscardUnknown() {
 var xxx_messageInfo_NoData proto.InternalMessageInfo
 
 type DepositRecord struct {
-	Id                 uint64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Amount             int64                `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Denom              string               `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
-	HostZoneId         string               `protobuf:"bytes,4,opt,name=hostZoneId,proto3" json:"hostZoneId,omitempty"`
-	Status             DepositRecord_Status `protobuf:"varint,6,opt,name=status,proto3,enum=Stridelabs.stride.records.DepositRecord_Status" json:"status,omitempty"`
-	DepositEpochNumber uint64               `protobuf:"varint,7,opt,name=depositEpochNumber,proto3" json:"depositEpochNumber,omitempty"`
-	Source             DepositRecord_Source `protobuf:"varint,8,opt,name=source,proto3,enum=Stridelabs.stride.records.DepositRecord_Source" json:"source,omitempty"`
+	Id                 uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount             github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	Denom              string                                 `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	HostZoneId         string                                 `protobuf:"bytes,4,opt,name=hostZoneId,proto3" json:"hostZoneId,omitempty"`
+	Status             DepositRecord_Status                   `protobuf:"varint,6,opt,name=status,proto3,enum=Stridelabs.stride.records.DepositRecord_Status" json:"status,omitempty"`
+	DepositEpochNumber uint64                                 `protobuf:"varint,7,opt,name=depositEpochNumber,proto3" json:"depositEpochNumber,omitempty"`
+	Source             DepositRecord_Source                   `protobuf:"varint,8,opt,name=source,proto3,enum=Stridelabs.stride.records.DepositRecord_Source" json:"source,omitempty"`
 }
 
 func (m *DepositRecord) Reset()         { *m = DepositRecord{} }
@@ -421,13 +421,6 @@ func (m *DepositRecord) GetId() uint64 {
 	return 0
 }
 
-func (m *DepositRecord) GetAmount() int64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
-}
-
 func (m *DepositRecord) GetDenom() string {
 	if m != nil {
 		return m.Denom
@@ -464,13 +457,13 @@ func (m *DepositRecord) GetSource() DepositRecord_Source {
 }
 
 type HostZoneUnbonding struct {
-	StTokenAmount         uint64                   `protobuf:"varint,1,opt,name=stTokenAmount,proto3" json:"stTokenAmount,omitempty"`
-	NativeTokenAmount     uint64                   `protobuf:"varint,2,opt,name=nativeTokenAmount,proto3" json:"nativeTokenAmount,omitempty"`
-	Denom                 string                   `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
-	HostZoneId            string                   `protobuf:"bytes,4,opt,name=hostZoneId,proto3" json:"hostZoneId,omitempty"`
-	UnbondingTime         uint64                   `protobuf:"varint,5,opt,name=unbondingTime,proto3" json:"unbondingTime,omitempty"`
-	Status                HostZoneUnbonding_Status `protobuf:"varint,6,opt,name=status,proto3,enum=Stridelabs.stride.records.HostZoneUnbonding_Status" json:"status,omitempty"`
-	UserRedemptionRecords []string                 `protobuf:"bytes,7,rep,name=userRedemptionRecords,proto3" json:"userRedemptionRecords,omitempty"`
+	StTokenAmount         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=stTokenAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"stTokenAmount"`
+	NativeTokenAmount     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=nativeTokenAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"nativeTokenAmount"`
+	Denom                 string                                 `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	HostZoneId            string                                 `protobuf:"bytes,4,opt,name=hostZoneId,proto3" json:"hostZoneId,omitempty"`
+	UnbondingTime         uint64                                 `protobuf:"varint,5,opt,name=unbondingTime,proto3" json:"unbondingTime,omitempty"`
+	Status                HostZoneUnbonding_Status               `protobuf:"varint,6,opt,name=status,proto3,enum=Stridelabs.stride.records.HostZoneUnbonding_Status" json:"status,omitempty"`
+	UserRedemptionRecords []string                               `protobuf:"bytes,7,rep,name=userRedemptionRecords,proto3" json:"userRedemptionRecords,omitempty"`
 }
 
 func (m *HostZoneUnbonding) Reset()         { *m = HostZoneUnbonding{} }
@@ -506,20 +499,6 @@ func (m *HostZoneUnbonding) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HostZoneUnbonding proto.InternalMessageInfo
 
-func (m *HostZoneUnbonding) GetStTokenAmount() uint64 {
-	if m != nil {
-		return m.StTokenAmount
-	}
-	return 0
-}
-
-func (m *HostZoneUnbonding) GetNativeTokenAmount() uint64 {
-	if m != nil {
-		return m.NativeTokenAmount
-	}
-	return 0
-}
-
 func (m *HostZoneUnbonding) GetDenom() string {
 	if m != nil {
 		return m.Denom
@@ -608,15 +587,17 @@ func (m *EpochUnbondingRecord) GetHostZoneUnbondings() []*HostZoneUnbonding {
 }
 
 // GenesisState defines the recordπs module's genesis state.
-// next id: 9
+// next id: 11
 type GenesisState struct {
-	Params                    Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	PortId                    string                 `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
-	UserRedemptionRecordList  []UserRedemptionRecord `protobuf:"bytes,3,rep,name=userRedemptionRecordList,proto3" json:"userRedemptionRecordList"`
-	UserRedemptionRecordCount uint64                 `protobuf:"varint,4,opt,name=userRedemptionRecordCount,proto3" json:"userRedemptionRecordCount,omitempty"`
-	EpochUnbondingRecordList  []EpochUnbondingRecord `protobuf:"bytes,5,rep,name=epochUnbondingRecordList,proto3" json:"epochUnbondingRecordList"`
-	DepositRecordList         []DepositRecord        `protobuf:"bytes,7,rep,name=depositRecordList,proto3" json:"depositRecordList"`
-	DepositRecordCount        uint64                 `protobuf:"varint,8,opt,name=depositRecordCount,proto3" json:"depositRecordCount,omitempty"`
+	Params                      Params                   `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PortId                      string                   `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	UserRedemptionRecordList    []UserRedemptionRecord   `protobuf:"bytes,3,rep,name=userRedemptionRecordList,proto3" json:"userRedemptionRecordList"`
+	UserRedemptionRecordCount   uint64                   `protobuf:"varint,4,opt,name=userRedemptionRecordCount,proto3" json:"userRedemptionRecordCount,omitempty"`
+	EpochUnbondingRecordList    []EpochUnbondingRecord   `protobuf:"bytes,5,rep,name=epochUnbondingRecordList,proto3" json:"epochUnbondingRecordList"`
+	DepositRecordList           []DepositRecord          `protobuf:"bytes,7,rep,name=depositRecordList,proto3" json:"depositRecordList"`
+	DepositRecordCount          uint64                   `protobuf:"varint,8,opt,name=depositRecordCount,proto3" json:"depositRecordCount,omitempty"`
+	RecordStatusTransitionList  []RecordStatusTransition `protobuf:"bytes,9,rep,name=recordStatusTransitionList,proto3" json:"recordStatusTransitionList"`
+	RecordStatusTransitionCount uint64                   `protobuf:"varint,10,opt,name=recordStatusTransitionCount,proto3" json:"recordStatusTransitionCount,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -701,6 +682,20 @@ func (m *GenesisState) GetDepositRecordCount() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetRecordStatusTransitionList() []RecordStatusTransition {
+	if m != nil {
+		return m.RecordStatusTransitionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRecordStatusTransitionCount() uint64 {
+	if m != nil {
+		return m.RecordStatusTransitionCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("Stridelabs.stride.records.DepositRecord_Status", DepositRecord_Status_name, DepositRecord_Status_value)
 	proto.RegisterEnum("Stridelabs.stride.records.DepositRecord_Source", DepositRecord_Source_name, DepositRecord_Source_value)
@@ -718,64 +713,71 @@ func init() {
 func init() { proto.RegisterFile("records/genesis.proto", fileDescriptor_03dd178cbf8084c6) }
 
 var fileDescriptor_03dd178cbf8084c6 = []byte{
-	// 901 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x8f, 0x63, 0xc7, 0x4d, 0x5f, 0x69, 0x48, 0x67, 0xd3, 0x5d, 0xb7, 0x12, 0x69, 0xb0, 0x56,
-	0x28, 0x87, 0xdd, 0x58, 0xea, 0x72, 0x02, 0x24, 0x94, 0x34, 0x26, 0xf5, 0x92, 0x4d, 0xcb, 0x24,
-	0xd1, 0xa2, 0x6a, 0xa5, 0xc8, 0x89, 0x87, 0xc4, 0xda, 0xda, 0x13, 0x3c, 0x93, 0x15, 0x9c, 0xf8,
-	0x0a, 0x1c, 0x11, 0xe2, 0xc0, 0xb7, 0x61, 0xb9, 0xed, 0x91, 0x13, 0x42, 0xed, 0x37, 0xe0, 0x13,
-	0x20, 0x8f, 0x9d, 0xe0, 0xc4, 0x4e, 0xd9, 0xe5, 0xe6, 0xf7, 0xe7, 0xf7, 0xde, 0x9b, 0xdf, 0x1b,
-	0xff, 0x6c, 0x38, 0x0c, 0xc8, 0x84, 0x06, 0x0e, 0x33, 0xa6, 0xc4, 0x27, 0xcc, 0x65, 0x8d, 0x79,
-	0x40, 0x39, 0x45, 0x47, 0x7d, 0x1e, 0xb8, 0x0e, 0xb9, 0xb6, 0xc7, 0xac, 0xc1, 0xc4, 0x63, 0x23,
-	0x4e, 0x3c, 0xae, 0x4c, 0xe9, 0x94, 0x8a, 0x2c, 0x23, 0x7c, 0x8a, 0x00, 0xc7, 0x27, 0x53, 0x4a,
-	0xa7, 0xd7, 0xc4, 0x10, 0xd6, 0x78, 0xf1, 0x8d, 0xc1, 0x5d, 0x8f, 0x30, 0x6e, 0x7b, 0xf3, 0x28,
-	0x41, 0xff, 0x5b, 0x82, 0xca, 0x90, 0x91, 0x00, 0x13, 0x87, 0x78, 0x73, 0xee, 0x52, 0x1f, 0x8b,
-	0x82, 0xa8, 0x04, 0x79, 0xd7, 0xd1, 0xa4, 0x9a, 0x54, 0xdf, 0xc5, 0x79, 0xd7, 0x41, 0xf7, 0x41,
-	0x65, 0xc4, 0x77, 0x48, 0xa0, 0xe5, 0x85, 0x2f, 0xb6, 0xd0, 0x31, 0x14, 0x03, 0x32, 0x21, 0xee,
-	0x2b, 0x12, 0x68, 0xb2, 0x88, 0xac, 0xec, 0x10, 0x63, 0x7b, 0x74, 0xe1, 0x73, 0x4d, 0xa9, 0x49,
-	0x75, 0x05, 0xc7, 0x16, 0xaa, 0x40, 0xc1, 0x21, 0x3e, 0xf5, 0xb4, 0x82, 0x00, 0x44, 0x06, 0xaa,
-	0x02, 0xcc, 0x28, 0xe3, 0x57, 0xd4, 0x27, 0x96, 0xa3, 0xa9, 0x22, 0x94, 0xf0, 0xa0, 0x1a, 0xec,
-	0x91, 0x39, 0x9d, 0xcc, 0x7a, 0x0b, 0x6f, 0x4c, 0x02, 0x6d, 0x47, 0x94, 0x4c, 0xba, 0xd0, 0x47,
-	0x50, 0x9a, 0x5c, 0xdb, 0xae, 0x67, 0xb1, 0x4b, 0xe2, 0x3b, 0xae, 0x3f, 0xd5, 0x8a, 0x35, 0xa9,
-	0x5e, 0xc4, 0x1b, 0x5e, 0xbd, 0x04, 0xea, 0xa5, 0x1d, 0xd8, 0x1e, 0xfb, 0x44, 0xf9, 0xe9, 0xd7,
-	0x93, 0x9c, 0x7e, 0x05, 0x07, 0xd1, 0xa9, 0xd9, 0xa5, 0x3d, 0x79, 0x49, 0x78, 0xdb, 0xe6, 0x36,
-	0xfa, 0x14, 0x54, 0x9f, 0x86, 0x4f, 0x82, 0x84, 0xbd, 0xd3, 0x0f, 0x1b, 0x5b, 0xc9, 0x6f, 0xf4,
-	0x44, 0xe2, 0x79, 0x0e, 0xc7, 0x90, 0x56, 0x11, 0xd4, 0xb9, 0x28, 0xa5, 0x17, 0x41, 0x8d, 0xa2,
-	0xfa, 0xef, 0x32, 0xec, 0xb7, 0xc9, 0x9c, 0x32, 0x97, 0xa7, 0x38, 0x56, 0x96, 0x1c, 0xc7, 0x7c,
-	0x85, 0x1c, 0xcb, 0x69, 0xbe, 0xe4, 0xed, 0x7c, 0x29, 0x29, 0xbe, 0x3a, 0xa0, 0x32, 0x6e, 0xf3,
-	0x05, 0x13, 0x5c, 0x96, 0x4e, 0x8d, 0x3b, 0x0e, 0xb0, 0x36, 0x57, 0xa3, 0x2f, 0x60, 0x38, 0x86,
-	0xa3, 0x06, 0x20, 0x27, 0x8a, 0x9b, 0x29, 0xfe, 0x33, 0x22, 0xa2, 0x31, 0x5d, 0x04, 0x13, 0x22,
-	0xe8, 0x7f, 0xa7, 0xc6, 0x02, 0x86, 0x63, 0xb8, 0x3e, 0x03, 0x35, 0x1a, 0x05, 0x21, 0x28, 0x0d,
-	0x70, 0xb3, 0xd7, 0xff, 0xc2, 0xc4, 0xa3, 0xaf, 0x86, 0xe6, 0xd0, 0x2c, 0xe7, 0x90, 0x06, 0x95,
-	0x95, 0xcf, 0xea, 0x8d, 0x2e, 0xf1, 0x45, 0x07, 0x9b, 0xfd, 0x7e, 0x39, 0x8f, 0x2a, 0x50, 0x6e,
-	0x9b, 0x5d, 0xb3, 0xd3, 0x1c, 0x58, 0x17, 0xbd, 0x38, 0x5f, 0x42, 0xc7, 0x70, 0x3f, 0xe1, 0x4d,
-	0x22, 0x64, 0xbd, 0x0e, 0x6a, 0xd4, 0x1b, 0x01, 0xa8, 0xfd, 0x01, 0xb6, 0xda, 0x61, 0x07, 0x04,
-	0xa5, 0xe7, 0xd6, 0xe0, 0xbc, 0x8d, 0x9b, 0xcf, 0x9b, 0xdd, 0x91, 0x75, 0xd6, 0x2c, 0x4b, 0x4f,
-	0x95, 0x62, 0xa1, 0xac, 0xea, 0xbf, 0xc9, 0x70, 0x70, 0x1e, 0x53, 0x3d, 0xf4, 0xc7, 0x54, 0xdc,
-	0x2b, 0xf4, 0x10, 0xf6, 0x19, 0x1f, 0xd0, 0x97, 0xc4, 0x6f, 0x46, 0x6b, 0x8c, 0x56, 0xbb, 0xee,
-	0x44, 0x8f, 0xe0, 0xc0, 0xb7, 0xb9, 0xfb, 0x8a, 0x24, 0x33, 0xf3, 0x22, 0x33, 0x1d, 0xf8, 0x9f,
-	0xbb, 0x7f, 0x08, 0xfb, 0x8b, 0xe5, 0x58, 0x03, 0xd7, 0x23, 0xe2, 0x4d, 0x53, 0xf0, 0xba, 0x13,
-	0x7d, 0xb9, 0x71, 0x43, 0x9e, 0xdc, 0xb1, 0xa8, 0xd4, 0x69, 0x37, 0x6f, 0xc9, 0xc7, 0x70, 0xb8,
-	0xc8, 0x10, 0x12, 0xa6, 0xed, 0xd4, 0xe4, 0xfa, 0x2e, 0xce, 0x0e, 0xea, 0x3f, 0xac, 0x56, 0x7c,
-	0x0f, 0xde, 0x1f, 0xf6, 0x5a, 0x17, 0xbd, 0xb6, 0xd5, 0xeb, 0xac, 0x76, 0x7c, 0x04, 0x87, 0xff,
-	0x3a, 0xd7, 0x56, 0x86, 0x1e, 0xc0, 0x3d, 0xf3, 0x6b, 0x6b, 0x30, 0xda, 0xb8, 0x17, 0x12, 0xfa,
-	0x00, 0x8e, 0xd6, 0x03, 0x49, 0x9c, 0x82, 0xf6, 0x61, 0xf7, 0xac, 0xdb, 0xb4, 0x9e, 0x35, 0x5b,
-	0x5d, 0xb3, 0x9c, 0xd7, 0x7f, 0x91, 0xa0, 0x22, 0x2e, 0xef, 0xea, 0x60, 0xf1, 0xcb, 0xb9, 0x21,
-	0x37, 0x52, 0x5a, 0x6e, 0x5e, 0x00, 0x9a, 0x6d, 0xb2, 0xc2, 0x34, 0xb9, 0x26, 0xd7, 0xf7, 0x4e,
-	0x1f, 0xbd, 0x0b, 0x95, 0x38, 0xa3, 0xce, 0x53, 0xa5, 0x98, 0x2f, 0xcb, 0xfa, 0xcf, 0x0a, 0xbc,
-	0xd7, 0x89, 0xbe, 0x01, 0x21, 0x4f, 0x04, 0x7d, 0x1e, 0x2a, 0x4b, 0xa8, 0x5d, 0x6f, 0x21, 0x4b,
-	0x91, 0xc8, 0xb5, 0x94, 0xd7, 0x7f, 0x9e, 0xe4, 0x70, 0x0c, 0x43, 0x0f, 0x60, 0x67, 0x4e, 0x03,
-	0x3e, 0x72, 0x9d, 0xa5, 0x92, 0x87, 0xa6, 0xe5, 0xa0, 0x6f, 0x41, 0xcb, 0xda, 0x51, 0xd7, 0x65,
-	0x3c, 0x3e, 0xd4, 0x5d, 0x2f, 0x72, 0xd6, 0x47, 0x24, 0xee, 0xbc, 0xb5, 0x2c, 0xfa, 0x0c, 0x8e,
-	0xb2, 0x62, 0x67, 0x89, 0x6f, 0xc6, 0xf6, 0x84, 0x70, 0x60, 0x92, 0xb1, 0x39, 0x31, 0x70, 0xe1,
-	0x3f, 0x07, 0xce, 0x5a, 0xfa, 0x72, 0xe0, 0x6d, 0x65, 0xd1, 0x0b, 0x38, 0x70, 0x92, 0x8a, 0x25,
-	0x7a, 0xed, 0x88, 0x5e, 0xf5, 0xb7, 0x55, 0xb9, 0xb8, 0x49, 0xba, 0x50, 0x42, 0x68, 0x93, 0x3c,
-	0x14, 0xd7, 0x84, 0x36, 0x11, 0x39, 0x2d, 0x80, 0xfc, 0x8c, 0x4d, 0x5b, 0x9d, 0xd7, 0x37, 0x55,
-	0xe9, 0xcd, 0x4d, 0x55, 0xfa, 0xeb, 0xa6, 0x2a, 0xfd, 0x78, 0x5b, 0xcd, 0xbd, 0xb9, 0xad, 0xe6,
-	0xfe, 0xb8, 0xad, 0xe6, 0xae, 0x1e, 0x4f, 0x5d, 0x3e, 0x5b, 0x8c, 0x1b, 0x13, 0xea, 0x19, 0xd1,
-	0x74, 0x8f, 0xbb, 0xf6, 0x98, 0x19, 0xd1, 0x78, 0xc6, 0x77, 0xc6, 0xf2, 0x37, 0x83, 0x7f, 0x3f,
-	0x27, 0x6c, 0xac, 0x8a, 0x7f, 0x82, 0x27, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x61, 0x78, 0x56,
-	0x99, 0x7e, 0x08, 0x00, 0x00,
+	// 1014 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0x13, 0xc7, 0x4d, 0x5f, 0x69, 0x49, 0x67, 0xd3, 0xae, 0x5b, 0x20, 0x0d, 0x16, 0x5a,
+	0xe5, 0x40, 0x6d, 0xd1, 0xe5, 0x80, 0x00, 0x09, 0x92, 0x26, 0x9b, 0x7a, 0xc9, 0xa6, 0x65, 0x92,
+	0x6a, 0x51, 0xb5, 0x52, 0xe4, 0xc4, 0x43, 0x62, 0xb5, 0xf6, 0x04, 0xcf, 0x64, 0x61, 0x4f, 0x7c,
+	0x05, 0x8e, 0x1c, 0x38, 0x20, 0x2e, 0x7c, 0x95, 0x3d, 0xee, 0x05, 0x09, 0x71, 0x58, 0xa1, 0xf6,
+	0x53, 0x70, 0x43, 0x1e, 0x3b, 0x59, 0x27, 0x71, 0xc2, 0x6e, 0xc5, 0xc9, 0x9e, 0xf7, 0x7b, 0xff,
+	0xdf, 0xf3, 0x6f, 0x0c, 0x3b, 0x3e, 0xe9, 0x53, 0xdf, 0x66, 0xc6, 0x80, 0x78, 0x84, 0x39, 0x4c,
+	0x1f, 0xf9, 0x94, 0x53, 0xb4, 0xd7, 0xe6, 0xbe, 0x63, 0x93, 0x2b, 0xab, 0xc7, 0x74, 0x26, 0x5e,
+	0xf5, 0x48, 0x71, 0xbf, 0x30, 0xa0, 0x03, 0x2a, 0xb4, 0x8c, 0xe0, 0x2d, 0x34, 0xd8, 0x7f, 0x77,
+	0xe2, 0x27, 0x7c, 0x76, 0x87, 0x0e, 0xe3, 0xd4, 0x7f, 0x16, 0xa1, 0x07, 0x03, 0x4a, 0x07, 0x57,
+	0xc4, 0x10, 0xa7, 0xde, 0xf8, 0x5b, 0x83, 0x3b, 0x2e, 0x61, 0xdc, 0x72, 0x47, 0xa1, 0x82, 0xf6,
+	0x5b, 0x1a, 0x0a, 0xe7, 0x8c, 0xf8, 0x98, 0xd8, 0xc4, 0x1d, 0x71, 0x87, 0x7a, 0x58, 0xf8, 0x41,
+	0x5b, 0x90, 0x76, 0x6c, 0x55, 0x2a, 0x49, 0xe5, 0x75, 0x9c, 0x76, 0x6c, 0xb4, 0x0b, 0x0a, 0x23,
+	0x9e, 0x4d, 0x7c, 0x35, 0x2d, 0x64, 0xd1, 0x09, 0xed, 0x43, 0xce, 0x27, 0x7d, 0xe2, 0x3c, 0x25,
+	0xbe, 0x9a, 0x11, 0xc8, 0xf4, 0x8c, 0x1e, 0x80, 0x62, 0xb9, 0x74, 0xec, 0x71, 0x55, 0x0e, 0x90,
+	0xaa, 0xfe, 0xfc, 0xe5, 0x41, 0xea, 0xaf, 0x97, 0x07, 0xf7, 0x06, 0x0e, 0x1f, 0x8e, 0x7b, 0x7a,
+	0x9f, 0xba, 0x46, 0x9f, 0x32, 0x97, 0xb2, 0xe8, 0x71, 0xc8, 0xec, 0x4b, 0x83, 0x3f, 0x1b, 0x11,
+	0xa6, 0x9b, 0x1e, 0xc7, 0x91, 0x35, 0x2a, 0x40, 0xd6, 0x26, 0x1e, 0x75, 0xd5, 0xac, 0x08, 0x10,
+	0x1e, 0x50, 0x11, 0x60, 0x48, 0x19, 0xbf, 0xa0, 0x1e, 0x31, 0x6d, 0x55, 0x11, 0x50, 0x4c, 0x82,
+	0x4a, 0xb0, 0x41, 0x46, 0xb4, 0x3f, 0x6c, 0x8d, 0xdd, 0x1e, 0xf1, 0xd5, 0xb5, 0x92, 0x54, 0x96,
+	0x71, 0x5c, 0x84, 0xee, 0xc1, 0x56, 0xff, 0xca, 0x72, 0x5c, 0x93, 0x9d, 0x11, 0xcf, 0x76, 0xbc,
+	0x81, 0x9a, 0x2b, 0x49, 0xe5, 0x1c, 0x9e, 0x93, 0x6a, 0x27, 0xa0, 0x9c, 0x59, 0xbe, 0xe5, 0x32,
+	0xf4, 0x09, 0xa8, 0x51, 0x83, 0xbb, 0x3e, 0xe1, 0xc4, 0x0b, 0x1a, 0xd6, 0xed, 0x5d, 0xd1, 0xfe,
+	0x25, 0x13, 0xbd, 0x92, 0xf1, 0x6e, 0x84, 0xe3, 0x09, 0x5c, 0x15, 0xe8, 0xa7, 0xf2, 0xcf, 0xbf,
+	0x1e, 0xa4, 0xb4, 0x0b, 0xd8, 0x0e, 0xfb, 0xcb, 0xce, 0xac, 0xfe, 0x25, 0xe1, 0x35, 0x8b, 0x5b,
+	0xe8, 0x33, 0x50, 0x3c, 0x1a, 0xbc, 0x09, 0x17, 0x1b, 0x47, 0xef, 0xeb, 0x4b, 0x97, 0x40, 0x6f,
+	0x09, 0xc5, 0x93, 0x14, 0x8e, 0x4c, 0xaa, 0x39, 0x50, 0x46, 0xc2, 0x95, 0x96, 0x03, 0x25, 0x44,
+	0xb5, 0x7f, 0x32, 0xb0, 0x59, 0x23, 0x23, 0xca, 0x1c, 0xbe, 0x30, 0x4d, 0x59, 0x4c, 0xf3, 0xd5,
+	0x64, 0xd2, 0xff, 0xcf, 0x64, 0x32, 0xcb, 0x27, 0x23, 0x2f, 0x4c, 0xa6, 0x01, 0x0a, 0xe3, 0x16,
+	0x1f, 0x33, 0x31, 0xb5, 0xad, 0x23, 0x63, 0x45, 0xc1, 0x33, 0x75, 0xe8, 0x6d, 0x61, 0x86, 0x23,
+	0x73, 0xa4, 0x03, 0xb2, 0x43, 0xbc, 0xbe, 0x30, 0xe9, 0x04, 0x44, 0x04, 0xa6, 0x63, 0xbf, 0x4f,
+	0xd4, 0xdc, 0x9b, 0x06, 0x16, 0x66, 0x38, 0x32, 0xd7, 0x86, 0xa0, 0x84, 0xa9, 0x20, 0x04, 0x5b,
+	0x1d, 0x5c, 0x69, 0xb5, 0x1f, 0xd4, 0x71, 0xf7, 0xeb, 0xf3, 0xfa, 0x79, 0x3d, 0x9f, 0x42, 0x2a,
+	0x14, 0xa6, 0x32, 0xb3, 0xd5, 0x3d, 0xc3, 0xa7, 0x0d, 0x5c, 0x6f, 0xb7, 0xf3, 0x69, 0x54, 0x80,
+	0x7c, 0xad, 0xde, 0xac, 0x37, 0x2a, 0x1d, 0xf3, 0xb4, 0x15, 0xe9, 0x4b, 0x68, 0x1f, 0x76, 0x63,
+	0xd2, 0xb8, 0x45, 0x46, 0x2b, 0x83, 0x12, 0xc6, 0x46, 0x00, 0x4a, 0xbb, 0x83, 0xcd, 0x5a, 0x10,
+	0x01, 0xc1, 0xd6, 0x63, 0xb3, 0x73, 0x52, 0xc3, 0x95, 0xc7, 0x95, 0x66, 0xd7, 0x3c, 0xae, 0xe4,
+	0xa5, 0x87, 0x72, 0x2e, 0x9b, 0x57, 0xb4, 0xdf, 0x65, 0xd8, 0x3e, 0x89, 0x5a, 0x7d, 0xee, 0xf5,
+	0xa8, 0xd8, 0x60, 0xd4, 0x81, 0x4d, 0xc6, 0x3b, 0xf4, 0x92, 0x78, 0x95, 0x70, 0xec, 0xd2, 0xad,
+	0xc6, 0x3e, 0xeb, 0x04, 0x3d, 0x81, 0x6d, 0xcf, 0xe2, 0xce, 0x53, 0x12, 0xf7, 0x7c, 0xbb, 0x85,
+	0x5a, 0x74, 0x74, 0xcb, 0xdd, 0xfa, 0x00, 0x36, 0xc7, 0x93, 0xb2, 0x3b, 0x8e, 0x4b, 0x04, 0x67,
+	0xc8, 0x78, 0x56, 0x88, 0xbe, 0x9a, 0xdb, 0xc0, 0xfb, 0x2b, 0x16, 0x61, 0xa1, 0x9b, 0xf3, 0x5b,
+	0xf8, 0x31, 0xec, 0x8c, 0x13, 0x28, 0x94, 0xa9, 0x6b, 0xa5, 0x4c, 0x79, 0x1d, 0x27, 0x83, 0xda,
+	0x8f, 0xd3, 0x15, 0xba, 0x03, 0x6f, 0x9f, 0xb7, 0xaa, 0xa7, 0xad, 0x9a, 0xd9, 0x6a, 0x4c, 0x77,
+	0x68, 0x0f, 0x76, 0x5e, 0x09, 0x67, 0x56, 0x02, 0xdd, 0x85, 0x3b, 0xf5, 0x6f, 0xcc, 0x4e, 0x77,
+	0x6e, 0xef, 0x24, 0xf4, 0x1e, 0xec, 0xcd, 0x02, 0x71, 0x3b, 0x19, 0x6d, 0xc2, 0xfa, 0x71, 0xb3,
+	0x62, 0x3e, 0xaa, 0x54, 0x9b, 0xf5, 0x7c, 0x5a, 0xfb, 0x45, 0x82, 0x82, 0xf8, 0x38, 0xa6, 0x85,
+	0x45, 0x64, 0x31, 0x47, 0x9c, 0xd2, 0x22, 0x71, 0x3e, 0x01, 0x34, 0x9c, 0xef, 0x0a, 0x53, 0x33,
+	0xa5, 0x4c, 0x79, 0xe3, 0xe8, 0xc3, 0x37, 0x69, 0x25, 0x4e, 0xf0, 0xf3, 0x50, 0xce, 0xa5, 0xf3,
+	0x19, 0xed, 0x8f, 0x2c, 0xbc, 0xd5, 0x08, 0xef, 0xc6, 0xa0, 0x4f, 0x04, 0x7d, 0x11, 0x30, 0x5d,
+	0xc0, 0xc2, 0xaf, 0x41, 0x93, 0x21, 0x5d, 0x57, 0xe5, 0x60, 0x0b, 0x71, 0x64, 0x86, 0xee, 0xc2,
+	0xda, 0x88, 0xfa, 0xbc, 0xeb, 0xd8, 0x93, 0x3b, 0x2c, 0x38, 0x9a, 0x36, 0xfa, 0x0e, 0xd4, 0xa4,
+	0x19, 0x35, 0x1d, 0xc6, 0xa3, 0xa2, 0x56, 0x11, 0x45, 0xd2, 0xf5, 0x19, 0x45, 0x5e, 0xea, 0x16,
+	0x7d, 0x0e, 0x7b, 0x49, 0xd8, 0xf1, 0xf4, 0xb6, 0x94, 0xf1, 0x72, 0x85, 0x20, 0x61, 0x92, 0x30,
+	0x39, 0x91, 0x70, 0xf6, 0x3f, 0x13, 0x4e, 0x1a, 0xfa, 0x24, 0xe1, 0x65, 0x6e, 0x83, 0x6f, 0xdd,
+	0x8e, 0x33, 0xa2, 0x88, 0xb5, 0x26, 0x62, 0x95, 0x5f, 0x97, 0x45, 0xa3, 0x20, 0x8b, 0x8e, 0x62,
+	0x44, 0x1e, 0xef, 0x43, 0x6e, 0x86, 0xc8, 0xe3, 0x0d, 0xf8, 0x1e, 0xf6, 0xc3, 0x08, 0xe1, 0x27,
+	0xd4, 0xf1, 0x2d, 0x8f, 0x39, 0x41, 0x97, 0x44, 0x5a, 0xeb, 0x22, 0xad, 0x8f, 0x56, 0xa4, 0x85,
+	0x13, 0x8d, 0xa3, 0xfc, 0x56, 0xb8, 0x46, 0x5f, 0xc2, 0x3b, 0xc9, 0x68, 0x98, 0x31, 0x88, 0x8c,
+	0x57, 0xa9, 0x1c, 0x65, 0x21, 0xf3, 0x88, 0x0d, 0xaa, 0x8d, 0xe7, 0xd7, 0x45, 0xe9, 0xc5, 0x75,
+	0x51, 0xfa, 0xfb, 0xba, 0x28, 0xfd, 0x74, 0x53, 0x4c, 0xbd, 0xb8, 0x29, 0xa6, 0xfe, 0xbc, 0x29,
+	0xa6, 0x2e, 0x0e, 0x63, 0x94, 0x19, 0x56, 0x70, 0xd8, 0xb4, 0x7a, 0xcc, 0x08, 0x4b, 0x30, 0x7e,
+	0x30, 0x26, 0x7f, 0x7c, 0x82, 0x3d, 0x7b, 0x8a, 0xf8, 0x91, 0xbb, 0xff, 0xef, 0x00, 0xac, 0xed,
+	0x60, 0xa7, 0x51, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -869,11 +871,16 @@ func (m *UserRedemptionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	if m.Amount != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x20
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.Receiver) > 0 {
 		i -= len(m.Receiver)
 		copy(dAtA[i:], m.Receiver)
@@ -918,6 +925,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HistoryRetentionBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.HistoryRetentionBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1046,11 +1058,16 @@ func (m *DepositRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Amount != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x10
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	if m.Id != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
 		i--
@@ -1112,16 +1129,26 @@ func (m *HostZoneUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.NativeTokenAmount != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.NativeTokenAmount))
-		i--
-		dAtA[i] = 0x10
+	{
+		size := m.NativeTokenAmount.Size()
+		i -= size
+		if _, err := m.NativeTokenAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.StTokenAmount != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.StTokenAmount))
-		i--
-		dAtA[i] = 0x8
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.StTokenAmount.Size()
+		i -= size
+		if _, err := m.StTokenAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -1187,6 +1214,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RecordStatusTransitionCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RecordStatusTransitionCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.RecordStatusTransitionList) > 0 {
+		for iNdEx := len(m.RecordStatusTransitionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordStatusTransitionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.DepositRecordCount != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.DepositRecordCount))
 		i--
@@ -1288,9 +1334,8 @@ func (m *UserRedemptionRecord) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	if m.Amount != 0 {
-		n += 1 + sovGenesis(uint64(m.Amount))
-	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
@@ -1314,6 +1359,9 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.HistoryRetentionBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.HistoryRetentionBlocks))
+	}
 	return n
 }
 
@@ -1359,9 +1407,8 @@ func (m *DepositRecord) Size() (n int) {
 	if m.Id != 0 {
 		n += 1 + sovGenesis(uint64(m.Id))
 	}
-	if m.Amount != 0 {
-		n += 1 + sovGenesis(uint64(m.Amount))
-	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
@@ -1388,12 +1435,10 @@ func (m *HostZoneUnbonding) Size() (n int) {
 	}
 	var l int
 	_ = l
-	if m.StTokenAmount != 0 {
-		n += 1 + sovGenesis(uint64(m.StTokenAmount))
-	}
-	if m.NativeTokenAmount != 0 {
-		n += 1 + sovGenesis(uint64(m.NativeTokenAmount))
-	}
+	l = m.StTokenAmount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.NativeTokenAmount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
@@ -1471,6 +1516,15 @@ func (m *GenesisState) Size() (n int) {
 	if m.DepositRecordCount != 0 {
 		n += 1 + sovGenesis(uint64(m.DepositRecordCount))
 	}
+	if len(m.RecordStatusTransitionList) > 0 {
+		for _, e := range m.RecordStatusTransitionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.RecordStatusTransitionCount != 0 {
+		n += 1 + sovGenesis(uint64(m.RecordStatusTransitionCount))
+	}
 	return n
 }
 
@@ -1606,10 +1660,10 @@ func (m *UserRedemptionRecord) Unmarshal(dAtA []byte) error {
 			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1619,11 +1673,26 @@ func (m *UserRedemptionRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
@@ -1777,6 +1846,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryRetentionBlocks", wireType)
+			}
+			m.HistoryRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1982,10 +2070,10 @@ func (m *DepositRecord) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1995,11 +2083,26 @@ func (m *DepositRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
@@ -2172,10 +2275,10 @@ func (m *HostZoneUnbonding) Unmarshal(dAtA []byte) error {
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StTokenAmount", wireType)
 			}
-			m.StTokenAmount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2185,16 +2288,31 @@ func (m *HostZoneUnbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StTokenAmount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StTokenAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field NativeTokenAmount", wireType)
 			}
-			m.NativeTokenAmount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2204,11 +2322,26 @@ func (m *HostZoneUnbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NativeTokenAmount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NativeTokenAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
@@ -2701,6 +2834,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordStatusTransitionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordStatusTransitionList = append(m.RecordStatusTransitionList, RecordStatusTransition{})
+			if err := m.RecordStatusTransitionList[len(m.RecordStatusTransitionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordStatusTransitionCount", wireType)
+			}
+			m.RecordStatusTransitionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordStatusTransitionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
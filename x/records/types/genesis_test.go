@@ -31,6 +31,15 @@ func TestGenesisState_Validate(t *testing.T) {
 					},
 				},
 				DepositRecordCount: 2,
+				RecordStatusTransitionList: []types.RecordStatusTransition{
+					{
+						Id: 0,
+					},
+					{
+						Id: 1,
+					},
+				},
+				RecordStatusTransitionCount: 2,
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -49,6 +58,35 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated recordStatusTransition",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				RecordStatusTransitionList: []types.RecordStatusTransition{
+					{
+						Id: 0,
+					},
+					{
+						Id: 0,
+					},
+				},
+				RecordStatusTransitionCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "recordStatusTransition id above the count",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				RecordStatusTransitionList: []types.RecordStatusTransition{
+					{
+						Id: 2,
+					},
+				},
+				RecordStatusTransitionCount: 2,
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -1,5 +1,10 @@
 package types
 
+import (
+	"encoding/binary"
+	"fmt"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "records"
@@ -33,11 +38,71 @@ func KeyPrefix(p string) []byte {
 const (
 	UserRedemptionRecordKey      = "UserRedemptionRecord-value-"
 	UserRedemptionRecordCountKey = "UserRedemptionRecord-count-"
+
+	// index of user redemption record ids by sender address, kept in sync with the user redemption records
+	UserRedemptionRecordAddressIndexKey = "UserRedemptionRecord-address-"
 )
 
+// UserRedemptionRecordAddressPrefix returns the index prefix of the user redemption records of an address
+func UserRedemptionRecordAddressPrefix(address string) []byte {
+	return append([]byte(address), '/')
+}
+
+// UserRedemptionRecordAddressIndex returns the index key of a user redemption record
+func UserRedemptionRecordAddressIndex(address string, id string) []byte {
+	return append(UserRedemptionRecordAddressPrefix(address), []byte(id)...)
+}
+
 const (
 	EpochUnbondingRecordKey      = "EpochUnbondingRecord-value-"
 	EpochUnbondingRecordCountKey = "EpochUnbondingRecord-count-"
 	DepositRecordKey             = "DepositRecord-value-"
 	DepositRecordCountKey        = "DepositRecord-count-"
+
+	// secondary indexes of deposit record ids, kept in sync with the deposit records
+	DepositRecordHostZoneStatusIndexKey = "DepositRecord-hostzone-status-"
+	DepositRecordEpochHostZoneIndexKey  = "DepositRecord-epoch-hostzone-"
 )
+
+// DepositRecordHostZoneStatusPrefix returns the index prefix of the deposit records of a host zone with a given status
+func DepositRecordHostZoneStatusPrefix(hostZoneId string, status DepositRecord_Status) []byte {
+	statusBz := make([]byte, 4)
+	binary.BigEndian.PutUint32(statusBz, uint32(status))
+
+	key := append([]byte(hostZoneId), '/')
+	key = append(key, statusBz...)
+	return append(key, '/')
+}
+
+// DepositRecordEpochHostZonePrefix returns the index prefix of the deposit records of a host zone in a given deposit epoch
+func DepositRecordEpochHostZonePrefix(epochNumber uint64, hostZoneId string) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, epochNumber)
+
+	key = append(key, []byte(hostZoneId)...)
+	return append(key, '/')
+}
+
+const (
+	RecordStatusTransitionKey      = "RecordStatusTransition-value-"
+	RecordStatusTransitionCountKey = "RecordStatusTransition-count-"
+	// index of record status transition ids by record, kept in sync with the record status transitions
+	RecordStatusTransitionRecordIndexKey = "RecordStatusTransition-record-"
+
+	// the status a record transitions to when it's removed
+	RecordStatusRemoved = "REMOVED"
+)
+
+// RecordStatusTransitionRecordPrefix returns the index prefix of the status transitions of a record
+func RecordStatusTransitionRecordPrefix(recordType RecordStatusTransition_RecordType, recordId string) []byte {
+	key := make([]byte, 4)
+	binary.BigEndian.PutUint32(key, uint32(recordType))
+
+	key = append(key, []byte(recordId)...)
+	return append(key, '/')
+}
+
+// HostZoneUnbondingRecordId returns the id of a host zone unbonding in the record history
+func HostZoneUnbondingRecordId(hostZoneId string, epochNumber uint64) string {
+	return fmt.Sprintf("%s.%d", hostZoneId, epochNumber)
+}
@@ -1,35 +1,56 @@
 package types
 
 import (
+	"fmt"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	// one week of 6s blocks
+	DefaultHistoryRetentionBlocks uint64 = 100800
+
+	// KeyHistoryRetentionBlocks is store's key for the HistoryRetentionBlocks option
+	KeyHistoryRetentionBlocks = []byte("HistoryRetentionBlocks")
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(historyRetentionBlocks uint64) Params {
+	return Params{
+		HistoryRetentionBlocks: historyRetentionBlocks,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultHistoryRetentionBlocks)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyHistoryRetentionBlocks, &p.HistoryRetentionBlocks, validUint64),
+	}
+}
+
+func validUint64(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("parameter not accepted: %T", i)
+	}
+	return nil
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return nil
+	return validUint64(p.HistoryRetentionBlocks)
 }
 
 // String implements the Stringer interface.
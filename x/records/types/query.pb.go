@@ -618,6 +618,313 @@ func (m *QueryAllUserRedemptionRecordForUserResponse) GetPagination() *query.Pag
 	return nil
 }
 
+// Query the UserRedemptionRecords of an address across all host zones
+type QueryUserRedemptionRecordsByAddressRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// only return the records that can be claimed now
+	ClaimableOnly bool               `protobuf:"varint,2,opt,name=claimableOnly,proto3" json:"claimableOnly,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUserRedemptionRecordsByAddressRequest) Reset() {
+	*m = QueryUserRedemptionRecordsByAddressRequest{}
+}
+func (m *QueryUserRedemptionRecordsByAddressRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryUserRedemptionRecordsByAddressRequest) ProtoMessage() {}
+func (*QueryUserRedemptionRecordsByAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f871b183106cf451, []int{12}
+}
+func (m *QueryUserRedemptionRecordsByAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUserRedemptionRecordsByAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUserRedemptionRecordsByAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUserRedemptionRecordsByAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUserRedemptionRecordsByAddressRequest.Merge(m, src)
+}
+func (m *QueryUserRedemptionRecordsByAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUserRedemptionRecordsByAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUserRedemptionRecordsByAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUserRedemptionRecordsByAddressRequest proto.InternalMessageInfo
+
+func (m *QueryUserRedemptionRecordsByAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryUserRedemptionRecordsByAddressRequest) GetClaimableOnly() bool {
+	if m != nil {
+		return m.ClaimableOnly
+	}
+	return false
+}
+
+func (m *QueryUserRedemptionRecordsByAddressRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// A UserRedemptionRecord joined with the HostZoneUnbonding it's part of
+type UserRedemptionRecordWithStatus struct {
+	UserRedemptionRecord UserRedemptionRecord     `protobuf:"bytes,1,opt,name=userRedemptionRecord,proto3" json:"userRedemptionRecord"`
+	UnbondingStatus      HostZoneUnbonding_Status `protobuf:"varint,2,opt,name=unbondingStatus,proto3,enum=Stridelabs.stride.records.HostZoneUnbonding_Status" json:"unbondingStatus,omitempty"`
+	// estimated time (unix nanos) at which the unbonding completes on the host,
+	// 0 until the unbonding has been initiated
+	EstimatedCompletionTime uint64 `protobuf:"varint,3,opt,name=estimatedCompletionTime,proto3" json:"estimatedCompletionTime,omitempty"`
+	// whether the record can be claimed now
+	Claimable bool `protobuf:"varint,4,opt,name=claimable,proto3" json:"claimable,omitempty"`
+}
+
+func (m *UserRedemptionRecordWithStatus) Reset()         { *m = UserRedemptionRecordWithStatus{} }
+func (m *UserRedemptionRecordWithStatus) String() string { return proto.CompactTextString(m) }
+func (*UserRedemptionRecordWithStatus) ProtoMessage()    {}
+func (*UserRedemptionRecordWithStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f871b183106cf451, []int{13}
+}
+func (m *UserRedemptionRecordWithStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserRedemptionRecordWithStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserRedemptionRecordWithStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserRedemptionRecordWithStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserRedemptionRecordWithStatus.Merge(m, src)
+}
+func (m *UserRedemptionRecordWithStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserRedemptionRecordWithStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserRedemptionRecordWithStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserRedemptionRecordWithStatus proto.InternalMessageInfo
+
+func (m *UserRedemptionRecordWithStatus) GetUserRedemptionRecord() UserRedemptionRecord {
+	if m != nil {
+		return m.UserRedemptionRecord
+	}
+	return UserRedemptionRecord{}
+}
+
+func (m *UserRedemptionRecordWithStatus) GetUnbondingStatus() HostZoneUnbonding_Status {
+	if m != nil {
+		return m.UnbondingStatus
+	}
+	return HostZoneUnbonding_UNBONDING_QUEUE
+}
+
+func (m *UserRedemptionRecordWithStatus) GetEstimatedCompletionTime() uint64 {
+	if m != nil {
+		return m.EstimatedCompletionTime
+	}
+	return 0
+}
+
+func (m *UserRedemptionRecordWithStatus) GetClaimable() bool {
+	if m != nil {
+		return m.Claimable
+	}
+	return false
+}
+
+type QueryUserRedemptionRecordsByAddressResponse struct {
+	UserRedemptionRecords []UserRedemptionRecordWithStatus `protobuf:"bytes,1,rep,name=userRedemptionRecords,proto3" json:"userRedemptionRecords"`
+	Pagination            *query.PageResponse              `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUserRedemptionRecordsByAddressResponse) Reset() {
+	*m = QueryUserRedemptionRecordsByAddressResponse{}
+}
+func (m *QueryUserRedemptionRecordsByAddressResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryUserRedemptionRecordsByAddressResponse) ProtoMessage() {}
+func (*QueryUserRedemptionRecordsByAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f871b183106cf451, []int{14}
+}
+func (m *QueryUserRedemptionRecordsByAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUserRedemptionRecordsByAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUserRedemptionRecordsByAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUserRedemptionRecordsByAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUserRedemptionRecordsByAddressResponse.Merge(m, src)
+}
+func (m *QueryUserRedemptionRecordsByAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUserRedemptionRecordsByAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUserRedemptionRecordsByAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUserRedemptionRecordsByAddressResponse proto.InternalMessageInfo
+
+func (m *QueryUserRedemptionRecordsByAddressResponse) GetUserRedemptionRecords() []UserRedemptionRecordWithStatus {
+	if m != nil {
+		return m.UserRedemptionRecords
+	}
+	return nil
+}
+
+func (m *QueryUserRedemptionRecordsByAddressResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryRecordHistoryRequest struct {
+	RecordType RecordStatusTransition_RecordType `protobuf:"varint,1,opt,name=recordType,proto3,enum=Stridelabs.stride.records.RecordStatusTransition_RecordType" json:"recordType,omitempty"`
+	// the deposit record id, or {host_zone_id}.{epoch_number} for host zone unbondings
+	RecordId   string             `protobuf:"bytes,2,opt,name=recordId,proto3" json:"recordId,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRecordHistoryRequest) Reset()         { *m = QueryRecordHistoryRequest{} }
+func (m *QueryRecordHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecordHistoryRequest) ProtoMessage()    {}
+func (*QueryRecordHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f871b183106cf451, []int{15}
+}
+func (m *QueryRecordHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecordHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecordHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecordHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecordHistoryRequest.Merge(m, src)
+}
+func (m *QueryRecordHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecordHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecordHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecordHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryRecordHistoryRequest) GetRecordType() RecordStatusTransition_RecordType {
+	if m != nil {
+		return m.RecordType
+	}
+	return RecordStatusTransition_DEPOSIT_RECORD
+}
+
+func (m *QueryRecordHistoryRequest) GetRecordId() string {
+	if m != nil {
+		return m.RecordId
+	}
+	return ""
+}
+
+func (m *QueryRecordHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryRecordHistoryResponse struct {
+	RecordStatusTransitions []RecordStatusTransition `protobuf:"bytes,1,rep,name=recordStatusTransitions,proto3" json:"recordStatusTransitions"`
+	Pagination              *query.PageResponse      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRecordHistoryResponse) Reset()         { *m = QueryRecordHistoryResponse{} }
+func (m *QueryRecordHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecordHistoryResponse) ProtoMessage()    {}
+func (*QueryRecordHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f871b183106cf451, []int{16}
+}
+func (m *QueryRecordHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecordHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecordHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecordHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecordHistoryResponse.Merge(m, src)
+}
+func (m *QueryRecordHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecordHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecordHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecordHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryRecordHistoryResponse) GetRecordStatusTransitions() []RecordStatusTransition {
+	if m != nil {
+		return m.RecordStatusTransitions
+	}
+	return nil
+}
+
+func (m *QueryRecordHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryGetEpochUnbondingRecordRequest struct {
 	EpochNumber uint64 `protobuf:"varint,1,opt,name=epochNumber,proto3" json:"epochNumber,omitempty"`
 }
@@ -626,7 +933,7 @@ func (m *QueryGetEpochUnbondingRecordRequest) Reset()         { *m = QueryGetEpo
 func (m *QueryGetEpochUnbondingRecordRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetEpochUnbondingRecordRequest) ProtoMessage()    {}
 func (*QueryGetEpochUnbondingRecordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f871b183106cf451, []int{12}
+	return fileDescriptor_f871b183106cf451, []int{17}
 }
 func (m *QueryGetEpochUnbondingRecordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -670,7 +977,7 @@ func (m *QueryGetEpochUnbondingRecordResponse) Reset()         { *m = QueryGetEp
 func (m *QueryGetEpochUnbondingRecordResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetEpochUnbondingRecordResponse) ProtoMessage()    {}
 func (*QueryGetEpochUnbondingRecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f871b183106cf451, []int{13}
+	return fileDescriptor_f871b183106cf451, []int{18}
 }
 func (m *QueryGetEpochUnbondingRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -714,7 +1021,7 @@ func (m *QueryAllEpochUnbondingRecordRequest) Reset()         { *m = QueryAllEpo
 func (m *QueryAllEpochUnbondingRecordRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllEpochUnbondingRecordRequest) ProtoMessage()    {}
 func (*QueryAllEpochUnbondingRecordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f871b183106cf451, []int{14}
+	return fileDescriptor_f871b183106cf451, []int{19}
 }
 func (m *QueryAllEpochUnbondingRecordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -759,7 +1066,7 @@ func (m *QueryAllEpochUnbondingRecordResponse) Reset()         { *m = QueryAllEp
 func (m *QueryAllEpochUnbondingRecordResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllEpochUnbondingRecordResponse) ProtoMessage()    {}
 func (*QueryAllEpochUnbondingRecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f871b183106cf451, []int{15}
+	return fileDescriptor_f871b183106cf451, []int{20}
 }
 func (m *QueryAllEpochUnbondingRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -815,6 +1122,11 @@ func init() {
 	proto.RegisterType((*QueryAllUserRedemptionRecordResponse)(nil), "Stridelabs.stride.records.QueryAllUserRedemptionRecordResponse")
 	proto.RegisterType((*QueryAllUserRedemptionRecordForUserRequest)(nil), "Stridelabs.stride.records.QueryAllUserRedemptionRecordForUserRequest")
 	proto.RegisterType((*QueryAllUserRedemptionRecordForUserResponse)(nil), "Stridelabs.stride.records.QueryAllUserRedemptionRecordForUserResponse")
+	proto.RegisterType((*QueryUserRedemptionRecordsByAddressRequest)(nil), "Stridelabs.stride.records.QueryUserRedemptionRecordsByAddressRequest")
+	proto.RegisterType((*UserRedemptionRecordWithStatus)(nil), "Stridelabs.stride.records.UserRedemptionRecordWithStatus")
+	proto.RegisterType((*QueryUserRedemptionRecordsByAddressResponse)(nil), "Stridelabs.stride.records.QueryUserRedemptionRecordsByAddressResponse")
+	proto.RegisterType((*QueryRecordHistoryRequest)(nil), "Stridelabs.stride.records.QueryRecordHistoryRequest")
+	proto.RegisterType((*QueryRecordHistoryResponse)(nil), "Stridelabs.stride.records.QueryRecordHistoryResponse")
 	proto.RegisterType((*QueryGetEpochUnbondingRecordRequest)(nil), "Stridelabs.stride.records.QueryGetEpochUnbondingRecordRequest")
 	proto.RegisterType((*QueryGetEpochUnbondingRecordResponse)(nil), "Stridelabs.stride.records.QueryGetEpochUnbondingRecordResponse")
 	proto.RegisterType((*QueryAllEpochUnbondingRecordRequest)(nil), "Stridelabs.stride.records.QueryAllEpochUnbondingRecordRequest")
@@ -824,64 +1136,85 @@ func init() {
 func init() { proto.RegisterFile("records/query.proto", fileDescriptor_f871b183106cf451) }
 
 var fileDescriptor_f871b183106cf451 = []byte{
-	// 905 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x97, 0xdf, 0x4f, 0xdb, 0x46,
-	0x1c, 0xc0, 0xe3, 0x24, 0x30, 0xed, 0x10, 0x13, 0x3a, 0x32, 0x2d, 0xcb, 0x58, 0x06, 0x66, 0xda,
-	0x18, 0x1b, 0x3e, 0x91, 0x6d, 0xca, 0xb6, 0x87, 0x31, 0xd0, 0x20, 0x9b, 0x34, 0x4d, 0x2c, 0x2b,
-	0x7d, 0xa8, 0x54, 0x45, 0x76, 0x7c, 0x18, 0x4b, 0x8e, 0xcf, 0xf8, 0x9c, 0xaa, 0x51, 0x94, 0x97,
-	0xfe, 0x05, 0x6d, 0x79, 0xee, 0x1f, 0x52, 0x55, 0xea, 0x33, 0x8f, 0x48, 0x95, 0x2a, 0xd4, 0x87,
-	0xaa, 0x40, 0xff, 0x90, 0xca, 0x77, 0x67, 0x88, 0xe1, 0xe2, 0x98, 0x90, 0x3e, 0xf4, 0xcd, 0xbe,
-	0xfb, 0xfe, 0xfa, 0x7c, 0xbf, 0xdf, 0xbb, 0xaf, 0x0d, 0x66, 0x7d, 0xdc, 0x24, 0xbe, 0x49, 0xd1,
-	0x7e, 0x1b, 0xfb, 0x1d, 0xcd, 0xf3, 0x49, 0x40, 0xe0, 0xe7, 0xff, 0x07, 0xbe, 0x6d, 0x62, 0x47,
-	0x37, 0xa8, 0x46, 0xd9, 0xa3, 0x26, 0xc4, 0x4a, 0x05, 0x8b, 0x58, 0x84, 0x49, 0xa1, 0xf0, 0x89,
-	0x2b, 0x94, 0xe6, 0x2c, 0x42, 0x2c, 0x07, 0x23, 0xdd, 0xb3, 0x91, 0xee, 0xba, 0x24, 0xd0, 0x03,
-	0x9b, 0xb8, 0x54, 0xec, 0x2e, 0x37, 0x09, 0x6d, 0x11, 0x8a, 0x0c, 0x9d, 0x62, 0xee, 0x07, 0xdd,
-	0x5b, 0x35, 0x70, 0xa0, 0xaf, 0x22, 0x4f, 0xb7, 0x6c, 0x97, 0x09, 0x0b, 0xd9, 0x4f, 0xa3, 0x78,
-	0x2c, 0xec, 0x62, 0x6a, 0x0b, 0x13, 0x6a, 0x01, 0xc0, 0xff, 0x42, 0xc5, 0x6d, 0xdd, 0xd7, 0x5b,
-	0xb4, 0x8e, 0xf7, 0xdb, 0x98, 0x06, 0xea, 0x6d, 0x30, 0x1b, 0x5b, 0xa5, 0x1e, 0x71, 0x29, 0x86,
-	0x6b, 0x60, 0xd2, 0x63, 0x2b, 0x45, 0x65, 0x5e, 0x59, 0x9a, 0xaa, 0x2c, 0x68, 0x03, 0x79, 0x34,
-	0xae, 0xba, 0x91, 0x3f, 0x7c, 0xfd, 0x55, 0xa6, 0x2e, 0xd4, 0x54, 0x0d, 0xcc, 0x31, 0xbb, 0x35,
-	0x1c, 0xfc, 0x89, 0x3d, 0x42, 0xed, 0xa0, 0xce, 0xc4, 0x85, 0x5f, 0xf8, 0x09, 0xc8, 0xda, 0x26,
-	0x33, 0x9e, 0xaf, 0x67, 0x6d, 0x53, 0x6d, 0x83, 0x2f, 0x07, 0xc8, 0x8b, 0x88, 0x6e, 0x81, 0xe9,
-	0xd8, 0x86, 0x08, 0x6c, 0x29, 0x21, 0xb0, 0x98, 0xbc, 0x88, 0x2f, 0x6e, 0x44, 0xdd, 0x15, 0x61,
-	0xae, 0x3b, 0x8e, 0x34, 0xcc, 0x2d, 0x00, 0x2e, 0xf2, 0x2b, 0x5c, 0x7e, 0xa3, 0xf1, 0x62, 0x68,
-	0x61, 0x31, 0x34, 0x5e, 0x74, 0x51, 0x0c, 0x6d, 0x5b, 0xb7, 0xb0, 0xd0, 0xad, 0xf7, 0x69, 0xaa,
-	0xcf, 0x15, 0xc1, 0x77, 0xd5, 0xd1, 0x60, 0xbe, 0xdc, 0x8d, 0xf9, 0x60, 0x2d, 0x16, 0x7f, 0x96,
-	0xc5, 0xff, 0xed, 0xd0, 0xf8, 0x79, 0x48, 0x31, 0x80, 0x9f, 0xc1, 0x62, 0x54, 0x9f, 0x1d, 0x8a,
-	0xfd, 0x3a, 0x36, 0x71, 0xcb, 0x0b, 0x77, 0x06, 0x95, 0xf5, 0x63, 0x56, 0xd6, 0x47, 0x0a, 0xf8,
-	0x3a, 0x59, 0x4f, 0xe0, 0xdb, 0xa0, 0x20, 0xdb, 0x17, 0x29, 0x47, 0x09, 0x59, 0x90, 0xa9, 0x89,
-	0x64, 0x48, 0x4d, 0xaa, 0x2d, 0x81, 0xb2, 0xee, 0x38, 0x49, 0x28, 0xe3, 0x2a, 0xfd, 0xab, 0x28,
-	0x05, 0x03, 0xfd, 0x0d, 0x4d, 0x41, 0x6e, 0xcc, 0x29, 0x18, 0x5f, 0x5b, 0x1c, 0x29, 0x60, 0x39,
-	0x09, 0x6e, 0x8b, 0xf8, 0x7c, 0x99, 0xe7, 0xb4, 0x08, 0x3e, 0x6a, 0xee, 0xe9, 0xb6, 0xfb, 0x77,
-	0xd4, 0x23, 0xd1, 0x2b, 0x9c, 0x01, 0x39, 0x53, 0xef, 0xb0, 0x50, 0xf2, 0xf5, 0xf0, 0x31, 0x94,
-	0xd5, 0x4d, 0xd3, 0xc7, 0x94, 0x16, 0x73, 0x5c, 0x56, 0xbc, 0xc2, 0x02, 0x98, 0x70, 0xec, 0x96,
-	0x1d, 0x14, 0xf3, 0x4c, 0x9a, 0xbf, 0x5c, 0xaa, 0xd7, 0xc4, 0xc8, 0xf5, 0x3a, 0x51, 0xc0, 0xf7,
-	0xa9, 0x90, 0x3e, 0xe0, 0xb2, 0xd5, 0x2e, 0x4e, 0xf3, 0xa6, 0x47, 0x9a, 0x7b, 0x3b, 0xae, 0x41,
-	0x5c, 0xd3, 0x76, 0xad, 0xf8, 0x11, 0x98, 0x07, 0x53, 0x38, 0xdc, 0xfe, 0xb7, 0xdd, 0x32, 0xb0,
-	0x2f, 0x6e, 0xeb, 0xfe, 0xa5, 0xd8, 0xf9, 0x96, 0x5b, 0xba, 0xc8, 0x92, 0x6c, 0x3f, 0xc5, 0xf9,
-	0x96, 0xa9, 0x45, 0x59, 0x92, 0xed, 0xf5, 0x9f, 0xef, 0x24, 0xb8, 0xf7, 0x71, 0xbe, 0x47, 0x4c,
-	0x41, 0x6e, 0xcc, 0x29, 0x18, 0x5b, 0xa3, 0x54, 0x9e, 0x4c, 0x83, 0x09, 0x06, 0x07, 0x1f, 0x2b,
-	0x60, 0x92, 0x4f, 0x7a, 0xb8, 0x92, 0x10, 0xea, 0xd5, 0x4f, 0x8c, 0x92, 0x96, 0x56, 0x9c, 0xfb,
-	0x57, 0xbf, 0x7b, 0xf0, 0xe2, 0xed, 0x41, 0x76, 0x11, 0x2e, 0x20, 0xae, 0xf7, 0x8f, 0x6e, 0x50,
-	0xc4, 0xf5, 0x50, 0xf4, 0x69, 0xc3, 0xbf, 0x32, 0xe0, 0xb1, 0x22, 0x3f, 0x7c, 0xf0, 0xf7, 0x61,
-	0x3e, 0x93, 0xe7, 0x58, 0x69, 0x6d, 0x64, 0x7d, 0x01, 0xb1, 0xc6, 0x20, 0x7e, 0x85, 0x55, 0x01,
-	0xb1, 0x22, 0xa3, 0x68, 0x53, 0xec, 0x37, 0xfc, 0x73, 0x13, 0x0d, 0xbe, 0x8e, 0xba, 0xb6, 0xd9,
-	0x83, 0x2f, 0x15, 0xf0, 0x99, 0xcc, 0xc3, 0xba, 0xe3, 0x0c, 0xa7, 0x4b, 0x1e, 0x6d, 0xc3, 0xe9,
-	0x86, 0x8c, 0x2a, 0xf5, 0x37, 0x46, 0xf7, 0x13, 0xac, 0x5c, 0x9f, 0x0e, 0x1e, 0x64, 0xc1, 0x17,
-	0x09, 0xf7, 0x2a, 0xdc, 0x1c, 0x31, 0xb8, 0xf8, 0xa8, 0x29, 0x6d, 0xdd, 0xd4, 0x8c, 0x40, 0xc5,
-	0x0c, 0xb5, 0x01, 0xef, 0x5e, 0x1f, 0xb5, 0xb1, 0x4b, 0xfc, 0x46, 0xb8, 0x85, 0xba, 0x62, 0xcc,
-	0xf5, 0x50, 0xd7, 0xd4, 0x3b, 0x3d, 0xd4, 0x15, 0xa3, 0xac, 0x87, 0xba, 0x6c, 0x78, 0xf5, 0xe0,
-	0x89, 0x22, 0xbf, 0x1d, 0x52, 0x75, 0x72, 0xc2, 0x35, 0x97, 0xaa, 0x93, 0x93, 0xae, 0x2d, 0xf5,
-	0x2f, 0x96, 0x80, 0x0d, 0xf8, 0x47, 0x52, 0x02, 0xd8, 0x4c, 0x68, 0xb4, 0x23, 0x13, 0xe7, 0x9d,
-	0xdc, 0x37, 0x2b, 0x78, 0x4b, 0xcb, 0x5c, 0xa5, 0x6d, 0xe9, 0x1b, 0x61, 0x0e, 0xb9, 0x9d, 0xd3,
-	0xb5, 0xb4, 0x1c, 0x13, 0x3e, 0x53, 0x2e, 0x7d, 0xbc, 0xc3, 0x6a, 0x8a, 0xac, 0xcb, 0x7e, 0x38,
-	0x4a, 0xbf, 0x5c, 0x5f, 0x51, 0x00, 0x54, 0x19, 0xc0, 0x2a, 0x44, 0x49, 0x00, 0x26, 0x57, 0x8d,
-	0xdd, 0x34, 0x4f, 0x15, 0x30, 0x13, 0x33, 0x19, 0xd6, 0xa3, 0x9a, 0x22, 0x9f, 0xa3, 0x01, 0x0c,
-	0xfa, 0x03, 0x52, 0x2b, 0x0c, 0xe0, 0x07, 0xb8, 0x9c, 0x1e, 0x60, 0xa3, 0x76, 0x78, 0x5a, 0x56,
-	0x8e, 0x4e, 0xcb, 0xca, 0x9b, 0xd3, 0xb2, 0xf2, 0xf0, 0xac, 0x9c, 0x39, 0x3a, 0x2b, 0x67, 0x8e,
-	0xcf, 0xca, 0x99, 0x3b, 0x2b, 0x96, 0x1d, 0xec, 0xb5, 0x0d, 0xad, 0x49, 0x5a, 0x32, 0x7b, 0xf7,
-	0xcf, 0x2d, 0x06, 0x1d, 0x0f, 0x53, 0x63, 0x92, 0xfd, 0x24, 0xff, 0xf8, 0x2e, 0x00, 0x00, 0xff,
-	0xff, 0xbd, 0xfc, 0xa3, 0xbb, 0xcd, 0x0f, 0x00, 0x00,
+	// 1242 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xce, 0xda, 0x49, 0x48, 0xa6, 0x4a, 0x88, 0xa6, 0xa9, 0x92, 0x2e, 0xa9, 0x69, 0xdd, 0x0a,
+	0x4a, 0x20, 0xbb, 0x8a, 0xdb, 0x2a, 0x2d, 0x42, 0x84, 0x84, 0x36, 0x1f, 0x15, 0x82, 0xb0, 0x4d,
+	0x41, 0xaa, 0xa8, 0xac, 0xb1, 0x77, 0x6a, 0x8f, 0xb4, 0xbb, 0xb3, 0xd9, 0x59, 0x23, 0x2c, 0xcb,
+	0x07, 0xf8, 0x05, 0x40, 0xff, 0x0a, 0x17, 0x84, 0xc4, 0x81, 0x53, 0x8e, 0x91, 0x90, 0x50, 0x84,
+	0x04, 0xa2, 0x09, 0x47, 0xf8, 0x0f, 0x68, 0x67, 0x66, 0x6d, 0x6f, 0x18, 0xaf, 0x3f, 0x62, 0x0e,
+	0x9c, 0xec, 0xf9, 0x78, 0x3f, 0x9e, 0xe7, 0x99, 0x7d, 0xe7, 0xdd, 0x05, 0x17, 0x03, 0x5c, 0xa6,
+	0x81, 0xcd, 0xcc, 0x83, 0x1a, 0x0e, 0xea, 0x86, 0x1f, 0xd0, 0x90, 0xc2, 0xcb, 0x8f, 0xc2, 0x80,
+	0xd8, 0xd8, 0x41, 0x25, 0x66, 0x30, 0xfe, 0xd7, 0x90, 0xdb, 0xf4, 0xf9, 0x0a, 0xad, 0x50, 0xbe,
+	0xcb, 0x8c, 0xfe, 0x09, 0x03, 0x7d, 0xa9, 0x42, 0x69, 0xc5, 0xc1, 0x26, 0xf2, 0x89, 0x89, 0x3c,
+	0x8f, 0x86, 0x28, 0x24, 0xd4, 0x63, 0x72, 0x75, 0xb9, 0x4c, 0x99, 0x4b, 0x99, 0x59, 0x42, 0x0c,
+	0x8b, 0x38, 0xe6, 0xe7, 0xab, 0x25, 0x1c, 0xa2, 0x55, 0xd3, 0x47, 0x15, 0xe2, 0xf1, 0xcd, 0x72,
+	0xef, 0xa5, 0x38, 0x9f, 0x0a, 0xf6, 0x30, 0x23, 0xb1, 0x8b, 0xa5, 0x78, 0x5a, 0xfc, 0x16, 0xab,
+	0x84, 0x85, 0x34, 0xce, 0x37, 0x3f, 0x0f, 0xe0, 0xc7, 0x91, 0xdb, 0x3d, 0x14, 0x20, 0x97, 0x59,
+	0xf8, 0xa0, 0x86, 0x59, 0x98, 0xff, 0x04, 0x5c, 0x4c, 0xcc, 0x32, 0x9f, 0x7a, 0x0c, 0xc3, 0x75,
+	0x30, 0xe9, 0xf3, 0x99, 0x45, 0xed, 0xaa, 0x76, 0xf3, 0x42, 0xe1, 0x9a, 0xd1, 0x15, 0xad, 0x21,
+	0x4c, 0x37, 0xc7, 0x0f, 0x7f, 0x7f, 0x75, 0xcc, 0x92, 0x66, 0x79, 0x03, 0x2c, 0x71, 0xbf, 0xdb,
+	0x38, 0xbc, 0x8f, 0x7d, 0xca, 0x48, 0x68, 0xf1, 0xed, 0x32, 0x2e, 0x9c, 0x05, 0x19, 0x62, 0x73,
+	0xe7, 0xe3, 0x56, 0x86, 0xd8, 0xf9, 0x1a, 0xb8, 0xd2, 0x65, 0xbf, 0xcc, 0x68, 0x1f, 0xcc, 0x24,
+	0x16, 0x64, 0x62, 0x37, 0x53, 0x12, 0x4b, 0xec, 0x97, 0xf9, 0x25, 0x9d, 0xe4, 0x9f, 0xc9, 0x34,
+	0x37, 0x1c, 0x47, 0x99, 0xe6, 0x16, 0x00, 0x6d, 0xf6, 0x65, 0xc8, 0xd7, 0x0c, 0x21, 0x95, 0x11,
+	0x49, 0x65, 0x88, 0x23, 0x21, 0xa5, 0x32, 0xf6, 0x50, 0x05, 0x4b, 0x5b, 0xab, 0xc3, 0x32, 0xff,
+	0xa3, 0x06, 0xae, 0x74, 0x09, 0xd4, 0x1d, 0x5f, 0xf6, 0xdc, 0xf8, 0xe0, 0x76, 0x22, 0xff, 0x0c,
+	0xcf, 0xff, 0xf5, 0x9e, 0xf9, 0x8b, 0x94, 0x12, 0x00, 0xee, 0x80, 0xeb, 0xb1, 0x3e, 0x8f, 0x19,
+	0x0e, 0x2c, 0x6c, 0x63, 0xd7, 0x8f, 0x56, 0xba, 0xc9, 0x3a, 0xcd, 0x65, 0xfd, 0x46, 0x03, 0x37,
+	0xd2, 0xed, 0x24, 0x7c, 0x02, 0xe6, 0x55, 0xeb, 0x92, 0x72, 0x33, 0x85, 0x05, 0x95, 0x99, 0x24,
+	0x43, 0xe9, 0x32, 0xef, 0x4a, 0x28, 0x1b, 0x8e, 0x93, 0x06, 0x65, 0x54, 0xd2, 0xff, 0x1a, 0x53,
+	0xd0, 0x35, 0x5e, 0x4f, 0x0a, 0xb2, 0x23, 0xa6, 0x60, 0x74, 0xc7, 0xe2, 0x48, 0x03, 0xcb, 0x69,
+	0xe0, 0xb6, 0x68, 0x20, 0xa6, 0x05, 0xa7, 0x8b, 0xe0, 0xa5, 0x72, 0x15, 0x11, 0x6f, 0x37, 0x3e,
+	0x23, 0xf1, 0x10, 0xce, 0x81, 0xac, 0x8d, 0xea, 0x3c, 0x95, 0x71, 0x2b, 0xfa, 0x1b, 0xed, 0x45,
+	0xb6, 0x1d, 0x60, 0xc6, 0x16, 0xb3, 0x62, 0xaf, 0x1c, 0xc2, 0x79, 0x30, 0xe1, 0x10, 0x97, 0x84,
+	0x8b, 0xe3, 0x7c, 0xb7, 0x18, 0x9c, 0xd1, 0x6b, 0x62, 0x68, 0xbd, 0x5e, 0x68, 0xe0, 0xcd, 0xbe,
+	0x20, 0xfd, 0x8f, 0x65, 0xfb, 0x2e, 0x96, 0x4d, 0x15, 0x86, 0x6d, 0xd6, 0x37, 0x04, 0xd3, 0x1d,
+	0xb2, 0xc5, 0x52, 0x68, 0x49, 0x29, 0x6e, 0x80, 0x99, 0xb2, 0x83, 0x88, 0x8b, 0x4a, 0x0e, 0xfe,
+	0xc8, 0x73, 0x84, 0x80, 0x53, 0x56, 0x72, 0xf2, 0x8c, 0x34, 0xd9, 0xa1, 0xa5, 0xf9, 0x29, 0x03,
+	0x72, 0xaa, 0x8c, 0x3f, 0x25, 0x61, 0xf5, 0x51, 0x88, 0xc2, 0x1a, 0x8b, 0xd4, 0xa8, 0x8d, 0xbe,
+	0x8e, 0xa8, 0x5c, 0xc2, 0xa7, 0xe0, 0xe5, 0x9a, 0x57, 0xa2, 0x9e, 0x4d, 0xbc, 0x8a, 0x88, 0xce,
+	0xd1, 0xcf, 0x16, 0x6e, 0xa5, 0x44, 0xd9, 0xa1, 0x2c, 0x7c, 0x42, 0x3d, 0xfc, 0x38, 0xb6, 0x34,
+	0x84, 0xa9, 0x75, 0xd6, 0x17, 0xbc, 0x0b, 0x16, 0x30, 0x0b, 0x89, 0x8b, 0x42, 0x6c, 0xbf, 0x4f,
+	0x5d, 0xdf, 0xc1, 0x51, 0xec, 0x7d, 0xe2, 0x62, 0xce, 0xe0, 0xb8, 0xd5, 0x6d, 0x19, 0x2e, 0x81,
+	0xe9, 0x16, 0xff, 0xfc, 0x19, 0x99, 0xb2, 0xda, 0x13, 0xf9, 0xbf, 0xe3, 0xf3, 0xdd, 0x4b, 0x7b,
+	0x79, 0xbe, 0x6b, 0xe0, 0x92, 0x0a, 0x3e, 0x93, 0x07, 0xfc, 0xde, 0x80, 0x94, 0xb6, 0xb5, 0x92,
+	0xe4, 0xaa, 0xbd, 0x8f, 0xee, 0xac, 0xff, 0xa6, 0x81, 0xcb, 0x1c, 0xaf, 0xf0, 0xbc, 0x23, 0x9a,
+	0xa2, 0xf8, 0x68, 0x7f, 0x06, 0x80, 0xc8, 0x76, 0xbf, 0xee, 0x63, 0x7e, 0x4a, 0x66, 0x0b, 0xef,
+	0xa4, 0x40, 0x12, 0x4e, 0x04, 0x80, 0xfd, 0x00, 0x79, 0x8c, 0x44, 0x41, 0x0c, 0xab, 0xe5, 0xc3,
+	0xea, 0xf0, 0x07, 0x75, 0x30, 0x25, 0x46, 0xbb, 0x36, 0x87, 0x30, 0x6d, 0xb5, 0xc6, 0x23, 0x7b,
+	0x28, 0x8e, 0x35, 0xa0, 0xab, 0xf0, 0x49, 0xf9, 0x0e, 0xc0, 0x42, 0xa0, 0xcc, 0x39, 0x16, 0x70,
+	0x75, 0x60, 0xb4, 0x52, 0xb8, 0x6e, 0x7e, 0x47, 0x27, 0xdd, 0x76, 0xbb, 0xe9, 0x78, 0xe0, 0xd3,
+	0x72, 0xb5, 0xf5, 0xd0, 0x24, 0x6f, 0xea, 0xab, 0xe0, 0x02, 0x8e, 0x96, 0x3f, 0xac, 0xb9, 0x25,
+	0x1c, 0xc8, 0xa6, 0xb2, 0x73, 0x2a, 0xd1, 0x86, 0xa8, 0x3d, 0xb5, 0x8b, 0xb9, 0x6a, 0xbd, 0x8f,
+	0xf2, 0xa1, 0x32, 0x8b, 0xcb, 0x87, 0x6a, 0xad, 0xb3, 0x0d, 0x49, 0x03, 0xf7, 0x5f, 0xb4, 0x21,
+	0x43, 0x52, 0x90, 0x1d, 0x31, 0x05, 0x23, 0x3b, 0x28, 0x85, 0xbf, 0xe6, 0xc0, 0x04, 0x07, 0x07,
+	0xbf, 0xd5, 0xc0, 0xa4, 0x78, 0x21, 0x81, 0x2b, 0x29, 0xa9, 0xfe, 0xfb, 0x4d, 0x48, 0x37, 0xfa,
+	0xdd, 0x2e, 0xe2, 0xe7, 0xdf, 0xf8, 0xea, 0xe7, 0x3f, 0x9f, 0x67, 0xae, 0xc3, 0x6b, 0xa6, 0xb0,
+	0xfb, 0x00, 0x95, 0x98, 0x29, 0xec, 0xe4, 0x0b, 0x18, 0x33, 0xc5, 0xcb, 0x10, 0x3c, 0xd6, 0xd4,
+	0x3d, 0x02, 0x7c, 0xb7, 0x57, 0xcc, 0xf4, 0x76, 0x5b, 0x5f, 0x1f, 0xda, 0x5e, 0x82, 0x58, 0xe7,
+	0x20, 0xee, 0xc1, 0x35, 0x09, 0x62, 0x45, 0x85, 0x22, 0x2a, 0xd0, 0xc5, 0xa0, 0xe5, 0xa2, 0x28,
+	0xe6, 0xcd, 0x06, 0xb1, 0x9b, 0xf0, 0x17, 0x0d, 0x2c, 0xa8, 0x22, 0x6c, 0x38, 0x4e, 0x6f, 0x74,
+	0xe9, 0x1d, 0xb8, 0xbe, 0x3e, 0xb4, 0xbd, 0x44, 0xf7, 0x36, 0x47, 0x77, 0x1b, 0x16, 0x06, 0x47,
+	0x07, 0x9f, 0x67, 0xc0, 0x2b, 0x29, 0xed, 0x1f, 0x7c, 0x30, 0x64, 0x72, 0xc9, 0x8e, 0x58, 0xdf,
+	0x3a, 0xaf, 0x1b, 0x09, 0x15, 0x73, 0xa8, 0x45, 0xf8, 0x74, 0x70, 0xa8, 0xc5, 0x67, 0x34, 0x28,
+	0x46, 0x4b, 0x66, 0x43, 0x76, 0xe3, 0x4d, 0xb3, 0x61, 0xa3, 0x7a, 0xd3, 0x6c, 0xc8, 0x36, 0xaf,
+	0x69, 0x36, 0x78, 0x8f, 0xdd, 0x84, 0x5f, 0x76, 0xe9, 0xc0, 0xda, 0x7d, 0x43, 0x6f, 0x62, 0xfa,
+	0xea, 0x39, 0xf5, 0xad, 0xf3, 0xba, 0x91, 0xc4, 0xec, 0x71, 0x62, 0x1e, 0xc2, 0x9d, 0xc1, 0x89,
+	0x61, 0xc5, 0x52, 0xbd, 0x28, 0x29, 0x68, 0x73, 0x01, 0x0f, 0x35, 0x30, 0x93, 0xb8, 0x6b, 0xe1,
+	0xed, 0x5e, 0xb9, 0xaa, 0x5a, 0x0f, 0xfd, 0xce, 0x80, 0x56, 0x12, 0xd0, 0x43, 0x0e, 0xe8, 0x3e,
+	0xdc, 0x4c, 0x03, 0x94, 0xfc, 0x02, 0x64, 0x36, 0xda, 0x3d, 0x49, 0x33, 0x1e, 0xec, 0xda, 0x4d,
+	0xf8, 0x42, 0x53, 0x17, 0xfb, 0xbe, 0x0a, 0x53, 0xca, 0xad, 0xa5, 0xaf, 0x0f, 0x6d, 0x2f, 0x51,
+	0xee, 0x70, 0x94, 0x9b, 0xf0, 0xbd, 0x34, 0x94, 0xfc, 0x8a, 0x2f, 0xb6, 0x1a, 0xe7, 0x56, 0x61,
+	0xea, 0xb8, 0xfa, 0x45, 0x85, 0x52, 0x85, 0xea, 0xb7, 0x42, 0x9d, 0x0b, 0x66, 0x8f, 0xcb, 0xb6,
+	0xbf, 0x0a, 0xa5, 0x86, 0x09, 0x7f, 0xd0, 0xce, 0x7c, 0x32, 0x82, 0x6b, 0x7d, 0xb0, 0xae, 0xfa,
+	0xcc, 0xa5, 0xdf, 0x1d, 0xdc, 0x50, 0x02, 0x58, 0xe3, 0x00, 0x56, 0xa1, 0x99, 0x06, 0xc0, 0x16,
+	0xa6, 0x89, 0x8b, 0xe3, 0x7b, 0x0d, 0xcc, 0x25, 0x5c, 0x46, 0x7a, 0xac, 0xf5, 0xc1, 0xe7, 0x70,
+	0x00, 0xba, 0x7d, 0x77, 0xcb, 0x17, 0x38, 0x80, 0xb7, 0xe0, 0x72, 0xff, 0x00, 0x36, 0xb7, 0x0f,
+	0x4f, 0x72, 0xda, 0xd1, 0x49, 0x4e, 0xfb, 0xe3, 0x24, 0xa7, 0x7d, 0x7d, 0x9a, 0x1b, 0x3b, 0x3a,
+	0xcd, 0x8d, 0x1d, 0x9f, 0xe6, 0xc6, 0x9e, 0xac, 0x54, 0x48, 0x58, 0xad, 0x95, 0x8c, 0x32, 0x75,
+	0x55, 0xfe, 0xbe, 0x68, 0x79, 0x0c, 0xeb, 0x3e, 0x66, 0xa5, 0x49, 0xfe, 0x69, 0xf6, 0xd6, 0x3f,
+	0x03, 0x00, 0x18, 0x36, 0x4d, 0x8d, 0x61, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -904,6 +1237,10 @@ type QueryClient interface {
 	UserRedemptionRecordAll(ctx context.Context, in *QueryAllUserRedemptionRecordRequest, opts ...grpc.CallOption) (*QueryAllUserRedemptionRecordResponse, error)
 	// Queries a list of UserRedemptionRecord items by chainId / userId pair.
 	UserRedemptionRecordForUser(ctx context.Context, in *QueryAllUserRedemptionRecordForUserRequest, opts ...grpc.CallOption) (*QueryAllUserRedemptionRecordForUserResponse, error)
+	// Queries the UserRedemptionRecords of an address across all host zones, along with the status of their unbonding
+	UserRedemptionRecordsByAddress(ctx context.Context, in *QueryUserRedemptionRecordsByAddressRequest, opts ...grpc.CallOption) (*QueryUserRedemptionRecordsByAddressResponse, error)
+	// Queries the status transitions of a deposit record or host zone unbonding
+	RecordHistory(ctx context.Context, in *QueryRecordHistoryRequest, opts ...grpc.CallOption) (*QueryRecordHistoryResponse, error)
 	// Queries a EpochUnbondingRecord by id.
 	EpochUnbondingRecord(ctx context.Context, in *QueryGetEpochUnbondingRecordRequest, opts ...grpc.CallOption) (*QueryGetEpochUnbondingRecordResponse, error)
 	// Queries a list of EpochUnbondingRecord items.
@@ -958,6 +1295,24 @@ func (c *queryClient) UserRedemptionRecordForUser(ctx context.Context, in *Query
 	return out, nil
 }
 
+func (c *queryClient) UserRedemptionRecordsByAddress(ctx context.Context, in *QueryUserRedemptionRecordsByAddressRequest, opts ...grpc.CallOption) (*QueryUserRedemptionRecordsByAddressResponse, error) {
+	out := new(QueryUserRedemptionRecordsByAddressResponse)
+	err := c.cc.Invoke(ctx, "/Stridelabs.stride.records.Query/UserRedemptionRecordsByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecordHistory(ctx context.Context, in *QueryRecordHistoryRequest, opts ...grpc.CallOption) (*QueryRecordHistoryResponse, error) {
+	out := new(QueryRecordHistoryResponse)
+	err := c.cc.Invoke(ctx, "/Stridelabs.stride.records.Query/RecordHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) EpochUnbondingRecord(ctx context.Context, in *QueryGetEpochUnbondingRecordRequest, opts ...grpc.CallOption) (*QueryGetEpochUnbondingRecordResponse, error) {
 	out := new(QueryGetEpochUnbondingRecordResponse)
 	err := c.cc.Invoke(ctx, "/Stridelabs.stride.records.Query/EpochUnbondingRecord", in, out, opts...)
@@ -1004,6 +1359,10 @@ type QueryServer interface {
 	UserRedemptionRecordAll(context.Context, *QueryAllUserRedemptionRecordRequest) (*QueryAllUserRedemptionRecordResponse, error)
 	// Queries a list of UserRedemptionRecord items by chainId / userId pair.
 	UserRedemptionRecordForUser(context.Context, *QueryAllUserRedemptionRecordForUserRequest) (*QueryAllUserRedemptionRecordForUserResponse, error)
+	// Queries the UserRedemptionRecords of an address across all host zones, along with the status of their unbonding
+	UserRedemptionRecordsByAddress(context.Context, *QueryUserRedemptionRecordsByAddressRequest) (*QueryUserRedemptionRecordsByAddressResponse, error)
+	// Queries the status transitions of a deposit record or host zone unbonding
+	RecordHistory(context.Context, *QueryRecordHistoryRequest) (*QueryRecordHistoryResponse, error)
 	// Queries a EpochUnbondingRecord by id.
 	EpochUnbondingRecord(context.Context, *QueryGetEpochUnbondingRecordRequest) (*QueryGetEpochUnbondingRecordResponse, error)
 	// Queries a list of EpochUnbondingRecord items.
@@ -1030,6 +1389,12 @@ func (*UnimplementedQueryServer) UserRedemptionRecordAll(ctx context.Context, re
 func (*UnimplementedQueryServer) UserRedemptionRecordForUser(ctx context.Context, req *QueryAllUserRedemptionRecordForUserRequest) (*QueryAllUserRedemptionRecordForUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserRedemptionRecordForUser not implemented")
 }
+func (*UnimplementedQueryServer) UserRedemptionRecordsByAddress(ctx context.Context, req *QueryUserRedemptionRecordsByAddressRequest) (*QueryUserRedemptionRecordsByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserRedemptionRecordsByAddress not implemented")
+}
+func (*UnimplementedQueryServer) RecordHistory(ctx context.Context, req *QueryRecordHistoryRequest) (*QueryRecordHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordHistory not implemented")
+}
 func (*UnimplementedQueryServer) EpochUnbondingRecord(ctx context.Context, req *QueryGetEpochUnbondingRecordRequest) (*QueryGetEpochUnbondingRecordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EpochUnbondingRecord not implemented")
 }
@@ -1119,6 +1484,42 @@ func _Query_UserRedemptionRecordForUser_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UserRedemptionRecordsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserRedemptionRecordsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserRedemptionRecordsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Stridelabs.stride.records.Query/UserRedemptionRecordsByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserRedemptionRecordsByAddress(ctx, req.(*QueryUserRedemptionRecordsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecordHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecordHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecordHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Stridelabs.stride.records.Query/RecordHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordHistory(ctx, req.(*QueryRecordHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_EpochUnbondingRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetEpochUnbondingRecordRequest)
 	if err := dec(in); err != nil {
@@ -1211,6 +1612,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UserRedemptionRecordForUser",
 			Handler:    _Query_UserRedemptionRecordForUser_Handler,
 		},
+		{
+			MethodName: "UserRedemptionRecordsByAddress",
+			Handler:    _Query_UserRedemptionRecordsByAddress_Handler,
+		},
+		{
+			MethodName: "RecordHistory",
+			Handler:    _Query_RecordHistory_Handler,
+		},
 		{
 			MethodName: "EpochUnbondingRecord",
 			Handler:    _Query_EpochUnbondingRecord_Handler,
@@ -1688,7 +2097,7 @@ func (m *QueryAllUserRedemptionRecordForUserResponse) MarshalToSizedBuffer(dAtA
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetEpochUnbondingRecordRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryUserRedemptionRecordsByAddressRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1698,26 +2107,276 @@ func (m *QueryGetEpochUnbondingRecordRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetEpochUnbondingRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryUserRedemptionRecordsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetEpochUnbondingRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryUserRedemptionRecordsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.EpochNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryGetEpochUnbondingRecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+	if m.ClaimableOnly {
+		i--
+		if m.ClaimableOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserRedemptionRecordWithStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserRedemptionRecordWithStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserRedemptionRecordWithStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Claimable {
+		i--
+		if m.Claimable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EstimatedCompletionTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EstimatedCompletionTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UnbondingStatus != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingStatus))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.UserRedemptionRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserRedemptionRecordsByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserRedemptionRecordsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserRedemptionRecordsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UserRedemptionRecords) > 0 {
+		for iNdEx := len(m.UserRedemptionRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserRedemptionRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecordHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecordHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RecordId) > 0 {
+		i -= len(m.RecordId)
+		copy(dAtA[i:], m.RecordId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RecordType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RecordType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecordHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecordHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordStatusTransitions) > 0 {
+		for iNdEx := len(m.RecordStatusTransitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordStatusTransitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetEpochUnbondingRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetEpochUnbondingRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetEpochUnbondingRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetEpochUnbondingRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
@@ -2021,35 +2680,58 @@ func (m *QueryAllUserRedemptionRecordForUserResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryGetEpochUnbondingRecordRequest) Size() (n int) {
+func (m *QueryUserRedemptionRecordsByAddressRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.EpochNumber != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNumber))
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ClaimableOnly {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryGetEpochUnbondingRecordResponse) Size() (n int) {
+func (m *UserRedemptionRecordWithStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.EpochUnbondingRecord.Size()
+	l = m.UserRedemptionRecord.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if m.UnbondingStatus != 0 {
+		n += 1 + sovQuery(uint64(m.UnbondingStatus))
+	}
+	if m.EstimatedCompletionTime != 0 {
+		n += 1 + sovQuery(uint64(m.EstimatedCompletionTime))
+	}
+	if m.Claimable {
+		n += 2
+	}
 	return n
 }
 
-func (m *QueryAllEpochUnbondingRecordRequest) Size() (n int) {
+func (m *QueryUserRedemptionRecordsByAddressResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if len(m.UserRedemptionRecords) > 0 {
+		for _, e := range m.UserRedemptionRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
@@ -2057,14 +2739,34 @@ func (m *QueryAllEpochUnbondingRecordRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryAllEpochUnbondingRecordResponse) Size() (n int) {
+func (m *QueryRecordHistoryRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.EpochUnbondingRecord) > 0 {
-		for _, e := range m.EpochUnbondingRecord {
+	if m.RecordType != 0 {
+		n += 1 + sovQuery(uint64(m.RecordType))
+	}
+	l = len(m.RecordId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecordHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecordStatusTransitions) > 0 {
+		for _, e := range m.RecordStatusTransitions {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -2076,12 +2778,67 @@ func (m *QueryAllEpochUnbondingRecordResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
+func (m *QueryGetEpochUnbondingRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *QueryGetEpochUnbondingRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EpochUnbondingRecord.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllEpochUnbondingRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllEpochUnbondingRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EpochUnbondingRecord) > 0 {
+		for _, e := range m.EpochUnbondingRecord {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2380,23 +3137,702 @@ func (m *QueryAllDepositRecordRequest) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDepositRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDepositRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDepositRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDepositRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDepositRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositRecord = append(m.DepositRecord, DepositRecord{})
+			if err := m.DepositRecord[len(m.DepositRecord)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetUserRedemptionRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetUserRedemptionRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetUserRedemptionRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetUserRedemptionRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetUserRedemptionRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetUserRedemptionRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserRedemptionRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UserRedemptionRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllUserRedemptionRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllUserRedemptionRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllUserRedemptionRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllUserRedemptionRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllUserRedemptionRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllUserRedemptionRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserRedemptionRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserRedemptionRecord = append(m.UserRedemptionRecord, UserRedemptionRecord{})
+			if err := m.UserRedemptionRecord[len(m.UserRedemptionRecord)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllUserRedemptionRecordForUserRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllUserRedemptionRecordForUserRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllUserRedemptionRecordForUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+			}
+			m.Day = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Day |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllDepositRecordRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllDepositRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2453,7 +3889,7 @@ func (m *QueryAllDepositRecordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllDepositRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllUserRedemptionRecordForUserResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2476,15 +3912,15 @@ func (m *QueryAllDepositRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllDepositRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllUserRedemptionRecordForUserResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllDepositRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllUserRedemptionRecordForUserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositRecord", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserRedemptionRecord", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2511,8 +3947,8 @@ func (m *QueryAllDepositRecordResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DepositRecord = append(m.DepositRecord, DepositRecord{})
-			if err := m.DepositRecord[len(m.DepositRecord)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UserRedemptionRecord = append(m.UserRedemptionRecord, UserRedemptionRecord{})
+			if err := m.UserRedemptionRecord[len(m.UserRedemptionRecord)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2573,7 +4009,7 @@ func (m *QueryAllDepositRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetUserRedemptionRecordRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUserRedemptionRecordsByAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2596,15 +4032,15 @@ func (m *QueryGetUserRedemptionRecordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetUserRedemptionRecordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUserRedemptionRecordsByAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetUserRedemptionRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUserRedemptionRecordsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2632,61 +4068,31 @@ func (m *QueryGetUserRedemptionRecordRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetUserRedemptionRecordResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableOnly", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetUserRedemptionRecordResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetUserRedemptionRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ClaimableOnly = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserRedemptionRecord", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2713,7 +4119,10 @@ func (m *QueryGetUserRedemptionRecordResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UserRedemptionRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2738,7 +4147,7 @@ func (m *QueryGetUserRedemptionRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllUserRedemptionRecordRequest) Unmarshal(dAtA []byte) error {
+func (m *UserRedemptionRecordWithStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2761,15 +4170,15 @@ func (m *QueryAllUserRedemptionRecordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllUserRedemptionRecordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserRedemptionRecordWithStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllUserRedemptionRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserRedemptionRecordWithStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserRedemptionRecord", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2796,13 +4205,68 @@ func (m *QueryAllUserRedemptionRecordRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UserRedemptionRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingStatus", wireType)
+			}
+			m.UnbondingStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingStatus |= HostZoneUnbonding_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedCompletionTime", wireType)
+			}
+			m.EstimatedCompletionTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedCompletionTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Claimable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2824,7 +4288,7 @@ func (m *QueryAllUserRedemptionRecordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllUserRedemptionRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUserRedemptionRecordsByAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2847,15 +4311,15 @@ func (m *QueryAllUserRedemptionRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllUserRedemptionRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUserRedemptionRecordsByAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllUserRedemptionRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUserRedemptionRecordsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserRedemptionRecord", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserRedemptionRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2882,8 +4346,8 @@ func (m *QueryAllUserRedemptionRecordResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserRedemptionRecord = append(m.UserRedemptionRecord, UserRedemptionRecord{})
-			if err := m.UserRedemptionRecord[len(m.UserRedemptionRecord)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UserRedemptionRecords = append(m.UserRedemptionRecords, UserRedemptionRecordWithStatus{})
+			if err := m.UserRedemptionRecords[len(m.UserRedemptionRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2944,7 +4408,7 @@ func (m *QueryAllUserRedemptionRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllUserRedemptionRecordForUserRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRecordHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2967,49 +4431,17 @@ func (m *QueryAllUserRedemptionRecordForUserRequest) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllUserRedemptionRecordForUserRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecordHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllUserRedemptionRecordForUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecordHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordType", wireType)
 			}
-			m.Day = 0
+			m.RecordType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3019,14 +4451,14 @@ func (m *QueryAllUserRedemptionRecordForUserRequest) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Day |= uint64(b&0x7F) << shift
+				m.RecordType |= RecordStatusTransition_RecordType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3054,28 +4486,9 @@ func (m *QueryAllUserRedemptionRecordForUserRequest) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.RecordId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3132,7 +4545,7 @@ func (m *QueryAllUserRedemptionRecordForUserRequest) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryAllUserRedemptionRecordForUserResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRecordHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3155,15 +4568,15 @@ func (m *QueryAllUserRedemptionRecordForUserResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllUserRedemptionRecordForUserResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecordHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllUserRedemptionRecordForUserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecordHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserRedemptionRecord", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordStatusTransitions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3190,8 +4603,8 @@ func (m *QueryAllUserRedemptionRecordForUserResponse) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserRedemptionRecord = append(m.UserRedemptionRecord, UserRedemptionRecord{})
-			if err := m.UserRedemptionRecord[len(m.UserRedemptionRecord)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RecordStatusTransitions = append(m.RecordStatusTransitions, RecordStatusTransition{})
+			if err := m.RecordStatusTransitions[len(m.RecordStatusTransitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type deferredTransitionsKey struct{}
+
+// WithDeferredTransitions collects the record status transitions made with the returned context in the given list,
+// rather than writing them to the record history, so that they can be written once the packet behind them is sent
+func WithDeferredTransitions(ctx sdk.Context, transitions *[]RecordStatusTransition) sdk.Context {
+	return ctx.WithValue(deferredTransitionsKey{}, transitions)
+}
+
+// GetDeferredTransitions returns the list collecting the transitions made with the context, if any
+func GetDeferredTransitions(ctx sdk.Context) (transitions *[]RecordStatusTransition, found bool) {
+	transitions, found = ctx.Value(deferredTransitionsKey{}).(*[]RecordStatusTransition)
+	return transitions, found
+}
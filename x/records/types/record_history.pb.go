@@ -0,0 +1,758 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: records/record_history.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type RecordStatusTransition_RecordType int32
+
+const (
+	RecordStatusTransition_DEPOSIT_RECORD      RecordStatusTransition_RecordType = 0
+	RecordStatusTransition_HOST_ZONE_UNBONDING RecordStatusTransition_RecordType = 1
+)
+
+var RecordStatusTransition_RecordType_name = map[int32]string{
+	0: "DEPOSIT_RECORD",
+	1: "HOST_ZONE_UNBONDING",
+}
+
+var RecordStatusTransition_RecordType_value = map[string]int32{
+	"DEPOSIT_RECORD":      0,
+	"HOST_ZONE_UNBONDING": 1,
+}
+
+func (x RecordStatusTransition_RecordType) String() string {
+	return proto.EnumName(RecordStatusTransition_RecordType_name, int32(x))
+}
+
+func (RecordStatusTransition_RecordType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6208709a59996fbd, []int{0, 0}
+}
+
+// RecordStatusTransition is an entry in the history of a deposit record or host zone unbonding,
+// recording a change of its status or its removal
+// It's also emitted as a typed event
+type RecordStatusTransition struct {
+	Id         uint64                            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RecordType RecordStatusTransition_RecordType `protobuf:"varint,2,opt,name=recordType,proto3,enum=Stridelabs.stride.records.RecordStatusTransition_RecordType" json:"recordType,omitempty"`
+	// the deposit record id, or {host_zone_id}.{epoch_number} for host zone unbondings
+	RecordId   string `protobuf:"bytes,3,opt,name=recordId,proto3" json:"recordId,omitempty"`
+	HostZoneId string `protobuf:"bytes,4,opt,name=hostZoneId,proto3" json:"hostZoneId,omitempty"`
+	FromStatus string `protobuf:"bytes,5,opt,name=fromStatus,proto3" json:"fromStatus,omitempty"`
+	// REMOVED once the record is removed
+	ToStatus    string `protobuf:"bytes,6,opt,name=toStatus,proto3" json:"toStatus,omitempty"`
+	BlockHeight int64  `protobuf:"varint,7,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+	// the IBC transfer or ICA packet that caused the transition, if any
+	// ICA txs are sent at the end of the block, so the transitions made when they're queued are written once they're sent
+	ChannelId      string `protobuf:"bytes,8,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	PacketSequence uint64 `protobuf:"varint,9,opt,name=packetSequence,proto3" json:"packetSequence,omitempty"`
+	// the result of the packet's acknowledgement (success, error or timeout), empty when the packet was sent
+	AckResult string `protobuf:"bytes,10,opt,name=ackResult,proto3" json:"ackResult,omitempty"`
+}
+
+func (m *RecordStatusTransition) Reset()         { *m = RecordStatusTransition{} }
+func (m *RecordStatusTransition) String() string { return proto.CompactTextString(m) }
+func (*RecordStatusTransition) ProtoMessage()    {}
+func (*RecordStatusTransition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6208709a59996fbd, []int{0}
+}
+func (m *RecordStatusTransition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordStatusTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordStatusTransition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordStatusTransition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordStatusTransition.Merge(m, src)
+}
+func (m *RecordStatusTransition) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordStatusTransition) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordStatusTransition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordStatusTransition proto.InternalMessageInfo
+
+func (m *RecordStatusTransition) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RecordStatusTransition) GetRecordType() RecordStatusTransition_RecordType {
+	if m != nil {
+		return m.RecordType
+	}
+	return RecordStatusTransition_DEPOSIT_RECORD
+}
+
+func (m *RecordStatusTransition) GetRecordId() string {
+	if m != nil {
+		return m.RecordId
+	}
+	return ""
+}
+
+func (m *RecordStatusTransition) GetHostZoneId() string {
+	if m != nil {
+		return m.HostZoneId
+	}
+	return ""
+}
+
+func (m *RecordStatusTransition) GetFromStatus() string {
+	if m != nil {
+		return m.FromStatus
+	}
+	return ""
+}
+
+func (m *RecordStatusTransition) GetToStatus() string {
+	if m != nil {
+		return m.ToStatus
+	}
+	return ""
+}
+
+func (m *RecordStatusTransition) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *RecordStatusTransition) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *RecordStatusTransition) GetPacketSequence() uint64 {
+	if m != nil {
+		return m.PacketSequence
+	}
+	return 0
+}
+
+func (m *RecordStatusTransition) GetAckResult() string {
+	if m != nil {
+		return m.AckResult
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("Stridelabs.stride.records.RecordStatusTransition_RecordType", RecordStatusTransition_RecordType_name, RecordStatusTransition_RecordType_value)
+	proto.RegisterType((*RecordStatusTransition)(nil), "Stridelabs.stride.records.RecordStatusTransition")
+}
+
+func init() { proto.RegisterFile("records/record_history.proto", fileDescriptor_6208709a59996fbd) }
+
+var fileDescriptor_6208709a59996fbd = []byte{
+	// 388 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x33, 0xed, 0xba, 0x6e, 0x9f, 0x10, 0x96, 0x11, 0x74, 0x94, 0x25, 0x84, 0x3d, 0x48,
+	0x2e, 0x9b, 0x80, 0x9e, 0x04, 0x4f, 0x6b, 0xcb, 0x36, 0x20, 0x89, 0x4c, 0xe2, 0xa5, 0x08, 0x21,
+	0x3f, 0xc6, 0x66, 0x68, 0x9a, 0x89, 0x99, 0x09, 0xd8, 0xff, 0xc2, 0xff, 0xc8, 0xab, 0xc7, 0x1e,
+	0x3d, 0x4a, 0xfb, 0x8f, 0x48, 0x33, 0xfd, 0x85, 0xe8, 0x29, 0xef, 0xfb, 0xfd, 0xe6, 0x7d, 0x06,
+	0xde, 0x7b, 0x70, 0xd3, 0xb2, 0x5c, 0xb4, 0x85, 0xf4, 0xf4, 0x37, 0x29, 0xb9, 0x54, 0xa2, 0x5d,
+	0xb9, 0x4d, 0x2b, 0x94, 0xc0, 0x2f, 0x22, 0xd5, 0xf2, 0x82, 0x55, 0x69, 0x26, 0x5d, 0xd9, 0x97,
+	0xee, 0xfe, 0xff, 0xdb, 0x1f, 0x43, 0x78, 0x46, 0xfb, 0x3a, 0x52, 0xa9, 0xea, 0x64, 0xdc, 0xa6,
+	0xb5, 0xe4, 0x8a, 0x8b, 0x1a, 0x9b, 0x30, 0xe0, 0x05, 0x41, 0x36, 0x72, 0x2e, 0xe8, 0x80, 0x17,
+	0xf8, 0x33, 0x80, 0xee, 0x8a, 0x57, 0x0d, 0x23, 0x03, 0x1b, 0x39, 0xe6, 0xeb, 0x77, 0xee, 0x7f,
+	0xd1, 0xee, 0xbf, 0xb1, 0x2e, 0x3d, 0x32, 0xe8, 0x19, 0x0f, 0xbf, 0x84, 0x2b, 0xad, 0xfc, 0x82,
+	0x0c, 0x6d, 0xe4, 0x8c, 0xe8, 0x51, 0x63, 0x0b, 0xa0, 0x14, 0x52, 0xcd, 0x44, 0xcd, 0xfc, 0x82,
+	0x5c, 0xf4, 0xe9, 0x99, 0xb3, 0xcb, 0xbf, 0xb4, 0x62, 0xa9, 0x9f, 0x22, 0x8f, 0x74, 0x7e, 0x72,
+	0x76, 0x6c, 0x25, 0xf6, 0xe9, 0xa5, 0x66, 0x1f, 0x34, 0xb6, 0xe1, 0x49, 0x56, 0x89, 0x7c, 0x31,
+	0x65, 0x7c, 0x5e, 0x2a, 0xf2, 0xd8, 0x46, 0xce, 0x90, 0x9e, 0x5b, 0xf8, 0x06, 0x46, 0x79, 0x99,
+	0xd6, 0x35, 0xab, 0xfc, 0x82, 0x5c, 0xf5, 0xed, 0x27, 0x03, 0xbf, 0x02, 0xb3, 0x49, 0xf3, 0x05,
+	0x53, 0x11, 0xfb, 0xda, 0xb1, 0x3a, 0x67, 0x64, 0xd4, 0x4f, 0xec, 0x2f, 0x77, 0x47, 0x49, 0xf3,
+	0x05, 0x65, 0xb2, 0xab, 0x14, 0x01, 0x4d, 0x39, 0x1a, 0xb7, 0x6f, 0x01, 0x4e, 0x73, 0xc1, 0x18,
+	0xcc, 0xf1, 0xe4, 0x63, 0x18, 0xf9, 0x71, 0x42, 0x27, 0xef, 0x43, 0x3a, 0xbe, 0x36, 0xf0, 0x73,
+	0x78, 0x3a, 0x0d, 0xa3, 0x38, 0x99, 0x85, 0xc1, 0x24, 0xf9, 0x14, 0xdc, 0x87, 0xc1, 0xd8, 0x0f,
+	0x1e, 0xae, 0xd1, 0xfd, 0xc3, 0xcf, 0x8d, 0x85, 0xd6, 0x1b, 0x0b, 0xfd, 0xde, 0x58, 0xe8, 0xfb,
+	0xd6, 0x32, 0xd6, 0x5b, 0xcb, 0xf8, 0xb5, 0xb5, 0x8c, 0xd9, 0xdd, 0x9c, 0xab, 0xb2, 0xcb, 0xdc,
+	0x5c, 0x2c, 0x3d, 0xbd, 0xa6, 0xbb, 0x0f, 0x69, 0x26, 0x3d, 0xbd, 0x27, 0xef, 0x9b, 0x77, 0x38,
+	0x1a, 0xb5, 0x6a, 0x98, 0xcc, 0x2e, 0xfb, 0x63, 0x79, 0xf3, 0x67, 0x00, 0x42, 0xff, 0x02, 0xf6,
+	0x4c, 0x02, 0x00, 0x00,
+}
+
+func (m *RecordStatusTransition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordStatusTransition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordStatusTransition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AckResult) > 0 {
+		i -= len(m.AckResult)
+		copy(dAtA[i:], m.AckResult)
+		i = encodeVarintRecordHistory(dAtA, i, uint64(len(m.AckResult)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.PacketSequence != 0 {
+		i = encodeVarintRecordHistory(dAtA, i, uint64(m.PacketSequence))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintRecordHistory(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintRecordHistory(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ToStatus) > 0 {
+		i -= len(m.ToStatus)
+		copy(dAtA[i:], m.ToStatus)
+		i = encodeVarintRecordHistory(dAtA, i, uint64(len(m.ToStatus)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.FromStatus) > 0 {
+		i -= len(m.FromStatus)
+		copy(dAtA[i:], m.FromStatus)
+		i = encodeVarintRecordHistory(dAtA, i, uint64(len(m.FromStatus)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.HostZoneId) > 0 {
+		i -= len(m.HostZoneId)
+		copy(dAtA[i:], m.HostZoneId)
+		i = encodeVarintRecordHistory(dAtA, i, uint64(len(m.HostZoneId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RecordId) > 0 {
+		i -= len(m.RecordId)
+		copy(dAtA[i:], m.RecordId)
+		i = encodeVarintRecordHistory(dAtA, i, uint64(len(m.RecordId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RecordType != 0 {
+		i = encodeVarintRecordHistory(dAtA, i, uint64(m.RecordType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintRecordHistory(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRecordHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRecordHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RecordStatusTransition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRecordHistory(uint64(m.Id))
+	}
+	if m.RecordType != 0 {
+		n += 1 + sovRecordHistory(uint64(m.RecordType))
+	}
+	l = len(m.RecordId)
+	if l > 0 {
+		n += 1 + l + sovRecordHistory(uint64(l))
+	}
+	l = len(m.HostZoneId)
+	if l > 0 {
+		n += 1 + l + sovRecordHistory(uint64(l))
+	}
+	l = len(m.FromStatus)
+	if l > 0 {
+		n += 1 + l + sovRecordHistory(uint64(l))
+	}
+	l = len(m.ToStatus)
+	if l > 0 {
+		n += 1 + l + sovRecordHistory(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovRecordHistory(uint64(m.BlockHeight))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovRecordHistory(uint64(l))
+	}
+	if m.PacketSequence != 0 {
+		n += 1 + sovRecordHistory(uint64(m.PacketSequence))
+	}
+	l = len(m.AckResult)
+	if l > 0 {
+		n += 1 + l + sovRecordHistory(uint64(l))
+	}
+	return n
+}
+
+func sovRecordHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRecordHistory(x uint64) (n int) {
+	return sovRecordHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RecordStatusTransition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecordHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordStatusTransition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordStatusTransition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordType", wireType)
+			}
+			m.RecordType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordType |= RecordStatusTransition_RecordType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecordHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecordHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecordHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecordHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecordHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecordHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecordHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecordHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecordHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecordHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSequence", wireType)
+			}
+			m.PacketSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckResult", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecordHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecordHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckResult = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecordHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecordHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRecordHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRecordHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRecordHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRecordHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRecordHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRecordHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRecordHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRecordHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRecordHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRecordHistory = fmt.Errorf("proto: unexpected end of group")
+)
@@ -24,3 +24,11 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper, bk types.BankKeeper, ak type
 		}
 	}
 }
+
+// EndBlocker of stakeibc module
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	// Send the ICA txs queued during the block, one packet per ICA account
+	k.FlushICATxQueues(ctx)
+}
@@ -33,6 +33,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdShowInterchainAccount())
 	cmd.AddCommand(CmdListEpochTracker())
 	cmd.AddCommand(CmdShowEpochTracker())
+	cmd.AddCommand(CmdShowICAHealth())
+	cmd.AddCommand(CmdShowHostZoneStatus())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+func CmdShowHostZoneStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "zone-status [chain-id]",
+		Short: "shows a host zone's ICA channels, records in flight, pending callbacks, outstanding queries and light client",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryHostZoneStatusRequest{
+				ChainId: args[0],
+			}
+
+			res, err := queryClient.HostZoneStatus(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
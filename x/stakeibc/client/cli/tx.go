@@ -35,6 +35,10 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdRestoreInterchainAccount())
 	cmd.AddCommand(CmdUpdateValidatorSharesExchRate())
 	cmd.AddCommand(CmdClearBalance())
+	cmd.AddCommand(CmdUpdateHostZoneSchedule())
+	cmd.AddCommand(CmdSetWithdrawalAddress())
+	cmd.AddCommand(CmdWindDownHostZone())
+	cmd.AddCommand(CmdUpdateMaxLightClientAge())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
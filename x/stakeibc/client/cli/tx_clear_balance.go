@@ -6,7 +6,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
-	"github.com/spf13/cast"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/Stride-Labs/stride/x/stakeibc/types"
@@ -21,9 +22,9 @@ func CmdClearBalance() *cobra.Command {
 		Args:  cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			argChainId := args[0]
-			argAmount, err := cast.ToUint64E(args[1])
-			if err != nil {
-				return err
+			argAmount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unable to parse amount (%s)", args[1])
 			}
 			argChannelId := args[2]
 
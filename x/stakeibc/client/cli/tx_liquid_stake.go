@@ -7,7 +7,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
-	"github.com/spf13/cast"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/spf13/cobra"
 )
 
@@ -19,9 +20,9 @@ func CmdLiquidStake() *cobra.Command {
 		Short: "Broadcast message liquid-stake",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
-			argAmount, err := cast.ToUint64E(args[0])
-			if err != nil {
-				return err
+			argAmount, ok := sdk.NewIntFromString(args[0])
+			if !ok {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unable to parse amount (%s)", args[0])
 			}
 			argHostDenom := args[1]
 
@@ -6,7 +6,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
-	"github.com/spf13/cast"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/Stride-Labs/stride/x/stakeibc/types"
@@ -20,9 +21,9 @@ func CmdRedeemStake() *cobra.Command {
 		Short: "Broadcast message redeem-stake",
 		Args:  cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			argAmount, err := cast.ToUint64E(args[0])
-			if err != nil {
-				return err
+			argAmount, ok := sdk.NewIntFromString(args[0])
+			if !ok {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unable to parse amount (%s)", args[0])
 			}
 			hostZoneID := args[1]
 
@@ -12,6 +12,11 @@ import (
 
 var _ = strconv.Itoa(0)
 
+const (
+	FlagDenomExponent = "denom-exponent"
+	FlagDisplayDenom  = "display-denom"
+)
+
 // TODO(TEST-53): Remove this pre-launch (no need for clients to create / interact with ICAs)
 func CmdRegisterHostZone() *cobra.Command {
 	cmd := &cobra.Command{
@@ -33,6 +38,17 @@ func CmdRegisterHostZone() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			denomExponent, err := cmd.Flags().GetUint32(FlagDenomExponent)
+			if err != nil {
+				return err
+			}
+			displayDenom, err := cmd.Flags().GetString(FlagDisplayDenom)
+			if err != nil {
+				return err
+			}
+			if displayDenom == "" {
+				displayDenom = types.DefaultDisplayDenom(hostDenom, denomExponent)
+			}
 			msg := types.NewMsgRegisterHostZone(
 				clientCtx.GetFromAddress().String(),
 				connectionId,
@@ -41,6 +57,8 @@ func CmdRegisterHostZone() *cobra.Command {
 				ibcDenom,
 				channelId,
 				unbondingFrequency,
+				denomExponent,
+				displayDenom,
 			)
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -51,6 +69,8 @@ func CmdRegisterHostZone() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint32(FlagDenomExponent, types.DefaultDenomExponent, "number of decimals between the host denom and its display denom (e.g. 18 for aevmos)")
+	cmd.Flags().String(FlagDisplayDenom, "", "display denom of the host token, inferred from the host denom's SI prefix if not provided")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
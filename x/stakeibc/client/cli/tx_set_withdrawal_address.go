@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+func CmdSetWithdrawalAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-withdrawal-address [host-zone]",
+		Short: "Broadcast message set-withdrawal-address",
+		Long: `Queries the withdrawal address of the host zone's delegation ICA, and re-sets it to the
+withdrawal ICA if it differs.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argHostZone := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetWithdrawalAddress(
+				clientCtx.GetFromAddress().String(),
+				argHostZone,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+func CmdUpdateHostZoneSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-host-zone-schedule [host-zone] [pipeline] [interval] [offset]",
+		Short: "Broadcast message update-host-zone-schedule",
+		Long: `Overrides how often a pipeline stage runs for a host zone. The pipeline is one of
+deposit, delegate, reinvest, redemption_rate or rebalance. The stage runs on every epoch where
+epochNumber % interval == offset. An interval of 0 uses the module param, and an interval and offset
+of 0 removes the override.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argHostZone := args[0]
+			argPipeline := args[1]
+			argInterval, err := cast.ToUint64E(args[2])
+			if err != nil {
+				return err
+			}
+			argOffset, err := cast.ToUint64E(args[3])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateHostZoneSchedule(
+				clientCtx.GetFromAddress().String(),
+				argHostZone,
+				argPipeline,
+				argInterval,
+				argOffset,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+func CmdUpdateMaxLightClientAge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-max-light-client-age [host-zone] [max-age-nanos]",
+		Short: "Broadcast message update-max-light-client-age",
+		Long: `Overrides how old the host zone's light client can be before the time-dependent
+pipelines (sweeping unbonded tokens and reinvesting rewards) are skipped. A max age of 0 uses the module param.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argHostZone := args[0]
+			argMaxAgeNanos, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateMaxLightClientAge(
+				clientCtx.GetFromAddress().String(),
+				argHostZone,
+				argMaxAgeNanos,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+func CmdWindDownHostZone() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wind-down-host-zone [host-zone]",
+		Short: "Broadcast message wind-down-host-zone",
+		Long: `Starts sunsetting a host zone. Liquid stakes are rejected, the remaining stake is undelegated,
+and the zone is removed once every stToken has been redeemed and claimed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argHostZone := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWindDownHostZone(
+				clientCtx.GetFromAddress().String(),
+				argHostZone,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
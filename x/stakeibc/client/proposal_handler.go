@@ -8,5 +8,6 @@ import (
 )
 
 var (
-	AddValidatorProposalHandler = govclient.NewProposalHandler(cli.CmdAddValidatorProposal, rest.ProposalAddValidatorRESTHandler)
+	AddValidatorProposalHandler    = govclient.NewProposalHandler(cli.CmdAddValidatorProposal, rest.ProposalAddValidatorRESTHandler)
+	DeleteValidatorProposalHandler = govclient.NewProposalHandler(cli.CmdDeleteValidatorProposal, rest.ProposalDeleteValidatorRESTHandler)
 )
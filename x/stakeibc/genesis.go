@@ -15,13 +15,35 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if genState.ICAAccount != nil {
 		k.SetICAAccount(ctx, *genState.ICAAccount)
 	}
-	// Set all the hostZone
+	// Set all the hostZone, along with each zone's module account
 	for _, elem := range genState.HostZoneList {
 		k.SetHostZone(ctx, elem)
+		k.SetZoneModuleAccount(ctx, elem.ChainId)
 	}
 
 	// Set hostZone count
 	k.SetHostZoneCount(ctx, genState.HostZoneCount)
+	// Set if defined
+	if genState.MinValidatorRequirements != nil {
+		k.SetMinValidatorRequirements(ctx, *genState.MinValidatorRequirements)
+	}
+	// Set all the pendingValidator
+	for _, elem := range genState.PendingValidatorList {
+		k.SetPendingValidator(ctx, elem.ChainId, elem.Validator, elem.ExpirationTime)
+	}
+	// Set all the redelegation
+	for _, elem := range genState.RedelegationList {
+		k.SetRedelegation(ctx, elem)
+	}
+	// Set all the icaRecovery
+	for _, elem := range genState.IcaRecoveryList {
+		k.SetICARecovery(ctx, elem)
+	}
+	// Set all the queuedICATx
+	for _, elem := range genState.QueuedICATxList {
+		k.SetQueuedICATx(ctx, elem)
+	}
+	k.SetQueuedICATxCount(ctx, genState.QueuedICATxCount)
 	// this line is used by starport scaffolding # genesis/module/init
 	// TODO(TEST-22): Set ports
 	// k.SetPort(ctx, genState.PortId)
@@ -50,7 +72,18 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	if found {
 		genesis.ICAAccount = &iCAAccount
 	}
-	genesis.EpochTrackerList = k.GetAllEpochTracker(ctx)
+	genesis.HostZoneList = k.GetAllHostZone(ctx)
+	genesis.HostZoneCount = k.GetHostZoneCount(ctx)
+	// Get minValidatorRequirements
+	minValidatorRequirements, found := k.GetMinValidatorRequirements(ctx)
+	if found {
+		genesis.MinValidatorRequirements = &minValidatorRequirements
+	}
+	genesis.PendingValidatorList = k.GetAllPendingValidators(ctx)
+	genesis.RedelegationList = k.GetAllRedelegations(ctx)
+	genesis.IcaRecoveryList = k.GetAllICARecoveries(ctx)
+	genesis.QueuedICATxList = k.GetAllQueuedICATxs(ctx)
+	genesis.QueuedICATxCount = k.GetQueuedICATxCount(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
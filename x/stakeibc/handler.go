@@ -27,6 +27,18 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgClearBalance:
 			res, err := msgServer.ClearBalance(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetWithdrawalAddress:
+			res, err := msgServer.SetWithdrawalAddress(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgWindDownHostZone:
+			res, err := msgServer.WindDownHostZone(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpdateMaxLightClientAge:
+			res, err := msgServer.UpdateMaxLightClientAge(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpdateHostZoneSchedule:
+			res, err := msgServer.UpdateHostZoneSchedule(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgRegisterHostZone:
 			res, err := msgServer.RegisterHostZone(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -1,16 +1,19 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	"github.com/spf13/cast"
 
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
-	epochtypes "github.com/Stride-Labs/stride/x/epochs/types"
 	icqtypes "github.com/Stride-Labs/stride/x/interchainquery/types"
 	"github.com/Stride-Labs/stride/x/stakeibc/types"
 )
@@ -46,11 +49,17 @@ func (c Callbacks) AddCallback(id string, fn interface{}) icqtypes.QueryCallback
 	return c
 }
 
+// The wind-down balance callback id is also used to find a sweeping host zone's outstanding balance queries
+const WIND_DOWN_BALANCE = "winddownbalance"
+
 func (c Callbacks) RegisterCallbacks() icqtypes.QueryCallbacks {
 	return c.
 		AddCallback("withdrawalbalance", Callback(WithdrawalBalanceCallback)).
 		AddCallback("delegation", Callback(DelegatorSharesCallback)).
-		AddCallback("validator", Callback(ValidatorExchangeRateCallback))
+		AddCallback("validator", Callback(ValidatorExchangeRateCallback)).
+		AddCallback("validatorrequirements", Callback(ValidatorRequirementsCallback)).
+		AddCallback("withdrawaladdress", Callback(WithdrawalAddressCallback)).
+		AddCallback(WIND_DOWN_BALANCE, Callback(WindDownBalanceCallback))
 }
 
 // -----------------------------------
@@ -87,15 +96,15 @@ func WithdrawalBalanceCallback(k Keeper, ctx sdk.Context, args []byte, query icq
 	}
 
 	// Confirm the balance is greater than zero
-	if withdrawalBalanceCoin.Amount.Int64() <= 0 {
+	if !withdrawalBalanceCoin.Amount.IsPositive() {
 		k.Logger(ctx).Info(fmt.Sprintf("WithdrawalBalanceCallback: no balance to transfer for zone: %s, accAddr: %v, coin: %v",
 			hostZone.ChainId, hostZone.WithdrawalAccount.Address, withdrawalBalanceCoin.String()))
 		return nil
 	}
 
 	// Sweep the withdrawal account balance, to the commission and the delegation accounts
-	k.Logger(ctx).Info(fmt.Sprintf("ICA Bank Sending %d%s from withdrawalAddr to delegationAddr.",
-		withdrawalBalanceCoin.Amount.Int64(), withdrawalBalanceCoin.Denom))
+	k.Logger(ctx).Info(fmt.Sprintf("ICA Bank Sending %v%s from withdrawalAddr to delegationAddr.",
+		withdrawalBalanceCoin.Amount, withdrawalBalanceCoin.Denom))
 
 	withdrawalAccount := hostZone.GetWithdrawalAccount()
 	if withdrawalAccount == nil {
@@ -133,25 +142,25 @@ func WithdrawalBalanceCallback(k Keeper, ctx sdk.Context, args []byte, query icq
 	strideClaimFloored := strideClaim.TruncateInt()
 
 	// back the reinvestment amount out of the total less the commission
-	reinvestAmountCeil := sdk.NewInt(withdrawalBalanceAmount.Int64()).Sub(strideClaimFloored)
+	reinvestAmountCeil := withdrawalBalanceAmount.Sub(strideClaimFloored)
 
-	// TODO(TEST-112) safety check, balances should add to original amount
-	if (strideClaimFloored.Int64() + reinvestAmountCeil.Int64()) != withdrawalBalanceAmount.Int64() {
-		ctx.Logger().Error(fmt.Sprintf("Error with withdraw logic: %d, Fee portion: %d, reinvestPortion %d", withdrawalBalanceAmount.Int64(), strideClaimFloored.Int64(), reinvestAmountCeil.Int64()))
+	// safety check, balances should add to original amount
+	if !strideClaimFloored.Add(reinvestAmountCeil).Equal(withdrawalBalanceAmount) {
+		ctx.Logger().Error(fmt.Sprintf("Error with withdraw logic: %v, Fee portion: %v, reinvestPortion %v", withdrawalBalanceAmount, strideClaimFloored, reinvestAmountCeil))
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Failed to subdivide rewards to feeAccount and delegationAccount")
 	}
 	strideCoin := sdk.NewCoin(withdrawalBalanceCoin.Denom, strideClaimFloored)
 	reinvestCoin := sdk.NewCoin(withdrawalBalanceCoin.Denom, reinvestAmountCeil)
 
 	var msgs []sdk.Msg
-	if strideCoin.Amount.Int64() > 0 {
+	if strideCoin.Amount.IsPositive() {
 		msgs = append(msgs, &banktypes.MsgSend{
 			FromAddress: withdrawalAccount.GetAddress(),
 			ToAddress:   feeAccount.GetAddress(),
 			Amount:      sdk.NewCoins(strideCoin),
 		})
 	}
-	if reinvestCoin.Amount.Int64() > 0 {
+	if reinvestCoin.Amount.IsPositive() {
 		msgs = append(msgs, &banktypes.MsgSend{
 			FromAddress: withdrawalAccount.GetAddress(),
 			ToAddress:   delegationAccount.GetAddress(),
@@ -171,21 +180,21 @@ func WithdrawalBalanceCallback(k Keeper, ctx sdk.Context, args []byte, query icq
 		return err
 	}
 
-	// Send the transaction through SubmitTx
-	_, err = k.SubmitTxsStrideEpoch(ctx, hostZone.ConnectionId, msgs, *withdrawalAccount, REINVEST, marshalledCallbackArgs)
+	// Queue the transaction, to be sent at the end of the block
+	reinvestEpochIdentifier := k.GetEpochIdentifierParam(ctx, types.KeyReinvestEpochIdentifier)
+	err = k.QueueTxs(ctx, hostZone.ConnectionId, msgs, *withdrawalAccount, reinvestEpochIdentifier, REINVEST, marshalledCallbackArgs)
 	if err != nil {
-		errMsg := fmt.Sprintf("Failed to SubmitTxs for %s - %s, Messages: %v | err: %s", hostZone.ChainId, hostZone.ConnectionId, msgs, err.Error())
+		errMsg := fmt.Sprintf("Failed to queue txs for %s - %s, Messages: %v | err: %s", hostZone.ChainId, hostZone.ConnectionId, msgs, err.Error())
 		k.Logger(ctx).Error(errMsg)
 		return sdkerrors.Wrapf(types.ErrICATxFailed, errMsg)
 	}
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			sdk.EventTypeMessage,
-			sdk.NewAttribute("hostZone", hostZone.ChainId),
-			sdk.NewAttribute("totalWithdrawalBalance", withdrawalBalanceCoin.Amount.String()),
-		),
-	)
+	k.EmitTypedEvent(ctx, &types.EventReinvest{
+		HostZoneId:        hostZone.ChainId,
+		WithdrawalBalance: withdrawalBalanceAmount,
+		FeeAmount:         strideCoin.Amount,
+		ReinvestAmount:    reinvestCoin.Amount,
+	})
 
 	return nil
 }
@@ -234,11 +243,12 @@ func ValidatorExchangeRateCallback(k Keeper, ctx sdk.Context, args []byte, query
 		k.Logger(ctx).Error(errMsg)
 		return sdkerrors.Wrapf(types.ErrValidatorNotFound, errMsg)
 	}
-	// get the stride epoch number
-	strideEpochTracker, found := k.GetEpochTracker(ctx, epochtypes.STRIDE_EPOCH)
+	// get the redemption rate epoch number, since the exchange rate is queried ahead of each redemption rate update
+	redemptionRateEpochIdentifier := k.GetEpochIdentifierParam(ctx, types.KeyRedemptionRateEpochIdentifier)
+	redemptionRateEpochTracker, found := k.GetEpochTracker(ctx, redemptionRateEpochIdentifier)
 	if !found {
-		k.Logger(ctx).Error("failed to find stride epoch")
-		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no epoch number for epoch (%s)", epochtypes.STRIDE_EPOCH)
+		k.Logger(ctx).Error(fmt.Sprintf("failed to find %s epoch", redemptionRateEpochIdentifier))
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no epoch number for epoch (%s)", redemptionRateEpochIdentifier)
 	}
 
 	// If the validator's delegation shares is 0, we'll get a division by zero error when trying to get the exchange rate
@@ -256,7 +266,7 @@ func ValidatorExchangeRateCallback(k Keeper, ctx sdk.Context, args []byte, query
 	//    and the returned number of tokens will be equal to the internal exchange rate
 	validator.InternalExchangeRate = &types.ValidatorExchangeRate{
 		InternalTokensToSharesRate: queriedValidator.TokensFromShares(sdk.NewDec(1.0)),
-		EpochNumber:                strideEpochTracker.GetEpochNumber(),
+		EpochNumber:                redemptionRateEpochTracker.GetEpochNumber(),
 	}
 	hostZone.Validators[valIndex] = &validator
 	k.SetHostZone(ctx, hostZone)
@@ -324,14 +334,15 @@ func DelegatorSharesCallback(k Keeper, ctx sdk.Context, args []byte, query icqty
 	}
 
 	// get the validator's internal exchange rate, aborting if it hasn't been updated this epoch
-	strideEpochTracker, found := k.GetEpochTracker(ctx, epochtypes.STRIDE_EPOCH)
+	redemptionRateEpochIdentifier := k.GetEpochIdentifierParam(ctx, types.KeyRedemptionRateEpochIdentifier)
+	redemptionRateEpochTracker, found := k.GetEpochTracker(ctx, redemptionRateEpochIdentifier)
 	if !found {
-		k.Logger(ctx).Error("failed to find stride epoch")
-		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no epoch number for epoch (%s)", epochtypes.STRIDE_EPOCH)
+		k.Logger(ctx).Error(fmt.Sprintf("failed to find %s epoch", redemptionRateEpochIdentifier))
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no epoch number for epoch (%s)", redemptionRateEpochIdentifier)
 	}
-	if validator.InternalExchangeRate.EpochNumber != strideEpochTracker.GetEpochNumber() {
+	if validator.InternalExchangeRate.EpochNumber != redemptionRateEpochTracker.GetEpochNumber() {
 		errMsg := fmt.Sprintf("DelegationCallback: validator (%s) internal exchange rate has not been updated this epoch (epoch #%d)",
-			validator.Address, strideEpochTracker.GetEpochNumber())
+			validator.Address, redemptionRateEpochTracker.GetEpochNumber())
 		k.Logger(ctx).Error(errMsg)
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, errMsg)
 	}
@@ -339,14 +350,14 @@ func DelegatorSharesCallback(k Keeper, ctx sdk.Context, args []byte, query icqty
 	// TODO: make sure conversion math precision matches the sdk's staking module's version (we did it slightly differently)
 	// note: truncateInt per https://github.com/cosmos/cosmos-sdk/blob/cb31043d35bad90c4daa923bb109f38fd092feda/x/staking/types/validator.go#L431
 	validatorTokens := queriedDelgation.Shares.Mul(validator.InternalExchangeRate.InternalTokensToSharesRate).TruncateInt()
-	k.Logger(ctx).Info(fmt.Sprintf("DelegationCallback: HostZone: %s, Validator: %s, Previous NumTokens: %d, Current NumTokens: %v",
+	k.Logger(ctx).Info(fmt.Sprintf("DelegationCallback: HostZone: %s, Validator: %s, Previous NumTokens: %v, Current NumTokens: %v",
 		hostZone.ChainId, validator.Address, validator.DelegationAmt, validatorTokens))
 
 	// Confirm the validator has actually been slashed
-	if validatorTokens.Uint64() == validator.DelegationAmt {
+	if validatorTokens.Equal(validator.DelegationAmt) {
 		k.Logger(ctx).Info(fmt.Sprintf("DelegationCallback: Validator (%s) was not slashed", validator.Address))
 		return nil
-	} else if validatorTokens.Uint64() > validator.DelegationAmt {
+	} else if validatorTokens.GT(validator.DelegationAmt) {
 		errMsg := fmt.Sprintf("DelegationCallback: Validator (%s) tokens returned from query is greater than the DelegationAmt", validator.Address)
 		k.Logger(ctx).Error(errMsg)
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, errMsg)
@@ -357,19 +368,7 @@ func DelegatorSharesCallback(k Keeper, ctx sdk.Context, args []byte, query icqty
 	// NOTE:  we assume any decrease in delegation amt that's not tracked via records is a slash
 
 	// Get slash percentage
-	delegationAmount, err := cast.ToInt64E(validator.DelegationAmt)
-	if err != nil {
-		errMsg := fmt.Sprintf("unable to convert validator delegation amount to int64, err: %s", err.Error())
-		k.Logger(ctx).Error(errMsg)
-		return sdkerrors.Wrapf(types.ErrIntCast, errMsg)
-	}
-	slashAmountUInt := validator.DelegationAmt - validatorTokens.Uint64()
-	slashAmount, err := cast.ToInt64E(slashAmountUInt)
-	if err != nil {
-		errMsg := fmt.Sprintf("unable to convert validator slash amount to int64, err: %s", err.Error())
-		k.Logger(ctx).Error(errMsg)
-		return sdkerrors.Wrapf(types.ErrIntCast, errMsg)
-	}
+	slashAmount := validator.DelegationAmt.Sub(validatorTokens)
 	weight, err := cast.ToInt64E(validator.Weight)
 	if err != nil {
 		errMsg := fmt.Sprintf("unable to convert validator weight to int64, err: %s", err.Error())
@@ -377,8 +376,8 @@ func DelegatorSharesCallback(k Keeper, ctx sdk.Context, args []byte, query icqty
 		return sdkerrors.Wrapf(types.ErrIntCast, errMsg)
 	}
 
-	slashPct := sdk.NewDec(slashAmount).Quo(sdk.NewDec(delegationAmount))
-	k.Logger(ctx).Info(fmt.Sprintf("ICQ'd Delegation Amoount Mismatch, HostZone: %s, Validator: %s, Delegator: %s, Records Tokens: %d, Tokens from ICQ %v, Slash Amount: %d, Slash Pct: %v!",
+	slashPct := slashAmount.ToDec().Quo(validator.DelegationAmt.ToDec())
+	k.Logger(ctx).Info(fmt.Sprintf("ICQ'd Delegation Amoount Mismatch, HostZone: %s, Validator: %s, Delegator: %s, Records Tokens: %v, Tokens from ICQ %v, Slash Amount: %v, Slash Pct: %v!",
 		hostZone.ChainId, validator.Address, queriedDelgation.DelegatorAddress, validator.DelegationAmt, validatorTokens, slashAmount, slashPct))
 
 	// Abort if the slash was greater than 10%
@@ -390,15 +389,222 @@ func DelegatorSharesCallback(k Keeper, ctx sdk.Context, args []byte, query icqty
 	}
 
 	// Update the host zone and validator to reflect the weight and delegation change
-	weightAdjustment := sdk.NewDec(validatorTokens.Int64()).Quo(sdk.NewDec(delegationAmount))
+	weightAdjustment := validatorTokens.ToDec().Quo(validator.DelegationAmt.ToDec())
 	validator.Weight = sdk.NewDec(weight).Mul(weightAdjustment).TruncateInt().Uint64()
-	validator.DelegationAmt -= slashAmountUInt
+	validator.DelegationAmt = validator.DelegationAmt.Sub(slashAmount)
 
-	hostZone.StakedBal -= slashAmountUInt
+	hostZone.StakedBal = hostZone.StakedBal.Sub(slashAmount)
 	hostZone.Validators[valIndex] = &validator
 	k.SetHostZone(ctx, hostZone)
 
 	k.Logger(ctx).Info(fmt.Sprintf("Validator (%s) slashed! Delegation updated to: %v", validator.Address, validator.DelegationAmt))
+	k.EmitTypedEvent(ctx, &types.EventValidatorSlashed{
+		HostZoneId:       hostZone.ChainId,
+		ValidatorAddress: validator.Address,
+		SlashAmount:      slashAmount,
+		SlashPct:         slashPct,
+		NewDelegationAmt: validator.DelegationAmt,
+		NewWeight:        validator.Weight,
+	})
+
+	return nil
+}
+
+// ValidatorRequirementsCallback is a callback handler for the validator query issued when a validator is added
+// while min validator requirements are configured
+//
+// The validator is only added to the host zone (with its actual commission rate) if:
+//  1. its exact commission rate on the host is at least the min commission rate
+//  2. when the uptime requirement is non-zero, it is bonded and not jailed on the host
+//     (the uptime isn't measured, the requirement only acts as a flag)
+//
+// Otherwise, the pending validator is dropped and a rejection event is emitted
+func ValidatorRequirementsCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+	hostZone, found := k.GetHostZone(ctx, query.GetChainId())
+	if !found {
+		errMsg := fmt.Sprintf("no registered zone for queried chain ID (%s)", query.GetChainId())
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(types.ErrHostZoneNotFound, errMsg)
+	}
+	queriedValidator := stakingtypes.Validator{}
+	err := k.cdc.Unmarshal(args, &queriedValidator)
+	if err != nil {
+		errMsg := fmt.Sprintf("unable to unmarshal queriedValidator info for zone %s, err: %s", hostZone.ChainId, err.Error())
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(types.ErrMarshalFailure, errMsg)
+	}
+	k.Logger(ctx).Info(fmt.Sprintf("ValidatorRequirementsCallback: HostZone %s, Queried Validator %v, Jailed: %v, Status: %v, Commission: %v",
+		hostZone.ChainId, queriedValidator.OperatorAddress, queriedValidator.Jailed, queriedValidator.Status, queriedValidator.Commission.Rate))
+
+	// Grab the validator that was waiting on this query
+	validator, found := k.GetPendingValidator(ctx, hostZone.ChainId, queriedValidator.OperatorAddress)
+	if !found {
+		errMsg := fmt.Sprintf("no pending validator for address (%s) on host zone (%s)", queriedValidator.OperatorAddress, hostZone.ChainId)
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(types.ErrValidatorNotFound, errMsg)
+	}
+	k.RemovePendingValidator(ctx, hostZone.ChainId, validator.Address)
+
+	// Check the validator against the requirements (if they were removed since the validator was added, there's nothing to check)
+	commissionRate := queriedValidator.Commission.Rate
+	minValidatorRequirements, found := k.GetMinValidatorRequirements(ctx)
+	if found {
+		minCommissionRate := sdk.NewDec(int64(minValidatorRequirements.CommissionRate)).Quo(sdk.NewDec(100))
+		if commissionRate.LT(minCommissionRate) {
+			reason := fmt.Sprintf("commission rate %v is below the min commission rate %v", commissionRate, minCommissionRate)
+			k.RejectPendingValidator(ctx, hostZone, validator, reason)
+			return nil
+		}
+		// the host's missed blocks aren't queried, so any uptime requirement means the validator must be active
+		if minValidatorRequirements.Uptime > 0 && (queriedValidator.IsJailed() || !queriedValidator.IsBonded()) {
+			reason := fmt.Sprintf("validator is not in the active set (jailed: %v, status: %v)", queriedValidator.Jailed, queriedValidator.Status)
+			k.RejectPendingValidator(ctx, hostZone, validator, reason)
+			return nil
+		}
+	}
+
+	// The host zone may have changed since the validator was added, so re-confirm it can still be added
+	if err := k.ConfirmValidatorCanBeAdded(ctx, hostZone, validator.Name, validator.Address); err != nil {
+		k.RejectPendingValidator(ctx, hostZone, validator, err.Error())
+		return nil
+	}
+
+	// Store the validator with its commission from the host (as a truncated percentage, which is only informational,
+	// since the requirement above is checked against the exact rate)
+	validator.CommissionRate = commissionRate.MulInt64(100).TruncateInt().Uint64()
+	hostZone.Validators = append(hostZone.Validators, &validator)
+	k.SetHostZone(ctx, hostZone)
+
+	k.Logger(ctx).Info(fmt.Sprintf("ValidatorRequirementsCallback: Validator %s added to host zone %s", validator.Address, hostZone.ChainId))
+	k.EmitValidatorAddedEvent(ctx, hostZone.ChainId, validator)
+
+	return nil
+}
+
+// WithdrawalAddressCallback is a callback handler for the query of the delegation ICA's withdrawal address
+// in the host's distribution store
+//
+// The withdrawal address is only re-set if it differs from the withdrawal ICA. If it was never set, the
+// key is absent and the host withdraws rewards to the delegation ICA itself
+func WithdrawalAddressCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+	k.Logger(ctx).Info(fmt.Sprintf("WithdrawalAddressCallback executing, QueryId: %vs, Host: %s, QueryType: %s, Height: %d, Connection: %s",
+		query.Id, query.ChainId, query.QueryType, query.Height, query.ConnectionId))
+
+	hostZone, found := k.GetHostZone(ctx, query.GetChainId())
+	if !found {
+		errMsg := fmt.Sprintf("no registered zone for queried chain ID (%s)", query.GetChainId())
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(types.ErrHostZoneNotFound, errMsg)
+	}
+
+	withdrawalIca := hostZone.GetWithdrawalAccount()
+	if withdrawalIca == nil || withdrawalIca.Address == "" {
+		errMsg := fmt.Sprintf("WithdrawalAddressCallback: no withdrawal account found for zone: %s", hostZone.ChainId)
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(types.ErrICAAccountNotFound, errMsg)
+	}
+	_, withdrawalIcaAddr, err := bech32.DecodeAndConvert(withdrawalIca.Address)
+	if err != nil {
+		errMsg := fmt.Sprintf("WithdrawalAddressCallback: invalid withdrawal account address %s for zone: %s", withdrawalIca.Address, hostZone.ChainId)
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, errMsg)
+	}
+
+	if bytes.Equal(args, withdrawalIcaAddr) {
+		k.Logger(ctx).Info(fmt.Sprintf("WithdrawalAddressCallback: withdrawal address is already set to %s on %s", withdrawalIca.Address, hostZone.ChainId))
+		return nil
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("WithdrawalAddressCallback: withdrawal address on %s is %X, re-setting it to %s", hostZone.ChainId, args, withdrawalIca.Address))
+	return k.SetWithdrawalAddressOnHost(ctx, hostZone)
+}
+
+// WindDownBalanceCallback is a callback handler for the balance queries of a sweeping host zone's ICA accounts
+// An empty account is marked as swept, and any residual balance is transferred to stride's fee account
+func WindDownBalanceCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+	k.Logger(ctx).Info(fmt.Sprintf("WindDownBalanceCallback executing, QueryId: %vs, Host: %s, QueryType: %s, Height: %d, Connection: %s",
+		query.Id, query.ChainId, query.QueryType, query.Height, query.ConnectionId))
+
+	hostZone, found := k.GetHostZone(ctx, query.GetChainId())
+	if !found {
+		errMsg := fmt.Sprintf("no registered zone for queried chain ID (%s)", query.GetChainId())
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(types.ErrHostZoneNotFound, errMsg)
+	}
+	if hostZone.WindDownStatus != types.HostZone_Sweeping {
+		errMsg := fmt.Sprintf("host zone %s is not sweeping, status: %s", hostZone.ChainId, hostZone.WindDownStatus.String())
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(types.ErrInvalidHostZone, errMsg)
+	}
+
+	accountType, found := getBalanceQueryAccountType(hostZone, query.Request)
+	if !found {
+		errMsg := fmt.Sprintf("balance query %s is not for an ICA account of host zone %s", query.Id, hostZone.ChainId)
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(types.ErrICAAccountNotFound, errMsg)
+	}
+	account := hostZone.GetICAAccount(accountType)
+
+	balanceCoin := sdk.Coin{}
+	if err := k.cdc.Unmarshal(args, &balanceCoin); err != nil {
+		errMsg := fmt.Sprintf("unable to unmarshal balance in callback args for zone: %s, err: %s", hostZone.ChainId, err.Error())
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(types.ErrMarshalFailure, errMsg)
+	}
+
+	// A nil coin means the account never had a balance
+	if balanceCoin.IsNil() || balanceCoin.Amount.IsNil() || !balanceCoin.Amount.IsPositive() {
+		k.Logger(ctx).Info(fmt.Sprintf("WindDownBalanceCallback: the %s account of host zone %s is empty", accountType.String(), hostZone.ChainId))
+		if !isAccountSwept(hostZone, accountType) {
+			hostZone.WindDownSweptAccounts = append(hostZone.WindDownSweptAccounts, accountType)
+			k.SetHostZone(ctx, hostZone)
+		}
+		return nil
+	}
+
+	// The transfer is sent from the host zone, over the counterparty of stride's transfer channel
+	transferChannel, found := k.IBCKeeper.ChannelKeeper.GetChannel(ctx, ibctransfertypes.PortID, hostZone.TransferChannelId)
+	if !found {
+		errMsg := fmt.Sprintf("transfer channel %s not found for host zone %s", hostZone.TransferChannelId, hostZone.ChainId)
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, errMsg)
+	}
+	feeTransferTimeoutNanos := k.GetParam(ctx, types.KeyFeeTransferTimeoutNanos)
+	msgs := []sdk.Msg{
+		&ibctransfertypes.MsgTransfer{
+			SourcePort:       ibctransfertypes.PortID,
+			SourceChannel:    transferChannel.Counterparty.ChannelId,
+			Token:            balanceCoin,
+			Sender:           account.Address,
+			Receiver:         types.FeeAccount,
+			TimeoutTimestamp: cast.ToUint64(ctx.BlockTime().UnixNano()) + feeTransferTimeoutNanos,
+		},
+	}
+
+	sweepCallback := types.WindDownSweepCallback{
+		HostZoneId:  hostZone.ChainId,
+		AccountType: accountType,
+		SweepAmount: balanceCoin,
+	}
+	marshalledCallbackArgs, err := k.MarshalWindDownSweepCallbackArgs(ctx, sweepCallback)
+	if err != nil {
+		return err
+	}
+
+	// the wind-down is driven by the unbonding epoch
+	unbondingEpochIdentifier := k.GetEpochIdentifierParam(ctx, types.KeyUnbondingEpochIdentifier)
+	err = k.QueueTxs(ctx, hostZone.ConnectionId, msgs, *account, unbondingEpochIdentifier, WIND_DOWN_SWEEP, marshalledCallbackArgs)
+	if err != nil {
+		errMsg := fmt.Sprintf("Failed to queue txs for %s - %s, Messages: %v | err: %s", hostZone.ChainId, hostZone.ConnectionId, msgs, err.Error())
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(types.ErrICATxFailed, errMsg)
+	}
+
+	k.EmitTypedEvent(ctx, &types.EventWindDownSweep{
+		HostZoneId:  hostZone.ChainId,
+		AccountType: accountType.String(),
+		Amount:      balanceCoin.Amount,
+	})
 
 	return nil
 }
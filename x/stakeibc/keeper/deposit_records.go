@@ -2,7 +2,7 @@ package keeper
 
 import (
 	"fmt"
-	"strconv"
+	"sort"
 
 	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
 
@@ -19,10 +19,14 @@ import (
 func (k Keeper) CreateDepositRecordsForEpoch(ctx sdk.Context, epochNumber uint64) {
 	// Create one new deposit record / host zone for the next epoch
 	createDepositRecords := func(ctx sdk.Context, index int64, zoneInfo types.HostZone) error {
+		// winding down host zones no longer accept liquid stakes
+		if zoneInfo.WindDownStatus != types.HostZone_Active {
+			return nil
+		}
 		k.Logger(ctx).Info(fmt.Sprintf("createDepositRecords, index: %d, zoneInfo: %s", index, zoneInfo.ConnectionId))
 		depositRecord := recordstypes.DepositRecord{
 			Id:                 0,
-			Amount:             0,
+			Amount:             sdk.ZeroInt(),
 			Denom:              zoneInfo.HostDenom,
 			HostZoneId:         zoneInfo.ChainId,
 			Status:             recordstypes.DepositRecord_TRANSFER_QUEUE,
@@ -34,6 +38,21 @@ func (k Keeper) CreateDepositRecordsForEpoch(ctx sdk.Context, epochNumber uint64
 	k.IterateHostZones(ctx, createDepositRecords)
 }
 
+// GetQueuedDepositRecords returns the deposit records, ordered by id, that are waiting to be transferred or delegated
+// These are the only records the epoch pipelines act on, so they're read from the host zone and status index
+// rather than loading every record
+func (k Keeper) GetQueuedDepositRecords(ctx sdk.Context) (depositRecords []recordstypes.DepositRecord) {
+	for _, hostZone := range k.GetAllHostZone(ctx) {
+		for _, status := range []recordstypes.DepositRecord_Status{recordstypes.DepositRecord_TRANSFER_QUEUE, recordstypes.DepositRecord_DELEGATION_QUEUE} {
+			depositRecords = append(depositRecords, k.RecordsKeeper.GetDepositRecordsByHostZoneAndStatus(ctx, hostZone.ChainId, status)...)
+		}
+	}
+	sort.SliceStable(depositRecords, func(i, j int) bool {
+		return depositRecords[i].Id < depositRecords[j].Id
+	})
+	return depositRecords
+}
+
 func (k Keeper) TransferExistingDepositsToHostZones(ctx sdk.Context, epochNumber uint64, depositRecords []recordstypes.DepositRecord) {
 	transferDepositRecords := utils.FilterDepositRecords(depositRecords, func(record recordstypes.DepositRecord) (condition bool) {
 		isTransferRecord := record.Status == recordstypes.DepositRecord_TRANSFER_QUEUE
@@ -44,11 +63,11 @@ func (k Keeper) TransferExistingDepositsToHostZones(ctx sdk.Context, epochNumber
 	ibcTransferTimeoutNanos := k.GetParam(ctx, types.KeyIBCTransferTimeoutNanos)
 
 	for _, depositRecord := range transferDepositRecords {
-		pstr := fmt.Sprintf("\t[TransferExistingDepositsToHostZones] Processing deposits {%d} {%s} {%d}", depositRecord.Id, depositRecord.Denom, depositRecord.Amount)
+		pstr := fmt.Sprintf("\t[TransferExistingDepositsToHostZones] Processing deposits {%d} {%s} {%v}", depositRecord.Id, depositRecord.Denom, depositRecord.Amount)
 		k.Logger(ctx).Info(pstr)
 
 		// if a TRANSFER_QUEUE record has 0 balance and was created in the previous epoch, it's safe to remove since it will never be updated or used
-		if depositRecord.Amount <= 0 && depositRecord.DepositEpochNumber < epochNumber {
+		if !depositRecord.Amount.IsPositive() && depositRecord.DepositEpochNumber < epochNumber {
 			k.Logger(ctx).Info("[TransferExistingDepositsToHostZones] Empty deposit record (ID: %s)! Removing.", depositRecord.Id)
 			k.RecordsKeeper.RemoveDepositRecord(ctx, depositRecord.Id)
 			continue
@@ -68,7 +87,7 @@ func (k Keeper) TransferExistingDepositsToHostZones(ctx sdk.Context, epochNumber
 		}
 		delegateAddress := delegateAccount.GetAddress()
 
-		transferCoin := sdk.NewCoin(hostZone.GetIBCDenom(), sdk.NewInt(depositRecord.Amount))
+		transferCoin := sdk.NewCoin(hostZone.GetIBCDenom(), depositRecord.Amount)
 		// timeout 30 min in the future
 		// NOTE: this assumes no clock drift between chains, which tendermint guarantees
 		// if we onboard non-tendermint chains, we need to use the time on the host chain to
@@ -79,13 +98,22 @@ func (k Keeper) TransferExistingDepositsToHostZones(ctx sdk.Context, epochNumber
 		k.Logger(ctx).Info(fmt.Sprintf("TransferExistingDepositsToHostZones msg %v", msg))
 
 		// transfer the deposit record and update its status to TRANSFER_IN_PROGRESS
-		err := k.RecordsKeeper.Transfer(ctx, msg, depositRecord)
+		err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.RecordsKeeper.Transfer(ctx, msg, depositRecord)
+		})
 		if err != nil {
 			k.Logger(ctx).Error(fmt.Sprintf("\t[TransferExistingDepositsToHostZones] Failed to initiate IBC transfer to host zone, HostZone: %v, Channel: %v, Amount: %v, ModuleAddress: %v, DelegateAddress: %v, Timeout: %v",
 				hostZone.ChainId, hostZone.TransferChannelId, transferCoin, hostZoneModuleAddress, delegateAddress, timeoutTimestamp))
 			k.Logger(ctx).Error(fmt.Sprintf("\t[TransferExistingDepositsToHostZones] err {%s}", err.Error()))
 			continue
 		}
+
+		k.EmitTypedEvent(ctx, &types.EventDepositTransfer{
+			HostZoneId:      hostZone.ChainId,
+			DepositRecordId: depositRecord.Id,
+			Amount:          transferCoin.Amount,
+			ChannelId:       hostZone.TransferChannelId,
+		})
 	}
 }
 
@@ -101,7 +129,7 @@ func (k Keeper) StakeExistingDepositsOnHostZones(ctx sdk.Context, epochNumber ui
 	k.Logger(ctx).Info(fmt.Sprintf("Staking %d out of %d deposit records", maxDepositRecordsToStake, len(stakeDepositRecords)))
 
 	for _, depositRecord := range stakeDepositRecords[:maxDepositRecordsToStake] {
-		k.Logger(ctx).Info(fmt.Sprintf("\t[StakeExistingDepositsOnHostZones] Processing deposit ID:{%d} DENOM:{%s} AMT:{%d}",
+		k.Logger(ctx).Info(fmt.Sprintf("\t[StakeExistingDepositsOnHostZones] Processing deposit ID:{%d} DENOM:{%s} AMT:{%v}",
 			depositRecord.Id, depositRecord.Denom, depositRecord.Amount))
 
 		hostZone, hostZoneFound := k.GetHostZone(ctx, depositRecord.HostZoneId)
@@ -116,10 +144,12 @@ func (k Keeper) StakeExistingDepositsOnHostZones(ctx sdk.Context, epochNumber ui
 			continue
 		}
 
-		k.Logger(ctx).Info(fmt.Sprintf("\t[StakeExistingDepositsOnHostZones] Staking %d on %s", depositRecord.Amount, hostZone.HostDenom))
-		stakeAmount := sdk.NewCoin(hostZone.HostDenom, sdk.NewInt(depositRecord.Amount))
+		k.Logger(ctx).Info(fmt.Sprintf("\t[StakeExistingDepositsOnHostZones] Staking %v on %s", depositRecord.Amount, hostZone.HostDenom))
+		stakeAmount := sdk.NewCoin(hostZone.HostDenom, depositRecord.Amount)
 
-		err := k.DelegateOnHost(ctx, hostZone, stakeAmount, depositRecord)
+		err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.DelegateOnHost(ctx, hostZone, stakeAmount, depositRecord)
+		})
 		if err != nil {
 			k.Logger(ctx).Error(fmt.Sprintf("Did not stake %s on %s | err: %s", stakeAmount.String(), hostZone.ChainId, err.Error()))
 			continue
@@ -127,12 +157,10 @@ func (k Keeper) StakeExistingDepositsOnHostZones(ctx sdk.Context, epochNumber ui
 			k.Logger(ctx).Info(fmt.Sprintf("Successfully submitted stake for %s on %s", stakeAmount.String(), hostZone.ChainId))
 		}
 
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				sdk.EventTypeMessage,
-				sdk.NewAttribute("hostZone", hostZone.ChainId),
-				sdk.NewAttribute("newAmountStaked", strconv.FormatInt(depositRecord.Amount, 10)),
-			),
-		)
+		k.EmitTypedEvent(ctx, &types.EventDelegation{
+			HostZoneId:      hostZone.ChainId,
+			DepositRecordId: depositRecord.Id,
+			Amount:          stakeAmount.Amount,
+		})
 	}
 }
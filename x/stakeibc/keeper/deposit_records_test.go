@@ -54,7 +54,7 @@ func (s *KeeperTestSuite) GetInitialDepositRecords(currentEpoch uint64) TestDepo
 	emptyDepositRecords := []recordstypes.DepositRecord{
 		{
 			Id:                 1,
-			Amount:             0,
+			Amount:             sdk.NewInt(0),
 			Denom:              Atom,
 			HostZoneId:         HostChainId,
 			Status:             recordstypes.DepositRecord_TRANSFER_QUEUE,
@@ -62,7 +62,7 @@ func (s *KeeperTestSuite) GetInitialDepositRecords(currentEpoch uint64) TestDepo
 		},
 		{
 			Id:                 2,
-			Amount:             0,
+			Amount:             sdk.NewInt(0),
 			Denom:              Atom,
 			HostZoneId:         HostChainId,
 			Status:             recordstypes.DepositRecord_TRANSFER_QUEUE,
@@ -73,7 +73,7 @@ func (s *KeeperTestSuite) GetInitialDepositRecords(currentEpoch uint64) TestDepo
 	recordsToBeTransfered := []recordstypes.DepositRecord{
 		{
 			Id:                 3,
-			Amount:             3000,
+			Amount:             sdk.NewInt(3000),
 			Denom:              Atom,
 			HostZoneId:         HostChainId,
 			Status:             recordstypes.DepositRecord_TRANSFER_QUEUE,
@@ -81,7 +81,7 @@ func (s *KeeperTestSuite) GetInitialDepositRecords(currentEpoch uint64) TestDepo
 		},
 		{
 			Id:                 4,
-			Amount:             4000,
+			Amount:             sdk.NewInt(4000),
 			Denom:              Atom,
 			HostZoneId:         HostChainId,
 			Status:             recordstypes.DepositRecord_TRANSFER_QUEUE,
@@ -93,7 +93,7 @@ func (s *KeeperTestSuite) GetInitialDepositRecords(currentEpoch uint64) TestDepo
 	recordsToBeStaked := []recordstypes.DepositRecord{
 		{
 			Id:                 5,
-			Amount:             5000,
+			Amount:             sdk.NewInt(5000),
 			Denom:              Atom,
 			HostZoneId:         HostChainId,
 			Status:             recordstypes.DepositRecord_DELEGATION_QUEUE,
@@ -101,7 +101,7 @@ func (s *KeeperTestSuite) GetInitialDepositRecords(currentEpoch uint64) TestDepo
 		},
 		{
 			Id:                 6,
-			Amount:             6000,
+			Amount:             sdk.NewInt(6000),
 			Denom:              Atom,
 			HostZoneId:         HostChainId,
 			Status:             recordstypes.DepositRecord_DELEGATION_QUEUE,
@@ -113,7 +113,7 @@ func (s *KeeperTestSuite) GetInitialDepositRecords(currentEpoch uint64) TestDepo
 	recordsInCurrentEpoch := []recordstypes.DepositRecord{
 		{
 			Id:                 7,
-			Amount:             7000,
+			Amount:             sdk.NewInt(7000),
 			Denom:              Atom,
 			HostZoneId:         HostChainId,
 			Status:             recordstypes.DepositRecord_DELEGATION_QUEUE,
@@ -121,7 +121,7 @@ func (s *KeeperTestSuite) GetInitialDepositRecords(currentEpoch uint64) TestDepo
 		},
 		{
 			Id:                 8,
-			Amount:             8000,
+			Amount:             sdk.NewInt(8000),
 			Denom:              Atom,
 			HostZoneId:         HostChainId,
 			Status:             recordstypes.DepositRecord_DELEGATION_QUEUE,
@@ -179,11 +179,11 @@ func (s *KeeperTestSuite) SetupDepositRecords() DepositRecordsTestCase {
 	strideEpochTracker := stakeibctypes.EpochTracker{
 		EpochIdentifier:    epochtypes.STRIDE_EPOCH,
 		EpochNumber:        currentEpoch,
-		NextEpochStartTime: uint64(s.Coordinator.CurrentTime.UnixNano() + 30_000_000_000), // dictates timeouts
+		NextEpochStartTime: uint64(s.Coordinator.CurrentTime.UnixNano() + 60_000_000_000), // dictates timeouts
 	}
 
 	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), hostZone)
-	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx(), strideEpochTracker)
+	s.SetEpochTracker(strideEpochTracker)
 
 	initialDepositRecords := s.GetInitialDepositRecords(currentEpoch)
 	for _, depositRecord := range initialDepositRecords.GetAllRecords() {
@@ -235,7 +235,7 @@ func (s *KeeperTestSuite) TestCreateDepositRecordsForEpoch_Successful() {
 		// Epoch 1
 		{
 			Id:                 0,
-			Amount:             0,
+			Amount:             sdk.NewInt(0),
 			Denom:              "denom1",
 			HostZoneId:         "HOST1",
 			Status:             recordstypes.DepositRecord_TRANSFER_QUEUE,
@@ -243,7 +243,7 @@ func (s *KeeperTestSuite) TestCreateDepositRecordsForEpoch_Successful() {
 		},
 		{
 			Id:                 1,
-			Amount:             0,
+			Amount:             sdk.NewInt(0),
 			Denom:              "denom2",
 			HostZoneId:         "HOST2",
 			Status:             recordstypes.DepositRecord_TRANSFER_QUEUE,
@@ -251,7 +251,7 @@ func (s *KeeperTestSuite) TestCreateDepositRecordsForEpoch_Successful() {
 		},
 		{
 			Id:                 2,
-			Amount:             0,
+			Amount:             sdk.NewInt(0),
 			Denom:              "denom3",
 			HostZoneId:         "HOST3",
 			Status:             recordstypes.DepositRecord_TRANSFER_QUEUE,
@@ -260,7 +260,7 @@ func (s *KeeperTestSuite) TestCreateDepositRecordsForEpoch_Successful() {
 		// Epoch 2
 		{
 			Id:                 3,
-			Amount:             0,
+			Amount:             sdk.NewInt(0),
 			Denom:              "denom1",
 			HostZoneId:         "HOST1",
 			Status:             recordstypes.DepositRecord_TRANSFER_QUEUE,
@@ -268,7 +268,7 @@ func (s *KeeperTestSuite) TestCreateDepositRecordsForEpoch_Successful() {
 		},
 		{
 			Id:                 4,
-			Amount:             0,
+			Amount:             sdk.NewInt(0),
 			Denom:              "denom2",
 			HostZoneId:         "HOST2",
 			Status:             recordstypes.DepositRecord_TRANSFER_QUEUE,
@@ -276,7 +276,7 @@ func (s *KeeperTestSuite) TestCreateDepositRecordsForEpoch_Successful() {
 		},
 		{
 			Id:                 5,
-			Amount:             0,
+			Amount:             sdk.NewInt(0),
 			Denom:              "denom3",
 			HostZoneId:         "HOST3",
 			Status:             recordstypes.DepositRecord_TRANSFER_QUEUE,
@@ -331,7 +331,7 @@ func (s *KeeperTestSuite) CheckStateAfterTransferringDepositRecords(tc DepositRe
 	// Confirm the module account balance decreased
 	expectedTransferAmount := sdk.NewInt(0)
 	for _, depositRecord := range recordsSuccessfullyTransferred {
-		expectedTransferAmount = expectedTransferAmount.AddRaw(depositRecord.Amount)
+		expectedTransferAmount = expectedTransferAmount.Add(depositRecord.Amount)
 	}
 	expectedModuleBalance := tc.initialModuleAccountBalance.SubAmount(expectedTransferAmount)
 	actualModuleBalance := s.App.BankKeeper.GetBalance(s.Ctx(), tc.hostModuleAddress, tc.hostZone.IBCDenom)
@@ -404,28 +404,54 @@ func (s *KeeperTestSuite) CheckStateAfterStakingDepositRecords(tc DepositRecords
 	// Stake deposit records
 	s.App.StakeibcKeeper.StakeExistingDepositsOnHostZones(s.Ctx(), tc.epochNumber, tc.initialDepositRecords.GetAllRecords())
 
+	// The delegations are queued and sent in a single ICA at the end of the block
+	s.App.StakeibcKeeper.FlushICATxQueues(s.Ctx())
+
 	// Confirm tx sequence was incremented
 	numDelegationAttempts := len(tc.initialDepositRecords.recordsToBeStaked)
 	numSuccessfulDelegations := uint64(numDelegationAttempts - numDelegationsFailed)
+	numPackets := uint64(0)
+	if numSuccessfulDelegations > 0 {
+		numPackets = 1
+	}
 
 	endSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx(), delegationPortID, delegationChannelID)
 	s.Require().True(found, "sequence number not found after delegation")
-	s.Require().Equal(startSequence+numSuccessfulDelegations, endSequence, "tx sequence number after delegation")
+	s.Require().Equal(startSequence+numPackets, endSequence, "tx sequence number after delegation")
 
-	// Confirm the callback data was stored for each delegation packet EXCLUDING the failed packets
+	// Confirm the callback data was stored for the delegation packet
 	numCallbacks := uint64(len(s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx())))
-	s.Require().Equal(numSuccessfulDelegations, numCallbacks, "number of callback's stored")
+	s.Require().Equal(numPackets, numCallbacks, "number of callback's stored")
+	if numPackets == 0 {
+		return
+	}
 
-	recordsSuccessfullyStaked := tc.initialDepositRecords.recordsToBeStaked[:numSuccessfulDelegations]
-	for i, depositRecord := range recordsSuccessfullyStaked {
-		// Confirm callback record
-		callbackKey := icacallbackstypes.PacketID(delegationPortID, delegationChannelID, startSequence+uint64(i))
-		callbackData, found := s.App.IcacallbacksKeeper.GetCallbackData(s.Ctx(), callbackKey)
-		s.Require().True(found, "callback data was not found for callback key (%s)", callbackKey)
+	// A single delegation keeps the delegate callback, while multiple delegations are batched
+	callbackKey := icacallbackstypes.PacketID(delegationPortID, delegationChannelID, startSequence)
+	callbackData, found := s.App.IcacallbacksKeeper.GetCallbackData(s.Ctx(), callbackKey)
+	s.Require().True(found, "callback data was not found for callback key (%s)", callbackKey)
+
+	delegateCallbackArgs := [][]byte{callbackData.CallbackArgs}
+	if numSuccessfulDelegations == 1 {
 		s.Require().Equal("delegate", callbackData.CallbackId, "callback ID")
+	} else {
+		s.Require().Equal("batch", callbackData.CallbackId, "callback ID")
+		batchCallback, err := s.App.StakeibcKeeper.UnmarshalBatchCallbackArgs(s.Ctx(), callbackData.CallbackArgs)
+		s.Require().NoError(err, "unmarshalling batch callback args error for callback key (%s)", callbackKey)
+		s.Require().Len(batchCallback.Callbacks, int(numSuccessfulDelegations), "number of batched callbacks")
+
+		delegateCallbackArgs = [][]byte{}
+		for _, batchedCallback := range batchCallback.Callbacks {
+			s.Require().Equal("delegate", batchedCallback.CallbackId, "batched callback ID")
+			s.Require().Equal(uint64(len(tc.hostZone.Validators)), batchedCallback.NumMsgs, "batched callback num msgs")
+			delegateCallbackArgs = append(delegateCallbackArgs, batchedCallback.CallbackArgs)
+		}
+	}
 
+	recordsSuccessfullyStaked := tc.initialDepositRecords.recordsToBeStaked[:numSuccessfulDelegations]
+	for i, depositRecord := range recordsSuccessfullyStaked {
 		// Confirm callback args
-		callbackArgs, err := s.App.StakeibcKeeper.UnmarshalDelegateCallbackArgs(s.Ctx(), callbackData.CallbackArgs)
+		callbackArgs, err := s.App.StakeibcKeeper.UnmarshalDelegateCallbackArgs(s.Ctx(), delegateCallbackArgs[i])
 		s.Require().NoError(err, "unmarshalling callback args error for callback key (%s)", callbackKey)
 		s.Require().Equal(depositRecord.Id, callbackArgs.DepositRecordId, "deposit record ID in callback args (%s)", callbackKey)
 		s.Require().Equal(tc.hostZone.ChainId, callbackArgs.HostZoneId, "host zone in callback args (%s)", callbackKey)
@@ -435,8 +461,8 @@ func (s *KeeperTestSuite) CheckStateAfterStakingDepositRecords(tc DepositRecords
 		val2 := tc.hostZone.Validators[1]
 		totalWeight := val1.Weight + val2.Weight
 
-		val1Delegation := uint64(depositRecord.Amount) * val1.Weight / totalWeight
-		val2Delegation := uint64(depositRecord.Amount) * val2.Weight / totalWeight
+		val1Delegation := depositRecord.Amount.Mul(sdk.NewIntFromUint64(val1.Weight)).Quo(sdk.NewIntFromUint64(totalWeight))
+		val2Delegation := depositRecord.Amount.Mul(sdk.NewIntFromUint64(val2.Weight)).Quo(sdk.NewIntFromUint64(totalWeight))
 
 		expectedDelegations := []*stakeibctypes.SplitDelegation{
 			{Validator: val1.Address, Amount: val1Delegation},
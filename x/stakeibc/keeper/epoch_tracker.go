@@ -1,61 +1,40 @@
 package keeper
 
 import (
-	"github.com/Stride-Labs/stride/x/stakeibc/types"
-	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
-)
 
-// SetEpochTracker set a specific epochTracker in the store from its index
-func (k Keeper) SetEpochTracker(ctx sdk.Context, epochTracker types.EpochTracker) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EpochTrackerKeyPrefix))
-	b := k.cdc.MustMarshal(&epochTracker)
-	store.Set(types.EpochTrackerKey(
-		epochTracker.EpochIdentifier,
-	), b)
-}
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
 
-// GetEpochTracker returns a epochTracker from its index
+// GetEpochTracker returns the epochTracker for an epoch, derived from the epoch's info in x/epochs
 func (k Keeper) GetEpochTracker(
 	ctx sdk.Context,
 	epochIdentifier string,
 ) (val types.EpochTracker, found bool) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EpochTrackerKeyPrefix))
-
-	b := store.Get(types.EpochTrackerKey(
-		epochIdentifier,
-	))
-	if b == nil {
+	epochInfo, found := k.EpochsKeeper.GetEpochInfo(ctx, epochIdentifier)
+	if !found {
 		return val, false
 	}
 
-	k.cdc.MustUnmarshal(b, &val)
-	return val, true
-}
-
-// RemoveEpochTracker removes a epochTracker from the store
-func (k Keeper) RemoveEpochTracker(
-	ctx sdk.Context,
-	epochIdentifier string,
-) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EpochTrackerKeyPrefix))
-	store.Delete(types.EpochTrackerKey(
-		epochIdentifier,
-	))
+	epochTracker, err := types.NewEpochTracker(epochInfo)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Unable to build epoch tracker for %s: %s", epochIdentifier, err.Error()))
+		return val, false
+	}
+	return epochTracker, true
 }
 
-// GetAllEpochTracker returns all epochTracker
+// GetAllEpochTracker returns the epochTracker for every epoch in x/epochs
 func (k Keeper) GetAllEpochTracker(ctx sdk.Context) (list []types.EpochTracker) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EpochTrackerKeyPrefix))
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-
-	defer iterator.Close()
-
-	for ; iterator.Valid(); iterator.Next() {
-		var val types.EpochTracker
-		k.cdc.MustUnmarshal(iterator.Value(), &val)
-		list = append(list, val)
+	for _, epochInfo := range k.EpochsKeeper.AllEpochInfos(ctx) {
+		epochTracker, err := types.NewEpochTracker(epochInfo)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to build epoch tracker for %s: %s", epochInfo.Identifier, err.Error()))
+			continue
+		}
+		list = append(list, epochTracker)
 	}
-
 	return
 }
@@ -3,23 +3,45 @@ package keeper_test
 import (
 	"strconv"
 	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
 
 	keepertest "github.com/Stride-Labs/stride/testutil/keeper"
-	"github.com/Stride-Labs/stride/testutil/nullify"
+	epochstypes "github.com/Stride-Labs/stride/x/epochs/types"
 	"github.com/Stride-Labs/stride/x/stakeibc/keeper"
 	"github.com/Stride-Labs/stride/x/stakeibc/types"
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/stretchr/testify/require"
 )
 
 // Prevent strconv unused error
 var _ = strconv.IntSize
 
+// Replaces the epochs in x/epochs with n started epochs and returns the trackers they should yield
 func createNEpochTracker(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.EpochTracker {
+	for _, epochInfo := range keeper.EpochsKeeper.AllEpochInfos(ctx) {
+		keeper.EpochsKeeper.DeleteEpochInfo(ctx, epochInfo.Identifier)
+	}
+
 	items := make([]types.EpochTracker, n)
 	for i := range items {
-		items[i].EpochIdentifier = strconv.Itoa(i)
-		keeper.SetEpochTracker(ctx, items[i])
+		duration := time.Duration(i+1) * time.Hour
+		currentEpochStartTime := time.Unix(int64(1_000_000+i), 0).UTC()
+		keeper.EpochsKeeper.SetEpochInfo(ctx, epochstypes.EpochInfo{
+			Identifier:            strconv.Itoa(i),
+			StartTime:             currentEpochStartTime,
+			Duration:              duration,
+			CurrentEpoch:          int64(i + 1),
+			CurrentEpochStartTime: currentEpochStartTime,
+			EpochCountingStarted:  true,
+		})
+
+		items[i] = types.EpochTracker{
+			EpochIdentifier:    strconv.Itoa(i),
+			EpochNumber:        uint64(i + 1),
+			NextEpochStartTime: uint64(currentEpochStartTime.Add(duration).UnixNano()),
+			Duration:           uint64(duration.Nanoseconds()),
+		}
 	}
 	return items
 }
@@ -32,18 +54,37 @@ func TestEpochTrackerGet(t *testing.T) {
 			item.EpochIdentifier,
 		)
 		require.True(t, found)
-		require.Equal(t,
-			nullify.Fill(&item),
-			nullify.Fill(&rst),
-		)
+		require.Equal(t, item, rst)
 	}
 }
 
+func TestEpochTrackerGet_NotStarted(t *testing.T) {
+	keeper, ctx := keepertest.StakeibcKeeper(t)
+
+	// Before the epoch starts counting, the next epoch starts at the epoch's start time
+	startTime := time.Unix(2_000_000, 0).UTC()
+	keeper.EpochsKeeper.SetEpochInfo(ctx, epochstypes.EpochInfo{
+		Identifier: "week",
+		StartTime:  startTime,
+		Duration:   time.Hour * 24 * 7,
+	})
+
+	rst, found := keeper.GetEpochTracker(ctx, "week")
+	require.True(t, found)
+	require.Equal(t, types.EpochTracker{
+		EpochIdentifier:    "week",
+		EpochNumber:        0,
+		NextEpochStartTime: uint64(startTime.UnixNano()),
+		Duration:           uint64((time.Hour * 24 * 7).Nanoseconds()),
+	}, rst)
+}
+
 func TestEpochTrackerRemove(t *testing.T) {
 	keeper, ctx := keepertest.StakeibcKeeper(t)
 	items := createNEpochTracker(keeper, ctx, 10)
 	for _, item := range items {
-		keeper.RemoveEpochTracker(ctx,
+		// Removing the epoch from x/epochs removes its tracker
+		keeper.EpochsKeeper.DeleteEpochInfo(ctx,
 			item.EpochIdentifier,
 		)
 		_, found := keeper.GetEpochTracker(ctx,
@@ -56,8 +97,5 @@ func TestEpochTrackerRemove(t *testing.T) {
 func TestEpochTrackerGetAll(t *testing.T) {
 	keeper, ctx := keepertest.StakeibcKeeper(t)
 	items := createNEpochTracker(keeper, ctx, 10)
-	require.ElementsMatch(t,
-		nullify.Fill(items),
-		nullify.Fill(keeper.GetAllEpochTracker(ctx)),
-	)
+	require.ElementsMatch(t, items, keeper.GetAllEpochTracker(ctx))
 }
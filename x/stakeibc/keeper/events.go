@@ -0,0 +1,17 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+// EmitTypedEvent emits a typed event describing a stakeibc state transition
+// Every stakeibc event is a typed event from events.proto, so that they can all be decoded by their message name
+// The state transition has already happened by the time it's emitted, so a failure to emit is only logged
+func (k Keeper) EmitTypedEvent(ctx sdk.Context, event proto.Message) {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Unable to emit %s event: %s", proto.MessageName(event), err.Error()))
+	}
+}
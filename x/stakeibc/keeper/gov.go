@@ -15,3 +15,7 @@ func (k Keeper) AddValidatorProposal(ctx sdk.Context, msg *types.AddValidatorPro
 	}
 	return k.AddValidatorToHostZone(ctx, addValMsg, true)
 }
+
+func (k Keeper) DeleteValidatorProposal(ctx sdk.Context, msg *types.DeleteValidatorProposal) error {
+	return k.DeleteValidatorFromHostZone(ctx, msg.HostZone, msg.ValidatorAddress)
+}
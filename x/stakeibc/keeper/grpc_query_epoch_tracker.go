@@ -3,12 +3,12 @@ package keeper
 import (
 	"context"
 
-	"github.com/Stride-Labs/stride/x/stakeibc/types"
-	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+
+	epochstypes "github.com/Stride-Labs/stride/x/epochs/types"
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
 )
 
 func (k Keeper) EpochTrackerAll(c context.Context, req *types.QueryAllEpochTrackerRequest) (*types.QueryAllEpochTrackerResponse, error) {
@@ -16,26 +16,22 @@ func (k Keeper) EpochTrackerAll(c context.Context, req *types.QueryAllEpochTrack
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	var epochTrackers []types.EpochTracker
-	ctx := sdk.UnwrapSDKContext(c)
-
-	store := ctx.KVStore(k.storeKey)
-	epochTrackerStore := prefix.NewStore(store, types.KeyPrefix(types.EpochTrackerKeyPrefix))
+	// the epoch trackers are derived from x/epochs, so they're paginated by the epochs store
+	epochsRes, err := k.EpochsKeeper.EpochInfos(c, &epochstypes.QueryEpochsInfoRequest{Pagination: req.Pagination})
+	if err != nil {
+		return nil, err
+	}
 
-	pageRes, err := query.Paginate(epochTrackerStore, req.Pagination, func(key []byte, value []byte) error {
-		var epochTracker types.EpochTracker
-		if err := k.cdc.Unmarshal(value, &epochTracker); err != nil {
-			return err
+	var epochTrackers []types.EpochTracker
+	for _, epochInfo := range epochsRes.Epochs {
+		epochTracker, err := types.NewEpochTracker(epochInfo)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
 		}
-
 		epochTrackers = append(epochTrackers, epochTracker)
-		return nil
-	})
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &types.QueryAllEpochTrackerResponse{EpochTracker: epochTrackers, Pagination: pageRes}, nil
+	return &types.QueryAllEpochTrackerResponse{EpochTracker: epochTrackers, Pagination: epochsRes.Pagination}, nil
 }
 
 func (k Keeper) EpochTracker(c context.Context, req *types.QueryGetEpochTrackerRequest) (*types.QueryGetEpochTrackerResponse, error) {
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
+	icqtypes "github.com/Stride-Labs/stride/x/interchainquery/types"
+	recordstypes "github.com/Stride-Labs/stride/x/records/types"
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+// The record statuses in the order records move through them
+var (
+	depositRecordStatuses = []recordstypes.DepositRecord_Status{
+		recordstypes.DepositRecord_TRANSFER_QUEUE,
+		recordstypes.DepositRecord_TRANSFER_IN_PROGRESS,
+		recordstypes.DepositRecord_DELEGATION_QUEUE,
+		recordstypes.DepositRecord_DELEGATION_IN_PROGRESS,
+	}
+	hostZoneUnbondingStatuses = []recordstypes.HostZoneUnbonding_Status{
+		recordstypes.HostZoneUnbonding_UNBONDING_QUEUE,
+		recordstypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS,
+		recordstypes.HostZoneUnbonding_EXIT_TRANSFER_QUEUE,
+		recordstypes.HostZoneUnbonding_EXIT_TRANSFER_IN_PROGRESS,
+		recordstypes.HostZoneUnbonding_CLAIMABLE,
+	}
+)
+
+func (k Keeper) HostZoneStatus(c context.Context, req *types.QueryHostZoneStatusRequest) (*types.QueryHostZoneStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	hostZone, found := k.GetHostZone(ctx, req.ChainId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	accounts := []types.ICAChannelHealth{}
+	for _, accountType := range types.HostZoneICAAccountTypes {
+		accounts = append(accounts, k.GetICAChannelHealth(ctx, hostZone, accountType))
+	}
+
+	// the light client is reported as zero if it can't be read, so the rest of the status is still returned
+	lightClientHeight, err := k.GetLightClientHeightSafely(ctx, hostZone.ConnectionId)
+	if err != nil {
+		lightClientHeight = 0
+	}
+	lightClientTime, err := k.GetLightClientTimeSafely(ctx, hostZone.ConnectionId)
+	if err != nil {
+		lightClientTime = 0
+	}
+
+	return &types.QueryHostZoneStatusResponse{
+		Accounts:           accounts,
+		DepositRecords:     k.GetDepositRecordSummaries(ctx, hostZone),
+		UnbondingRecords:   k.GetHostZoneUnbondingSummaries(ctx, hostZone),
+		PendingCallbacks:   k.GetPendingCallbacks(ctx, hostZone),
+		OutstandingQueries: k.GetOutstandingQueries(ctx, hostZone),
+		LightClientHeight:  lightClientHeight,
+		LightClientTime:    lightClientTime,
+	}, nil
+}
+
+// GetDepositRecordSummaries counts and sums a host zone's deposit records by status
+func (k Keeper) GetDepositRecordSummaries(ctx sdk.Context, hostZone types.HostZone) []types.RecordStatusSummary {
+	summaries := []types.RecordStatusSummary{}
+	for _, depositStatus := range depositRecordStatuses {
+		summary := types.RecordStatusSummary{Status: depositStatus.String(), Amount: sdk.ZeroInt()}
+		for _, depositRecord := range k.RecordsKeeper.GetDepositRecordsByHostZoneAndStatus(ctx, hostZone.ChainId, depositStatus) {
+			summary.Count++
+			summary.Amount = summary.Amount.Add(depositRecord.Amount)
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries
+}
+
+// GetHostZoneUnbondingSummaries counts and sums a host zone's unbondings across the epoch unbonding records by status
+func (k Keeper) GetHostZoneUnbondingSummaries(ctx sdk.Context, hostZone types.HostZone) []types.RecordStatusSummary {
+	summaryIndexes := make(map[recordstypes.HostZoneUnbonding_Status]int)
+	summaries := []types.RecordStatusSummary{}
+	for i, unbondingStatus := range hostZoneUnbondingStatuses {
+		summaryIndexes[unbondingStatus] = i
+		summaries = append(summaries, types.RecordStatusSummary{Status: unbondingStatus.String(), Amount: sdk.ZeroInt()})
+	}
+
+	for _, epochUnbondingRecord := range k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx) {
+		for _, hostZoneUnbonding := range epochUnbondingRecord.HostZoneUnbondings {
+			if hostZoneUnbonding.HostZoneId != hostZone.ChainId {
+				continue
+			}
+			i := summaryIndexes[hostZoneUnbonding.Status]
+			summaries[i].Count++
+			summaries[i].Amount = summaries[i].Amount.Add(hostZoneUnbonding.NativeTokenAmount)
+		}
+	}
+	return summaries
+}
+
+// GetPendingCallbacks returns the callbacks waiting on packets sent over a host zone's ICA or transfer channels
+func (k Keeper) GetPendingCallbacks(ctx sdk.Context, hostZone types.HostZone) []icacallbackstypes.CallbackData {
+	icaPortIds := make(map[string]bool)
+	for _, accountType := range types.HostZoneICAAccountTypes {
+		portId, err := icatypes.NewControllerPortID(types.FormatICAAccountOwner(hostZone.ChainId, accountType))
+		if err == nil {
+			icaPortIds[portId] = true
+		}
+	}
+
+	callbacks := []icacallbackstypes.CallbackData{}
+	for _, callback := range k.ICACallbacksKeeper.GetAllCallbackData(ctx) {
+		isTransfer := callback.PortId == transfertypes.PortID && callback.ChannelId == hostZone.TransferChannelId
+		if icaPortIds[callback.PortId] || isTransfer {
+			callbacks = append(callbacks, callback)
+		}
+	}
+	return callbacks
+}
+
+// GetOutstandingQueries returns the interchain queries submitted to a host zone that haven't been answered
+func (k Keeper) GetOutstandingQueries(ctx sdk.Context, hostZone types.HostZone) []icqtypes.Query {
+	queries := []icqtypes.Query{}
+	for _, query := range k.InterchainQueryKeeper.AllQueries(ctx) {
+		if query.ChainId == hostZone.ChainId {
+			queries = append(queries, query)
+		}
+	}
+	return queries
+}
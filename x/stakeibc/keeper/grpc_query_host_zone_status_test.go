@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
+	icqtypes "github.com/Stride-Labs/stride/x/interchainquery/types"
+	recordtypes "github.com/Stride-Labs/stride/x/records/types"
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) TestHostZoneStatus_Successful() {
+	tc := s.SetupICARecovery()
+
+	for _, depositRecord := range []recordtypes.DepositRecord{
+		{HostZoneId: HostChainId, Amount: sdk.NewInt(1_000), Status: recordtypes.DepositRecord_TRANSFER_QUEUE},
+		{HostZoneId: HostChainId, Amount: sdk.NewInt(2_000), Status: recordtypes.DepositRecord_TRANSFER_QUEUE},
+		{HostZoneId: HostChainId, Amount: sdk.NewInt(3_000), Status: recordtypes.DepositRecord_DELEGATION_IN_PROGRESS},
+		{HostZoneId: OsmoChainId, Amount: sdk.NewInt(4_000), Status: recordtypes.DepositRecord_TRANSFER_QUEUE},
+	} {
+		s.App.RecordsKeeper.AppendDepositRecord(s.Ctx(), depositRecord)
+	}
+
+	for epochNumber, hostZoneStatus := range []recordtypes.HostZoneUnbonding_Status{
+		recordtypes.HostZoneUnbonding_UNBONDING_QUEUE,
+		recordtypes.HostZoneUnbonding_CLAIMABLE,
+		recordtypes.HostZoneUnbonding_CLAIMABLE,
+	} {
+		s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx(), recordtypes.EpochUnbondingRecord{
+			EpochNumber: uint64(epochNumber),
+			HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+				{HostZoneId: HostChainId, NativeTokenAmount: sdk.NewInt(100), Status: hostZoneStatus},
+				{HostZoneId: OsmoChainId, NativeTokenAmount: sdk.NewInt(500), Status: hostZoneStatus},
+			},
+		})
+	}
+
+	delegationCallback := icacallbackstypes.CallbackData{CallbackKey: "key1", PortId: tc.delegationPortId, ChannelId: tc.delegationChannel, Sequence: 1, CallbackId: "delegate"}
+	s.App.IcacallbacksKeeper.SetCallbackData(s.Ctx(), delegationCallback)
+	s.App.IcacallbacksKeeper.SetCallbackData(s.Ctx(), icacallbackstypes.CallbackData{CallbackKey: "key2", PortId: "icacontroller-OSMO.DELEGATION", ChannelId: "channel-9", Sequence: 1})
+
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx(), icqtypes.Query{Id: "query1", ChainId: HostChainId, Period: sdk.ZeroInt(), LastHeight: sdk.ZeroInt()})
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx(), icqtypes.Query{Id: "query2", ChainId: OsmoChainId, Period: sdk.ZeroInt(), LastHeight: sdk.ZeroInt()})
+
+	response, err := s.App.StakeibcKeeper.HostZoneStatus(sdk.WrapSDKContext(s.Ctx()), &types.QueryHostZoneStatusRequest{ChainId: HostChainId})
+	s.Require().NoError(err, "no error expected when querying the host zone status")
+
+	s.Require().Len(response.Accounts, len(types.HostZoneICAAccountTypes), "one entry per ICA account")
+	s.Require().Equal(tc.delegationChannel, response.Accounts[0].ChannelId, "delegation channel")
+	s.Require().True(response.Accounts[0].Open, "delegation channel open")
+
+	s.Require().Equal([]types.RecordStatusSummary{
+		{Status: "TRANSFER_QUEUE", Count: 2, Amount: sdk.NewInt(3_000)},
+		{Status: "TRANSFER_IN_PROGRESS", Count: 0, Amount: sdk.ZeroInt()},
+		{Status: "DELEGATION_QUEUE", Count: 0, Amount: sdk.ZeroInt()},
+		{Status: "DELEGATION_IN_PROGRESS", Count: 1, Amount: sdk.NewInt(3_000)},
+	}, response.DepositRecords, "deposit record summaries")
+
+	s.Require().Equal([]types.RecordStatusSummary{
+		{Status: "UNBONDING_QUEUE", Count: 1, Amount: sdk.NewInt(100)},
+		{Status: "UNBONDING_IN_PROGRESS", Count: 0, Amount: sdk.ZeroInt()},
+		{Status: "EXIT_TRANSFER_QUEUE", Count: 0, Amount: sdk.ZeroInt()},
+		{Status: "EXIT_TRANSFER_IN_PROGRESS", Count: 0, Amount: sdk.ZeroInt()},
+		{Status: "CLAIMABLE", Count: 2, Amount: sdk.NewInt(200)},
+	}, response.UnbondingRecords, "unbonding record summaries")
+
+	s.Require().Equal([]icacallbackstypes.CallbackData{delegationCallback}, response.PendingCallbacks, "pending callbacks")
+
+	s.Require().Len(response.OutstandingQueries, 1, "one outstanding query")
+	s.Require().Equal("query1", response.OutstandingQueries[0].Id, "outstanding query")
+
+	expectedHeight, err := s.App.StakeibcKeeper.GetLightClientHeightSafely(s.Ctx(), tc.hostZone.ConnectionId)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedHeight, response.LightClientHeight, "light client height")
+	s.Require().NotZero(response.LightClientTime, "light client time")
+}
+
+func (s *KeeperTestSuite) TestHostZoneStatus_HostZoneNotFound() {
+	_, err := s.App.StakeibcKeeper.HostZoneStatus(sdk.WrapSDKContext(s.Ctx()), &types.QueryHostZoneStatusRequest{ChainId: "fake_host_zone"})
+	s.Require().ErrorIs(err, status.Error(codes.NotFound, "not found"))
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+func (k Keeper) ICAHealth(c context.Context, req *types.QueryICAHealthRequest) (*types.QueryICAHealthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	hostZone, found := k.GetHostZone(ctx, req.ChainId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	accounts := []types.ICAChannelHealth{}
+	for _, accountType := range types.HostZoneICAAccountTypes {
+		accounts = append(accounts, k.GetICAChannelHealth(ctx, hostZone, accountType))
+	}
+
+	return &types.QueryICAHealthResponse{Accounts: accounts}, nil
+}
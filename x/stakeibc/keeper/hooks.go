@@ -13,14 +13,6 @@ import (
 )
 
 // TODO [TEST-127]: ensure all timeouts are less than the epoch length
-// TODO [TEST-126]: add events from event manager, e.g.
-// ctx.EventManager().EmitEvents(sdk.Events{
-// 	sdk.NewEvent(
-// 		sdk.EventTypeMessage,
-// 		sdk.NewAttribute("hostZone", zoneInfo.ChainId),
-// 		sdk.NewAttribute("newAmountStaked", balance.String()),
-// 	),
-// })
 
 func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInfo) {
 	// every epoch
@@ -34,46 +26,57 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInf
 	k.Logger(ctx).Info(fmt.Sprintf("Handling epoch start %s %d", epochIdentifier, epochNumber))
 	k.Logger(ctx).Info(fmt.Sprintf("Epoch start time %d", epochInfo.GetCurrentEpochStartTime().UnixNano()))
 
-	ns, err := cast.ToUint64E(epochInfo.GetDuration().Nanoseconds())
-	if err != nil {
-		k.Logger(ctx).Error(fmt.Sprintf("Could not convert epoch duration to uint64: %v", err))
-		return
-	}
-	nextEpochStartTime, err := cast.ToUint64E(epochInfo.GetCurrentEpochStartTime().Add(epochInfo.GetDuration()).UnixNano())
-	if err != nil {
-		k.Logger(ctx).Error(fmt.Sprintf("Could not convert epoch duration to uint64: %v", err))
+	// the pipelines read the epoch tracker derived from this epoch, so its next start time must fit in a uint64
+	if _, err := cast.ToUint64E(epochInfo.GetCurrentEpochStartTime().Add(epochInfo.GetDuration()).UnixNano()); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Could not convert next epoch start time to uint64: %v", err))
 		return
 	}
-	epochTracker := types.EpochTracker{
-		EpochIdentifier:    epochIdentifier,
-		EpochNumber:        epochNumber,
-		Duration:           ns,
-		NextEpochStartTime: nextEpochStartTime,
+
+	// each pipeline is driven by the epoch configured in the params
+	params := k.GetParams(ctx)
+
+	// re-open any ICA channels that closed, so the pipelines below can use them once the handshake completes
+	if epochIdentifier == params.IcaRecoveryEpochIdentifier &&
+		params.IcaRecoveryInterval > 0 && epochNumber%params.IcaRecoveryInterval == 0 {
+		k.Logger(ctx).Info("RecoverClosedICAChannels")
+		k.RecoverClosedICAChannels(ctx)
 	}
-	// deposit records *must* exist for this epoch
-	k.Logger(ctx).Info(fmt.Sprintf("Setting epochTracker %v", epochTracker))
-	k.SetEpochTracker(ctx, epochTracker)
+
+	// drop the pending validators whose requirements query went unanswered
+	// each pending validator holds the expiration time of its query, so this isn't tied to a particular epoch
+	k.Logger(ctx).Info("ExpirePendingValidators")
+	k.ExpirePendingValidators(ctx)
 
 	// process redemption records
-	if epochIdentifier == "day" {
+	if epochIdentifier == params.UnbondingEpochIdentifier {
 		// here, we process everything we need to for redemptions
-		k.Logger(ctx).Info(fmt.Sprintf("Day %d Beginning", epochNumber))
+		k.Logger(ctx).Info(fmt.Sprintf("Unbonding Epoch %d Beginning", epochNumber))
 		// first we initiate unbondings from any hostZone where it's appropriate
 		k.Logger(ctx).Info("InitiateAllHostZoneUnbondings")
 		k.InitiateAllHostZoneUnbondings(ctx, epochNumber)
+	}
+	if epochIdentifier == params.SweepEpochIdentifier {
 		// then we check previous epochs to see if unbondings finished, and sweep the tokens if so
 		k.Logger(ctx).Info("SweepAllUnbondedTokens")
 		k.SweepAllUnbondedTokens(ctx)
+	}
+	if epochIdentifier == params.UnbondingEpochIdentifier {
 		// then we cleanup any records that are no longer needed
 		k.Logger(ctx).Info("CleanupEpochUnbondingRecords")
 		k.CleanupEpochUnbondingRecords(ctx, epochNumber)
 		// lastly we create an empty unbonding record for this epoch
 		k.Logger(ctx).Info("CreateEpochUnbondingRecord")
 		k.CreateEpochUnbondingRecord(ctx, epochNumber)
+		// then we queue the remaining stake of winding down host zones on the new record,
+		// and remove the host zones that have finished winding down
+		k.Logger(ctx).Info("StartWindDownUnbondings")
+		k.StartWindDownUnbondings(ctx, epochNumber)
+		k.Logger(ctx).Info("RemoveWoundDownHostZones")
+		k.RemoveWoundDownHostZones(ctx)
 	}
 
-	if epochIdentifier == epochstypes.STRIDE_EPOCH {
-		k.Logger(ctx).Info(fmt.Sprintf("Stride Epoch %d", epochNumber))
+	if epochIdentifier == params.DepositEpochIdentifier {
+		k.Logger(ctx).Info(fmt.Sprintf("Deposit Epoch %d", epochNumber))
 
 		// NOTE: We could nest this under `if epochNumber%depositInterval == 0 {`
 		// -- should we?
@@ -84,77 +87,92 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInf
 		// Create a new deposit record for each host zone for the upcoming epoch
 		k.Logger(ctx).Info("CreateDepositRecordsForEpoch")
 		k.CreateDepositRecordsForEpoch(ctx, epochNumber)
+	}
 
-		k.Logger(ctx).Info("SetWithdrawalAddress")
-		// TODO: move this to an external function that anyone can call, so that we don't have to call it every
-		// epoch
-		k.SetWithdrawalAddress(ctx)
-
-		depositRecords := k.RecordsKeeper.GetAllDepositRecord(ctx)
+	// the remaining pipelines all operate on the deposit records
+	if epochIdentifier != params.RedemptionRateEpochIdentifier &&
+		epochIdentifier != params.DepositEpochIdentifier &&
+		epochIdentifier != params.DelegateEpochIdentifier &&
+		epochIdentifier != params.RebalanceEpochIdentifier &&
+		epochIdentifier != params.ReinvestEpochIdentifier {
+		return
+	}
+	depositRecords := k.GetQueuedDepositRecords(ctx)
+
+	// Each pipeline stage only runs for the host zones that are due this epoch, based on their schedule
+	// Update the redemption rate
+	if epochIdentifier == params.RedemptionRateEpochIdentifier {
+		k.Logger(ctx).Info("Triggering update redemption rate")
+		hostZones := k.GetHostZonesForPipeline(ctx, types.PipelineRedemptionRate, epochNumber)
+		k.UpdateRedemptionRatesForHostZones(ctx, hostZones, depositRecords)
+	}
 
-		// Update the redemption rate
-		redemptionRateInterval, err := cast.ToUint64E(k.GetParam(ctx, types.KeyRedemptionRateInterval))
-		if err != nil {
-			k.Logger(ctx).Error(fmt.Sprintf("Could not convert redemptionRateInterval to uint64: %v", err))
+	// Deposit records are numbered by the deposit epoch, so the transfer and delegation steps
+	// process the records from before the current deposit epoch, even when driven by a different epoch
+	if epochIdentifier == params.DepositEpochIdentifier || epochIdentifier == params.DelegateEpochIdentifier {
+		depositEpochTracker, found := k.GetEpochTracker(ctx, params.DepositEpochIdentifier)
+		if !found {
+			k.Logger(ctx).Error(fmt.Sprintf("Epoch tracker not found for deposit epoch %s", params.DepositEpochIdentifier))
 			return
 		}
-		if epochNumber%redemptionRateInterval == 0 {
-			k.Logger(ctx).Info("Triggering update redemption rate")
-			k.UpdateRedemptionRates(ctx, depositRecords)
-		}
 
-		depositInterval, err := cast.ToUint64E(k.GetParam(ctx, types.KeyDepositInterval))
-		if err != nil {
-			k.Logger(ctx).Error(fmt.Sprintf("Could not convert depositInterval to int64: %v", err))
-			return
-		}
-		if epochNumber%depositInterval == 0 {
+		if epochIdentifier == params.DepositEpochIdentifier {
 			// process previous deposit records
 			k.Logger(ctx).Info("TransferExistingDepositsToHostZones")
-			k.TransferExistingDepositsToHostZones(ctx, epochNumber, depositRecords)
+			hostZones := k.GetHostZonesForPipeline(ctx, types.PipelineDeposit, epochNumber)
+			k.TransferExistingDepositsToHostZones(ctx, depositEpochTracker.EpochNumber, FilterDepositRecordsForHostZones(depositRecords, hostZones))
 		}
 
-		delegationInterval, err := cast.ToUint64E(k.GetParam(ctx, types.KeyDelegateInterval))
-		if err != nil {
-			k.Logger(ctx).Error(fmt.Sprintf("Could not convert delegationInterval to int64: %v", err))
-			return
-		}
-		if epochNumber%delegationInterval == 0 {
+		if epochIdentifier == params.DelegateEpochIdentifier {
 			k.Logger(ctx).Info("StakeExistingDepositsOnHostZones")
-			k.StakeExistingDepositsOnHostZones(ctx, epochNumber, depositRecords)
+			hostZones := k.GetHostZonesForPipeline(ctx, types.PipelineDelegate, epochNumber)
+			k.StakeExistingDepositsOnHostZones(ctx, depositEpochTracker.EpochNumber, FilterDepositRecordsForHostZones(depositRecords, hostZones))
 		}
+	}
 
-		reinvestInterval, err := cast.ToUint64E(k.GetParam(ctx, types.KeyReinvestInterval))
-		if err != nil {
-			k.Logger(ctx).Error(fmt.Sprintf("Could not convert reinvestInterval to int64: %v", err))
-			return
-		}
-		if epochNumber%reinvestInterval == 0 { // allow a few blocks from UpdateUndelegatedBal to avoid conflicts
-			k.Logger(ctx).Info("Reinvesting tokens")
-			for _, hz := range k.GetAllHostZone(ctx) {
-				// only process host zones once withdrawal accounts are registered
-				withdrawalIca := hz.GetWithdrawalAccount()
-				if withdrawalIca != nil {
-					// read clock time on host zone
-					blockTime, err := k.GetLightClientTimeSafely(ctx, hz.ConnectionId)
-					if err != nil {
-						k.Logger(ctx).Error(fmt.Sprintf("Could not find blockTime for host zone %s, err: %s", hz.ConnectionId, err.Error()))
-						continue
-					} else {
-						k.Logger(ctx).Info(fmt.Sprintf("Found blockTime for host zone %s: %d", hz.ConnectionId, blockTime))
-					}
-
-					err = k.UpdateWithdrawalBalance(ctx, hz)
-					if err != nil {
-						k.Logger(ctx).Error(fmt.Sprintf("Error updating withdrawal balance for host zone %s: %s", hz.ConnectionId, err.Error()))
-						continue
-					} else {
-						k.Logger(ctx).Info(fmt.Sprintf("Updated withdrawal balance for host zone %s", hz.ConnectionId))
-					}
-				} else {
-					k.Logger(ctx).Info(fmt.Sprintf("Withdrawal account not registered for host zone %s", hz.ChainId))
-				}
+	if epochIdentifier == params.RebalanceEpochIdentifier {
+		k.Logger(ctx).Info("RebalanceHostZones")
+		k.RebalanceHostZones(ctx, k.GetHostZonesForPipeline(ctx, types.PipelineRebalance, epochNumber))
+	}
+
+	// allow a few blocks from UpdateUndelegatedBal to avoid conflicts
+	if epochIdentifier == params.ReinvestEpochIdentifier {
+		k.ReinvestHostZones(ctx, k.GetHostZonesForPipeline(ctx, types.PipelineReinvest, epochNumber))
+	}
+}
+
+// ReinvestAllHostZones queries the withdrawal balance of each host zone, which triggers the reinvestment of rewards
+func (k Keeper) ReinvestAllHostZones(ctx sdk.Context) {
+	k.ReinvestHostZones(ctx, k.GetAllHostZone(ctx))
+}
+
+// ReinvestHostZones queries the withdrawal balance of the given host zones, which triggers the reinvestment of rewards
+func (k Keeper) ReinvestHostZones(ctx sdk.Context, hostZones []types.HostZone) {
+	k.Logger(ctx).Info("Reinvesting tokens")
+	for _, hz := range hostZones {
+		// only process host zones once withdrawal accounts are registered
+		withdrawalIca := hz.GetWithdrawalAccount()
+		if withdrawalIca != nil {
+			// read clock time on host zone, skipping the reinvestment if the light client is stale
+			blockTime, err := k.GetFreshLightClientTime(ctx, hz, types.PipelineReinvest)
+			if err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("Could not find blockTime for host zone %s, err: %s", hz.ConnectionId, err.Error()))
+				continue
+			} else {
+				k.Logger(ctx).Info(fmt.Sprintf("Found blockTime for host zone %s: %d", hz.ConnectionId, blockTime))
+			}
+
+			err = utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+				return k.UpdateWithdrawalBalance(ctx, hz)
+			})
+			if err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("Error updating withdrawal balance for host zone %s: %s", hz.ConnectionId, err.Error()))
+				continue
+			} else {
+				k.Logger(ctx).Info(fmt.Sprintf("Updated withdrawal balance for host zone %s", hz.ConnectionId))
 			}
+		} else {
+			k.Logger(ctx).Info(fmt.Sprintf("Withdrawal account not registered for host zone %s", hz.ChainId))
 		}
 	}
 }
@@ -164,8 +182,8 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochInfo epochstypes.EpochInfo)
 	epochIdentifier := epochInfo.Identifier
 	epochNumber := epochInfo.CurrentEpoch
 	k.Logger(ctx).Info(fmt.Sprintf("Handling epoch end %s %d", epochIdentifier, epochNumber))
-	if epochIdentifier == "day" {
-		k.Logger(ctx).Info(fmt.Sprintf("Day %d Ending", epochNumber))
+	if epochIdentifier == k.GetEpochIdentifierParam(ctx, types.KeyUnbondingEpochIdentifier) {
+		k.Logger(ctx).Info(fmt.Sprintf("Unbonding Epoch %d Ending", epochNumber))
 	}
 }
 
@@ -190,22 +208,11 @@ func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochInfo epochstypes.EpochInfo) {
 }
 
 // -------------------- helper functions --------------------
-func (k Keeper) SetWithdrawalAddress(ctx sdk.Context) {
-	setWithdrawalAddresses := func(ctx sdk.Context, index int64, zoneInfo types.HostZone) error {
-		k.Logger(ctx).Info(fmt.Sprintf("\tsetting withdrawal address for index %v, zoneInfo %v", index, zoneInfo))
-		err := k.SetWithdrawalAddressOnHost(ctx, zoneInfo)
-		if err != nil {
-			k.Logger(ctx).Error(fmt.Sprintf("Did not set withdrawal address to %s on %s", zoneInfo.GetWithdrawalAccount().GetAddress(), zoneInfo.GetChainId()))
-			k.Logger(ctx).Error(fmt.Sprintf("Withdrawal address setting error: %v", err))
-		} else {
-			k.Logger(ctx).Info(fmt.Sprintf("Successfully set withdrawal address to %s on %s", zoneInfo.GetWithdrawalAccount().GetAddress(), zoneInfo.GetChainId()))
-		}
-		return nil
-	}
-	k.IterateHostZones(ctx, setWithdrawalAddresses)
+func (k Keeper) UpdateRedemptionRates(ctx sdk.Context, depositRecords []recordstypes.DepositRecord) {
+	k.UpdateRedemptionRatesForHostZones(ctx, k.GetAllHostZone(ctx), depositRecords)
 }
 
-func (k Keeper) UpdateRedemptionRates(ctx sdk.Context, depositRecords []recordstypes.DepositRecord) {
+func (k Keeper) UpdateRedemptionRatesForHostZones(ctx sdk.Context, hostZones []types.HostZone, depositRecords []recordstypes.DepositRecord) {
 	// Calc redemptionRate for each host zone
 	UpdateRedemptionRate := func(ctx sdk.Context, index int64, zoneInfo types.HostZone) error {
 		k.Logger(ctx).Info(fmt.Sprintf("index: %d, zoneInfo: %s", index, zoneInfo.ChainId))
@@ -215,29 +222,25 @@ func (k Keeper) UpdateRedemptionRates(ctx sdk.Context, depositRecords []recordst
 			k.Logger(ctx).Error(fmt.Sprintf("Could not get undelegated balance for host zone %s: %s", zoneInfo.ChainId, error.Error()))
 			return error
 		}
-		k.Logger(ctx).Info(fmt.Sprintf("undelegatedBalance: %d", undelegatedBalance))
-		stakedBalance, err := cast.ToInt64E(zoneInfo.GetStakedBal())
-		if err != nil {
-			k.Logger(ctx).Error(fmt.Sprintf("Could not get staked balance for host zone %s: %s", zoneInfo.ChainId, err.Error()))
-			return err
-		}
-		k.Logger(ctx).Info(fmt.Sprintf("stakedBalance: %d", stakedBalance))
+		k.Logger(ctx).Info(fmt.Sprintf("undelegatedBalance: %v", undelegatedBalance))
+		stakedBalance := zoneInfo.StakedBal
+		k.Logger(ctx).Info(fmt.Sprintf("stakedBalance: %v", stakedBalance))
 		moduleAcctBalance, error := k.GetModuleAccountBalance(zoneInfo, depositRecords)
 		if error != nil {
 			k.Logger(ctx).Error(fmt.Sprintf("Could not get module account balance for host zone %s: %s", zoneInfo.ChainId, error.Error()))
 			return error
 		}
-		k.Logger(ctx).Info(fmt.Sprintf("moduleAcctBalance: %d", moduleAcctBalance))
-		stSupply := k.bankKeeper.GetSupply(ctx, types.StAssetDenomFromHostZoneDenom(zoneInfo.HostDenom)).Amount.Int64()
-		if stSupply == 0 {
-			k.Logger(ctx).Info(fmt.Sprintf("stSupply: %d", stSupply))
+		k.Logger(ctx).Info(fmt.Sprintf("moduleAcctBalance: %v", moduleAcctBalance))
+		stSupply := k.bankKeeper.GetSupply(ctx, types.StAssetDenomFromHostZoneDenom(zoneInfo.HostDenom)).Amount
+		if stSupply.IsZero() {
+			k.Logger(ctx).Info(fmt.Sprintf("stSupply: %v", stSupply))
 			return nil
 		}
-		k.Logger(ctx).Info(fmt.Sprintf("stSupply: %d", stSupply))
+		k.Logger(ctx).Info(fmt.Sprintf("stSupply: %v", stSupply))
 
 		// calc redemptionRate = (UB+SB+MA)/stSupply
-		k.Logger(ctx).Info(fmt.Sprintf("[REDEMPTION-RATE] undelegatedBalance: %d, stakedBalance: %d, moduleAcctBalance: %d, stSupply: %d", undelegatedBalance, stakedBalance, moduleAcctBalance, stSupply))
-		redemptionRate := (sdk.NewDec(undelegatedBalance).Add(sdk.NewDec(stakedBalance)).Add(sdk.NewDec(moduleAcctBalance))).Quo(sdk.NewDec(stSupply))
+		k.Logger(ctx).Info(fmt.Sprintf("[REDEMPTION-RATE] undelegatedBalance: %v, stakedBalance: %v, moduleAcctBalance: %v, stSupply: %v", undelegatedBalance, stakedBalance, moduleAcctBalance, stSupply))
+		redemptionRate := undelegatedBalance.Add(stakedBalance).Add(moduleAcctBalance).ToDec().Quo(stSupply.ToDec())
 		k.Logger(ctx).Info(fmt.Sprintf("[REDEMPTION-RATE] New Rate is %d (vs prev %d)", redemptionRate, zoneInfo.LastRedemptionRate))
 
 		// set redemptionRate attribute for the hostZone (and update last RedemptionRate)
@@ -245,37 +248,52 @@ func (k Keeper) UpdateRedemptionRates(ctx sdk.Context, depositRecords []recordst
 		zoneInfo.RedemptionRate = redemptionRate
 		k.SetHostZone(ctx, zoneInfo)
 
+		k.EmitTypedEvent(ctx, &types.EventRedemptionRateUpdate{
+			HostZoneId:   zoneInfo.ChainId,
+			PreviousRate: zoneInfo.LastRedemptionRate,
+			NewRate:      redemptionRate,
+			StSupply:     stSupply,
+		})
+
 		return nil
 	}
-	// Iterate the zones and apply icaReinvest
-	k.IterateHostZones(ctx, UpdateRedemptionRate)
+	// Iterate the zones and update each rate in isolation, so that a failure on one zone doesn't block the others
+	for index, zoneInfo := range hostZones {
+		err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return UpdateRedemptionRate(ctx, int64(index), zoneInfo)
+		})
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to update redemption rate for host zone %s: %s", zoneInfo.ChainId, err.Error()))
+			continue
+		}
+	}
 }
 
-func (k Keeper) GetUndelegatedBalance(hostZone types.HostZone, depositRecords []recordstypes.DepositRecord) (int64, error) {
+func (k Keeper) GetUndelegatedBalance(hostZone types.HostZone, depositRecords []recordstypes.DepositRecord) (sdk.Int, error) {
 	// filter to only the deposit records for the host zone with status DELEGATION_QUEUE
 	UndelegatedDepositRecords := utils.FilterDepositRecords(depositRecords, func(record recordstypes.DepositRecord) (condition bool) {
 		return record.Status == recordstypes.DepositRecord_DELEGATION_QUEUE && record.HostZoneId == hostZone.ChainId
 	})
 
 	// sum the amounts of the deposit records
-	var totalAmount int64
+	totalAmount := sdk.ZeroInt()
 	for _, depositRecord := range UndelegatedDepositRecords {
-		totalAmount += depositRecord.Amount
+		totalAmount = totalAmount.Add(depositRecord.Amount)
 	}
 
 	return totalAmount, nil
 }
 
-func (k Keeper) GetModuleAccountBalance(hostZone types.HostZone, depositRecords []recordstypes.DepositRecord) (int64, error) {
+func (k Keeper) GetModuleAccountBalance(hostZone types.HostZone, depositRecords []recordstypes.DepositRecord) (sdk.Int, error) {
 	// filter to only the deposit records for the host zone with status DELEGATION
 	ModuleAccountRecords := utils.FilterDepositRecords(depositRecords, func(record recordstypes.DepositRecord) (condition bool) {
 		return record.Status == recordstypes.DepositRecord_TRANSFER_QUEUE && record.HostZoneId == hostZone.ChainId
 	})
 
 	// sum the amounts of the deposit records
-	totalAmount := int64(0)
+	totalAmount := sdk.ZeroInt()
 	for _, depositRecord := range ModuleAccountRecords {
-		totalAmount += depositRecord.Amount
+		totalAmount = totalAmount.Add(depositRecord.Amount)
 	}
 
 	return totalAmount, nil
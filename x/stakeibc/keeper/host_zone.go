@@ -9,7 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/spf13/cast"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/Stride-Labs/stride/x/stakeibc/types"
 )
@@ -94,31 +94,16 @@ func (k Keeper) GetAllHostZone(ctx sdk.Context) (list []types.HostZone) {
 	return
 }
 
-func (k Keeper) AddDelegationToValidator(ctx sdk.Context, hostZone types.HostZone, valAddr string, amt int64) (success bool) {
+func (k Keeper) AddDelegationToValidator(ctx sdk.Context, hostZone types.HostZone, valAddr string, amt sdk.Int) (success bool) {
 	for _, val := range hostZone.GetValidators() {
 		if val.GetAddress() == valAddr {
-			k.Logger(ctx).Info(fmt.Sprintf("Validator %s, Current Delegation: %d, Delegation Change: %d", val.GetAddress(), val.GetDelegationAmt(), amt))
-			if amt >= 0 {
-				amt, err := cast.ToUint64E(amt)
-				if err != nil {
-					k.Logger(ctx).Error(fmt.Sprintf("Error converting %d to uint64", amt))
-					return false
-				}
-				val.DelegationAmt = val.GetDelegationAmt() + amt
-				return true
-			} else {
-				absAmt, err := cast.ToUint64E(-amt)
-				if err != nil {
-					k.Logger(ctx).Error(fmt.Sprintf("Error converting %d to uint64", amt))
-					return false
-				}
-				if absAmt > val.GetDelegationAmt() {
-					k.Logger(ctx).Error(fmt.Sprintf("Delegation amount %d is greater than validator %s delegation amount %d", absAmt, valAddr, val.GetDelegationAmt()))
-					return false
-				}
-				val.DelegationAmt = val.GetDelegationAmt() - absAmt
-				return true
+			k.Logger(ctx).Info(fmt.Sprintf("Validator %s, Current Delegation: %v, Delegation Change: %v", val.GetAddress(), val.DelegationAmt, amt))
+			if amt.IsNegative() && amt.Abs().GT(val.DelegationAmt) {
+				k.Logger(ctx).Error(fmt.Sprintf("Delegation amount %v is greater than validator %s delegation amount %v", amt.Abs(), valAddr, val.DelegationAmt))
+				return false
 			}
+			val.DelegationAmt = val.DelegationAmt.Add(amt)
+			return true
 		}
 	}
 	k.Logger(ctx).Error(fmt.Sprintf("Could not find validator %s on host zone %s", valAddr, hostZone.GetChainId()))
@@ -134,26 +119,21 @@ func (k Keeper) AddValidatorToHostZone(ctx sdk.Context, msg *types.MsgAddValidat
 		return sdkerrors.Wrap(types.ErrHostZoneNotFound, errMsg)
 	}
 
-	// Get max number of validators and confirm we won't exceed it
-	err := k.ConfirmValSetHasSpace(ctx, hostZone.Validators)
-	if err != nil {
-		return sdkerrors.Wrap(types.ErrMaxNumValidators, "cannot add validator on host zone")
+	// Confirm there's space for the validator and that it isn't already registered
+	if err := k.ConfirmValidatorCanBeAdded(ctx, hostZone, msg.Name, msg.Address); err != nil {
+		return err
 	}
 
-	// Check that we don't already have this validator
-	// Grab the minimum weight in the process (to assign to validator's added through governance)
+	// Confirm the validator isn't still waiting on its requirements query (an expired query no longer holds it)
+	if pendingValidator, found := k.getPendingValidatorEntry(ctx, hostZone.ChainId, msg.Address); found && !k.ExpirePendingValidator(ctx, pendingValidator) {
+		errMsg := fmt.Sprintf("Validator address (%s) is already pending on Host Zone (%s)", msg.Address, hostZone.ChainId)
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrap(types.ErrValidatorPending, errMsg)
+	}
+
+	// Grab the minimum weight (to assign to validator's added through governance)
 	var minWeight uint64 = math.MaxUint64
 	for _, validator := range hostZone.Validators {
-		if validator.Address == msg.Address {
-			errMsg := fmt.Sprintf("Validator address (%s) already exists on Host Zone (%s)", msg.Address, msg.HostZone)
-			k.Logger(ctx).Error(errMsg)
-			return sdkerrors.Wrap(types.ErrValidatorAlreadyExists, errMsg)
-		}
-		if validator.Name == msg.Name {
-			errMsg := fmt.Sprintf("Validator name (%s) already exists on Host Zone (%s)", msg.Name, msg.HostZone)
-			k.Logger(ctx).Error(errMsg)
-			return sdkerrors.Wrap(types.ErrValidatorAlreadyExists, errMsg)
-		}
 		// Store the min weight to assign to new validator added through governance (ignore zero-weight validators)
 		if validator.Weight < minWeight && validator.Weight > 0 {
 			minWeight = validator.Weight
@@ -166,17 +146,62 @@ func (k Keeper) AddValidatorToHostZone(ctx sdk.Context, msg *types.MsgAddValidat
 		valWeight = minWeight
 	}
 
-	// Finally, add the validator to the host
-	hostZone.Validators = append(hostZone.Validators, &types.Validator{
+	validator := types.Validator{
 		Name:           msg.Name,
 		Address:        msg.Address,
 		Status:         types.Validator_Active,
 		CommissionRate: msg.Commission,
-		DelegationAmt:  0,
+		DelegationAmt:  sdk.ZeroInt(),
 		Weight:         valWeight,
-	})
+	}
 
+	// If min validator requirements are configured, the validator is only admitted after its staking record
+	// on the host has been queried and checked against them (see ValidatorRequirementsCallback)
+	if _, found := k.GetMinValidatorRequirements(ctx); found {
+		return k.QueryValidatorRequirementsIcq(ctx, hostZone, validator)
+	}
+
+	// Otherwise, add the validator to the host
+	hostZone.Validators = append(hostZone.Validators, &validator)
 	k.SetHostZone(ctx, hostZone)
+	k.EmitValidatorAddedEvent(ctx, hostZone.ChainId, validator)
+
+	return nil
+}
+
+// EmitValidatorAddedEvent emits the typed event for a validator that was added to a host zone
+func (k Keeper) EmitValidatorAddedEvent(ctx sdk.Context, chainId string, validator types.Validator) {
+	k.EmitTypedEvent(ctx, &types.EventValidatorAdded{
+		HostZoneId:       chainId,
+		ValidatorName:    validator.Name,
+		ValidatorAddress: validator.Address,
+		Weight:           validator.Weight,
+		CommissionRate:   validator.CommissionRate,
+	})
+}
+
+// ConfirmValidatorCanBeAdded checks that the host zone has space for a new validator
+// and that the validator's name and address are not already registered
+func (k Keeper) ConfirmValidatorCanBeAdded(ctx sdk.Context, hostZone types.HostZone, name string, address string) error {
+	// Get max number of validators and confirm we won't exceed it
+	err := k.ConfirmValSetHasSpace(ctx, hostZone.Validators)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrMaxNumValidators, "cannot add validator on host zone")
+	}
+
+	// Check that we don't already have this validator
+	for _, validator := range hostZone.Validators {
+		if validator.Address == address {
+			errMsg := fmt.Sprintf("Validator address (%s) already exists on Host Zone (%s)", address, hostZone.ChainId)
+			k.Logger(ctx).Error(errMsg)
+			return sdkerrors.Wrap(types.ErrValidatorAlreadyExists, errMsg)
+		}
+		if validator.Name == name {
+			errMsg := fmt.Sprintf("Validator name (%s) already exists on Host Zone (%s)", name, hostZone.ChainId)
+			k.Logger(ctx).Error(errMsg)
+			return sdkerrors.Wrap(types.ErrValidatorAlreadyExists, errMsg)
+		}
+	}
 
 	return nil
 }
@@ -190,12 +215,16 @@ func (k Keeper) RemoveValidatorFromHostZone(ctx sdk.Context, chainId string, val
 	}
 	for i, val := range hostZone.Validators {
 		if val.GetAddress() == validatorAddress {
-			if val.GetDelegationAmt() == 0 && val.GetWeight() == 0 {
+			if val.DelegationAmt.IsZero() && val.GetWeight() == 0 {
 				hostZone.Validators = append(hostZone.Validators[:i], hostZone.Validators[i+1:]...)
 				k.SetHostZone(ctx, hostZone)
+				k.EmitTypedEvent(ctx, &types.EventValidatorRemoved{
+					HostZoneId:       chainId,
+					ValidatorAddress: validatorAddress,
+				})
 				return nil
 			} else {
-				errMsg := fmt.Sprintf("Validator (%s) has non-zero delegation (%d) or weight (%d)", validatorAddress, val.GetDelegationAmt(), val.GetWeight())
+				errMsg := fmt.Sprintf("Validator (%s) has non-zero delegation (%v) or weight (%d)", validatorAddress, val.DelegationAmt, val.GetWeight())
 				k.Logger(ctx).Error(errMsg)
 				return errors.New(errMsg)
 			}
@@ -206,6 +235,105 @@ func (k Keeper) RemoveValidatorFromHostZone(ctx sdk.Context, chainId string, val
 	return sdkerrors.Wrapf(types.ErrValidatorNotFound, errMsg)
 }
 
+// DeleteValidatorFromHostZone removes a validator from a host zone
+// If the validator has no delegations, it is removed right away. Otherwise, the removal is staged:
+// the validator's weight is set to zero, its stake is redelegated across the remaining validators
+// (according to their weights), and it is removed in the rebalance callback once its delegation reaches zero
+// Deleting a validator that's already being removed re-submits the redelegation of its remaining delegation,
+// which picks up any stake left behind by a partial redelegation or a failed/timed out rebalance
+func (k Keeper) DeleteValidatorFromHostZone(ctx sdk.Context, chainId string, validatorAddress string) error {
+	hostZone, found := k.GetHostZone(ctx, chainId)
+	if !found {
+		errMsg := fmt.Sprintf("HostZone (%s) not found", chainId)
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(types.ErrHostZoneNotFound, errMsg)
+	}
+	validator, valIndex, found := GetValidatorFromAddress(hostZone.Validators, validatorAddress)
+	if !found {
+		errMsg := fmt.Sprintf("Validator address (%s) not found on host zone (%s)", validatorAddress, chainId)
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(types.ErrValidatorNotFound, errMsg)
+	}
+
+	// Zero out the validator's weight so that it no longer receives stake
+	hostZone.Validators[valIndex].Weight = 0
+	if validator.Weight != 0 {
+		k.EmitTypedEvent(ctx, &types.EventValidatorWeightChanged{
+			HostZoneId:       chainId,
+			ValidatorAddress: validatorAddress,
+			PreviousWeight:   validator.Weight,
+			NewWeight:        0,
+		})
+	}
+
+	// If there's nothing delegated to the validator, it can be removed immediately
+	if validator.DelegationAmt.IsZero() {
+		k.SetHostZone(ctx, hostZone)
+		return k.RemoveValidatorFromHostZone(ctx, chainId, validatorAddress)
+	}
+
+	hostZone.Validators[valIndex].Status = types.Validator_Removing
+	k.SetHostZone(ctx, hostZone)
+
+	// Redelegate the validator's stake to the remaining validators
+	return k.RedelegateFromRemovedValidator(ctx, hostZone, validator)
+}
+
+// RedelegateFromRemovedValidator submits an ICA tx to redelegate the full delegation of a validator that's being removed
+// across the remaining validators, according to their weights
+// The rebalance callback updates each validator's delegation and removes the validator once its delegation is zero
+func (k Keeper) RedelegateFromRemovedValidator(ctx sdk.Context, hostZone types.HostZone, removedValidator types.Validator) error {
+	// Split the validator's delegation across the remaining validators
+	// Since the removed validator has a weight of zero, none of the stake is allocated back to it
+	targetAmts, err := k.GetTargetValAmtsForHostZone(ctx, hostZone, removedValidator.DelegationAmt)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to redelegate from validator %s, no remaining validators with a non-zero weight on %s", removedValidator.Address, hostZone.ChainId)
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrap(err, errMsg)
+	}
+
+	validatorDeltas := make(map[string]sdk.Int)
+	for _, validator := range hostZone.Validators {
+		if validator.Address == removedValidator.Address {
+			continue
+		}
+		validatorDeltas[validator.Address] = targetAmts[validator.Address]
+	}
+	validatorDeltas[removedValidator.Address] = removedValidator.DelegationAmt.Neg()
+
+	// Any stake that can't be redelegated yet (due to the host's redelegation limits) is picked up when the deletion is retried
+	// Any stake with a redelegation still pending (e.g. from a previous deletion attempt) is netted out of the plan
+	rebalancings, err := k.PlanRedelegations(ctx, hostZone, validatorDeltas, len(hostZone.Validators))
+	if err != nil {
+		return err
+	}
+	if len(rebalancings) == 0 {
+		errMsg := fmt.Sprintf("Unable to redelegate from validator %s on %s, all redelegations are blocked by in-flight redelegations", removedValidator.Address, hostZone.ChainId)
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(types.ErrNoRedelegationsPossible, errMsg)
+	}
+
+	return k.SubmitRebalancings(ctx, hostZone, rebalancings)
+}
+
+// SetZoneModuleAccount creates and saves the host zone's module account to the account keeper,
+// unless the module account already exists (e.g. when it was imported from genesis), and returns its address
+func (k Keeper) SetZoneModuleAccount(ctx sdk.Context, chainId string) sdk.AccAddress {
+	zoneAddress := types.NewZoneAddress(chainId)
+	if _, isModuleAccount := k.accountKeeper.GetAccount(ctx, zoneAddress).(authtypes.ModuleAccountI); isModuleAccount {
+		return zoneAddress
+	}
+	acc := k.accountKeeper.NewAccount(
+		ctx,
+		authtypes.NewModuleAccount(
+			authtypes.NewBaseAccountWithAddress(zoneAddress),
+			zoneAddress.String(),
+		),
+	)
+	k.accountKeeper.SetAccount(ctx, acc)
+	return zoneAddress
+}
+
 // GetHostZoneFromIBCDenom returns a HostZone from a IBCDenom
 func (k Keeper) GetHostZoneFromIBCDenom(ctx sdk.Context, denom string) (*types.HostZone, error) {
 	var matchZone types.HostZone
@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+
+	"github.com/Stride-Labs/stride/utils"
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+// SetICARecovery stores the recovery of a host zone's closed ICA channel
+func (k Keeper) SetICARecovery(ctx sdk.Context, recovery types.ICARecovery) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ICARecoveryKeyPrefix))
+	b := k.cdc.MustMarshal(&recovery)
+	store.Set(types.ICARecoveryKey(recovery.ChainId, recovery.AccountType), b)
+}
+
+// GetICARecovery returns the recovery of a host zone's closed ICA channel
+func (k Keeper) GetICARecovery(ctx sdk.Context, chainId string, accountType types.ICAAccountType) (val types.ICARecovery, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ICARecoveryKeyPrefix))
+	b := store.Get(types.ICARecoveryKey(chainId, accountType))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveICARecovery removes the recovery of a host zone's ICA channel
+func (k Keeper) RemoveICARecovery(ctx sdk.Context, chainId string, accountType types.ICAAccountType) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ICARecoveryKeyPrefix))
+	store.Delete(types.ICARecoveryKey(chainId, accountType))
+}
+
+// GetAllICARecoveries returns the recoveries of every closed ICA channel
+func (k Keeper) GetAllICARecoveries(ctx sdk.Context) (list []types.ICARecovery) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ICARecoveryKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ICARecovery
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetICAChannel returns the port and active channel behind a host zone's ICA account
+// The active channel stays set after the channel closes, until a new channel finishes its handshake
+func (k Keeper) GetICAChannel(
+	ctx sdk.Context,
+	hostZone types.HostZone,
+	accountType types.ICAAccountType,
+) (portId string, channelId string, channel channeltypes.Channel, found bool) {
+	portId, err := icatypes.NewControllerPortID(types.FormatICAAccountOwner(hostZone.ChainId, accountType))
+	if err != nil {
+		return "", "", channel, false
+	}
+	channelId, found = k.ICAControllerKeeper.GetActiveChannelID(ctx, hostZone.ConnectionId, portId)
+	if !found {
+		return portId, "", channel, false
+	}
+	channel, found = k.IBCKeeper.ChannelKeeper.GetChannel(ctx, portId, channelId)
+	return portId, channelId, channel, found
+}
+
+// IsICAChannelOpen returns whether the channel behind a host zone's ICA account is open
+func (k Keeper) IsICAChannelOpen(ctx sdk.Context, hostZone types.HostZone, accountType types.ICAAccountType) bool {
+	_, _, channel, found := k.GetICAChannel(ctx, hostZone, accountType)
+	return found && channel.State == channeltypes.OPEN
+}
+
+// GetICAChannelHealth returns the state of the channel behind a host zone's ICA account,
+// along with the recovery in progress if the channel closed
+func (k Keeper) GetICAChannelHealth(ctx sdk.Context, hostZone types.HostZone, accountType types.ICAAccountType) types.ICAChannelHealth {
+	health := types.ICAChannelHealth{AccountType: accountType}
+	if account := hostZone.GetICAAccount(accountType); account != nil {
+		health.Address = account.Address
+	}
+
+	portId, channelId, channel, found := k.GetICAChannel(ctx, hostZone, accountType)
+	health.PortId = portId
+	health.ChannelId = channelId
+	if found {
+		health.ChannelState = channel.State.String()
+		health.Open = channel.State == channeltypes.OPEN
+	}
+
+	if recovery, found := k.GetICARecovery(ctx, hostZone.ChainId, accountType); found {
+		health.Recovery = &recovery
+	}
+	return health
+}
+
+// RestoreICAChannel opens a new channel for a host zone's ICA account, whose previous channel closed
+// The account keeps its address, and the new channel becomes active once a relayer completes the handshake
+func (k Keeper) RestoreICAChannel(ctx sdk.Context, hostZone types.HostZone, accountType types.ICAAccountType) error {
+	_, closedChannelId, _, _ := k.GetICAChannel(ctx, hostZone, accountType)
+
+	// the new channel takes the next channel identifier
+	pendingChannelId := channeltypes.FormatChannelIdentifier(k.IBCKeeper.ChannelKeeper.GetNextChannelSequence(ctx))
+
+	owner := types.FormatICAAccountOwner(hostZone.ChainId, accountType)
+	if err := k.ICAControllerKeeper.RegisterInterchainAccount(ctx, hostZone.ConnectionId, owner); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("unable to re-open %s ICA channel for %s: %s", accountType.String(), hostZone.ChainId, err.Error()))
+		return err
+	}
+
+	recovery, found := k.GetICARecovery(ctx, hostZone.ChainId, accountType)
+	if !found {
+		recovery = types.ICARecovery{
+			ChainId:     hostZone.ChainId,
+			AccountType: accountType,
+		}
+	}
+	recovery.ClosedChannelId = closedChannelId
+	recovery.PendingChannelId = pendingChannelId
+	recovery.Attempts++
+	recovery.LastAttemptHeight = ctx.BlockHeight()
+	k.SetICARecovery(ctx, recovery)
+
+	k.Logger(ctx).Info(fmt.Sprintf("Re-opening %s ICA channel %s for %s on %s (attempt %d)",
+		accountType.String(), closedChannelId, hostZone.ChainId, pendingChannelId, recovery.Attempts))
+	k.EmitTypedEvent(ctx, &types.EventICAChannelRecovery{
+		HostZoneId:      hostZone.ChainId,
+		AccountType:     accountType.String(),
+		ClosedChannelId: closedChannelId,
+		ChannelId:       pendingChannelId,
+		Attempt:         recovery.Attempts,
+	})
+	return nil
+}
+
+// RecoverICAChannel re-opens the channel behind a host zone's ICA account if it closed
+// If a previous attempt is still waiting on its handshake, it's left to complete instead
+func (k Keeper) RecoverICAChannel(ctx sdk.Context, hostZone types.HostZone, accountType types.ICAAccountType) error {
+	portId, channelId, channel, found := k.GetICAChannel(ctx, hostZone, accountType)
+	if !found {
+		errMsg := fmt.Sprintf("no active %s ICA channel found for %s", accountType.String(), hostZone.ChainId)
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(types.ErrICAChannelClosed, errMsg)
+	}
+	if channel.State == channeltypes.OPEN {
+		return nil
+	}
+
+	recovery, found := k.GetICARecovery(ctx, hostZone.ChainId, accountType)
+	if found && recovery.PendingChannelId != "" {
+		pendingChannel, found := k.IBCKeeper.ChannelKeeper.GetChannel(ctx, portId, recovery.PendingChannelId)
+		if found && (pendingChannel.State == channeltypes.INIT || pendingChannel.State == channeltypes.TRYOPEN) {
+			k.Logger(ctx).Info(fmt.Sprintf("Waiting on the handshake of %s to replace %s ICA channel %s for %s",
+				recovery.PendingChannelId, accountType.String(), channelId, hostZone.ChainId))
+			return nil
+		}
+	}
+
+	return k.RestoreICAChannel(ctx, hostZone, accountType)
+}
+
+// RecoverClosedICAChannels re-opens every closed ICA channel
+func (k Keeper) RecoverClosedICAChannels(ctx sdk.Context) {
+	for _, hostZone := range k.GetAllHostZone(ctx) {
+		for _, accountType := range types.HostZoneICAAccountTypes {
+			// accounts that were never registered have no channel to recover
+			if hostZone.GetICAAccount(accountType) == nil {
+				continue
+			}
+			err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+				return k.RecoverICAChannel(ctx, hostZone, accountType)
+			})
+			if err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("Unable to recover %s ICA channel for %s: %s", accountType.String(), hostZone.ChainId, err.Error()))
+			}
+		}
+	}
+}
+
+// CompleteICARecovery clears the recovery of a host zone's ICA channel once its new channel is open
+func (k Keeper) CompleteICARecovery(ctx sdk.Context, chainId string, accountType types.ICAAccountType, channelId string) (restored bool) {
+	recovery, found := k.GetICARecovery(ctx, chainId, accountType)
+	if !found {
+		return false
+	}
+	k.RemoveICARecovery(ctx, chainId, accountType)
+
+	k.Logger(ctx).Info(fmt.Sprintf("Restored %s ICA channel for %s on %s after %d attempt(s)",
+		accountType.String(), chainId, channelId, recovery.Attempts))
+	k.EmitTypedEvent(ctx, &types.EventICAChannelRestored{
+		HostZoneId:      chainId,
+		AccountType:     accountType.String(),
+		ClosedChannelId: recovery.ClosedChannelId,
+		ChannelId:       channelId,
+		Attempts:        recovery.Attempts,
+	})
+	return true
+}
@@ -0,0 +1,269 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	_ "github.com/stretchr/testify/suite"
+
+	"github.com/Stride-Labs/stride/app/apptesting"
+	stakeibctypes "github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+type ICARecoveryTestCase struct {
+	hostZone           stakeibctypes.HostZone
+	delegationPortId   string
+	delegationChannel  string
+	delegationAccount  string
+	nextChannelId      string
+	withdrawalChannel  string
+	withdrawalPortId   string
+	withdrawalAccount  string
+	hostDelegationPort string
+}
+
+func (s *KeeperTestSuite) SetupICARecovery() ICARecoveryTestCase {
+	delegationOwner := stakeibctypes.FormatICAAccountOwner(HostChainId, stakeibctypes.ICAAccountType_DELEGATION)
+	delegationChannelId := s.CreateICAChannel(delegationOwner)
+	delegationPortId, err := icatypes.NewControllerPortID(delegationOwner)
+	s.Require().NoError(err)
+
+	withdrawalOwner := stakeibctypes.FormatICAAccountOwner(HostChainId, stakeibctypes.ICAAccountType_WITHDRAWAL)
+	withdrawalChannelId := s.CreateICAChannel(withdrawalOwner)
+	withdrawalPortId, err := icatypes.NewControllerPortID(withdrawalOwner)
+	s.Require().NoError(err)
+
+	// Only the delegation and withdrawal accounts are registered
+	hostZone := stakeibctypes.HostZone{
+		ChainId:           HostChainId,
+		ConnectionId:      ibctesting.FirstConnectionID,
+		RedemptionRate:    sdk.OneDec(),
+		DelegationAccount: &stakeibctypes.ICAAccount{Address: s.IcaAddresses[delegationOwner], Target: stakeibctypes.ICAAccountType_DELEGATION},
+		WithdrawalAccount: &stakeibctypes.ICAAccount{Address: s.IcaAddresses[withdrawalOwner], Target: stakeibctypes.ICAAccountType_WITHDRAWAL},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), hostZone)
+
+	return ICARecoveryTestCase{
+		hostZone:          hostZone,
+		delegationPortId:  delegationPortId,
+		delegationChannel: delegationChannelId,
+		delegationAccount: s.IcaAddresses[delegationOwner],
+		nextChannelId:     channeltypes.FormatChannelIdentifier(s.App.IBCKeeper.ChannelKeeper.GetNextChannelSequence(s.Ctx())),
+		withdrawalChannel: withdrawalChannelId,
+		withdrawalPortId:  withdrawalPortId,
+		withdrawalAccount: s.IcaAddresses[withdrawalOwner],
+	}
+}
+
+// Closes a channel on stride, as happens when a packet on the ordered ICA channel times out
+func (s *KeeperTestSuite) closeChannel(portId string, channelId string) {
+	channel, found := s.App.IBCKeeper.ChannelKeeper.GetChannel(s.Ctx(), portId, channelId)
+	s.Require().True(found, "channel %s should exist", channelId)
+	channel.State = channeltypes.CLOSED
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx(), portId, channelId, channel)
+}
+
+// Returns the number of channels bound to a port
+func (s *KeeperTestSuite) countChannelsOnPort(portId string) int {
+	count := 0
+	for _, channel := range s.App.IBCKeeper.ChannelKeeper.GetAllChannels(s.Ctx()) {
+		if channel.PortId == portId {
+			count++
+		}
+	}
+	return count
+}
+
+func (s *KeeperTestSuite) TestRecoverClosedICAChannels_ReopensClosedChannel() {
+	tc := s.SetupICARecovery()
+	s.closeChannel(tc.delegationPortId, tc.delegationChannel)
+	s.Require().False(s.App.StakeibcKeeper.IsICAChannelOpen(s.Ctx(), tc.hostZone, stakeibctypes.ICAAccountType_DELEGATION))
+
+	ctx := s.Ctx().WithEventManager(sdk.NewEventManager())
+	s.App.StakeibcKeeper.RecoverClosedICAChannels(ctx)
+
+	// A new channel should be opened on the delegation port
+	newChannel, found := s.App.IBCKeeper.ChannelKeeper.GetChannel(s.Ctx(), tc.delegationPortId, tc.nextChannelId)
+	s.Require().True(found, "new delegation channel should exist")
+	s.Require().Equal(channeltypes.INIT, newChannel.State, "new channel state")
+	s.Require().Equal(2, s.countChannelsOnPort(tc.delegationPortId), "channels on the delegation port")
+
+	// The open withdrawal channel should be left alone
+	s.Require().Equal(1, s.countChannelsOnPort(tc.withdrawalPortId), "channels on the withdrawal port")
+
+	recovery, found := s.App.StakeibcKeeper.GetICARecovery(s.Ctx(), HostChainId, stakeibctypes.ICAAccountType_DELEGATION)
+	s.Require().True(found, "recovery should be recorded")
+	s.Require().Equal(stakeibctypes.ICARecovery{
+		ChainId:           HostChainId,
+		AccountType:       stakeibctypes.ICAAccountType_DELEGATION,
+		ClosedChannelId:   tc.delegationChannel,
+		PendingChannelId:  tc.nextChannelId,
+		Attempts:          1,
+		LastAttemptHeight: s.Ctx().BlockHeight(),
+	}, recovery)
+
+	_, found = s.App.StakeibcKeeper.GetICARecovery(s.Ctx(), HostChainId, stakeibctypes.ICAAccountType_WITHDRAWAL)
+	s.Require().False(found, "no recovery for the withdrawal account")
+
+	s.CheckTypedEventEmitted(ctx, &stakeibctypes.EventICAChannelRecovery{
+		HostZoneId:      HostChainId,
+		AccountType:     stakeibctypes.ICAAccountType_DELEGATION.String(),
+		ClosedChannelId: tc.delegationChannel,
+		ChannelId:       tc.nextChannelId,
+		Attempt:         1,
+	})
+}
+
+func (s *KeeperTestSuite) TestRecoverClosedICAChannels_WaitsOnPendingHandshake() {
+	tc := s.SetupICARecovery()
+	s.closeChannel(tc.delegationPortId, tc.delegationChannel)
+
+	// The second attempt should wait on the handshake of the first
+	s.App.StakeibcKeeper.RecoverClosedICAChannels(s.Ctx())
+	s.App.StakeibcKeeper.RecoverClosedICAChannels(s.Ctx())
+
+	s.Require().Equal(2, s.countChannelsOnPort(tc.delegationPortId), "channels on the delegation port")
+	recovery, found := s.App.StakeibcKeeper.GetICARecovery(s.Ctx(), HostChainId, stakeibctypes.ICAAccountType_DELEGATION)
+	s.Require().True(found, "recovery should be recorded")
+	s.Require().Equal(uint64(1), recovery.Attempts, "attempts")
+}
+
+func (s *KeeperTestSuite) TestRecoverClosedICAChannels_RetriesFailedHandshake() {
+	tc := s.SetupICARecovery()
+	s.closeChannel(tc.delegationPortId, tc.delegationChannel)
+	s.App.StakeibcKeeper.RecoverClosedICAChannels(s.Ctx())
+
+	// If the handshake of the first attempt fails, the next attempt opens another channel
+	s.closeChannel(tc.delegationPortId, tc.nextChannelId)
+	s.App.StakeibcKeeper.RecoverClosedICAChannels(s.Ctx())
+
+	s.Require().Equal(3, s.countChannelsOnPort(tc.delegationPortId), "channels on the delegation port")
+	recovery, found := s.App.StakeibcKeeper.GetICARecovery(s.Ctx(), HostChainId, stakeibctypes.ICAAccountType_DELEGATION)
+	s.Require().True(found, "recovery should be recorded")
+	s.Require().Equal(uint64(2), recovery.Attempts, "attempts")
+	s.Require().NotEqual(tc.nextChannelId, recovery.PendingChannelId, "pending channel should be the newest channel")
+}
+
+func (s *KeeperTestSuite) TestRecoverClosedICAChannels_NoClosedChannels() {
+	tc := s.SetupICARecovery()
+
+	s.App.StakeibcKeeper.RecoverClosedICAChannels(s.Ctx())
+
+	s.Require().Equal(1, s.countChannelsOnPort(tc.delegationPortId), "channels on the delegation port")
+	s.Require().Equal(1, s.countChannelsOnPort(tc.withdrawalPortId), "channels on the withdrawal port")
+	s.Require().Empty(s.App.StakeibcKeeper.GetAllICARecoveries(s.Ctx()), "no recoveries")
+}
+
+func (s *KeeperTestSuite) TestRecoverClosedICAChannels_HandshakeCompletesRecovery() {
+	tc := s.SetupICARecovery()
+
+	// Close the channel on both ends, as happens after a timeout
+	s.closeChannel(tc.delegationPortId, tc.delegationChannel)
+	hostChannel, found := s.App.IBCKeeper.ChannelKeeper.GetChannel(s.Ctx(), tc.delegationPortId, tc.delegationChannel)
+	s.Require().True(found)
+	hostChannelId := hostChannel.Counterparty.ChannelId
+	channel, found := s.HostApp.IBCKeeper.ChannelKeeper.GetChannel(s.HostCtx(), icatypes.PortID, hostChannelId)
+	s.Require().True(found, "host channel should exist")
+	channel.State = channeltypes.CLOSED
+	s.HostApp.IBCKeeper.ChannelKeeper.SetChannel(s.HostCtx(), icatypes.PortID, hostChannelId, channel)
+
+	s.App.StakeibcKeeper.RecoverClosedICAChannels(s.Ctx())
+	s.StrideChain.App.Commit()
+	s.StrideChain.NextBlock()
+
+	// Complete the handshake for the new channel
+	icaPath := apptesting.NewIcaPath(s.StrideChain, s.HostChain)
+	icaPath = apptesting.CopyConnectionAndClientToPath(icaPath, s.TransferPath)
+	icaPath.EndpointA.ChannelID = tc.nextChannelId
+	icaPath.EndpointA.ChannelConfig.PortID = tc.delegationPortId
+
+	s.Require().NoError(icaPath.EndpointB.ChanOpenTry(), "ChanOpenTry error")
+	s.Require().NoError(icaPath.EndpointA.ChanOpenAck(), "ChanOpenAck error")
+	s.Require().NoError(icaPath.EndpointB.ChanOpenConfirm(), "ChanOpenConfirm error")
+
+	// The account should be usable again, under the same address
+	s.Require().True(s.App.StakeibcKeeper.IsICAChannelOpen(s.Ctx(), tc.hostZone, stakeibctypes.ICAAccountType_DELEGATION), "channel should be open")
+	_, found = s.App.StakeibcKeeper.GetICARecovery(s.Ctx(), HostChainId, stakeibctypes.ICAAccountType_DELEGATION)
+	s.Require().False(found, "recovery should be complete")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
+	s.Require().True(found)
+	s.Require().Equal(tc.delegationAccount, hostZone.DelegationAccount.Address, "delegation account address")
+}
+
+func (s *KeeperTestSuite) TestCompleteICARecovery() {
+	ctx := s.Ctx()
+	s.App.StakeibcKeeper.SetICARecovery(ctx, stakeibctypes.ICARecovery{
+		ChainId:          HostChainId,
+		AccountType:      stakeibctypes.ICAAccountType_DELEGATION,
+		ClosedChannelId:  "channel-1",
+		PendingChannelId: "channel-2",
+		Attempts:         2,
+	})
+
+	restored := s.App.StakeibcKeeper.CompleteICARecovery(ctx, HostChainId, stakeibctypes.ICAAccountType_DELEGATION, "channel-2")
+	s.Require().True(restored, "recovery should be completed")
+	_, found := s.App.StakeibcKeeper.GetICARecovery(ctx, HostChainId, stakeibctypes.ICAAccountType_DELEGATION)
+	s.Require().False(found, "recovery should be removed")
+
+	s.CheckTypedEventEmitted(ctx, &stakeibctypes.EventICAChannelRestored{
+		HostZoneId:      HostChainId,
+		AccountType:     stakeibctypes.ICAAccountType_DELEGATION.String(),
+		ClosedChannelId: "channel-1",
+		ChannelId:       "channel-2",
+		Attempts:        2,
+	})
+
+	// Without a recovery in progress, there's nothing to complete
+	restored = s.App.StakeibcKeeper.CompleteICARecovery(ctx, HostChainId, stakeibctypes.ICAAccountType_WITHDRAWAL, "channel-3")
+	s.Require().False(restored, "no recovery to complete")
+}
+
+func (s *KeeperTestSuite) TestSubmitTxs_ClosedChannel() {
+	tc := s.SetupICARecovery()
+	s.closeChannel(tc.delegationPortId, tc.delegationChannel)
+
+	// Records are held while the channel is closed, rather than sent into a closed channel
+	msgs := []sdk.Msg{}
+	_, err := s.App.StakeibcKeeper.SubmitTxs(s.Ctx(), tc.hostZone.ConnectionId, msgs, *tc.hostZone.DelegationAccount, 0, "", nil)
+	s.Require().ErrorIs(err, stakeibctypes.ErrICAChannelClosed)
+}
+
+func (s *KeeperTestSuite) TestQueryICAHealth() {
+	tc := s.SetupICARecovery()
+	s.closeChannel(tc.delegationPortId, tc.delegationChannel)
+	s.App.StakeibcKeeper.RecoverClosedICAChannels(s.Ctx())
+
+	res, err := s.App.StakeibcKeeper.ICAHealth(sdk.WrapSDKContext(s.Ctx()), &stakeibctypes.QueryICAHealthRequest{ChainId: HostChainId})
+	s.Require().NoError(err)
+	s.Require().Len(res.Accounts, 4, "one entry per account type")
+
+	healthByType := map[stakeibctypes.ICAAccountType]stakeibctypes.ICAChannelHealth{}
+	for _, health := range res.Accounts {
+		healthByType[health.AccountType] = health
+	}
+
+	delegation := healthByType[stakeibctypes.ICAAccountType_DELEGATION]
+	s.Require().Equal(tc.delegationAccount, delegation.Address, "delegation address")
+	s.Require().Equal(tc.delegationChannel, delegation.ChannelId, "delegation channel")
+	s.Require().Equal(channeltypes.CLOSED.String(), delegation.ChannelState, "delegation channel state")
+	s.Require().False(delegation.Open, "delegation channel open")
+	s.Require().NotNil(delegation.Recovery, "delegation recovery")
+	s.Require().Equal(tc.nextChannelId, delegation.Recovery.PendingChannelId, "delegation pending channel")
+
+	withdrawal := healthByType[stakeibctypes.ICAAccountType_WITHDRAWAL]
+	s.Require().Equal(tc.withdrawalAccount, withdrawal.Address, "withdrawal address")
+	s.Require().Equal(tc.withdrawalChannel, withdrawal.ChannelId, "withdrawal channel")
+	s.Require().True(withdrawal.Open, "withdrawal channel open")
+	s.Require().Nil(withdrawal.Recovery, "withdrawal recovery")
+
+	// The fee account was never registered
+	fee := healthByType[stakeibctypes.ICAAccountType_FEE]
+	s.Require().Empty(fee.Address, "fee address")
+	s.Require().Empty(fee.ChannelId, "fee channel")
+	s.Require().False(fee.Open, "fee channel open")
+
+	_, err = s.App.StakeibcKeeper.ICAHealth(sdk.WrapSDKContext(s.Ctx()), &stakeibctypes.QueryICAHealthRequest{ChainId: "fake_chain"})
+	s.Require().ErrorContains(err, "not found")
+}
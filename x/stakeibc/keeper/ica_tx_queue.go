@@ -0,0 +1,282 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+
+	"github.com/Stride-Labs/stride/utils"
+	epochstypes "github.com/Stride-Labs/stride/x/epochs/types"
+	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
+	recordstypes "github.com/Stride-Labs/stride/x/records/types"
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+// GetQueuedICATxCount returns the counter used to assign queued ICA tx ids
+func (k Keeper) GetQueuedICATxCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	bz := store.Get(types.KeyPrefix(types.QueuedICATxCountKey))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetQueuedICATxCount sets the counter used to assign queued ICA tx ids
+func (k Keeper) SetQueuedICATxCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	store.Set(types.KeyPrefix(types.QueuedICATxCountKey), sdk.Uint64ToBigEndian(count))
+}
+
+// SetQueuedICATx stores a tx queued for one of a host zone's ICA accounts
+func (k Keeper) SetQueuedICATx(ctx sdk.Context, queuedTx types.QueuedICATx) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.QueuedICATxKeyPrefix))
+	b := k.cdc.MustMarshal(&queuedTx)
+	store.Set(types.QueuedICATxKey(queuedTx.ChainId, queuedTx.AccountType, queuedTx.Id), b)
+}
+
+// RemoveQueuedICATx removes a queued tx once it's been sent
+func (k Keeper) RemoveQueuedICATx(ctx sdk.Context, chainId string, accountType types.ICAAccountType, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.QueuedICATxKeyPrefix))
+	store.Delete(types.QueuedICATxKey(chainId, accountType, id))
+}
+
+// GetICATxQueue returns the txs queued for one of a host zone's ICA accounts, in the order they were queued
+func (k Keeper) GetICATxQueue(ctx sdk.Context, chainId string, accountType types.ICAAccountType) (list []types.QueuedICATx) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.QueuedICATxKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.ICATxQueueKey(chainId, accountType))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.QueuedICATx
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllQueuedICATxs returns the txs queued for every ICA account
+func (k Keeper) GetAllQueuedICATxs(ctx sdk.Context) (list []types.QueuedICATx) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.QueuedICATxKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.QueuedICATx
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// QueueTxs queues messages to be sent from a host zone's ICA account
+// The queue of each account is coalesced into a single packet at the end of the block, which
+// times out with the earliest of its txs' epochs
+func (k Keeper) QueueTxs(
+	ctx sdk.Context,
+	connectionId string,
+	msgs []sdk.Msg,
+	account types.ICAAccount,
+	epochType string,
+	callbackId string,
+	callbackArgs []byte,
+) error {
+	return k.QueueTxsWithRecordUpdates(ctx, connectionId, msgs, account, epochType, callbackId, callbackArgs, nil)
+}
+
+// QueueTxsWithRecordUpdates queues messages along with the record status updates they drive
+// The updates are applied right away, so the records aren't picked up again while the tx is queued, but their
+// transitions are only written to the record history once the packet is sent, so that they trace back to it
+func (k Keeper) QueueTxsWithRecordUpdates(
+	ctx sdk.Context,
+	connectionId string,
+	msgs []sdk.Msg,
+	account types.ICAAccount,
+	epochType string,
+	callbackId string,
+	callbackArgs []byte,
+	updateRecords func(ctx sdk.Context) error,
+) error {
+	chainId, err := k.GetChainID(ctx, connectionId)
+	if err != nil {
+		return err
+	}
+
+	// confirm the timeout can be derived from the epoch before accepting the tx
+	if _, err := k.GetICATimeoutNanos(ctx, epochType); err != nil {
+		errMsg := fmt.Sprintf("Failed to get ICA timeout nanos for epochType %s using param, error: %s", epochType, err.Error())
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, errMsg)
+	}
+
+	var anyMsgs []*codectypes.Any
+	for _, msg := range msgs {
+		anyMsg, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return err
+		}
+		anyMsgs = append(anyMsgs, anyMsg)
+	}
+
+	transitions := []recordstypes.RecordStatusTransition{}
+	if updateRecords != nil {
+		if err := utils.ApplyFuncIfNoError(recordstypes.WithDeferredTransitions(ctx, &transitions), updateRecords); err != nil {
+			return err
+		}
+	}
+
+	id := k.GetQueuedICATxCount(ctx)
+	k.SetQueuedICATx(ctx, types.QueuedICATx{
+		ChainId:                 chainId,
+		AccountType:             account.Target,
+		Id:                      id,
+		Msgs:                    anyMsgs,
+		EpochIdentifier:         epochType,
+		CallbackId:              callbackId,
+		CallbackArgs:            callbackArgs,
+		RecordStatusTransitions: transitions,
+	})
+	k.SetQueuedICATxCount(ctx, id+1)
+
+	k.Logger(ctx).Info(fmt.Sprintf("Queued %d msgs for the %s ICA on %s, id: %d, callback: %s", len(msgs), account.Target.String(), chainId, id, callbackId))
+	return nil
+}
+
+func (k Keeper) QueueTxsStrideEpoch(
+	ctx sdk.Context,
+	connectionId string,
+	msgs []sdk.Msg,
+	account types.ICAAccount,
+	callbackId string,
+	callbackArgs []byte,
+) error {
+	k.Logger(ctx).Info(fmt.Sprintf("QueueTxsStrideEpoch %v", msgs))
+	return k.QueueTxs(ctx, connectionId, msgs, account, epochstypes.STRIDE_EPOCH, callbackId, callbackArgs)
+}
+
+// FlushICATxQueues sends the txs queued for each ICA account, coalescing each queue into a single packet
+// Txs are coalesced until the packet reaches MaxIcaMsgsPerPacket msgs, and the rest stay queued for the next block
+// (MaxStakeIcaCallsPerEpoch already bounds how many delegation txs are queued each epoch, before they get here)
+// If a queue can't be sent (e.g. its channel is closed), it's held until the next block
+func (k Keeper) FlushICATxQueues(ctx sdk.Context) {
+	maxMsgsPerPacket := k.GetParam(ctx, types.KeyMaxICAMsgsPerPacket)
+
+	for _, hostZone := range k.GetAllHostZone(ctx) {
+		for _, accountType := range types.HostZoneICAAccountTypes {
+			queue := k.GetICATxQueue(ctx, hostZone.ChainId, accountType)
+			if len(queue) == 0 {
+				continue
+			}
+			queue = capQueuedTxsByMsgs(queue, maxMsgsPerPacket)
+
+			err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+				return k.SubmitQueuedTxs(ctx, hostZone, accountType, queue)
+			})
+			if err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("Holding %d queued txs for the %s ICA on %s | err: %s", len(queue), accountType.String(), hostZone.ChainId, err.Error()))
+				continue
+			}
+		}
+	}
+}
+
+// capQueuedTxsByMsgs returns the leading queued txs whose msgs fit in a single packet
+// The first tx is always included so that a tx with more msgs than the cap can't block the queue
+func capQueuedTxsByMsgs(queue []types.QueuedICATx, maxMsgs uint64) []types.QueuedICATx {
+	numMsgs := uint64(0)
+	for i, queuedTx := range queue {
+		numMsgs += uint64(len(queuedTx.Msgs))
+		if i > 0 && numMsgs > maxMsgs {
+			return queue[:i]
+		}
+	}
+	return queue
+}
+
+// SubmitQueuedTxs sends queued txs in a single ICA packet and removes them from the queue
+// If there's more than one tx, the packet uses a batch callback that fans the ack out to each tx's callback
+func (k Keeper) SubmitQueuedTxs(ctx sdk.Context, hostZone types.HostZone, accountType types.ICAAccountType, queue []types.QueuedICATx) error {
+	account := hostZone.GetICAAccount(accountType)
+	if account == nil || account.Address == "" {
+		errMsg := fmt.Sprintf("Zone %s is missing a %s address!", hostZone.ChainId, accountType.String())
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(types.ErrHostZoneICAAccountNotFound, errMsg)
+	}
+
+	var msgs []sdk.Msg
+	timeout := uint64(0)
+	batchCallback := types.BatchCallback{HostZoneId: hostZone.ChainId}
+	hasCallback := false
+	for _, queuedTx := range queue {
+		txTimeout, err := k.GetICATimeoutNanos(ctx, queuedTx.EpochIdentifier)
+		if err != nil {
+			errMsg := fmt.Sprintf("Failed to get ICA timeout nanos for epochType %s using param, error: %s", queuedTx.EpochIdentifier, err.Error())
+			k.Logger(ctx).Error(errMsg)
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, errMsg)
+		}
+		if timeout == 0 || txTimeout < timeout {
+			timeout = txTimeout
+		}
+
+		for _, anyMsg := range queuedTx.Msgs {
+			var msg sdk.Msg
+			if err := k.cdc.UnpackAny(anyMsg, &msg); err != nil {
+				return err
+			}
+			msgs = append(msgs, msg)
+		}
+
+		batchCallback.Callbacks = append(batchCallback.Callbacks, &types.BatchedCallback{
+			CallbackId:   queuedTx.CallbackId,
+			CallbackArgs: queuedTx.CallbackArgs,
+			NumMsgs:      uint64(len(queuedTx.Msgs)),
+		})
+		hasCallback = hasCallback || queuedTx.CallbackId != ""
+	}
+
+	// a single tx keeps its own callback
+	callbackId, callbackArgs := queue[0].CallbackId, queue[0].CallbackArgs
+	if len(queue) > 1 && hasCallback {
+		marshalledCallbackArgs, err := k.MarshalBatchCallbackArgs(ctx, batchCallback)
+		if err != nil {
+			return err
+		}
+		callbackId, callbackArgs = BATCH, marshalledCallbackArgs
+	}
+
+	sequence, err := k.SubmitTxs(ctx, hostZone.ConnectionId, msgs, *account, timeout, callbackId, callbackArgs)
+	if err != nil {
+		return err
+	}
+
+	// the record status transitions made when the txs were queued are written now, tracing them back to the packet
+	portId, err := icatypes.NewControllerPortID(types.FormatICAAccountOwner(hostZone.ChainId, accountType))
+	if err != nil {
+		return err
+	}
+	channelId, _ := k.ICAControllerKeeper.GetActiveChannelID(ctx, hostZone.ConnectionId, portId)
+	packetCtx := icacallbackstypes.WithPacketContext(ctx, icacallbackstypes.PacketContext{
+		ChannelId: channelId,
+		Sequence:  sequence,
+	})
+
+	for _, queuedTx := range queue {
+		for _, transition := range queuedTx.RecordStatusTransitions {
+			k.RecordsKeeper.WriteRecordStatusTransition(packetCtx, transition)
+		}
+		k.RemoveQueuedICATx(ctx, queuedTx.ChainId, queuedTx.AccountType, queuedTx.Id)
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("Submitted %d queued txs (%d msgs) for the %s ICA on %s, sequence: %d, block: %d",
+		len(queue), len(msgs), accountType.String(), hostZone.ChainId, sequence, ctx.BlockHeight()))
+	return nil
+}
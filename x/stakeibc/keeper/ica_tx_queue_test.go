@@ -0,0 +1,265 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	_ "github.com/stretchr/testify/suite"
+
+	epochtypes "github.com/Stride-Labs/stride/x/epochs/types"
+	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
+	recordtypes "github.com/Stride-Labs/stride/x/records/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+type ICATxQueueTestCase struct {
+	hostZone          stakeibctypes.HostZone
+	delegationPortId  string
+	delegationChannel string
+	delegationAccount string
+	withdrawalAccount string
+}
+
+func (s *KeeperTestSuite) SetupICATxQueue() ICATxQueueTestCase {
+	delegationOwner := stakeibctypes.FormatICAAccountOwner(HostChainId, stakeibctypes.ICAAccountType_DELEGATION)
+	delegationChannelId := s.CreateICAChannel(delegationOwner)
+	delegationPortId, err := icatypes.NewControllerPortID(delegationOwner)
+	s.Require().NoError(err)
+
+	withdrawalOwner := stakeibctypes.FormatICAAccountOwner(HostChainId, stakeibctypes.ICAAccountType_WITHDRAWAL)
+	s.CreateICAChannel(withdrawalOwner)
+
+	hostZone := stakeibctypes.HostZone{
+		ChainId:           HostChainId,
+		ConnectionId:      ibctesting.FirstConnectionID,
+		RedemptionRate:    sdk.OneDec(),
+		DelegationAccount: &stakeibctypes.ICAAccount{Address: s.IcaAddresses[delegationOwner], Target: stakeibctypes.ICAAccountType_DELEGATION},
+		WithdrawalAccount: &stakeibctypes.ICAAccount{Address: s.IcaAddresses[withdrawalOwner], Target: stakeibctypes.ICAAccountType_WITHDRAWAL},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), hostZone)
+
+	for _, epochIdentifier := range []string{epochtypes.STRIDE_EPOCH, epochtypes.DAY_EPOCH} {
+		s.SetEpochTracker(stakeibctypes.EpochTracker{
+			EpochIdentifier:    epochIdentifier,
+			EpochNumber:        1,
+			NextEpochStartTime: uint64(s.Coordinator.CurrentTime.UnixNano() + 60_000_000_000), // dictates timeouts
+			Duration:           60_000_000_000,
+		})
+	}
+
+	return ICATxQueueTestCase{
+		hostZone:          hostZone,
+		delegationPortId:  delegationPortId,
+		delegationChannel: delegationChannelId,
+		delegationAccount: s.IcaAddresses[delegationOwner],
+		withdrawalAccount: s.IcaAddresses[withdrawalOwner],
+	}
+}
+
+// Queues a delegation from the delegation ICA with a delegate callback for the given deposit record
+func (s *KeeperTestSuite) queueDelegation(tc ICATxQueueTestCase, depositRecordId uint64) {
+	msgs := []sdk.Msg{&stakingtypes.MsgDelegate{
+		DelegatorAddress: tc.delegationAccount,
+		ValidatorAddress: "val1_address",
+		Amount:           sdk.NewCoin(Atom, sdk.NewInt(100)),
+	}}
+	callbackArgs, err := s.App.StakeibcKeeper.MarshalDelegateCallbackArgs(s.Ctx(), stakeibctypes.DelegateCallback{
+		HostZoneId:      HostChainId,
+		DepositRecordId: depositRecordId,
+	})
+	s.Require().NoError(err)
+
+	err = s.App.StakeibcKeeper.QueueTxsStrideEpoch(s.Ctx(), tc.hostZone.ConnectionId, msgs, *tc.hostZone.DelegationAccount, stakeibckeeper.DELEGATE, callbackArgs)
+	s.Require().NoError(err, "no error expected when queueing delegation")
+}
+
+func (s *KeeperTestSuite) getNextICASequence(tc ICATxQueueTestCase) uint64 {
+	sequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx(), tc.delegationPortId, tc.delegationChannel)
+	s.Require().True(found, "sequence number not found")
+	return sequence
+}
+
+func (s *KeeperTestSuite) TestFlushICATxQueues_CoalescesTxs() {
+	tc := s.SetupICATxQueue()
+	startSequence := s.getNextICASequence(tc)
+
+	// Queue txs from two pipelines on the delegation account, one of which has no callback
+	s.queueDelegation(tc, 1)
+	err := s.App.StakeibcKeeper.SetWithdrawalAddressOnHost(s.Ctx(), tc.hostZone)
+	s.Require().NoError(err, "no error expected when setting withdrawal address")
+	s.queueDelegation(tc, 2)
+
+	queue := s.App.StakeibcKeeper.GetICATxQueue(s.Ctx(), HostChainId, stakeibctypes.ICAAccountType_DELEGATION)
+	s.Require().Len(queue, 3, "number of queued txs")
+	s.Require().Equal([]uint64{0, 1, 2}, []uint64{queue[0].Id, queue[1].Id, queue[2].Id}, "queued tx ids")
+	s.Require().Equal(startSequence, s.getNextICASequence(tc), "no packet should be sent before the queue is flushed")
+
+	s.App.StakeibcKeeper.FlushICATxQueues(s.Ctx())
+
+	// All three txs should be sent in one packet
+	s.Require().Equal(startSequence+1, s.getNextICASequence(tc), "sequence number after flush")
+	s.Require().Empty(s.App.StakeibcKeeper.GetAllQueuedICATxs(s.Ctx()), "queue should be empty after flush")
+
+	callbackKey := icacallbackstypes.PacketID(tc.delegationPortId, tc.delegationChannel, startSequence)
+	callbackData, found := s.App.IcacallbacksKeeper.GetCallbackData(s.Ctx(), callbackKey)
+	s.Require().True(found, "callback data should be stored")
+	s.Require().Equal(stakeibckeeper.BATCH, callbackData.CallbackId, "callback id")
+
+	batchCallback, err := s.App.StakeibcKeeper.UnmarshalBatchCallbackArgs(s.Ctx(), callbackData.CallbackArgs)
+	s.Require().NoError(err)
+	s.Require().Equal(HostChainId, batchCallback.HostZoneId, "batch host zone")
+	s.Require().Len(batchCallback.Callbacks, 3, "number of batched callbacks")
+	s.Require().Equal(stakeibckeeper.DELEGATE, batchCallback.Callbacks[0].CallbackId, "first batched callback")
+	s.Require().Equal("", batchCallback.Callbacks[1].CallbackId, "second batched callback")
+	s.Require().Equal(stakeibckeeper.DELEGATE, batchCallback.Callbacks[2].CallbackId, "third batched callback")
+	for i, batchedCallback := range batchCallback.Callbacks {
+		s.Require().Equal(uint64(1), batchedCallback.NumMsgs, "num msgs in batched callback %d", i)
+	}
+}
+
+func (s *KeeperTestSuite) TestFlushICATxQueues_SingleTxKeepsCallback() {
+	tc := s.SetupICATxQueue()
+	startSequence := s.getNextICASequence(tc)
+
+	s.queueDelegation(tc, 1)
+	s.App.StakeibcKeeper.FlushICATxQueues(s.Ctx())
+
+	s.Require().Equal(startSequence+1, s.getNextICASequence(tc), "sequence number after flush")
+	callbackKey := icacallbackstypes.PacketID(tc.delegationPortId, tc.delegationChannel, startSequence)
+	callbackData, found := s.App.IcacallbacksKeeper.GetCallbackData(s.Ctx(), callbackKey)
+	s.Require().True(found, "callback data should be stored")
+	s.Require().Equal(stakeibckeeper.DELEGATE, callbackData.CallbackId, "callback id")
+
+	callbackArgs, err := s.App.StakeibcKeeper.UnmarshalDelegateCallbackArgs(s.Ctx(), callbackData.CallbackArgs)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), callbackArgs.DepositRecordId, "deposit record id")
+}
+
+func (s *KeeperTestSuite) TestFlushICATxQueues_SeparatePacketPerAccount() {
+	tc := s.SetupICATxQueue()
+	startSequence := s.getNextICASequence(tc)
+
+	// Queue a tx on both the delegation and withdrawal accounts
+	s.queueDelegation(tc, 1)
+	msgs := []sdk.Msg{&distributiontypes.MsgSetWithdrawAddress{DelegatorAddress: tc.withdrawalAccount, WithdrawAddress: tc.withdrawalAccount}}
+	err := s.App.StakeibcKeeper.QueueTxsStrideEpoch(s.Ctx(), tc.hostZone.ConnectionId, msgs, *tc.hostZone.WithdrawalAccount, "", nil)
+	s.Require().NoError(err)
+
+	s.App.StakeibcKeeper.FlushICATxQueues(s.Ctx())
+
+	s.Require().Equal(startSequence+1, s.getNextICASequence(tc), "delegation sequence number after flush")
+	s.Require().Empty(s.App.StakeibcKeeper.GetAllQueuedICATxs(s.Ctx()), "queue should be empty after flush")
+}
+
+func (s *KeeperTestSuite) TestFlushICATxQueues_CappedByMaxICAMsgsPerPacket() {
+	tc := s.SetupICATxQueue()
+	startSequence := s.getNextICASequence(tc)
+
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx())
+	params.MaxIcaMsgsPerPacket = 2
+	s.App.StakeibcKeeper.SetParams(s.Ctx(), params)
+
+	s.queueDelegation(tc, 1)
+	s.queueDelegation(tc, 2)
+	s.queueDelegation(tc, 3)
+
+	// Each tx has one msg, so the first two txs should be sent, and the last one held for the next block
+	s.App.StakeibcKeeper.FlushICATxQueues(s.Ctx())
+	s.Require().Equal(startSequence+1, s.getNextICASequence(tc), "sequence number after first flush")
+	queue := s.App.StakeibcKeeper.GetICATxQueue(s.Ctx(), HostChainId, stakeibctypes.ICAAccountType_DELEGATION)
+	s.Require().Len(queue, 1, "number of queued txs after first flush")
+	s.Require().Equal(uint64(2), queue[0].Id, "remaining queued tx")
+
+	s.App.StakeibcKeeper.FlushICATxQueues(s.Ctx())
+	s.Require().Equal(startSequence+2, s.getNextICASequence(tc), "sequence number after second flush")
+	s.Require().Empty(s.App.StakeibcKeeper.GetAllQueuedICATxs(s.Ctx()), "queue should be empty after second flush")
+}
+
+func (s *KeeperTestSuite) TestFlushICATxQueues_OversizedTxNotHeld() {
+	tc := s.SetupICATxQueue()
+	startSequence := s.getNextICASequence(tc)
+
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx())
+	params.MaxIcaMsgsPerPacket = 1
+	s.App.StakeibcKeeper.SetParams(s.Ctx(), params)
+
+	msg := &stakingtypes.MsgDelegate{
+		DelegatorAddress: tc.delegationAccount,
+		ValidatorAddress: "val1_address",
+		Amount:           sdk.NewCoin(Atom, sdk.NewInt(100)),
+	}
+	err := s.App.StakeibcKeeper.QueueTxsStrideEpoch(s.Ctx(), tc.hostZone.ConnectionId, []sdk.Msg{msg, msg}, *tc.hostZone.DelegationAccount, "", nil)
+	s.Require().NoError(err, "no error expected when queueing tx")
+
+	// A single tx with more msgs than the cap should still be sent on its own
+	s.App.StakeibcKeeper.FlushICATxQueues(s.Ctx())
+	s.Require().Equal(startSequence+1, s.getNextICASequence(tc), "sequence number after flush")
+	s.Require().Empty(s.App.StakeibcKeeper.GetAllQueuedICATxs(s.Ctx()), "queue should be empty after flush")
+}
+
+func (s *KeeperTestSuite) TestFlushICATxQueues_WritesRecordTransitions() {
+	tc := s.SetupICATxQueue()
+	startSequence := s.getNextICASequence(tc)
+
+	depositRecord := recordtypes.DepositRecord{Id: 1, HostZoneId: HostChainId, Status: recordtypes.DepositRecord_DELEGATION_QUEUE}
+	s.App.RecordsKeeper.SetDepositRecord(s.Ctx(), depositRecord)
+
+	msgs := []sdk.Msg{&stakingtypes.MsgDelegate{
+		DelegatorAddress: tc.delegationAccount,
+		ValidatorAddress: "val1_address",
+		Amount:           sdk.NewCoin(Atom, sdk.NewInt(100)),
+	}}
+	err := s.App.StakeibcKeeper.QueueTxsWithRecordUpdates(s.Ctx(), tc.hostZone.ConnectionId, msgs, *tc.hostZone.DelegationAccount, epochtypes.STRIDE_EPOCH, "", nil,
+		func(ctx sdk.Context) error {
+			depositRecord.Status = recordtypes.DepositRecord_DELEGATION_IN_PROGRESS
+			s.App.RecordsKeeper.SetDepositRecord(ctx, depositRecord)
+			return nil
+		})
+	s.Require().NoError(err, "no error expected when queueing tx")
+
+	// The record should be updated right away, but its transition held until the packet is sent
+	actualDepositRecord, found := s.App.RecordsKeeper.GetDepositRecord(s.Ctx(), 1)
+	s.Require().True(found, "deposit record found")
+	s.Require().Equal(recordtypes.DepositRecord_DELEGATION_IN_PROGRESS, actualDepositRecord.Status, "deposit record status after queueing")
+	s.Require().Empty(s.App.RecordsKeeper.GetRecordHistory(s.Ctx(), recordtypes.RecordStatusTransition_DEPOSIT_RECORD, "1"), "history before flush")
+
+	s.App.StakeibcKeeper.FlushICATxQueues(s.Ctx())
+
+	history := s.App.RecordsKeeper.GetRecordHistory(s.Ctx(), recordtypes.RecordStatusTransition_DEPOSIT_RECORD, "1")
+	s.Require().Len(history, 1, "history after flush")
+	s.Require().Equal(recordtypes.DepositRecord_DELEGATION_QUEUE.String(), history[0].FromStatus, "transition from status")
+	s.Require().Equal(recordtypes.DepositRecord_DELEGATION_IN_PROGRESS.String(), history[0].ToStatus, "transition to status")
+	s.Require().Equal(tc.delegationChannel, history[0].ChannelId, "transition channel")
+	s.Require().Equal(startSequence, history[0].PacketSequence, "transition packet sequence")
+}
+
+func (s *KeeperTestSuite) TestFlushICATxQueues_HeldWhileChannelClosed() {
+	tc := s.SetupICATxQueue()
+	startSequence := s.getNextICASequence(tc)
+
+	s.queueDelegation(tc, 1)
+
+	channel, found := s.App.IBCKeeper.ChannelKeeper.GetChannel(s.Ctx(), tc.delegationPortId, tc.delegationChannel)
+	s.Require().True(found)
+	channel.State = channeltypes.CLOSED
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx(), tc.delegationPortId, tc.delegationChannel, channel)
+
+	s.App.StakeibcKeeper.FlushICATxQueues(s.Ctx())
+
+	s.Require().Equal(startSequence, s.getNextICASequence(tc), "no packet should be sent on a closed channel")
+	s.Require().Len(s.App.StakeibcKeeper.GetAllQueuedICATxs(s.Ctx()), 1, "tx should stay queued")
+	s.Require().Empty(s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx()), "no callback should be stored")
+}
+
+func (s *KeeperTestSuite) TestQueueTxs_InvalidEpoch() {
+	tc := s.SetupICATxQueue()
+
+	msgs := []sdk.Msg{&stakingtypes.MsgDelegate{}}
+	err := s.App.StakeibcKeeper.QueueTxs(s.Ctx(), tc.hostZone.ConnectionId, msgs, *tc.hostZone.DelegationAccount, "fake_epoch", "", nil)
+	s.Require().ErrorContains(err, "Failed to get ICA timeout nanos for epochType fake_epoch")
+	s.Require().Empty(s.App.StakeibcKeeper.GetAllQueuedICATxs(s.Ctx()), "nothing should be queued")
+}
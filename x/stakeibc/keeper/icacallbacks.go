@@ -2,7 +2,9 @@ package keeper
 
 import (
 	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 )
@@ -14,6 +16,9 @@ const (
 	REINVEST   = "reinvest"
 	REDEMPTION = "redemption"
   REBALANCE = "rebalance"
+	BATCH      = "batch"
+
+	WIND_DOWN_SWEEP = "winddownsweep"
 )
 
 // ICACallbacks wrapper struct for stakeibc keeper
@@ -44,13 +49,40 @@ func (c ICACallbacks) AddICACallback(id string, fn interface{}) icacallbackstype
 	return c
 }
 
+// icaCallbackRegistration pairs an ICA callback with the type of its args
+type icaCallbackRegistration struct {
+	id       string
+	callback ICACallback
+	newArgs  func() codec.ProtoMarshaler
+}
+
+// icaCallbackRegistrations lists every ICA callback, which is registered with the handler,
+// and whose args types are used to validate the callback data in genesis
+func icaCallbackRegistrations() []icaCallbackRegistration {
+	return []icaCallbackRegistration{
+		{DELEGATE, DelegateCallback, func() codec.ProtoMarshaler { return &types.DelegateCallback{} }},
+		{CLAIM, ClaimCallback, func() codec.ProtoMarshaler { return &types.ClaimCallback{} }},
+		{UNDELEGATE, UndelegateCallback, func() codec.ProtoMarshaler { return &types.UndelegateCallback{} }},
+		{REINVEST, ReinvestCallback, func() codec.ProtoMarshaler { return &types.ReinvestCallback{} }},
+		{REDEMPTION, RedemptionCallback, func() codec.ProtoMarshaler { return &types.RedemptionCallback{} }},
+		{REBALANCE, RebalanceCallback, func() codec.ProtoMarshaler { return &types.RebalanceCallback{} }},
+		{BATCH, BatchCallback, func() codec.ProtoMarshaler { return &types.BatchCallback{} }},
+		{WIND_DOWN_SWEEP, WindDownSweepCallback, func() codec.ProtoMarshaler { return &types.WindDownSweepCallback{} }},
+	}
+}
+
 func (c ICACallbacks) RegisterICACallbacks() icacallbackstypes.ICACallbackHandler {
-	a := c.
-		AddICACallback(DELEGATE, ICACallback(DelegateCallback)).
-		AddICACallback(CLAIM, ICACallback(ClaimCallback)).
-		AddICACallback(UNDELEGATE, ICACallback(UndelegateCallback)).
-		AddICACallback(REINVEST, ICACallback(ReinvestCallback)).
-		AddICACallback(REDEMPTION, ICACallback(RedemptionCallback)).
-		AddICACallback(REBALANCE, ICACallback(RebalanceCallback))
-	return a.(ICACallbacks)
+	for _, registration := range icaCallbackRegistrations() {
+		c.AddICACallback(registration.id, registration.callback)
+	}
+	return c
+}
+
+// ICACallbackArgTypes returns a constructor for the args of each registered ICA callback
+func ICACallbackArgTypes() map[string]func() codec.ProtoMarshaler {
+	argTypes := make(map[string]func() codec.ProtoMarshaler)
+	for _, registration := range icaCallbackRegistrations() {
+		argTypes[registration.id] = registration.newArgs
+	}
+	return argTypes
 }
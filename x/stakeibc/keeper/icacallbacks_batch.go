@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/Stride-Labs/stride/utils"
+	"github.com/Stride-Labs/stride/x/icacallbacks"
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+
+	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+)
+
+func (k Keeper) MarshalBatchCallbackArgs(ctx sdk.Context, batchCallback types.BatchCallback) ([]byte, error) {
+	out, err := proto.Marshal(&batchCallback)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("MarshalBatchCallbackArgs %v", err.Error()))
+		return nil, err
+	}
+	return out, nil
+}
+
+func (k Keeper) UnmarshalBatchCallbackArgs(ctx sdk.Context, batchCallback []byte) (*types.BatchCallback, error) {
+	unmarshalledBatchCallback := types.BatchCallback{}
+	if err := proto.Unmarshal(batchCallback, &unmarshalledBatchCallback); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("UnmarshalBatchCallbackArgs %v", err.Error()))
+		return nil, err
+	}
+	return &unmarshalledBatchCallback, nil
+}
+
+// BatchCallback fans the ack of a packet of coalesced txs back out to the callback of each tx
+// Each callback is given an ack with the responses of only its own msgs, and runs in its own
+// cached context so that one failing callback doesn't revert the others
+func BatchCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ack *channeltypes.Acknowledgement, args []byte) error {
+	k.Logger(ctx).Info("BatchCallback executing", "packet", packet)
+	batchCallback, err := k.UnmarshalBatchCallbackArgs(ctx, args)
+	if err != nil {
+		return err
+	}
+
+	// split a successful ack's msg responses by tx
+	// timeouts and failed txs are passed through to every callback as is
+	var txMsgData *sdk.TxMsgData
+	if ack != nil {
+		txMsgData, err = icacallbacks.GetTxMsgData(ctx, *ack, k.Logger(ctx))
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to fetch txMsgData, packet %v", packet))
+			return sdkerrors.Wrap(icacallbackstypes.ErrTxMsgData, err.Error())
+		}
+		if len(txMsgData.Data) == 0 {
+			txMsgData = nil
+		}
+	}
+
+	callbackHandler := k.ICACallbackHandler().RegisterICACallbacks()
+	msgIndex := uint64(0)
+	for _, batchedCallback := range batchCallback.Callbacks {
+		startIndex := msgIndex
+		msgIndex += batchedCallback.NumMsgs
+
+		if batchedCallback.CallbackId == "" {
+			continue
+		}
+		if batchedCallback.CallbackId == BATCH || !callbackHandler.HasICACallback(batchedCallback.CallbackId) {
+			k.Logger(ctx).Error(fmt.Sprintf("BatchCallback has no associated callback for %s", batchedCallback.CallbackId))
+			continue
+		}
+
+		batchedAck := ack
+		if txMsgData != nil {
+			if msgIndex > uint64(len(txMsgData.Data)) {
+				errMsg := fmt.Sprintf("BatchCallback expected at least %d msg responses, got %d", msgIndex, len(txMsgData.Data))
+				k.Logger(ctx).Error(errMsg)
+				return sdkerrors.Wrapf(icacallbackstypes.ErrTxMsgData, errMsg)
+			}
+			batchedTxMsgData := sdk.TxMsgData{Data: txMsgData.Data[startIndex:msgIndex]}
+			result, err := proto.Marshal(&batchedTxMsgData)
+			if err != nil {
+				return sdkerrors.Wrap(icacallbackstypes.ErrTxMsgData, err.Error())
+			}
+			resultAck := channeltypes.NewResultAcknowledgement(result)
+			batchedAck = &resultAck
+		}
+
+		err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return callbackHandler.CallICACallback(ctx, batchedCallback.CallbackId, packet, batchedAck, batchedCallback.CallbackArgs)
+		})
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("BatchCallback failed to call %s callback on %s | err: %s", batchedCallback.CallbackId, batchCallback.HostZoneId, err.Error()))
+			continue
+		}
+	}
+
+	return nil
+}
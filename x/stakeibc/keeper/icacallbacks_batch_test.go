@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	_ "github.com/stretchr/testify/suite"
+
+	recordtypes "github.com/Stride-Labs/stride/x/records/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/x/stakeibc/keeper"
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+type BatchCallbackTestCase struct {
+	depositRecords []recordtypes.DepositRecord
+	packet         channeltypes.Packet
+	args           []byte
+	msgs           []sdk.Msg
+}
+
+// Batches two delegations, with a tx that has no callback between them
+func (s *KeeperTestSuite) SetupBatchCallback() BatchCallbackTestCase {
+	hostZone := types.HostZone{
+		ChainId:        HostChainId,
+		HostDenom:      Atom,
+		RedemptionRate: sdk.OneDec(),
+		Validators: []*types.Validator{
+			{Name: "val1", Address: "val1_address", DelegationAmt: sdk.NewInt(100)},
+			{Name: "val2", Address: "val2_address", DelegationAmt: sdk.NewInt(100)},
+		},
+		StakedBal: sdk.NewInt(200),
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), hostZone)
+
+	batchCallback := types.BatchCallback{HostZoneId: HostChainId}
+	depositRecords := []recordtypes.DepositRecord{}
+	for _, id := range []uint64{1, 2} {
+		depositRecord := recordtypes.DepositRecord{
+			Id:         id,
+			HostZoneId: HostChainId,
+			Amount:     sdk.NewInt(50),
+			Status:     recordtypes.DepositRecord_DELEGATION_IN_PROGRESS,
+		}
+		s.App.RecordsKeeper.SetDepositRecord(s.Ctx(), depositRecord)
+		depositRecords = append(depositRecords, depositRecord)
+
+		args, err := s.App.StakeibcKeeper.MarshalDelegateCallbackArgs(s.Ctx(), types.DelegateCallback{
+			HostZoneId:      HostChainId,
+			DepositRecordId: id,
+			SplitDelegations: []*types.SplitDelegation{
+				{Validator: "val1_address", Amount: sdk.NewInt(20)},
+				{Validator: "val2_address", Amount: sdk.NewInt(30)},
+			},
+		})
+		s.Require().NoError(err)
+
+		if id == 2 {
+			batchCallback.Callbacks = append(batchCallback.Callbacks, &types.BatchedCallback{NumMsgs: 1})
+		}
+		batchCallback.Callbacks = append(batchCallback.Callbacks, &types.BatchedCallback{
+			CallbackId:   stakeibckeeper.DELEGATE,
+			CallbackArgs: args,
+			NumMsgs:      2,
+		})
+	}
+
+	args, err := s.App.StakeibcKeeper.MarshalBatchCallbackArgs(s.Ctx(), batchCallback)
+	s.Require().NoError(err)
+
+	msgs := []sdk.Msg{
+		&stakingtypes.MsgDelegate{}, &stakingtypes.MsgDelegate{},
+		&distributiontypes.MsgSetWithdrawAddress{},
+		&stakingtypes.MsgDelegate{}, &stakingtypes.MsgDelegate{},
+	}
+
+	return BatchCallbackTestCase{
+		depositRecords: depositRecords,
+		packet:         channeltypes.Packet{},
+		args:           args,
+		msgs:           msgs,
+	}
+}
+
+func (s *KeeperTestSuite) TestBatchCallback_Successful() {
+	tc := s.SetupBatchCallback()
+
+	ack := s.ICAPacketAcknowledgement(tc.msgs, nil)
+	err := stakeibckeeper.BatchCallback(s.App.StakeibcKeeper, s.Ctx(), tc.packet, &ack, tc.args)
+	s.Require().NoError(err)
+
+	// Both delegations should be processed
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(300), hostZone.StakedBal, "staked balance")
+	s.Require().Equal(sdk.NewInt(140), hostZone.Validators[0].DelegationAmt, "val1 delegation")
+	s.Require().Equal(sdk.NewInt(160), hostZone.Validators[1].DelegationAmt, "val2 delegation")
+	s.Require().Empty(s.App.RecordsKeeper.GetAllDepositRecord(s.Ctx()), "deposit records should be removed")
+}
+
+func (s *KeeperTestSuite) TestBatchCallback_Timeout() {
+	tc := s.SetupBatchCallback()
+
+	err := stakeibckeeper.BatchCallback(s.App.StakeibcKeeper, s.Ctx(), tc.packet, nil, tc.args)
+	s.Require().NoError(err)
+
+	// Both deposit records should be requeued
+	for _, depositRecord := range tc.depositRecords {
+		record, found := s.App.RecordsKeeper.GetDepositRecord(s.Ctx(), depositRecord.Id)
+		s.Require().True(found, "deposit record %d", depositRecord.Id)
+		s.Require().Equal(recordtypes.DepositRecord_DELEGATION_QUEUE, record.Status, "deposit record %d status", depositRecord.Id)
+	}
+}
+
+func (s *KeeperTestSuite) TestBatchCallback_AckFailure() {
+	tc := s.SetupBatchCallback()
+
+	ack := channeltypes.NewErrorAcknowledgement("error")
+	err := stakeibckeeper.BatchCallback(s.App.StakeibcKeeper, s.Ctx(), tc.packet, &ack, tc.args)
+	s.Require().NoError(err)
+
+	// Both deposit records should be requeued
+	for _, depositRecord := range tc.depositRecords {
+		record, found := s.App.RecordsKeeper.GetDepositRecord(s.Ctx(), depositRecord.Id)
+		s.Require().True(found, "deposit record %d", depositRecord.Id)
+		s.Require().Equal(recordtypes.DepositRecord_DELEGATION_QUEUE, record.Status, "deposit record %d status", depositRecord.Id)
+	}
+}
+
+func (s *KeeperTestSuite) TestBatchCallback_FailedCallbackIsolated() {
+	tc := s.SetupBatchCallback()
+
+	// Remove the first deposit record so its callback fails
+	s.App.RecordsKeeper.RemoveDepositRecord(s.Ctx(), tc.depositRecords[0].Id)
+
+	ack := s.ICAPacketAcknowledgement(tc.msgs, nil)
+	err := stakeibckeeper.BatchCallback(s.App.StakeibcKeeper, s.Ctx(), tc.packet, &ack, tc.args)
+	s.Require().NoError(err)
+
+	// The second delegation should still be processed
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(250), hostZone.StakedBal, "staked balance")
+	_, found = s.App.RecordsKeeper.GetDepositRecord(s.Ctx(), tc.depositRecords[1].Id)
+	s.Require().False(found, "second deposit record should be removed")
+}
+
+func (s *KeeperTestSuite) TestBatchCallback_MissingMsgResponses() {
+	tc := s.SetupBatchCallback()
+
+	// The ack only has responses for the first delegation
+	ack := s.ICAPacketAcknowledgement(tc.msgs[:2], nil)
+	err := stakeibckeeper.BatchCallback(s.App.StakeibcKeeper, s.Ctx(), tc.packet, &ack, tc.args)
+	s.Require().ErrorContains(err, "BatchCallback expected at least 5 msg responses, got 2")
+}
@@ -9,8 +9,10 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/spf13/cast"
 )
 
 func (k Keeper) MarshalRebalanceCallbackArgs(ctx sdk.Context, rebalanceCallback types.RebalanceCallback) ([]byte, error) {
@@ -33,9 +35,21 @@ func (k Keeper) UnmarshalRebalanceCallbackArgs(ctx sdk.Context, rebalanceCallbac
 
 func RebalanceCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ack *channeltypes.Acknowledgement, args []byte) error {
 	k.Logger(ctx).Info("RebalanceCallback executing", "packet", packet)
+
+	// deserialize the args
+	rebalanceCallback, err := k.UnmarshalRebalanceCallbackArgs(ctx, args)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to unmarshal rebalance callback args | %s", err.Error())
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(types.ErrUnmarshalFailure, errMsg)
+	}
+	k.Logger(ctx).Info(fmt.Sprintf("RebalanceCallback %v", rebalanceCallback))
+	rebalancings := rebalanceCallback.GetRebalancings()
+
 	if ack == nil {
 		// timeout
 		k.Logger(ctx).Error(fmt.Sprintf("RebalanceCallback timeout, ack is nil, packet %v", packet))
+		k.RemovePendingRedelegationEntries(ctx, rebalanceCallback.GetHostZoneId(), rebalancings)
 		return nil
 	}
 
@@ -48,17 +62,10 @@ func RebalanceCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ac
 	if len(txMsgData.Data) == 0 {
 		// failed transaction
 		k.Logger(ctx).Error(fmt.Sprintf("RebalanceCallback tx failed, ack is empty (ack error), packet %v", packet))
+		k.RemovePendingRedelegationEntries(ctx, rebalanceCallback.GetHostZoneId(), rebalancings)
 		return nil
 	}
 
-	// deserialize the args
-	rebalanceCallback, err := k.UnmarshalRebalanceCallbackArgs(ctx, args)
-	if err != nil {
-		errMsg := fmt.Sprintf("Unable to unmarshal rebalance callback args | %s", err.Error())
-		k.Logger(ctx).Error(errMsg)
-		return sdkerrors.Wrapf(types.ErrUnmarshalFailure, errMsg)
-	}
-	k.Logger(ctx).Info(fmt.Sprintf("RebalanceCallback %v", rebalanceCallback))
 	hostZone := rebalanceCallback.GetHostZoneId()
 	zone, found := k.GetHostZone(ctx, hostZone)
 	if !found {
@@ -66,7 +73,6 @@ func RebalanceCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ac
 	}
 
 	// update the host zone
-	rebalancings := rebalanceCallback.GetRebalancings()
 	// assemble a map from validatorAddress -> validator
 	valAddrMap := make(map[string]*types.Validator)
 	for _, val := range zone.GetValidators() {
@@ -75,19 +81,55 @@ func RebalanceCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ac
 	for _, rebalancing := range rebalancings {
 		srcValidator := rebalancing.GetSrcValidator()
 		dstValidator := rebalancing.GetDstValidator()
-		amt := rebalancing.GetAmt()
+		amt := rebalancing.Amt
 		if _, valFound := valAddrMap[srcValidator]; valFound {
-			valAddrMap[srcValidator].DelegationAmt -= amt
+			valAddrMap[srcValidator].DelegationAmt = valAddrMap[srcValidator].DelegationAmt.Sub(amt)
 		} else {
 			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "validator not found %s", srcValidator)
 		}
 		if _, valFound := valAddrMap[dstValidator]; valFound {
-			valAddrMap[dstValidator].DelegationAmt += amt
+			valAddrMap[dstValidator].DelegationAmt = valAddrMap[dstValidator].DelegationAmt.Add(amt)
 		} else {
 			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "validator not found %s", dstValidator)
 		}
 	}
+
+	// Record the in-flight redelegations so that future rebalances stay within the host's redelegation limits
+	k.RecordRebalanceRedelegations(ctx, zone, rebalancings, txMsgData)
+
+	// Remove any validators that were being deleted and have now been fully redelegated away from
+	remainingValidators := []*types.Validator{}
+	for _, validator := range zone.Validators {
+		if validator.Status == types.Validator_Removing && validator.DelegationAmt.IsZero() {
+			k.Logger(ctx).Info(fmt.Sprintf("RebalanceCallback: removing validator %s from host zone %s", validator.Address, zone.ChainId))
+			continue
+		}
+		remainingValidators = append(remainingValidators, validator)
+	}
+	zone.Validators = remainingValidators
 	k.SetHostZone(ctx, zone)
 
 	return nil
 }
+
+// RecordRebalanceRedelegations replaces the pending redelegation entry of each rebalancing with an in-flight entry,
+// using the completion time returned by the host in the corresponding MsgBeginRedelegateResponse
+func (k Keeper) RecordRebalanceRedelegations(ctx sdk.Context, hostZone types.HostZone, rebalancings []*types.Rebalancing, txMsgData *sdk.TxMsgData) {
+	k.RemovePendingRedelegationEntries(ctx, hostZone.ChainId, rebalancings)
+
+	for i, rebalancing := range rebalancings {
+		if i >= len(txMsgData.Data) || txMsgData.Data[i] == nil {
+			k.Logger(ctx).Error(fmt.Sprintf("RebalanceCallback: no msg response for redelegation from %s to %s", rebalancing.SrcValidator, rebalancing.DstValidator))
+			continue
+		}
+		var redelegateResponse stakingtypes.MsgBeginRedelegateResponse
+		if err := proto.Unmarshal(txMsgData.Data[i].Data, &redelegateResponse); err != nil || redelegateResponse.CompletionTime.IsZero() {
+			k.Logger(ctx).Error(fmt.Sprintf("RebalanceCallback: unable to get completion time for redelegation from %s to %s", rebalancing.SrcValidator, rebalancing.DstValidator))
+			continue
+		}
+		k.AddRedelegationEntry(ctx, hostZone, rebalancing.SrcValidator, rebalancing.DstValidator, types.RedelegationEntry{
+			Amount:         rebalancing.Amt,
+			CompletionTime: cast.ToUint64(redelegateResponse.CompletionTime.UnixNano()),
+		})
+	}
+}
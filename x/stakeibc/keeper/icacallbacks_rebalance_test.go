@@ -1,9 +1,13 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/gogo/protobuf/proto"
 	_ "github.com/stretchr/testify/suite"
 
 	stakeibckeeper "github.com/Stride-Labs/stride/x/stakeibc/keeper"
@@ -40,12 +44,12 @@ func (s *KeeperTestSuite) SetupRebalanceCallback() RebalanceCallbackTestCase {
 			{
 				SrcValidator: "stride_VAL3",
 				DstValidator: "stride_VAL1",
-				Amt:          104,
+				Amt:          sdk.NewInt(104),
 			},
 			{
 				SrcValidator: "stride_VAL4",
 				DstValidator: "stride_VAL1",
-				Amt:          13,
+				Amt:          sdk.NewInt(13),
 			},
 		},
 	}
@@ -79,11 +83,121 @@ func (s *KeeperTestSuite) TestRebalanceCallback_Successful() {
 	validators := hz.GetValidators()
 	s.Require().Len(validators, 5, "host zone has 5 validators")
 
-	s.Require().Equal(217, int(validators[0].DelegationAmt), "validator 1 stake")
-	s.Require().Equal(500, int(validators[1].DelegationAmt), "validator 2 stake")
-	s.Require().Equal(96, int(validators[2].DelegationAmt), "validator 3 stake")
-	s.Require().Equal(387, int(validators[3].DelegationAmt), "validator 4 stake")
-	s.Require().Equal(400, int(validators[4].DelegationAmt), "validator 5 stake")
+	s.Require().Equal(sdk.NewInt(217), validators[0].DelegationAmt, "validator 1 stake")
+	s.Require().Equal(sdk.NewInt(500), validators[1].DelegationAmt, "validator 2 stake")
+	s.Require().Equal(sdk.NewInt(96), validators[2].DelegationAmt, "validator 3 stake")
+	s.Require().Equal(sdk.NewInt(387), validators[3].DelegationAmt, "validator 4 stake")
+	s.Require().Equal(sdk.NewInt(400), validators[4].DelegationAmt, "validator 5 stake")
+}
+
+func (s *KeeperTestSuite) TestRebalanceCallback_RecordsRedelegations() {
+	tc := s.SetupRebalanceCallback()
+
+	// The redelegations were tracked as pending when the tx was submitted
+	callbackArgs, err := s.App.StakeibcKeeper.UnmarshalRebalanceCallbackArgs(s.Ctx(), tc.validArgs.args)
+	s.Require().NoError(err)
+	s.App.StakeibcKeeper.AddPendingRedelegationEntries(s.Ctx(), tc.initialState.hostZone, callbackArgs.Rebalancings)
+
+	// Build an ack with a MsgBeginRedelegateResponse for each rebalancing
+	completionTime := s.Ctx().BlockTime().Add(time.Hour).UTC()
+	var redelegateResponse proto.Message = &stakingtypes.MsgBeginRedelegateResponse{CompletionTime: completionTime}
+	msgs := []sdk.Msg{&stakingtypes.MsgBeginRedelegate{}, &stakingtypes.MsgBeginRedelegate{}}
+	ack := s.ICAPacketAcknowledgement(msgs, &redelegateResponse)
+
+	err = stakeibckeeper.RebalanceCallback(s.App.StakeibcKeeper, s.Ctx(), tc.validArgs.packet, &ack, tc.validArgs.args)
+	s.Require().NoError(err, "rebalance callback succeeded")
+
+	// Each pending entry should be replaced with the host's completion time
+	expectedEntries := map[string]sdk.Int{"stride_VAL3": sdk.NewInt(104), "stride_VAL4": sdk.NewInt(13)}
+	for srcValidator, amount := range expectedEntries {
+		redelegation, found := s.App.StakeibcKeeper.GetRedelegation(s.Ctx(), "GAIA", srcValidator, "stride_VAL1")
+		s.Require().True(found, "redelegation from %s should be recorded", srcValidator)
+		s.Require().Len(redelegation.Entries, 1, "number of entries from %s", srcValidator)
+		s.Require().Equal(amount, redelegation.Entries[0].Amount, "entry amount from %s", srcValidator)
+		s.Require().Equal(uint64(completionTime.UnixNano()), redelegation.Entries[0].CompletionTime, "entry completion time from %s", srcValidator)
+		s.Require().False(redelegation.Entries[0].Pending, "entry from %s should no longer be pending", srcValidator)
+	}
+	activeRedelegations, err := s.App.StakeibcKeeper.GetActiveRedelegationsForHostZone(s.Ctx(), tc.initialState.hostZone)
+	s.Require().NoError(err, "no error getting active redelegations")
+	s.Require().Len(activeRedelegations, 2, "active redelegations")
+}
+
+func (s *KeeperTestSuite) TestRebalanceCallback_RemovesDrainedValidator() {
+	tc := s.SetupRebalanceCallback()
+
+	// Mark val1 as removing and redelegate its full stake away
+	hostZone := tc.initialState.hostZone
+	hostZone.Validators[0].Weight = 0
+	hostZone.Validators[0].Status = stakeibctypes.Validator_Removing
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), hostZone)
+
+	callbackArgs := types.RebalanceCallback{
+		HostZoneId: HostChainId,
+		Rebalancings: []*types.Rebalancing{
+			{
+				SrcValidator: "stride_VAL1",
+				DstValidator: "stride_VAL2",
+				Amt:          sdk.NewInt(60),
+			},
+			{
+				SrcValidator: "stride_VAL1",
+				DstValidator: "stride_VAL3",
+				Amt:          sdk.NewInt(40),
+			},
+		},
+	}
+	args, err := s.App.StakeibcKeeper.MarshalRebalanceCallbackArgs(s.Ctx(), callbackArgs)
+	s.Require().NoError(err)
+
+	err = stakeibckeeper.RebalanceCallback(s.App.StakeibcKeeper, s.Ctx(), tc.validArgs.packet, tc.validArgs.ack, args)
+	s.Require().NoError(err, "rebalance callback succeeded")
+
+	hz, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), "GAIA")
+	s.Require().True(found, "host zone found")
+
+	// val1 should be removed now that it has no delegation
+	validators := hz.GetValidators()
+	s.Require().Len(validators, 4, "host zone has 4 validators")
+
+	s.Require().Equal("stride_VAL2", validators[0].Address, "validator 2 address")
+	s.Require().Equal(sdk.NewInt(560), validators[0].DelegationAmt, "validator 2 stake")
+	s.Require().Equal(sdk.NewInt(240), validators[1].DelegationAmt, "validator 3 stake")
+	s.Require().Equal(sdk.NewInt(400), validators[2].DelegationAmt, "validator 4 stake")
+	s.Require().Equal(sdk.NewInt(400), validators[3].DelegationAmt, "validator 5 stake")
+}
+
+func (s *KeeperTestSuite) TestRebalanceCallback_KeepsPartiallyDrainedValidator() {
+	tc := s.SetupRebalanceCallback()
+
+	// Mark val1 as removing, but only redelegate part of its stake
+	hostZone := tc.initialState.hostZone
+	hostZone.Validators[0].Weight = 0
+	hostZone.Validators[0].Status = stakeibctypes.Validator_Removing
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), hostZone)
+
+	callbackArgs := types.RebalanceCallback{
+		HostZoneId: HostChainId,
+		Rebalancings: []*types.Rebalancing{
+			{
+				SrcValidator: "stride_VAL1",
+				DstValidator: "stride_VAL2",
+				Amt:          sdk.NewInt(60),
+			},
+		},
+	}
+	args, err := s.App.StakeibcKeeper.MarshalRebalanceCallbackArgs(s.Ctx(), callbackArgs)
+	s.Require().NoError(err)
+
+	err = stakeibckeeper.RebalanceCallback(s.App.StakeibcKeeper, s.Ctx(), tc.validArgs.packet, tc.validArgs.ack, args)
+	s.Require().NoError(err, "rebalance callback succeeded")
+
+	hz, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), "GAIA")
+	s.Require().True(found, "host zone found")
+
+	validators := hz.GetValidators()
+	s.Require().Len(validators, 5, "host zone has 5 validators")
+	s.Require().Equal(sdk.NewInt(40), validators[0].DelegationAmt, "validator 1 stake")
+	s.Require().Equal(stakeibctypes.Validator_Removing, validators[0].Status, "validator 1 status")
 }
 
 func (s *KeeperTestSuite) checkDelegationStateIfCallbackFailed() {
@@ -93,11 +207,11 @@ func (s *KeeperTestSuite) checkDelegationStateIfCallbackFailed() {
 	validators := hz.GetValidators()
 	s.Require().Len(validators, 5, "host zone has 5 validators")
 
-	s.Require().Equal(100, int(validators[0].DelegationAmt), "validator 1 stake")
-	s.Require().Equal(500, int(validators[1].DelegationAmt), "validator 2 stake")
-	s.Require().Equal(200, int(validators[2].DelegationAmt), "validator 3 stake")
-	s.Require().Equal(400, int(validators[3].DelegationAmt), "validator 4 stake")
-	s.Require().Equal(400, int(validators[4].DelegationAmt), "validator 5 stake")
+	s.Require().Equal(sdk.NewInt(100), validators[0].DelegationAmt, "validator 1 stake")
+	s.Require().Equal(sdk.NewInt(500), validators[1].DelegationAmt, "validator 2 stake")
+	s.Require().Equal(sdk.NewInt(200), validators[2].DelegationAmt, "validator 3 stake")
+	s.Require().Equal(sdk.NewInt(400), validators[3].DelegationAmt, "validator 4 stake")
+	s.Require().Equal(sdk.NewInt(400), validators[4].DelegationAmt, "validator 5 stake")
 }
 
 func (s *KeeperTestSuite) TestRebalanceCallback_Timeout() {
@@ -111,6 +225,22 @@ func (s *KeeperTestSuite) TestRebalanceCallback_Timeout() {
 	s.checkDelegationStateIfCallbackFailed()
 }
 
+func (s *KeeperTestSuite) TestRebalanceCallback_TimeoutClearsPendingRedelegations() {
+	tc := s.SetupRebalanceCallback()
+	callbackArgs, err := s.App.StakeibcKeeper.UnmarshalRebalanceCallbackArgs(s.Ctx(), tc.validArgs.args)
+	s.Require().NoError(err)
+	s.App.StakeibcKeeper.AddPendingRedelegationEntries(s.Ctx(), tc.initialState.hostZone, callbackArgs.Rebalancings)
+
+	// The timed out redelegations never happened on the host, so they no longer count towards its limits
+	err = stakeibckeeper.RebalanceCallback(s.App.StakeibcKeeper, s.Ctx(), tc.validArgs.packet, nil, tc.validArgs.args)
+	s.Require().NoError(err)
+
+	for _, rebalancing := range callbackArgs.Rebalancings {
+		_, found := s.App.StakeibcKeeper.GetRedelegation(s.Ctx(), "GAIA", rebalancing.SrcValidator, rebalancing.DstValidator)
+		s.Require().False(found, "pending redelegation from %s should be removed", rebalancing.SrcValidator)
+	}
+}
+
 func (s *KeeperTestSuite) TestRebalanceCallback_ErrorOnHost() {
 	tc := s.SetupRebalanceCallback()
 	invalidArgs := tc.validArgs
@@ -141,12 +271,12 @@ func (s *KeeperTestSuite) TestRebalanceCallback_WrongValidator() {
 			{
 				SrcValidator: "stride_VAL3",
 				DstValidator: "stride_VAL1",
-				Amt:          104,
+				Amt:          sdk.NewInt(104),
 			},
 			{
 				SrcValidator: "stride_VAL4_WRONG",
 				DstValidator: "stride_VAL1",
-				Amt:          13,
+				Amt:          sdk.NewInt(13),
 			},
 		},
 	}
@@ -159,12 +289,12 @@ func (s *KeeperTestSuite) TestRebalanceCallback_WrongValidator() {
 			{
 				SrcValidator: "stride_VAL3",
 				DstValidator: "stride_VAL1_WRONG",
-				Amt:          104,
+				Amt:          sdk.NewInt(104),
 			},
 			{
 				SrcValidator: "stride_VAL4",
 				DstValidator: "stride_VAL1",
-				Amt:          13,
+				Amt:          sdk.NewInt(13),
 			},
 		},
 	}
@@ -3,7 +3,6 @@ package keeper
 import (
 	"fmt"
 
-	epochtypes "github.com/Stride-Labs/stride/x/epochs/types"
 	"github.com/Stride-Labs/stride/x/icacallbacks"
 	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
 	recordstypes "github.com/Stride-Labs/stride/x/records/types"
@@ -62,15 +61,16 @@ func ReinvestCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ack
 	denom := reinvestCallback.ReinvestAmount.Denom
 
 	// fetch epoch
-	strideEpochTracker, found := k.GetEpochTracker(ctx, epochtypes.STRIDE_EPOCH)
+	depositEpochIdentifier := k.GetEpochIdentifierParam(ctx, types.KeyDepositEpochIdentifier)
+	depositEpochTracker, found := k.GetEpochTracker(ctx, depositEpochIdentifier)
 	if !found {
 		k.Logger(ctx).Error("failed to find epoch")
-		return sdkerrors.Wrapf(types.ErrInvalidLengthEpochTracker, "no number for epoch (%s)", epochtypes.STRIDE_EPOCH)
+		return sdkerrors.Wrapf(types.ErrInvalidLengthEpochTracker, "no number for epoch (%s)", depositEpochIdentifier)
 	}
-	epochNumber := strideEpochTracker.EpochNumber
+	epochNumber := depositEpochTracker.EpochNumber
 	// create a new record so that rewards are reinvested
 	record := recordstypes.DepositRecord{
-		Amount:             amount.Int64(),
+		Amount:             amount,
 		Denom:              denom,
 		HostZoneId:         reinvestCallback.HostZoneId,
 		Status:             recordstypes.DepositRecord_DELEGATION_QUEUE,
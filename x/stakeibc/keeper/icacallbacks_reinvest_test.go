@@ -44,7 +44,7 @@ func (s *KeeperTestSuite) SetupReinvestCallback() ReinvestCallbackTestCase {
 		Id:                 0,
 		DepositEpochNumber: 1,
 		HostZoneId:         HostChainId,
-		Amount:             reinvestAmt,
+		Amount:             sdk.NewInt(reinvestAmt),
 		Status:             recordtypes.DepositRecord_DELEGATION_QUEUE,
 		Source:             recordtypes.DepositRecord_WITHDRAWAL_ICA,
 	}
@@ -53,7 +53,7 @@ func (s *KeeperTestSuite) SetupReinvestCallback() ReinvestCallbackTestCase {
 		EpochNumber:     1,
 	}
 	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), hostZone)
-	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx(), epochTracker)
+	s.SetEpochTracker(epochTracker)
 
 	packet := channeltypes.Packet{}
 	var msgs []sdk.Msg
@@ -146,7 +146,7 @@ func (s *KeeperTestSuite) TestReinvestCallback_MissingEpoch() {
 	invalidArgs := tc.validArgs
 
 	// Remove epoch tracker
-	s.App.StakeibcKeeper.RemoveEpochTracker(s.Ctx(), epochtypes.STRIDE_EPOCH)
+	s.App.EpochsKeeper.DeleteEpochInfo(s.Ctx(), epochtypes.STRIDE_EPOCH)
 
 	err := stakeibckeeper.ReinvestCallback(s.App.StakeibcKeeper, s.Ctx(), invalidArgs.packet, invalidArgs.ack, invalidArgs.args)
 	s.Require().ErrorContains(err, "no number for epoch (stride_epoch)")
@@ -17,13 +17,13 @@ import (
 )
 
 type UndelegateCallbackState struct {
-	stakedBal          uint64
-	val1Bal            uint64
-	val2Bal            uint64
+	stakedBal          sdk.Int
+	val1Bal            sdk.Int
+	val2Bal            sdk.Int
 	epochNumber        uint64
 	completionTime     time.Time
 	callbackArgs       types.UndelegateCallback
-	zoneAccountBalance int64
+	zoneAccountBalance sdk.Int
 }
 
 type UndelegateCallbackArgs struct {
@@ -35,19 +35,19 @@ type UndelegateCallbackArgs struct {
 type UndelegateCallbackTestCase struct {
 	initialState           UndelegateCallbackState
 	validArgs              UndelegateCallbackArgs
-	val1UndelegationAmount int64
-	val2UndelegationAmount int64
-	balanceToUnstake       int64
+	val1UndelegationAmount sdk.Int
+	val2UndelegationAmount sdk.Int
+	balanceToUnstake       sdk.Int
 }
 
 func (s *KeeperTestSuite) SetupUndelegateCallback() UndelegateCallbackTestCase {
 	// Set up host zone and validator state
-	stakedBal := uint64(1_000_000)
-	val1Bal := uint64(400_000)
-	val2Bal := uint64(stakedBal) - val1Bal
-	balanceToUnstake := int64(300_000)
-	val1UndelegationAmount := int64(120_000)
-	val2UndelegationAmount := balanceToUnstake - val1UndelegationAmount
+	stakedBal := sdk.NewInt(1_000_000)
+	val1Bal := sdk.NewInt(400_000)
+	val2Bal := stakedBal.Sub(val1Bal)
+	balanceToUnstake := sdk.NewInt(300_000)
+	val1UndelegationAmount := sdk.NewInt(120_000)
+	val2UndelegationAmount := balanceToUnstake.Sub(val1UndelegationAmount)
 	epochNumber := uint64(1)
 	val1 := types.Validator{
 		Name:          "val1",
@@ -60,10 +60,10 @@ func (s *KeeperTestSuite) SetupUndelegateCallback() UndelegateCallbackTestCase {
 		DelegationAmt: val2Bal,
 	}
 	zoneAddress := types.NewZoneAddress(HostChainId)
-	zoneAccountBalance := balanceToUnstake + 10
+	zoneAccountBalance := balanceToUnstake.AddRaw(10)
 	zoneAccount := Account{
 		acc:           zoneAddress,
-		stAtomBalance: sdk.NewInt64Coin(StAtom, zoneAccountBalance), // Add a few extra tokens to make the test more robust
+		stAtomBalance: sdk.NewCoin(StAtom, zoneAccountBalance), // Add a few extra tokens to make the test more robust
 	}
 	hostZone := stakeibc.HostZone{
 		ChainId:        HostChainId,
@@ -80,7 +80,7 @@ func (s *KeeperTestSuite) SetupUndelegateCallback() UndelegateCallbackTestCase {
 	hostZoneUnbonding := recordtypes.HostZoneUnbonding{
 		HostZoneId:    HostChainId,
 		Status:        recordtypes.HostZoneUnbonding_UNBONDING_QUEUE,
-		StTokenAmount: uint64(balanceToUnstake),
+		StTokenAmount: balanceToUnstake,
 	}
 	epochUnbondingRecord := recordtypes.EpochUnbondingRecord{
 		EpochNumber:        epochNumber,
@@ -99,11 +99,11 @@ func (s *KeeperTestSuite) SetupUndelegateCallback() UndelegateCallbackTestCase {
 	ack := s.ICAPacketAcknowledgement(msgs, &protoMsgUndelegateResponse)
 	val1SplitDelegation := types.SplitDelegation{
 		Validator: val1.Address,
-		Amount:    uint64(val1UndelegationAmount),
+		Amount:    val1UndelegationAmount,
 	}
 	val2SplitDelegation := types.SplitDelegation{
 		Validator: val2.Address,
-		Amount:    uint64(val2UndelegationAmount),
+		Amount:    val2UndelegationAmount,
 	}
 	callbackArgs := types.UndelegateCallback{
 		HostZoneId:              HostChainId,
@@ -146,15 +146,15 @@ func (s *KeeperTestSuite) TestUndelegateCallback_Successful() {
 	// Check that stakedBal has decreased on the host zone
 	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
 	s.Require().True(found)
-	s.Require().Equal(int64(hostZone.StakedBal), int64(initialState.stakedBal)-tc.balanceToUnstake, "stakedBal has decreased on the host zone")
+	s.Require().Equal(hostZone.StakedBal, initialState.stakedBal.Sub(tc.balanceToUnstake), "stakedBal has decreased on the host zone")
 
 	// Check that Delegations on validators have decreased
 	s.Require().True(len(hostZone.Validators) == 2, "Expected 2 validators")
 	val1 := hostZone.Validators[0]
-	s.Require().Equal(int64(val1.DelegationAmt), int64(initialState.val1Bal)-tc.val1UndelegationAmount, "val1 delegation has decreased")
+	s.Require().Equal(val1.DelegationAmt, initialState.val1Bal.Sub(tc.val1UndelegationAmount), "val1 delegation has decreased")
 	val2 := hostZone.Validators[1]
 	// Check that the host zone unbonding records have been updated
-	s.Require().Equal(int64(val2.DelegationAmt), int64(initialState.val2Bal)-tc.val2UndelegationAmount, "val2 delegation has decreased")
+	s.Require().Equal(val2.DelegationAmt, initialState.val2Bal.Sub(tc.val2UndelegationAmount), "val2 delegation has decreased")
 
 	epochUnbondingRecord, found := s.App.RecordsKeeper.GetEpochUnbondingRecord(s.Ctx(), initialState.epochNumber)
 	s.Require().True(found, "epoch unbonding record found")
@@ -164,7 +164,7 @@ func (s *KeeperTestSuite) TestUndelegateCallback_Successful() {
 	s.Require().Equal(hzu.Status, recordtypes.HostZoneUnbonding_EXIT_TRANSFER_QUEUE, "hzu status is set to EXIT_TRANSFER_QUEUE")
 	zoneAccount, err := sdk.AccAddressFromBech32(hostZone.Address)
 	s.Require().NoError(err, "zone account address is valid")
-	s.Require().Equal(tc.balanceToUnstake, initialState.zoneAccountBalance-s.App.BankKeeper.GetBalance(s.Ctx(), zoneAccount, StAtom).Amount.Int64(), "tokens are burned")
+	s.Require().Equal(tc.balanceToUnstake, initialState.zoneAccountBalance.Sub(s.App.BankKeeper.GetBalance(s.Ctx(), zoneAccount, StAtom).Amount), "tokens are burned")
 }
 
 func (s *KeeperTestSuite) checkStateIfUndelegateCallbackFailed(tc UndelegateCallbackTestCase) {
@@ -173,15 +173,15 @@ func (s *KeeperTestSuite) checkStateIfUndelegateCallbackFailed(tc UndelegateCall
 	// Check that stakedBal has NOT decreased on the host zone
 	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
 	s.Require().True(found, "host zone found")
-	s.Require().Equal(int64(hostZone.StakedBal), int64(initialState.stakedBal), "stakedBal has NOT decreased on the host zone")
+	s.Require().Equal(hostZone.StakedBal, initialState.stakedBal, "stakedBal has NOT decreased on the host zone")
 
 	// Check that Delegations on validators have NOT decreased
 	s.Require().True(len(hostZone.Validators) == 2, "Expected 2 validators")
 	val1 := hostZone.Validators[0]
-	s.Require().Equal(int64(val1.DelegationAmt), int64(initialState.val1Bal), "val1 delegation has NOT decreased")
+	s.Require().Equal(val1.DelegationAmt, initialState.val1Bal, "val1 delegation has NOT decreased")
 	val2 := hostZone.Validators[1]
 	// Check that the host zone unbonding records have not been updated
-	s.Require().Equal(int64(val2.DelegationAmt), int64(initialState.val2Bal), "val2 delegation has NOT decreased")
+	s.Require().Equal(val2.DelegationAmt, initialState.val2Bal, "val2 delegation has NOT decreased")
 
 	epochUnbondingRecord, found := s.App.RecordsKeeper.GetEpochUnbondingRecord(s.Ctx(), initialState.epochNumber)
 	s.Require().True(found, "epoch unbonding record found")
@@ -191,7 +191,7 @@ func (s *KeeperTestSuite) checkStateIfUndelegateCallbackFailed(tc UndelegateCall
 	s.Require().Equal(hzu.Status, recordtypes.HostZoneUnbonding_UNBONDING_QUEUE, "hzu status is set to UNBONDING_QUEUE")
 	zoneAccount, err := sdk.AccAddressFromBech32(hostZone.Address)
 	s.Require().NoError(err, "zone account address is valid")
-	s.Require().Equal(initialState.zoneAccountBalance, s.App.BankKeeper.GetBalance(s.Ctx(), zoneAccount, StAtom).Amount.Int64(), "tokens are NOT burned")
+	s.Require().Equal(initialState.zoneAccountBalance, s.App.BankKeeper.GetBalance(s.Ctx(), zoneAccount, StAtom).Amount, "tokens are NOT burned")
 }
 
 func (s *KeeperTestSuite) TestUndelegateCallback_UndelegateCallbackTimeout() {
@@ -248,17 +248,18 @@ func (s *KeeperTestSuite) TestUpdateDelegationBalances_Success() {
 	// Check that Delegations on validators have decreased
 	s.Require().True(len(updatedHostZone.Validators) == 2, "Expected 2 validators")
 	val1 := updatedHostZone.Validators[0]
-	s.Require().Equal(int64(val1.DelegationAmt), int64(tc.initialState.val1Bal)-tc.val1UndelegationAmount, "val1 delegation has decreased")
+	s.Require().Equal(val1.DelegationAmt, tc.initialState.val1Bal.Sub(tc.val1UndelegationAmount), "val1 delegation has decreased")
 	val2 := updatedHostZone.Validators[1]
-	s.Require().Equal(int64(val2.DelegationAmt), int64(tc.initialState.val2Bal)-tc.val2UndelegationAmount, "val2 delegation has decreased")
+	s.Require().Equal(val2.DelegationAmt, tc.initialState.val2Bal.Sub(tc.val2UndelegationAmount), "val2 delegation has decreased")
 }
 
-func (s *KeeperTestSuite) TestUpdateDelegationBalances_BigDelegation() {
-	_ = s.SetupUndelegateCallback()
+func (s *KeeperTestSuite) TestUpdateDelegationBalances_ExceedsDelegation() {
+	tc := s.SetupUndelegateCallback()
 	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
 	s.Require().True(found, "host zone found")
 	splitDelegation := types.SplitDelegation{
-		Amount: math.MaxUint64,
+		Validator: "val1_address",
+		Amount:    tc.initialState.val1Bal.AddRaw(1),
 	}
 	invalidCallbackArgs := types.UndelegateCallback{
 		HostZoneId:              HostChainId,
@@ -267,9 +268,7 @@ func (s *KeeperTestSuite) TestUpdateDelegationBalances_BigDelegation() {
 	}
 
 	err := s.App.StakeibcKeeper.UpdateDelegationBalances(s.Ctx(), hostZone, invalidCallbackArgs)
-	expectedErrMsg := `Could not convert undelegate amount to int64 in undelegation callback | `
-	expectedErrMsg += `overflow: unable to cast \d+ of type uint64 to int64: unable to cast to safe cast int`
-	s.Require().Regexp(expectedErrMsg, err.Error())
+	s.Require().EqualError(err, "Failed to remove delegation to validator: can't change delegation on validator")
 }
 
 // GetLatestCompletionTime tests
@@ -326,17 +325,17 @@ func (s *KeeperTestSuite) TestUpdateHostZoneUnbondings_Success() {
 	hostZoneUnbonding1 := recordtypes.HostZoneUnbonding{
 		HostZoneId:    HostChainId,
 		Status:        recordtypes.HostZoneUnbonding_UNBONDING_QUEUE,
-		StTokenAmount: uint64(stAmtHzu1),
+		StTokenAmount: sdk.NewIntFromUint64(uint64(stAmtHzu1)),
 	}
 	hostZoneUnbonding2 := recordtypes.HostZoneUnbonding{
 		HostZoneId:    "not_gaia",
 		Status:        recordtypes.HostZoneUnbonding_UNBONDING_QUEUE,
-		StTokenAmount: uint64(stAmtHzu2),
+		StTokenAmount: sdk.NewIntFromUint64(uint64(stAmtHzu2)),
 	}
 	hostZoneUnbonding3 := recordtypes.HostZoneUnbonding{
 		HostZoneId:    HostChainId,
 		Status:        recordtypes.HostZoneUnbonding_UNBONDING_QUEUE,
-		StTokenAmount: uint64(stAmtHzu3),
+		StTokenAmount: sdk.NewIntFromUint64(uint64(stAmtHzu3)),
 	}
 	// Create two epoch unbonding records (status UNBONDING_QUEUE, completion time unset)
 	epochUnbondingRecord := recordtypes.EpochUnbondingRecord{
@@ -355,7 +354,7 @@ func (s *KeeperTestSuite) TestUpdateHostZoneUnbondings_Success() {
 	completionTime := time.Now().Add(time.Second * time.Duration(10))
 	burnAmount, err := s.App.StakeibcKeeper.UpdateHostZoneUnbondings(s.Ctx(), completionTime, hostZone, callbackArgs)
 	s.Require().NoError(err)
-	s.Require().Equal(int64(stAmtHzu1+stAmtHzu3), int64(burnAmount), "burn amount is correct")
+	s.Require().Equal(sdk.NewInt(int64(stAmtHzu1+stAmtHzu3)), burnAmount, "burn amount is correct")
 
 	// Verify that 2 hzus have status EXIT_TRANSFER_QUEUE, while the third has status UNBONDING_QUEUE
 	// Verify that 2 hzus have completion time set, while the third has no completion time
@@ -409,31 +408,32 @@ func (s *KeeperTestSuite) TestUpdateHostZoneUnbondings_HostZoneUnbondingDNE() {
 }
 
 // Test failure case - Amount too big
-func (s *KeeperTestSuite) TestUpdateHostZoneUnbondings_AmountTooBig() {
+func (s *KeeperTestSuite) TestUpdateHostZoneUnbondings_BigAmount() {
 	hostZone := stakeibc.HostZone{
 		ChainId: HostChainId,
 	}
-	// Set up EpochUnbondingRecord, HostZoneUnbonding and token state
-	hostZoneUnbonding := recordtypes.HostZoneUnbonding{
-		HostZoneId:    HostChainId,
-		Status:        recordtypes.HostZoneUnbonding_UNBONDING_QUEUE,
-		StTokenAmount: math.MaxUint64,
+	// Set up two EpochUnbondingRecords whose combined stTokenAmount exceeds a uint64
+	stTokenAmount := sdk.NewIntFromUint64(math.MaxUint64)
+	for _, epochNumber := range []uint64{1, 2} {
+		hostZoneUnbonding := recordtypes.HostZoneUnbonding{
+			HostZoneId:    HostChainId,
+			Status:        recordtypes.HostZoneUnbonding_UNBONDING_QUEUE,
+			StTokenAmount: stTokenAmount,
+		}
+		epochUnbondingRecord := recordtypes.EpochUnbondingRecord{
+			EpochNumber:        epochNumber,
+			HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{&hostZoneUnbonding},
+		}
+		s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx(), epochUnbondingRecord)
 	}
-	// Create two epoch unbonding records (status UNBONDING_QUEUE, completion time unset)
-	epochUnbondingRecord := recordtypes.EpochUnbondingRecord{
-		EpochNumber:        1,
-		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{&hostZoneUnbonding, &hostZoneUnbonding},
-	}
-	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx(), epochUnbondingRecord)
 	callbackArgs := types.UndelegateCallback{
 		EpochUnbondingRecordIds: []uint64{1, 2},
 	}
 	completionTime := time.Now().Add(time.Second * time.Duration(10))
 
-	_, err := s.App.StakeibcKeeper.UpdateHostZoneUnbondings(s.Ctx(), completionTime, hostZone, callbackArgs)
-	expectedErrMsg := `Could not convert stTokenAmount to int64 in redeem stake | `
-	expectedErrMsg += `overflow: unable to cast \d+ of type uint64 to int64: unable to cast to safe cast int`
-	s.Require().Regexp(expectedErrMsg, err.Error())
+	burnAmount, err := s.App.StakeibcKeeper.UpdateHostZoneUnbondings(s.Ctx(), completionTime, hostZone, callbackArgs)
+	s.Require().NoError(err)
+	s.Require().Equal(stTokenAmount.MulRaw(2), burnAmount, "burn amount is correct")
 }
 
 // BurnTokens Tests
@@ -445,17 +445,17 @@ func (s *KeeperTestSuite) TestBurnTokens_Success() {
 
 	zoneAccount, err := sdk.AccAddressFromBech32(hostZone.Address)
 	s.Require().NoError(err, "zoneAccount is valid")
-	s.Require().Equal(tc.initialState.zoneAccountBalance, s.App.BankKeeper.GetBalance(s.Ctx(), zoneAccount, StAtom).Amount.Int64(), "initial token balance is 300_010")
+	s.Require().Equal(tc.initialState.zoneAccountBalance, s.App.BankKeeper.GetBalance(s.Ctx(), zoneAccount, StAtom).Amount, "initial token balance is 300_010")
 
-	burnAmt := int64(123456)
+	burnAmt := sdk.NewInt(123456)
 	err = s.App.StakeibcKeeper.BurnTokens(s.Ctx(), hostZone, burnAmt)
 	s.Require().NoError(err)
 
-	s.Require().Equal(tc.initialState.zoneAccountBalance-burnAmt, s.App.BankKeeper.GetBalance(s.Ctx(), zoneAccount, StAtom).Amount.Int64(), "post burn amount is 176_554")
+	s.Require().Equal(tc.initialState.zoneAccountBalance.Sub(burnAmt), s.App.BankKeeper.GetBalance(s.Ctx(), zoneAccount, StAtom).Amount, "post burn amount is 176_554")
 }
 
-// Test failure case - could not parse coin
-func (s *KeeperTestSuite) TestBurnTokens_CouldNotParseCoin() {
+// Test failure case - invalid coin
+func (s *KeeperTestSuite) TestBurnTokens_InvalidCoin() {
 	s.SetupUndelegateCallback()
 
 	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
@@ -463,8 +463,8 @@ func (s *KeeperTestSuite) TestBurnTokens_CouldNotParseCoin() {
 	hostZone.HostDenom = ":"
 
 	burnAmt := int64(123456)
-	err := s.App.StakeibcKeeper.BurnTokens(s.Ctx(), hostZone, burnAmt)
-	s.Require().EqualError(err, "could not parse burnCoin: 123456.000000000000000000st:. err: invalid decimal coin expression: 123456.000000000000000000st:: invalid coins")
+	err := s.App.StakeibcKeeper.BurnTokens(s.Ctx(), hostZone, sdk.NewInt(burnAmt))
+	s.Require().EqualError(err, "invalid burnCoin: 123456st:. err: invalid denom: st:: invalid coins")
 }
 
 // Test failure case - could not decode address
@@ -475,7 +475,7 @@ func (s *KeeperTestSuite) TestBurnTokens_CouldNotParseAddress() {
 	s.Require().True(found, "host zone found")
 	hostZone.Address = "invalid"
 
-	err := s.App.StakeibcKeeper.BurnTokens(s.Ctx(), hostZone, int64(123456))
+	err := s.App.StakeibcKeeper.BurnTokens(s.Ctx(), hostZone, sdk.NewInt(123456))
 	s.Require().EqualError(err, "could not bech32 decode address invalid of zone with id: GAIA")
 }
 
@@ -487,6 +487,6 @@ func (s *KeeperTestSuite) TestBurnTokens_CouldNotSendCoinsFromAccountToModule()
 	s.Require().True(found, "host zone found")
 	hostZone.HostDenom = "coinDNE"
 
-	err := s.App.StakeibcKeeper.BurnTokens(s.Ctx(), hostZone, int64(123456))
+	err := s.App.StakeibcKeeper.BurnTokens(s.Ctx(), hostZone, sdk.NewInt(123456))
 	s.Require().EqualError(err, "could not send coins from account stride1755g4dkhpw73gz9h9nwhlcefc6sdf8kcmvcwrk4rxfrz8xpxxjms7savm8 to module stakeibc. err: 0stcoinDNE is smaller than 123456stcoinDNE: insufficient funds")
 }
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/Stride-Labs/stride/x/icacallbacks"
+	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+)
+
+func (k Keeper) MarshalWindDownSweepCallbackArgs(ctx sdk.Context, sweepCallback types.WindDownSweepCallback) ([]byte, error) {
+	out, err := proto.Marshal(&sweepCallback)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("MarshalWindDownSweepCallbackArgs %v", err.Error()))
+		return nil, err
+	}
+	return out, nil
+}
+
+func (k Keeper) UnmarshalWindDownSweepCallbackArgs(ctx sdk.Context, sweepCallback []byte) (*types.WindDownSweepCallback, error) {
+	unmarshalledSweepCallback := types.WindDownSweepCallback{}
+	if err := proto.Unmarshal(sweepCallback, &unmarshalledSweepCallback); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("UnmarshalWindDownSweepCallbackArgs %s", err.Error()))
+		return nil, err
+	}
+	return &unmarshalledSweepCallback, nil
+}
+
+// WindDownSweepCallback marks the end of a wound down host zone's sweep from one of its ICA accounts
+// The account isn't marked as swept here, since the transfer to stride could still fail or time out;
+// instead, the account's balance is queried again on the next epoch, and it's marked as swept once it's empty
+func WindDownSweepCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ack *channeltypes.Acknowledgement, args []byte) error {
+	k.Logger(ctx).Info("WindDownSweepCallback executing", "packet", packet)
+	if ack == nil {
+		// handle timeout
+		k.Logger(ctx).Error(fmt.Sprintf("WindDownSweepCallback timeout, ack is nil, packet %v", packet))
+		return nil
+	}
+
+	txMsgData, err := icacallbacks.GetTxMsgData(ctx, *ack, k.Logger(ctx))
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("failed to fetch txMsgData, packet %v", packet))
+		return sdkerrors.Wrap(icacallbackstypes.ErrTxMsgData, err.Error())
+	}
+
+	sweepCallback, err := k.UnmarshalWindDownSweepCallbackArgs(ctx, args)
+	if err != nil {
+		return err
+	}
+
+	if len(txMsgData.Data) == 0 {
+		// handle tx failure
+		k.Logger(ctx).Error(fmt.Sprintf("WindDownSweepCallback tx failed, the %s account of host zone %s will be swept again, packet %v",
+			sweepCallback.AccountType.String(), sweepCallback.HostZoneId, packet))
+		return nil
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("Swept %v from the %s account of host zone %s to stride",
+		sweepCallback.SweepAmount, sweepCallback.AccountType.String(), sweepCallback.HostZoneId))
+	return nil
+}
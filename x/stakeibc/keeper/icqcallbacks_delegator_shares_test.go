@@ -28,8 +28,8 @@ type DelegatorSharesICQCallbackTestCase struct {
 	validArgs                DelegatorSharesICQCallbackArgs
 	numShares                uint64
 	slashPercentage          float64
-	expectedDelegationAmount uint64
-	expectedSlashAmount      uint64
+	expectedDelegationAmount sdk.Int
+	expectedSlashAmount      sdk.Int
 	expectedWeight           uint64
 }
 
@@ -71,7 +71,7 @@ func (s *KeeperTestSuite) SetupDelegatorSharesICQCallback() DelegatorSharesICQCa
 	currentEpoch := uint64(1)
 	hostZone := stakeibctypes.HostZone{
 		ChainId:   HostChainId,
-		StakedBal: stakedBal,
+		StakedBal: sdk.NewIntFromUint64(stakedBal),
 		Validators: []*stakeibctypes.Validator{
 			// This validator isn't being queried
 			{
@@ -87,7 +87,7 @@ func (s *KeeperTestSuite) SetupDelegatorSharesICQCallback() DelegatorSharesICQCa
 					InternalTokensToSharesRate: internalExchangeRate,
 					EpochNumber:                currentEpoch,
 				},
-				DelegationAmt: tokensBeforeSlash,
+				DelegationAmt: sdk.NewIntFromUint64(tokensBeforeSlash),
 				Weight:        weightBeforeSlash,
 			},
 		},
@@ -102,7 +102,7 @@ func (s *KeeperTestSuite) SetupDelegatorSharesICQCallback() DelegatorSharesICQCa
 	}
 
 	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), hostZone)
-	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx(), strideEpochTracker)
+	s.SetEpochTracker(strideEpochTracker)
 
 	queryResponse := s.CreateDelegatorSharesQueryResponse(valAddress, numShares)
 
@@ -120,8 +120,8 @@ func (s *KeeperTestSuite) SetupDelegatorSharesICQCallback() DelegatorSharesICQCa
 		},
 		numShares:                numShares,
 		slashPercentage:          slashPercentage,
-		expectedDelegationAmount: expectedTokensAfterSlash,
-		expectedSlashAmount:      expectedSlashAmount,
+		expectedDelegationAmount: sdk.NewIntFromUint64(expectedTokensAfterSlash),
+		expectedSlashAmount:      sdk.NewIntFromUint64(expectedSlashAmount),
 		expectedWeight:           expectedWeightAfterSlash,
 	}
 }
@@ -130,18 +130,30 @@ func (s *KeeperTestSuite) TestDelegatorSharesCallback_Successful() {
 	tc := s.SetupDelegatorSharesICQCallback()
 
 	// Callback
-	err := stakeibckeeper.DelegatorSharesCallback(s.App.StakeibcKeeper, s.Ctx(), tc.validArgs.callbackArgs, tc.validArgs.query)
+	ctx := s.Ctx()
+	err := stakeibckeeper.DelegatorSharesCallback(s.App.StakeibcKeeper, ctx, tc.validArgs.callbackArgs, tc.validArgs.query)
 	s.Require().NoError(err, "delegator shares callback error")
 
 	// Confirm the staked balance was decreased on the host
 	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), tc.initialState.hostZone.ChainId)
 	s.Require().True(found, "host zone found")
-	s.Require().Equal(tc.expectedSlashAmount, tc.initialState.hostZone.StakedBal-hostZone.StakedBal)
+	s.Require().Equal(tc.expectedSlashAmount, tc.initialState.hostZone.StakedBal.Sub(hostZone.StakedBal))
 
 	// Confirm the validator's weight and delegation amount were reduced
 	validator := hostZone.Validators[tc.valIndexQueried]
 	s.Require().Equal(tc.expectedWeight, validator.Weight, "validator weight")
 	s.Require().Equal(tc.expectedDelegationAmount, validator.DelegationAmt, "validator delegation amount")
+
+	// Confirm the slash event was emitted
+	initialDelegation := tc.initialState.hostZone.Validators[tc.valIndexQueried].DelegationAmt
+	s.CheckTypedEventEmitted(ctx, &stakeibctypes.EventValidatorSlashed{
+		HostZoneId:       hostZone.ChainId,
+		ValidatorAddress: validator.Address,
+		SlashAmount:      tc.expectedSlashAmount,
+		SlashPct:         tc.expectedSlashAmount.ToDec().Quo(initialDelegation.ToDec()),
+		NewDelegationAmt: tc.expectedDelegationAmount,
+		NewWeight:        tc.expectedWeight,
+	})
 }
 
 func (s *KeeperTestSuite) checkStateIfValidatorNotSlashed(tc DelegatorSharesICQCallbackTestCase) {
@@ -185,7 +197,7 @@ func (s *KeeperTestSuite) TestDelegatorSharesCallback_BufferWindowError() {
 	epochTracker := tc.initialState.strideEpochTracker
 	epochTracker.Duration = 0 // duration of 0 will make the epoch start time equal to the epoch end time
 
-	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx(), epochTracker)
+	s.SetEpochTracker(epochTracker)
 
 	err := stakeibckeeper.DelegatorSharesCallback(s.App.StakeibcKeeper, s.Ctx(), tc.validArgs.callbackArgs, tc.validArgs.query)
 	s.Require().ErrorContains(err, "unable to determine if ICQ callback is inside buffer window")
@@ -201,7 +213,7 @@ func (s *KeeperTestSuite) TestDelegatorSharesCallback_OutsideBufferWindow() {
 	epochTracker.Duration = 10_000_000_000                                                         // 10 second epochs
 	epochTracker.NextEpochStartTime = uint64(s.Coordinator.CurrentTime.UnixNano() + 5_000_000_000) // epoch ends in 5 second
 
-	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx(), epochTracker)
+	s.SetEpochTracker(epochTracker)
 
 	// In this case, we should return success instead of error, but we should exit early before updating the validator's state
 	err := stakeibckeeper.DelegatorSharesCallback(s.App.StakeibcKeeper, s.Ctx(), tc.validArgs.callbackArgs, tc.validArgs.query)
@@ -225,7 +237,7 @@ func (s *KeeperTestSuite) TestDelegatorSharesCallback_ExchangeRateNotFound() {
 	// Increment the epoch number so that we're in an epoch that has not queried the validator's exchange rate
 	epochTracker := tc.initialState.strideEpochTracker
 	epochTracker.EpochNumber += 1
-	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx(), epochTracker)
+	s.SetEpochTracker(epochTracker)
 
 	err := stakeibckeeper.DelegatorSharesCallback(s.App.StakeibcKeeper, s.Ctx(), tc.validArgs.callbackArgs, tc.validArgs.query)
 	s.Require().EqualError(err, "DelegationCallback: validator (valoper2) internal exchange rate has not been updated this epoch (epoch #2): invalid request")
@@ -263,20 +275,37 @@ func (s *KeeperTestSuite) TestDelegatorSharesCallback_InvalidNumTokens() {
 	s.Require().EqualError(err, expectedErrMsg)
 }
 
-func (s *KeeperTestSuite) TestDelegatorSharesCallback_DelegationAmtOverfow() {
+func (s *KeeperTestSuite) TestDelegatorSharesCallback_BigDelegationAmt() {
 	tc := s.SetupDelegatorSharesICQCallback()
 
-	// Update the delegation amount to max int so it overflows when casted
+	// Scale the delegation amount beyond a uint64 (as with an 18-decimal host denom)
+	scale := sdk.NewIntWithDecimal(1, 18)
 	hostZone := tc.initialState.hostZone
 	validator := hostZone.Validators[tc.valIndexQueried]
-	validator.DelegationAmt = math.MaxUint64
+	initialDelegation := validator.DelegationAmt.Mul(scale)
+	validator.DelegationAmt = initialDelegation
+	hostZone.StakedBal = hostZone.StakedBal.Mul(scale)
 	hostZone.Validators[tc.valIndexQueried] = validator
 	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), hostZone)
 
-	err := stakeibckeeper.DelegatorSharesCallback(s.App.StakeibcKeeper, s.Ctx(), tc.validArgs.callbackArgs, tc.validArgs.query)
-	expectedErrMsg := `unable to convert validator delegation amount to int64, err: overflow: `
-	expectedErrMsg += `unable to cast \d+ of type uint64 to int64: unable to cast to safe cast int`
-	s.Require().Regexp(expectedErrMsg, err.Error())
+	delegation := stakingtypes.Delegation{
+		ValidatorAddress: validator.Address,
+		DelegatorAddress: "cosmos_DELEGATION",
+		Shares:           sdk.NewDecFromInt(sdk.NewIntFromUint64(tc.numShares).Mul(scale)),
+	}
+	callbackArgs := s.App.RecordsKeeper.Cdc.MustMarshal(&delegation)
+
+	err := stakeibckeeper.DelegatorSharesCallback(s.App.StakeibcKeeper, s.Ctx(), callbackArgs, tc.validArgs.query)
+	s.Require().NoError(err, "delegator shares callback error")
+
+	// Confirm the slash was applied at the same percentage
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
+	s.Require().True(found, "host zone found")
+	expectedSlashAmount := tc.expectedSlashAmount.Mul(scale)
+	s.Require().Equal(tc.initialState.hostZone.StakedBal.Mul(scale).Sub(expectedSlashAmount), hostZone.StakedBal, "staked balance")
+	validator = hostZone.Validators[tc.valIndexQueried]
+	s.Require().Equal(initialDelegation.Sub(expectedSlashAmount), validator.DelegationAmt, "validator delegation amount")
+	s.Require().Equal(tc.expectedWeight, validator.Weight, "validator weight")
 }
 
 func (s *KeeperTestSuite) TestDelegatorSharesCallback_WeightOverfow() {
@@ -86,7 +86,7 @@ func (s *KeeperTestSuite) SetupValidatorICQCallback() ValidatorICQCallbackTestCa
 	}
 
 	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), hostZone)
-	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx(), strideEpochTracker)
+	s.SetEpochTracker(strideEpochTracker)
 
 	queryResponse := s.CreateValidatorQueryResponse(valAddress, numTokens, numShares)
 
@@ -149,7 +149,7 @@ func (s *KeeperTestSuite) TestValidatorExchangeRateCallback_BufferWindowError()
 	epochTracker := tc.initialState.strideEpochTracker
 	epochTracker.Duration = 0 // duration of 0 will make the epoch start time equal to the epoch end time
 
-	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx(), epochTracker)
+	s.SetEpochTracker(epochTracker)
 
 	err := stakeibckeeper.ValidatorExchangeRateCallback(s.App.StakeibcKeeper, s.Ctx(), tc.validArgs.callbackArgs, tc.validArgs.query)
 	s.Require().ErrorContains(err, "unable to determine if ICQ callback is inside buffer window")
@@ -165,7 +165,7 @@ func (s *KeeperTestSuite) TestValidatorExchangeRateCallback_OutsideBufferWindow(
 	epochTracker.Duration = 10_000_000_000                                                         // 10 second epochs
 	epochTracker.NextEpochStartTime = uint64(s.Coordinator.CurrentTime.UnixNano() + 5_000_000_000) // epoch ends in 5 second
 
-	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx(), epochTracker)
+	s.SetEpochTracker(epochTracker)
 
 	// In this case, we should return success instead of error, but we should exit early before updating the validator's exchange rate
 	err := stakeibckeeper.ValidatorExchangeRateCallback(s.App.StakeibcKeeper, s.Ctx(), tc.validArgs.callbackArgs, tc.validArgs.query)
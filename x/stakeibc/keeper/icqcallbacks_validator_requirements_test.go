@@ -0,0 +1,248 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/gogo/protobuf/proto"
+
+	epochtypes "github.com/Stride-Labs/stride/x/epochs/types"
+	icqtypes "github.com/Stride-Labs/stride/x/interchainquery/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+type ValidatorRequirementsICQCallbackTestCase struct {
+	hostZone     stakeibctypes.HostZone
+	addMsg       stakeibctypes.MsgAddValidator
+	query        icqtypes.Query
+	callbackArgs []byte
+}
+
+func (s *KeeperTestSuite) CreateValidatorRequirementsQueryResponse(address string, commission sdk.Dec, status stakingtypes.BondStatus, jailed bool) []byte {
+	validator := stakingtypes.Validator{
+		OperatorAddress: address,
+		Status:          status,
+		Jailed:          jailed,
+		Commission:      stakingtypes.NewCommission(commission, sdk.OneDec(), sdk.OneDec()),
+	}
+	return s.App.RecordsKeeper.Cdc.MustMarshal(&validator)
+}
+
+func (s *KeeperTestSuite) SetupValidatorRequirementsICQCallback() ValidatorRequirementsICQCallbackTestCase {
+	s.CreateTransferChannel(HostChainId)
+
+	valAddress, err := bech32.ConvertAndEncode("cosmosvaloper", []byte("validator_address___"))
+	s.Require().NoError(err, "no error encoding validator address")
+
+	hostZone := stakeibctypes.HostZone{
+		ChainId:      HostChainId,
+		ConnectionId: ibctesting.FirstConnectionID,
+		Bech32Prefix: Bech32Prefix,
+		Validators:   []*stakeibctypes.Validator{},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), hostZone)
+
+	strideEpochTracker := stakeibctypes.EpochTracker{
+		EpochIdentifier:    epochtypes.STRIDE_EPOCH,
+		EpochNumber:        1,
+		NextEpochStartTime: uint64(s.Coordinator.CurrentTime.UnixNano() + 60_000_000_000), // dictates timeouts
+	}
+	s.SetEpochTracker(strideEpochTracker)
+
+	// Validators must charge at least 5% commission and be in the active set
+	s.App.StakeibcKeeper.SetMinValidatorRequirements(s.Ctx(), stakeibctypes.MinValidatorRequirements{
+		CommissionRate: 5,
+		Uptime:         90,
+	})
+
+	addMsg := stakeibctypes.MsgAddValidator{
+		Creator:    "stride_ADMIN",
+		HostZone:   HostChainId,
+		Name:       "val1",
+		Address:    valAddress,
+		Commission: 1, // should be replaced by the commission on the host
+		Weight:     1,
+	}
+
+	return ValidatorRequirementsICQCallbackTestCase{
+		hostZone: hostZone,
+		addMsg:   addMsg,
+		query: icqtypes.Query{
+			ChainId: HostChainId,
+		},
+		callbackArgs: s.CreateValidatorRequirementsQueryResponse(valAddress, sdk.NewDecWithPrec(10, 2), stakingtypes.Bonded, false),
+	}
+}
+
+func (s *KeeperTestSuite) TestValidatorRequirementsCallback_Successful() {
+	tc := s.SetupValidatorRequirementsICQCallback()
+
+	// Adding the validator should only store it as pending
+	_, err := s.GetMsgServer().AddValidator(sdk.WrapSDKContext(s.Ctx()), &tc.addMsg)
+	s.Require().NoError(err, "no error adding validator")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Len(hostZone.Validators, 0, "validator should not be added before the query returns")
+
+	_, found = s.App.StakeibcKeeper.GetPendingValidator(s.Ctx(), HostChainId, tc.addMsg.Address)
+	s.Require().True(found, "pending validator found")
+
+	// Once the query returns, the validator should be added with its commission from the host
+	err = stakeibckeeper.ValidatorRequirementsCallback(s.App.StakeibcKeeper, s.Ctx(), tc.callbackArgs, tc.query)
+	s.Require().NoError(err, "no error in callback")
+
+	hostZone, found = s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Len(hostZone.Validators, 1, "validator should be added")
+	s.Require().Equal(tc.addMsg.Address, hostZone.Validators[0].Address, "validator address")
+	s.Require().Equal(tc.addMsg.Weight, hostZone.Validators[0].Weight, "validator weight")
+	s.Require().Equal(uint64(10), hostZone.Validators[0].CommissionRate, "validator commission")
+
+	_, found = s.App.StakeibcKeeper.GetPendingValidator(s.Ctx(), HostChainId, tc.addMsg.Address)
+	s.Require().False(found, "pending validator should be removed")
+}
+
+func (s *KeeperTestSuite) checkValidatorRejected(ctx sdk.Context, tc ValidatorRequirementsICQCallbackTestCase) {
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Len(hostZone.Validators, 0, "validator should not be added")
+
+	_, found = s.App.StakeibcKeeper.GetPendingValidator(s.Ctx(), HostChainId, tc.addMsg.Address)
+	s.Require().False(found, "pending validator should be removed")
+
+	rejected := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == proto.MessageName(&stakeibctypes.EventValidatorRejected{}) {
+			rejected = true
+		}
+	}
+	s.Require().True(rejected, "rejection event emitted")
+}
+
+func (s *KeeperTestSuite) TestValidatorRequirementsCallback_CommissionTooLow() {
+	tc := s.SetupValidatorRequirementsICQCallback()
+
+	_, err := s.GetMsgServer().AddValidator(sdk.WrapSDKContext(s.Ctx()), &tc.addMsg)
+	s.Require().NoError(err, "no error adding validator")
+
+	// 1% commission is below the 5% requirement
+	callbackArgs := s.CreateValidatorRequirementsQueryResponse(tc.addMsg.Address, sdk.NewDecWithPrec(1, 2), stakingtypes.Bonded, false)
+	ctx := s.Ctx()
+	err = stakeibckeeper.ValidatorRequirementsCallback(s.App.StakeibcKeeper, ctx, callbackArgs, tc.query)
+	s.Require().NoError(err, "no error in callback")
+
+	s.checkValidatorRejected(ctx, tc)
+}
+
+func (s *KeeperTestSuite) TestValidatorRequirementsCallback_FractionalCommissionTooLow() {
+	tc := s.SetupValidatorRequirementsICQCallback()
+
+	_, err := s.GetMsgServer().AddValidator(sdk.WrapSDKContext(s.Ctx()), &tc.addMsg)
+	s.Require().NoError(err, "no error adding validator")
+
+	// 4.99% commission is checked against the 5% requirement with its exact rate, not a whole percentage
+	callbackArgs := s.CreateValidatorRequirementsQueryResponse(tc.addMsg.Address, sdk.NewDecWithPrec(499, 4), stakingtypes.Bonded, false)
+	ctx := s.Ctx()
+	err = stakeibckeeper.ValidatorRequirementsCallback(s.App.StakeibcKeeper, ctx, callbackArgs, tc.query)
+	s.Require().NoError(err, "no error in callback")
+
+	s.checkValidatorRejected(ctx, tc)
+}
+
+func (s *KeeperTestSuite) TestValidatorRequirementsCallback_Jailed() {
+	tc := s.SetupValidatorRequirementsICQCallback()
+
+	_, err := s.GetMsgServer().AddValidator(sdk.WrapSDKContext(s.Ctx()), &tc.addMsg)
+	s.Require().NoError(err, "no error adding validator")
+
+	callbackArgs := s.CreateValidatorRequirementsQueryResponse(tc.addMsg.Address, sdk.NewDecWithPrec(10, 2), stakingtypes.Unbonding, true)
+	ctx := s.Ctx()
+	err = stakeibckeeper.ValidatorRequirementsCallback(s.App.StakeibcKeeper, ctx, callbackArgs, tc.query)
+	s.Require().NoError(err, "no error in callback")
+
+	s.checkValidatorRejected(ctx, tc)
+}
+
+func (s *KeeperTestSuite) TestValidatorRequirementsCallback_NoPendingValidator() {
+	tc := s.SetupValidatorRequirementsICQCallback()
+
+	// Call the callback without first adding the validator
+	err := stakeibckeeper.ValidatorRequirementsCallback(s.App.StakeibcKeeper, s.Ctx(), tc.callbackArgs, tc.query)
+	s.Require().ErrorContains(err, "no pending validator for address")
+}
+
+func (s *KeeperTestSuite) TestValidatorRequirementsCallback_HostZoneNotFound() {
+	tc := s.SetupValidatorRequirementsICQCallback()
+
+	badQuery := tc.query
+	badQuery.ChainId = "fake_host_zone"
+
+	err := stakeibckeeper.ValidatorRequirementsCallback(s.App.StakeibcKeeper, s.Ctx(), tc.callbackArgs, badQuery)
+	s.Require().EqualError(err, "no registered zone for queried chain ID (fake_host_zone): host zone not found")
+}
+
+func (s *KeeperTestSuite) TestAddValidator_AlreadyPending() {
+	tc := s.SetupValidatorRequirementsICQCallback()
+
+	_, err := s.GetMsgServer().AddValidator(sdk.WrapSDKContext(s.Ctx()), &tc.addMsg)
+	s.Require().NoError(err, "no error adding validator")
+
+	// Adding the same validator again while its query is outstanding should fail
+	secondAddMsg := tc.addMsg
+	secondAddMsg.Weight = 2
+	_, err = s.GetMsgServer().AddValidator(sdk.WrapSDKContext(s.Ctx()), &secondAddMsg)
+	s.Require().ErrorIs(err, stakeibctypes.ErrValidatorPending)
+
+	pendingValidator, found := s.App.StakeibcKeeper.GetPendingValidator(s.Ctx(), HostChainId, tc.addMsg.Address)
+	s.Require().True(found, "pending validator found")
+	s.Require().Equal(tc.addMsg.Weight, pendingValidator.Weight, "pending validator should not be overwritten")
+}
+
+func (s *KeeperTestSuite) TestExpirePendingValidators() {
+	tc := s.SetupValidatorRequirementsICQCallback()
+
+	_, err := s.GetMsgServer().AddValidator(sdk.WrapSDKContext(s.Ctx()), &tc.addMsg)
+	s.Require().NoError(err, "no error adding validator")
+
+	// The pending validator is held until its query expires at the start of the next stride epoch
+	s.App.StakeibcKeeper.ExpirePendingValidators(s.Ctx())
+	_, found := s.App.StakeibcKeeper.GetPendingValidator(s.Ctx(), HostChainId, tc.addMsg.Address)
+	s.Require().True(found, "pending validator should be held before the query expires")
+
+	// Once the query expires without a response, the validator is dropped
+	strideEpochTracker, found := s.App.StakeibcKeeper.GetEpochTracker(s.Ctx(), epochtypes.STRIDE_EPOCH)
+	s.Require().True(found, "stride epoch tracker found")
+	expiredCtx := s.Ctx().WithBlockTime(time.Unix(0, int64(strideEpochTracker.NextEpochStartTime)))
+	s.App.StakeibcKeeper.ExpirePendingValidators(expiredCtx)
+	s.checkValidatorRejected(expiredCtx, tc)
+
+	// And it can be added again
+	_, err = s.GetMsgServer().AddValidator(sdk.WrapSDKContext(expiredCtx), &tc.addMsg)
+	s.Require().NoError(err, "no error re-adding validator after its query expired")
+}
+
+func (s *KeeperTestSuite) TestAddValidator_PendingExpired() {
+	tc := s.SetupValidatorRequirementsICQCallback()
+
+	_, err := s.GetMsgServer().AddValidator(sdk.WrapSDKContext(s.Ctx()), &tc.addMsg)
+	s.Require().NoError(err, "no error adding validator")
+
+	// If the query expired before the pending validator was dropped, it shouldn't block adding the validator again
+	strideEpochTracker, found := s.App.StakeibcKeeper.GetEpochTracker(s.Ctx(), epochtypes.STRIDE_EPOCH)
+	s.Require().True(found, "stride epoch tracker found")
+	expiredCtx := s.Ctx().WithBlockTime(time.Unix(0, int64(strideEpochTracker.NextEpochStartTime)+1))
+
+	secondAddMsg := tc.addMsg
+	secondAddMsg.Weight = 2
+	_, err = s.GetMsgServer().AddValidator(sdk.WrapSDKContext(expiredCtx), &secondAddMsg)
+	s.Require().NoError(err, "no error re-adding validator after its query expired")
+
+	pendingValidator, found := s.App.StakeibcKeeper.GetPendingValidator(expiredCtx, HostChainId, tc.addMsg.Address)
+	s.Require().True(found, "pending validator found")
+	s.Require().Equal(secondAddMsg.Weight, pendingValidator.Weight, "pending validator from the second add")
+}
@@ -71,11 +71,11 @@ func (s *KeeperTestSuite) SetupWithdrawalBalanceCallbackTest() WithdrawalBalance
 	strideEpochTracker := stakeibctypes.EpochTracker{
 		EpochIdentifier:    epochtypes.STRIDE_EPOCH,
 		EpochNumber:        1,
-		NextEpochStartTime: uint64(s.Coordinator.CurrentTime.UnixNano() + 30_000_000_000), // dictates timeouts
+		NextEpochStartTime: uint64(s.Coordinator.CurrentTime.UnixNano() + 60_000_000_000), // dictates timeouts
 	}
 
 	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), hostZone)
-	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx(), strideEpochTracker)
+	s.SetEpochTracker(strideEpochTracker)
 
 	withdrawalBalance := int64(1000)
 	commission := uint64(10)
@@ -121,6 +121,11 @@ func (s *KeeperTestSuite) TestWithdrawalBalanceCallback_Successful() {
 	err := stakeibckeeper.WithdrawalBalanceCallback(s.App.StakeibcKeeper, s.Ctx(), tc.validArgs.callbackArgs, tc.validArgs.query)
 	s.Require().NoError(err)
 
+	// The reinvestment is sent with the queued ICA txs at the end of the block
+	s.Require().Len(s.App.StakeibcKeeper.GetICATxQueue(s.Ctx(), HostChainId, stakeibctypes.ICAAccountType_WITHDRAWAL), 1, "number of queued txs")
+	s.App.StakeibcKeeper.FlushICATxQueues(s.Ctx())
+	s.Require().Empty(s.App.StakeibcKeeper.GetAllQueuedICATxs(s.Ctx()), "queue should be empty after flushing")
+
 	// Confirm ICA reinvestment callback data was stored
 	s.Require().Len(s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx()), 1, "number of callbacks found")
 	callbackKey := icacallbackstypes.PacketID(withdrawalPortId, withdrawalChannelId, startSequence)
@@ -254,6 +259,6 @@ func (s *KeeperTestSuite) TestWithdrawalBalanceCallback_FailedSubmitTx() {
 	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), badHostZone)
 
 	err := stakeibckeeper.WithdrawalBalanceCallback(s.App.StakeibcKeeper, s.Ctx(), tc.validArgs.callbackArgs, tc.validArgs.query)
-	s.Require().ErrorContains(err, "Failed to SubmitTxs for GAIA - connection-X")
+	s.Require().ErrorContains(err, "Failed to queue txs for GAIA - connection-X")
 	s.Require().ErrorContains(err, "invalid connection id, connection-X not found")
 }
@@ -22,7 +22,7 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v3/modules/core/keeper"
 	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
 
-	epochstypes "github.com/Stride-Labs/stride/x/epochs/types"
+	epochskeeper "github.com/Stride-Labs/stride/x/epochs/keeper"
 	icacallbackskeeper "github.com/Stride-Labs/stride/x/icacallbacks/keeper"
 	recordsmodulekeeper "github.com/Stride-Labs/stride/x/records/keeper"
 )
@@ -42,6 +42,7 @@ type (
 		RecordsKeeper         recordsmodulekeeper.Keeper
 		StakingKeeper         stakingkeeper.Keeper
 		ICACallbacksKeeper    icacallbackskeeper.Keeper
+		EpochsKeeper          epochskeeper.Keeper
 
 		accountKeeper types.AccountKeeper
 	}
@@ -64,6 +65,7 @@ func NewKeeper(
 	RecordsKeeper recordsmodulekeeper.Keeper,
 	StakingKeeper stakingkeeper.Keeper,
 	ICACallbacksKeeper icacallbackskeeper.Keeper,
+	EpochsKeeper epochskeeper.Keeper,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -84,6 +86,7 @@ func NewKeeper(
 		RecordsKeeper:         RecordsKeeper,
 		StakingKeeper:         StakingKeeper,
 		ICACallbacksKeeper:    ICACallbacksKeeper,
+		EpochsKeeper:          EpochsKeeper,
 	}
 }
 
@@ -154,12 +157,14 @@ func (k Keeper) GetConnectionId(ctx sdk.Context, portId string) (string, error)
 	return "", fmt.Errorf(errMsg)
 }
 
-// helper to get what share of the curr epoch we're through
-func (k Keeper) GetStrideEpochElapsedShare(ctx sdk.Context) (sdk.Dec, error) {
-	// Get the current stride epoch
-	epochTracker, found := k.GetEpochTracker(ctx, epochstypes.STRIDE_EPOCH)
+// helper to get what share of the curr redemption rate epoch we're through
+// The slashing queries are only accepted at the end of the epoch, right before the redemption rate is updated
+func (k Keeper) GetRedemptionRateEpochElapsedShare(ctx sdk.Context) (sdk.Dec, error) {
+	// Get the current redemption rate epoch
+	epochIdentifier := k.GetEpochIdentifierParam(ctx, types.KeyRedemptionRateEpochIdentifier)
+	epochTracker, found := k.GetEpochTracker(ctx, epochIdentifier)
 	if !found {
-		errMsg := fmt.Sprintf("Failed to get epoch tracker for %s", epochstypes.STRIDE_EPOCH)
+		errMsg := fmt.Sprintf("Failed to get epoch tracker for %s", epochIdentifier)
 		k.Logger(ctx).Error(errMsg)
 		return sdk.ZeroDec(), sdkerrors.Wrapf(sdkerrors.ErrNotFound, errMsg)
 	}
@@ -202,7 +207,7 @@ func (k Keeper) GetStrideEpochElapsedShare(ctx sdk.Context) (sdk.Dec, error) {
 
 // helper to check whether ICQs are valid in this portion of the epoch
 func (k Keeper) IsWithinBufferWindow(ctx sdk.Context) (bool, error) {
-	elapsedShareOfEpoch, err := k.GetStrideEpochElapsedShare(ctx)
+	elapsedShareOfEpoch, err := k.GetRedemptionRateEpochElapsedShare(ctx)
 	if err != nil {
 		return false, err
 	}
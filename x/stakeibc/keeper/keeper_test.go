@@ -2,10 +2,16 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cast"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/Stride-Labs/stride/app/apptesting"
+	epochstypes "github.com/Stride-Labs/stride/x/epochs/types"
 	"github.com/Stride-Labs/stride/x/stakeibc/keeper"
 	"github.com/Stride-Labs/stride/x/stakeibc/types"
 )
@@ -23,6 +29,11 @@ const (
 	IbcOsmo     = "ibc/uosmo"
 	OsmoPrefix  = "osmo"
 	OsmoChainId = "OSMO"
+
+	Evmos       = "aevmos"
+	StEvmos     = "staevmos"
+	IbcEvmos    = "ibc/aevmos"
+	EvmosPrefix = "evmos"
 )
 
 type KeeperTestSuite struct {
@@ -37,6 +48,43 @@ func (s *KeeperTestSuite) GetMsgServer() types.MsgServer {
 	return keeper.NewMsgServerImpl(s.App.StakeibcKeeper)
 }
 
+// Epoch trackers are derived from x/epochs, so this stores the epoch info that yields the given tracker
+func (s *KeeperTestSuite) SetEpochTracker(epochTracker types.EpochTracker) {
+	duration := time.Duration(cast.ToInt64(epochTracker.Duration))
+	currentEpochStartTime := time.Unix(0, cast.ToInt64(epochTracker.NextEpochStartTime)).Add(-duration).UTC()
+
+	s.App.EpochsKeeper.SetEpochInfo(s.Ctx(), epochstypes.EpochInfo{
+		Identifier:            epochTracker.EpochIdentifier,
+		StartTime:             currentEpochStartTime,
+		Duration:              duration,
+		CurrentEpoch:          cast.ToInt64(epochTracker.EpochNumber),
+		CurrentEpochStartTime: currentEpochStartTime,
+		EpochCountingStarted:  true,
+	})
+}
+
+// CheckTypedEventEmitted confirms that the expected typed event was emitted on the given context
+// The events are compared by their JSON since that's how they're serialized in the event attributes
+func (s *KeeperTestSuite) CheckTypedEventEmitted(ctx sdk.Context, expectedEvent proto.Message) {
+	expectedJson, err := codec.ProtoMarshalJSON(expectedEvent, nil)
+	s.Require().NoError(err, "marshal expected event")
+
+	eventType := proto.MessageName(expectedEvent)
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if event.Type != eventType {
+			continue
+		}
+		actualEvent, err := sdk.ParseTypedEvent(event)
+		s.Require().NoError(err, "parse %s event", eventType)
+		actualJson, err := codec.ProtoMarshalJSON(actualEvent, nil)
+		s.Require().NoError(err, "marshal %s event", eventType)
+		if string(expectedJson) == string(actualJson) {
+			return
+		}
+	}
+	s.Require().Fail("typed event not emitted", "expected %s", string(expectedJson))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
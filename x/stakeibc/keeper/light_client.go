@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+// GetMaxLightClientAgeNanos returns how stale a host zone's light client can be before
+// time-dependent pipelines are skipped, using the host zone's override if it's set
+func (k Keeper) GetMaxLightClientAgeNanos(ctx sdk.Context, hostZone types.HostZone) uint64 {
+	if hostZone.MaxLightClientAgeNanos > 0 {
+		return hostZone.MaxLightClientAgeNanos
+	}
+	return k.GetParam(ctx, types.KeyMaxLightClientAgeNanos)
+}
+
+// GetFreshLightClientTime returns the latest time of the host zone's light client, erroring if the
+// light client hasn't been updated within the max light client age
+// Skipped pipelines emit an event so that a stalled relayer doesn't go unnoticed
+func (k Keeper) GetFreshLightClientTime(ctx sdk.Context, hostZone types.HostZone, pipeline string) (uint64, error) {
+	lightClientTime, err := k.GetLightClientTimeSafely(ctx, hostZone.ConnectionId)
+	if err != nil {
+		return 0, err
+	}
+
+	blockTime := uint64(ctx.BlockTime().UnixNano())
+	maxAge := k.GetMaxLightClientAgeNanos(ctx, hostZone)
+	if lightClientTime < blockTime && blockTime-lightClientTime > maxAge {
+		k.EmitTypedEvent(ctx, &types.EventLightClientStale{
+			HostZoneId:             hostZone.ChainId,
+			Pipeline:               pipeline,
+			LightClientTime:        lightClientTime,
+			BlockTime:              blockTime,
+			MaxLightClientAgeNanos: maxAge,
+		})
+
+		errMsg := fmt.Sprintf("Light client for host zone %s was last updated at %d, more than %dns before block time %d, skipping %s",
+			hostZone.ChainId, lightClientTime, maxAge, blockTime, pipeline)
+		k.Logger(ctx).Error(errMsg)
+		return 0, sdkerrors.Wrapf(types.ErrLightClientStale, errMsg)
+	}
+
+	return lightClientTime, nil
+}
+
+// GetHostTimeoutTimestamp converts a timeout in stride time to the host's time, by measuring the time
+// remaining until the timeout from the light client's latest time instead of from the block time
+// If the light client lags stride by more than the time remaining, the packet would already be expired
+// when it's sent, so the light client is treated as stale
+func (k Keeper) GetHostTimeoutTimestamp(ctx sdk.Context, connectionId string, timeoutTimestamp uint64) (uint64, error) {
+	blockTime := uint64(ctx.BlockTime().UnixNano())
+	if timeoutTimestamp <= blockTime {
+		errMsg := fmt.Sprintf("timeout timestamp %d must be after the block time %d", timeoutTimestamp, blockTime)
+		k.Logger(ctx).Error(errMsg)
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, errMsg)
+	}
+
+	lightClientTime, err := k.GetLightClientTimeSafely(ctx, connectionId)
+	if err != nil {
+		return 0, err
+	}
+
+	hostTimeoutTimestamp := lightClientTime + (timeoutTimestamp - blockTime)
+	if hostTimeoutTimestamp <= blockTime {
+		errMsg := fmt.Sprintf("light client time %d on connection %s lags block time %d by more than the timeout window of %dns",
+			lightClientTime, connectionId, blockTime, timeoutTimestamp-blockTime)
+		k.Logger(ctx).Error(errMsg)
+		return 0, sdkerrors.Wrapf(types.ErrLightClientStale, errMsg)
+	}
+
+	return hostTimeoutTimestamp, nil
+}
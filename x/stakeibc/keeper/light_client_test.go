@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"time"
+
+	_ "github.com/stretchr/testify/suite"
+
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) SetupLightClientAge() (hostZone types.HostZone, lightClientTime uint64) {
+	tc := s.SetupGetLightClientSafely()
+
+	hostZone = types.HostZone{
+		ChainId:      HostChainId,
+		ConnectionId: tc.connectionId,
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), hostZone)
+
+	return hostZone, uint64(tc.expectedLightClientTime)
+}
+
+func (s *KeeperTestSuite) TestGetMaxLightClientAgeNanos() {
+	hostZone := types.HostZone{ChainId: HostChainId}
+	s.Require().Equal(types.DefaultMaxLightClientAgeNanos, s.App.StakeibcKeeper.GetMaxLightClientAgeNanos(s.Ctx(), hostZone), "param used without an override")
+
+	hostZone.MaxLightClientAgeNanos = 1_000
+	s.Require().Equal(uint64(1_000), s.App.StakeibcKeeper.GetMaxLightClientAgeNanos(s.Ctx(), hostZone), "host zone override")
+}
+
+func (s *KeeperTestSuite) TestGetFreshLightClientTime_Fresh() {
+	hostZone, lightClientTime := s.SetupLightClientAge()
+
+	// the light client is exactly the max age, which is still fresh
+	ctx := s.Ctx().WithBlockTime(time.Unix(0, int64(lightClientTime+types.DefaultMaxLightClientAgeNanos)))
+
+	actualTime, err := s.App.StakeibcKeeper.GetFreshLightClientTime(ctx, hostZone, types.PipelineReinvest)
+	s.Require().NoError(err, "no error expected when the light client is fresh")
+	s.Require().Equal(lightClientTime, actualTime, "light client time")
+}
+
+func (s *KeeperTestSuite) TestGetFreshLightClientTime_Stale() {
+	hostZone, lightClientTime := s.SetupLightClientAge()
+	hostZone.MaxLightClientAgeNanos = 1_000
+
+	blockTime := lightClientTime + 1_001
+	ctx := s.Ctx().WithBlockTime(time.Unix(0, int64(blockTime)))
+
+	_, err := s.App.StakeibcKeeper.GetFreshLightClientTime(ctx, hostZone, types.PipelineReinvest)
+	s.Require().ErrorIs(err, types.ErrLightClientStale)
+
+	s.CheckTypedEventEmitted(ctx, &types.EventLightClientStale{
+		HostZoneId:             HostChainId,
+		Pipeline:               types.PipelineReinvest,
+		LightClientTime:        lightClientTime,
+		BlockTime:              blockTime,
+		MaxLightClientAgeNanos: 1_000,
+	})
+}
+
+func (s *KeeperTestSuite) TestGetFreshLightClientTime_InvalidConnection() {
+	hostZone, _ := s.SetupLightClientAge()
+	hostZone.ConnectionId = "connection-invalid"
+
+	_, err := s.App.StakeibcKeeper.GetFreshLightClientTime(s.Ctx(), hostZone, types.PipelineReinvest)
+	s.Require().ErrorContains(err, "invalid connection id")
+}
+
+func (s *KeeperTestSuite) TestGetHostTimeoutTimestamp_Successful() {
+	hostZone, lightClientTime := s.SetupLightClientAge()
+
+	// the light client lags stride by 10 seconds, and the timeout is 60 seconds after the block time
+	blockTime := lightClientTime + 10_000_000_000
+	ctx := s.Ctx().WithBlockTime(time.Unix(0, int64(blockTime)))
+
+	hostTimeout, err := s.App.StakeibcKeeper.GetHostTimeoutTimestamp(ctx, hostZone.ConnectionId, blockTime+60_000_000_000)
+	s.Require().NoError(err, "no error expected when converting the timeout")
+	s.Require().Equal(lightClientTime+60_000_000_000, hostTimeout, "timeout measured from the light client time")
+}
+
+func (s *KeeperTestSuite) TestGetHostTimeoutTimestamp_LightClientLagsTimeout() {
+	hostZone, lightClientTime := s.SetupLightClientAge()
+
+	// the light client lags stride by more than the 60 second timeout window
+	blockTime := lightClientTime + 60_000_000_000
+	ctx := s.Ctx().WithBlockTime(time.Unix(0, int64(blockTime)))
+
+	_, err := s.App.StakeibcKeeper.GetHostTimeoutTimestamp(ctx, hostZone.ConnectionId, blockTime+60_000_000_000)
+	s.Require().ErrorIs(err, types.ErrLightClientStale)
+}
+
+func (s *KeeperTestSuite) TestGetHostTimeoutTimestamp_TimeoutInPast() {
+	hostZone, lightClientTime := s.SetupLightClientAge()
+
+	ctx := s.Ctx().WithBlockTime(time.Unix(0, int64(lightClientTime)))
+
+	_, err := s.App.StakeibcKeeper.GetHostTimeoutTimestamp(ctx, hostZone.ConnectionId, lightClientTime)
+	s.Require().ErrorContains(err, "must be after the block time")
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/Stride-Labs/stride/x/stakeibc/migrations/v2"
+	v3 "github.com/Stride-Labs/stride/x/stakeibc/migrations/v3"
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+type Migrator struct {
+	keeper Keeper
+}
+
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.keeper.storeKey, m.keeper.paramstore)
+}
+
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	if err := v3.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.keeper.ICACallbacksKeeper); err != nil {
+		return err
+	}
+
+	// register denom metadata for the stTokens of host zones that predate it
+	for _, hostZone := range m.keeper.GetAllHostZone(ctx) {
+		stDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
+		if _, found := m.keeper.bankKeeper.GetDenomMetaData(ctx, stDenom); found {
+			continue
+		}
+		displayDenom := types.DefaultDisplayDenom(hostZone.HostDenom, hostZone.DenomExponent)
+		metadata := types.StTokenMetadata(hostZone.HostDenom, displayDenom, hostZone.DenomExponent)
+		if displayDenom == hostZone.HostDenom {
+			// the display unit can't be inferred, so only the base unit is registered
+			metadata = types.StTokenMetadata(hostZone.HostDenom, hostZone.HostDenom, 0)
+		}
+		if err := metadata.Validate(); err != nil {
+			return fmt.Errorf("invalid stToken metadata for host zone %s: %s", hostZone.ChainId, err.Error())
+		}
+		m.keeper.bankKeeper.SetDenomMetaData(ctx, metadata)
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+package keeper_test
+
+import (
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
+	"github.com/Stride-Labs/stride/x/stakeibc/keeper"
+	v2 "github.com/Stride-Labs/stride/x/stakeibc/migrations/v2"
+	oldstakeibctypes "github.com/Stride-Labs/stride/x/stakeibc/migrations/v2/types"
+	v3 "github.com/Stride-Labs/stride/x/stakeibc/migrations/v3"
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) TestMigrate1to2_RemovesEpochTrackers() {
+	store := s.Ctx().KVStore(s.App.GetKey(types.StoreKey))
+	epochTrackerStore := prefix.NewStore(store, []byte(v2.EpochTrackerKeyPrefix))
+
+	// Store epoch trackers the way they were stored before the migration
+	for _, epochTracker := range []types.EpochTracker{
+		{EpochIdentifier: "stride_epoch", EpochNumber: 4, NextEpochStartTime: 100, Duration: 10},
+		{EpochIdentifier: "day", EpochNumber: 1, NextEpochStartTime: 200, Duration: 40},
+	} {
+		bz := s.App.AppCodec().MustMarshal(&epochTracker)
+		epochTrackerStore.Set([]byte(epochTracker.EpochIdentifier+"/"), bz)
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), types.HostZone{ChainId: HostChainId})
+
+	err := keeper.NewMigrator(s.App.StakeibcKeeper).Migrate1to2(s.Ctx())
+	s.Require().NoError(err)
+
+	// The epoch trackers should be removed, and the rest of the store untouched
+	iterator := epochTrackerStore.Iterator(nil, nil)
+	defer iterator.Close()
+	s.Require().False(iterator.Valid(), "no epoch trackers should be left in the store")
+
+	_, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
+	s.Require().True(found, "host zone should not be removed")
+}
+
+func (s *KeeperTestSuite) TestMigrate2to3_ConvertsAmounts() {
+	ctx := s.Ctx()
+	store := ctx.KVStore(s.App.GetKey(types.StoreKey))
+	cdc := s.App.AppCodec()
+
+	// Store the host zone, pending validator, and redelegation the way they were stored before the migration
+	legacyValidator := oldstakeibctypes.Validator{Name: "val1", Address: "cosmos_VAL1", DelegationAmt: math.MaxUint64, Weight: 10}
+	legacyHostZone := oldstakeibctypes.HostZone{
+		ChainId:    HostChainId,
+		HostDenom:  Atom,
+		Validators: []*oldstakeibctypes.Validator{&legacyValidator},
+		DelegationAccount: &oldstakeibctypes.ICAAccount{
+			Address: "cosmos_DELEGATION",
+			Delegations: []*oldstakeibctypes.Delegation{
+				{DelegateAcctAddress: "cosmos_DELEGATION", Validator: &legacyValidator, Amt: 100},
+			},
+			Target: oldstakeibctypes.ICAAccountType_DELEGATION,
+		},
+		RedemptionRate:     sdk.OneDec(),
+		LastRedemptionRate: sdk.OneDec(),
+		StakedBal:          math.MaxUint64,
+	}
+	prefix.NewStore(store, types.KeyPrefix(types.HostZoneKey)).Set([]byte(HostChainId), cdc.MustMarshal(&legacyHostZone))
+
+	legacyPendingValidator := oldstakeibctypes.PendingValidator{
+		ChainId:   HostChainId,
+		Validator: oldstakeibctypes.Validator{Name: "val2", Address: "cosmos_VAL2", DelegationAmt: 5},
+	}
+	prefix.NewStore(store, types.KeyPrefix(types.PendingValidatorKeyPrefix)).
+		Set(types.PendingValidatorKey(HostChainId, "cosmos_VAL2"), cdc.MustMarshal(&legacyPendingValidator))
+
+	legacyRedelegation := oldstakeibctypes.Redelegation{
+		HostZoneId:   HostChainId,
+		SrcValidator: "cosmos_VAL1",
+		DstValidator: "cosmos_VAL2",
+		Entries:      []*oldstakeibctypes.RedelegationEntry{{Amount: 40, CompletionTime: 1}},
+	}
+	prefix.NewStore(store, types.KeyPrefix(types.RedelegationKeyPrefix)).
+		Set(types.RedelegationKey(HostChainId, "cosmos_VAL1", "cosmos_VAL2"), cdc.MustMarshal(&legacyRedelegation))
+
+	// Queue an ICA tx and store in-flight callbacks with args encoded the way they were before the migration
+	legacyDelegateArgs := cdc.MustMarshal(&oldstakeibctypes.DelegateCallback{
+		HostZoneId:       HostChainId,
+		DepositRecordId:  1,
+		SplitDelegations: []*oldstakeibctypes.SplitDelegation{{Validator: "cosmos_VAL1", Amount: math.MaxUint64}},
+	})
+	legacyRebalanceArgs := cdc.MustMarshal(&oldstakeibctypes.RebalanceCallback{
+		HostZoneId:   HostChainId,
+		Rebalancings: []*oldstakeibctypes.Rebalancing{{SrcValidator: "cosmos_VAL1", DstValidator: "cosmos_VAL2", Amt: 40}},
+	})
+	batchArgs := cdc.MustMarshal(&types.BatchCallback{
+		HostZoneId: HostChainId,
+		Callbacks: []*types.BatchedCallback{
+			{CallbackId: v3.DelegateCallbackId, CallbackArgs: legacyDelegateArgs, NumMsgs: 1},
+			{CallbackId: v3.RebalanceCallbackId, CallbackArgs: legacyRebalanceArgs, NumMsgs: 1},
+		},
+	})
+
+	s.App.StakeibcKeeper.SetQueuedICATx(ctx, types.QueuedICATx{
+		ChainId:      HostChainId,
+		AccountType:  types.ICAAccountType_DELEGATION,
+		Id:           1,
+		CallbackId:   v3.DelegateCallbackId,
+		CallbackArgs: legacyDelegateArgs,
+	})
+	s.App.IcacallbacksKeeper.SetCallbackData(ctx, icacallbackstypes.CallbackData{
+		CallbackKey:  "batch_key",
+		CallbackId:   v3.BatchCallbackId,
+		CallbackArgs: batchArgs,
+	})
+	s.App.IcacallbacksKeeper.SetCallbackData(ctx, icacallbackstypes.CallbackData{
+		CallbackKey:  "claim_key",
+		CallbackId:   "claim",
+		CallbackArgs: []byte("unchanged"),
+	})
+
+	err := keeper.NewMigrator(s.App.StakeibcKeeper).Migrate2to3(ctx)
+	s.Require().NoError(err)
+
+	// The amounts should be readable as sdk.Int
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(sdk.NewIntFromUint64(math.MaxUint64), hostZone.StakedBal, "staked balance")
+	s.Require().Equal(sdk.NewIntFromUint64(math.MaxUint64), hostZone.Validators[0].DelegationAmt, "validator delegation amount")
+	s.Require().Equal(uint64(10), hostZone.Validators[0].Weight, "validator weight")
+	s.Require().Equal("cosmos_DELEGATION", hostZone.DelegationAccount.Address, "delegation account")
+	s.Require().Equal(int64(100), hostZone.DelegationAccount.Delegations[0].Amt, "delegation account delegation")
+	s.Require().Equal(sdk.NewIntFromUint64(math.MaxUint64), hostZone.DelegationAccount.Delegations[0].Validator.DelegationAmt,
+		"delegation account validator")
+
+	// Existing host zones are micro-denoms, and their stToken metadata should be registered
+	s.Require().Equal(uint32(6), hostZone.DenomExponent, "denom exponent")
+	metadata, found := s.App.BankKeeper.GetDenomMetaData(ctx, StAtom)
+	s.Require().True(found, "stToken metadata found")
+	s.Require().Equal("statom", metadata.Display, "stToken metadata display")
+	s.Require().Len(metadata.DenomUnits, 2, "stToken metadata denom units")
+	s.Require().Equal(uint32(6), metadata.DenomUnits[1].Exponent, "stToken metadata display exponent")
+
+	// The staked balance can now grow beyond a uint64
+	hostZone.StakedBal = hostZone.StakedBal.Add(sdk.OneInt())
+	s.App.StakeibcKeeper.SetHostZone(ctx, hostZone)
+	hostZone, _ = s.App.StakeibcKeeper.GetHostZone(ctx, HostChainId)
+	s.Require().Equal(sdk.NewIntFromUint64(math.MaxUint64).Add(sdk.OneInt()), hostZone.StakedBal, "staked balance beyond uint64")
+
+	pendingValidator, found := s.App.StakeibcKeeper.GetPendingValidator(ctx, HostChainId, "cosmos_VAL2")
+	s.Require().True(found, "pending validator found")
+	s.Require().Equal(sdk.NewInt(5), pendingValidator.DelegationAmt, "pending validator delegation amount")
+
+	redelegation, found := s.App.StakeibcKeeper.GetRedelegation(ctx, HostChainId, "cosmos_VAL1", "cosmos_VAL2")
+	s.Require().True(found, "redelegation found")
+	s.Require().Equal(sdk.NewInt(40), redelegation.Entries[0].Amount, "redelegation entry amount")
+	s.Require().Equal(uint64(1), redelegation.Entries[0].CompletionTime, "redelegation entry completion time")
+
+	// The callback args should be readable with the new types
+	queuedTxs := s.App.StakeibcKeeper.GetAllQueuedICATxs(ctx)
+	s.Require().Len(queuedTxs, 1, "number of queued txs")
+	delegateCallback, err := s.App.StakeibcKeeper.UnmarshalDelegateCallbackArgs(ctx, queuedTxs[0].CallbackArgs)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewIntFromUint64(math.MaxUint64), delegateCallback.SplitDelegations[0].Amount, "queued delegate amount")
+
+	batchCallbackData, found := s.App.IcacallbacksKeeper.GetCallbackData(ctx, "batch_key")
+	s.Require().True(found, "batch callback data found")
+	var batchCallback types.BatchCallback
+	s.Require().NoError(cdc.Unmarshal(batchCallbackData.CallbackArgs, &batchCallback))
+	batchedDelegateCallback, err := s.App.StakeibcKeeper.UnmarshalDelegateCallbackArgs(ctx, batchCallback.Callbacks[0].CallbackArgs)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewIntFromUint64(math.MaxUint64), batchedDelegateCallback.SplitDelegations[0].Amount, "batched delegate amount")
+	batchedRebalanceCallback, err := s.App.StakeibcKeeper.UnmarshalRebalanceCallbackArgs(ctx, batchCallback.Callbacks[1].CallbackArgs)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(40), batchedRebalanceCallback.Rebalancings[0].Amt, "batched rebalance amount")
+
+	claimCallbackData, found := s.App.IcacallbacksKeeper.GetCallbackData(ctx, "claim_key")
+	s.Require().True(found, "claim callback data found")
+	s.Require().Equal([]byte("unchanged"), claimCallbackData.CallbackArgs, "other callback args should be unchanged")
+}
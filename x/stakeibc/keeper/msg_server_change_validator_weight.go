@@ -30,8 +30,16 @@ func (k msgServer) ChangeValidatorWeight(goCtx context.Context, msg *types.MsgCh
 					return nil, sdkerrors.Wrap(types.ErrMaxNumValidators, "cannot set val weight from zero to nonzero on host zone")
 				}
 			}
+			previousWeight := validator.Weight
 			validator.Weight = msg.Weight
 			k.SetHostZone(ctx, hostZone)
+
+			k.EmitTypedEvent(ctx, &types.EventValidatorWeightChanged{
+				HostZoneId:       hostZone.ChainId,
+				ValidatorAddress: validator.Address,
+				PreviousWeight:   previousWeight,
+				NewWeight:        msg.Weight,
+			})
 			return &types.MsgChangeValidatorWeightResponse{}, nil
 
 		}
@@ -4,15 +4,12 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/spf13/cast"
-
 	recordstypes "github.com/Stride-Labs/stride/x/records/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
-	epochstypes "github.com/Stride-Labs/stride/x/epochs/types"
 	"github.com/Stride-Labs/stride/x/stakeibc/types"
 )
 
@@ -48,16 +45,29 @@ func (k msgServer) ClaimUndelegatedTokens(goCtx context.Context, msg *types.MsgC
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "unable to marshal claim callback args")
 	}
-	_, err = k.SubmitTxs(ctx, icaTx.ConnectionId, icaTx.Msgs, icaTx.Account, icaTx.Timeout, CLAIM, marshalledCallbackArgs)
+	// queue the claim so that claims from the same block are coalesced into one packet on the redemption ICA
+	// claims pay out the tokens swept to the redemption ICA, so they're timed out by the sweep epoch
+	sweepEpochIdentifier := k.GetEpochIdentifierParam(ctx, types.KeySweepEpochIdentifier)
+	err = k.QueueTxs(ctx, icaTx.ConnectionId, icaTx.Msgs, icaTx.Account, sweepEpochIdentifier, CLAIM, marshalledCallbackArgs)
 	if err != nil {
-		k.Logger(ctx).Error(fmt.Sprintf("Submit tx error: %s", err.Error()))
-		return nil, sdkerrors.Wrap(err, "unable to submit ICA redemption tx")
+		k.Logger(ctx).Error(fmt.Sprintf("Queue tx error: %s", err.Error()))
+		return nil, sdkerrors.Wrap(err, "unable to queue ICA redemption tx")
 	}
 
 	// Set claimIsPending to true, so that the record can't be double claimed
 	userRedemptionRecord.ClaimIsPending = true
 	k.RecordsKeeper.SetUserRedemptionRecord(ctx, *userRedemptionRecord)
 
+	k.EmitTypedEvent(ctx, &types.EventClaimUndelegatedTokens{
+		Creator:                msg.Creator,
+		Sender:                 msg.Sender,
+		Receiver:               userRedemptionRecord.Receiver,
+		HostZoneId:             msg.HostZoneId,
+		NativeAmount:           userRedemptionRecord.Amount,
+		UserRedemptionRecordId: userRedemptionRecord.Id,
+		EpochNumber:            userRedemptionRecord.EpochNumber,
+	})
+
 	return &types.MsgClaimUndelegatedTokensResponse{}, nil
 }
 
@@ -109,20 +119,17 @@ func (k Keeper) GetRedemptionTransferMsg(ctx sdk.Context, userRedemptionRecord *
 	}
 
 	var msgs []sdk.Msg
-	rrAmt, err := cast.ToInt64E(userRedemptionRecord.Amount)
-	if err != nil {
-		return nil, sdkerrors.Wrap(types.ErrInvalidUserRedemptionRecord, err.Error())
-	}
 	msgs = append(msgs, &bankTypes.MsgSend{
 		FromAddress: redemptionAccount.Address,
 		ToAddress:   userRedemptionRecord.Receiver,
-		Amount:      sdk.NewCoins(sdk.NewInt64Coin(userRedemptionRecord.Denom, rrAmt)),
+		Amount:      sdk.NewCoins(sdk.NewCoin(userRedemptionRecord.Denom, userRedemptionRecord.Amount)),
 	})
 
-	// Give claims a 10 minute timeout
-	epochTracker, found := k.GetEpochTracker(ctx, epochstypes.STRIDE_EPOCH)
+	// Give claims a 10 minute timeout past the next sweep epoch
+	sweepEpochIdentifier := k.GetEpochIdentifierParam(ctx, types.KeySweepEpochIdentifier)
+	epochTracker, found := k.GetEpochTracker(ctx, sweepEpochIdentifier)
 	if !found {
-		errMsg := fmt.Sprintf("Epoch tracker not found for epoch %s", epochstypes.STRIDE_EPOCH)
+		errMsg := fmt.Sprintf("Epoch tracker not found for epoch %s", sweepEpochIdentifier)
 		k.Logger(ctx).Error(errMsg)
 		return nil, sdkerrors.Wrap(types.ErrEpochNotFound, errMsg)
 	}
@@ -55,21 +55,21 @@ func (s *KeeperTestSuite) SetupClaimUndelegatedTokens() ClaimUndelegatedTestCase
 		Receiver:       receiverAddr,
 		Denom:          "uatom",
 		ClaimIsPending: false,
-		Amount:         1000,
+		Amount:         sdk.NewInt(1000),
 	}
-	redemptionAmount := sdk.NewCoins(sdk.NewInt64Coin(redemptionRecord.Denom, int64(redemptionRecord.Amount)))
+	redemptionAmount := sdk.NewCoins(sdk.NewCoin(redemptionRecord.Denom, redemptionRecord.Amount))
 
 	epochTracker := stakeibctypes.EpochTracker{
-		EpochIdentifier:    epochtypes.STRIDE_EPOCH,
+		EpochIdentifier:    epochtypes.DAY_EPOCH,
 		EpochNumber:        epochNumber,
-		NextEpochStartTime: uint64(s.Coordinator.CurrentTime.UnixNano() + 30_000_000_000), // dictates timeouts
+		NextEpochStartTime: uint64(s.Coordinator.CurrentTime.UnixNano() + 60_000_000_000), // dictates timeouts
 	}
 
 	hostZoneUnbonding1 := recordtypes.HostZoneUnbonding{
 		HostZoneId:            HostChainId,
 		Status:                recordtypes.HostZoneUnbonding_CLAIMABLE,
 		UserRedemptionRecords: []string{redemptionRecordId},
-		NativeTokenAmount:     uint64(1_000_000),
+		NativeTokenAmount:     sdk.NewInt(1_000_000),
 	}
 	epochUnbondingRecord := recordtypes.EpochUnbondingRecord{
 		EpochNumber:        epochNumber,
@@ -78,7 +78,7 @@ func (s *KeeperTestSuite) SetupClaimUndelegatedTokens() ClaimUndelegatedTestCase
 	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx(), epochUnbondingRecord)
 
 	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), hostZone)
-	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx(), epochTracker)
+	s.SetEpochTracker(epochTracker)
 	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx(), redemptionRecord)
 
 	return ClaimUndelegatedTestCase{
@@ -110,14 +110,33 @@ func (s *KeeperTestSuite) TestClaimUndelegatedTokens_Successful() {
 	redemptionRecordId := tc.initialState.redemptionRecordId
 	expectedRedemptionRecord := tc.initialState.redemptionRecord
 
-	_, err := s.GetMsgServer().ClaimUndelegatedTokens(sdk.WrapSDKContext(s.Ctx()), &tc.validMsg)
+	ctx := s.Ctx()
+	_, err := s.GetMsgServer().ClaimUndelegatedTokens(sdk.WrapSDKContext(ctx), &tc.validMsg)
 	s.Require().NoError(err, "claim undelegated tokens")
 
 	actualRedemptionRecord, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx(), redemptionRecordId)
 	s.Require().True(found, "redemption record found")
 	s.Require().True(actualRedemptionRecord.ClaimIsPending, "redemption record should be pending")
 	s.Require().Equal(expectedRedemptionRecord.Amount, actualRedemptionRecord.Amount, "record has expected amount")
-	// TODO: check callback data here
+
+	// Confirm the claim was queued on the redemption ICA with its callback
+	queue := s.App.StakeibcKeeper.GetICATxQueue(s.Ctx(), HostChainId, stakeibctypes.ICAAccountType_REDEMPTION)
+	s.Require().Len(queue, 1, "number of queued txs")
+	s.Require().Equal(stakeibckeeper.CLAIM, queue[0].CallbackId, "queued tx callback id")
+	callbackArgs, err := s.App.StakeibcKeeper.UnmarshalClaimCallbackArgs(s.Ctx(), queue[0].CallbackArgs)
+	s.Require().NoError(err, "unmarshal claim callback args")
+	s.Require().Equal(redemptionRecordId, callbackArgs.UserRedemptionRecordId, "claim callback record id")
+
+	// Confirm the claim event was emitted
+	s.CheckTypedEventEmitted(ctx, &stakeibctypes.EventClaimUndelegatedTokens{
+		Creator:                tc.validMsg.Creator,
+		Sender:                 tc.validMsg.Sender,
+		Receiver:               expectedRedemptionRecord.Receiver,
+		HostZoneId:             tc.validMsg.HostZoneId,
+		NativeAmount:           expectedRedemptionRecord.Amount,
+		UserRedemptionRecordId: redemptionRecordId,
+		EpochNumber:            expectedRedemptionRecord.EpochNumber,
+	})
 }
 
 func (s *KeeperTestSuite) TestClaimUndelegatedTokens_SuccessfulMsgSendICA() {
@@ -189,11 +208,11 @@ func (s *KeeperTestSuite) TestClaimUndelegatedTokens_NoRedemptionAccount() {
 
 func (s *KeeperTestSuite) TestClaimUndelegatedTokens_NoEpochTracker() {
 	tc := s.SetupClaimUndelegatedTokens()
-	s.App.StakeibcKeeper.RemoveEpochTracker(s.Ctx(), epochtypes.STRIDE_EPOCH)
+	s.App.EpochsKeeper.DeleteEpochInfo(s.Ctx(), epochtypes.DAY_EPOCH)
 
 	_, err := s.GetMsgServer().ClaimUndelegatedTokens(sdk.WrapSDKContext(s.Ctx()), &tc.validMsg)
 	expectedErr := "unable to build redemption transfer message: "
-	expectedErr += "Epoch tracker not found for epoch stride_epoch: epoch not found"
+	expectedErr += "Epoch tracker not found for epoch day: epoch not found"
 	s.Require().EqualError(err, expectedErr)
 }
 
@@ -28,11 +28,10 @@ func (k msgServer) ClearBalance(goCtx context.Context, msg *types.MsgClearBalanc
 	// Should this be a param?
 	// I think as long as we have a timeout on this, it should be hard to attack (even if someone send a tx on a bad channel, it would be reverted relatively quickly)
 	sourceChannel := msg.Channel
-	coinString := cast.ToString(msg.Amount) + zone.GetHostDenom()
-	tokens, err := sdk.ParseCoinNormalized(coinString)
+	tokens, err := types.NewCoinFromAmount(zone.GetHostDenom(), msg.Amount)
 	if err != nil {
-		k.Logger(ctx).Error(fmt.Sprintf("failed to parse coin (%s)", coinString))
-		return nil, sdkerrors.Wrapf(err, "failed to parse coin (%s)", coinString)
+		k.Logger(ctx).Error(fmt.Sprintf("invalid coin (%v%s)", msg.Amount, zone.GetHostDenom()))
+		return nil, sdkerrors.Wrapf(err, "invalid coin (%v%s)", msg.Amount, zone.GetHostDenom())
 	}
 	sender := feeAccount.GetAddress()
 	// KeyICATimeoutNanos are for our Stride ICA calls, KeyFeeTransferTimeoutNanos is for the IBC transfer
@@ -51,12 +50,9 @@ func (k msgServer) ClearBalance(goCtx context.Context, msg *types.MsgClearBalanc
 
 	connectionId := zone.GetConnectionId()
 
-	icaTimeoutNanos := k.GetParam(ctx, types.KeyICATimeoutNanos)
-	icaTimeoutNanos = cast.ToUint64(ctx.BlockTime().UnixNano()) + icaTimeoutNanos
-
-	_, err = k.SubmitTxs(ctx, connectionId, msgs, *feeAccount, icaTimeoutNanos, "", nil)
+	err = k.QueueTxsStrideEpoch(ctx, connectionId, msgs, *feeAccount, "", nil)
 	if err != nil {
-		return nil, sdkerrors.Wrapf(err, "failed to submit txs")
+		return nil, sdkerrors.Wrapf(err, "failed to queue txs")
 	}
 	return &types.MsgClearBalanceResponse{}, nil
 }
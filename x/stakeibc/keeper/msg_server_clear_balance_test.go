@@ -8,6 +8,7 @@ import (
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 	_ "github.com/stretchr/testify/suite"
 
+	epochtypes "github.com/Stride-Labs/stride/x/epochs/types"
 	"github.com/Stride-Labs/stride/x/stakeibc/types"
 	stakeibctypes "github.com/Stride-Labs/stride/x/stakeibc/types"
 )
@@ -49,6 +50,11 @@ func (s *KeeperTestSuite) SetupClearBalance() ClearBalanceTestCase {
 	}
 
 	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), hostZone)
+	s.SetEpochTracker(stakeibctypes.EpochTracker{
+		EpochIdentifier:    epochtypes.STRIDE_EPOCH,
+		EpochNumber:        1,
+		NextEpochStartTime: uint64(s.Coordinator.CurrentTime.UnixNano() + 60_000_000_000), // dictates timeouts
+	})
 
 	return ClearBalanceTestCase{
 		initialState: ClearBalanceState{
@@ -61,7 +67,7 @@ func (s *KeeperTestSuite) SetupClearBalance() ClearBalanceTestCase {
 		validMsg: stakeibctypes.MsgClearBalance{
 			Creator: user.acc.String(),
 			ChainId: HostChainId,
-			Amount:  amount,
+			Amount:  sdk.NewIntFromUint64(amount),
 			Channel: feeChannelID,
 		},
 	}
@@ -81,7 +87,17 @@ func (s *KeeperTestSuite) TestClearBalance_Successful() {
 	_, err := s.GetMsgServer().ClearBalance(sdk.WrapSDKContext(s.Ctx()), &tc.validMsg)
 	s.Require().NoError(err, "balance clears")
 
-	// Confirm the sequence number was incremented
+	// Confirm the transfer was queued on the fee ICA rather than sent immediately
+	queue := s.App.StakeibcKeeper.GetICATxQueue(s.Ctx(), HostChainId, stakeibctypes.ICAAccountType_FEE)
+	s.Require().Len(queue, 1, "number of queued txs")
+	queuedSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx(), feePortId, feeChannelId)
+	s.Require().True(found, "sequence number not found after queueing")
+	s.Require().Equal(startSequence, queuedSequence, "sequence number after queueing")
+
+	// Flush the queue and confirm the sequence number was incremented
+	s.App.StakeibcKeeper.FlushICATxQueues(s.Ctx())
+	s.Require().Empty(s.App.StakeibcKeeper.GetICATxQueue(s.Ctx(), HostChainId, stakeibctypes.ICAAccountType_FEE), "queue after flush")
+
 	endSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx(), feePortId, feeChannelId)
 	s.Require().True(found, "sequence number not found after clear balance")
 	s.Require().Equal(endSequence, startSequence+1, "sequence number after clear balance")
@@ -104,11 +120,11 @@ func (s *KeeperTestSuite) TestClearBalance_FeeAccountMissing() {
 	s.Require().EqualError(err, "chainId: GAIA: fee account is not registered")
 }
 
-func (s *KeeperTestSuite) TestClearBalance_ParseCoinError() {
+func (s *KeeperTestSuite) TestClearBalance_InvalidCoin() {
 	tc := s.SetupClearBalance()
 	// invalid denom
 	tc.initialState.hz.HostDenom = ":"
 	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), tc.initialState.hz)
 	_, err := s.GetMsgServer().ClearBalance(sdk.WrapSDKContext(s.Ctx()), &tc.validMsg)
-	s.Require().EqualError(err, "failed to parse coin (1000000:): invalid decimal coin expression: 1000000:")
+	s.Require().EqualError(err, "invalid coin (1000000:): invalid denom: :")
 }
@@ -13,7 +13,7 @@ import (
 func (k msgServer) DeleteValidator(goCtx context.Context, msg *types.MsgDeleteValidator) (*types.MsgDeleteValidatorResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	err := k.RemoveValidatorFromHostZone(ctx, msg.HostZone, msg.ValAddr)
+	err := k.DeleteValidatorFromHostZone(ctx, msg.HostZone, msg.ValAddr)
 	if err != nil {
 		errMsg := fmt.Sprintf("Validator (%s) not removed from host zone (%s) | err: %s", msg.ValAddr, msg.HostZone, err.Error())
 		k.Logger(ctx).Error(errMsg)
@@ -1,10 +1,18 @@
 package keeper_test
 
 import (
+	"time"
+
 	_ "github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/gogo/protobuf/proto"
 
+	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/x/stakeibc/keeper"
 	stakeibctypes "github.com/Stride-Labs/stride/x/stakeibc/types"
 )
 
@@ -22,7 +30,7 @@ func (s *KeeperTestSuite) SetupDeleteValidator() DeleteValidatorTestCase {
 			CommissionRate: 1,
 			Weight:         0,
 			Status:         stakeibctypes.Validator_Active,
-			DelegationAmt:  0,
+			DelegationAmt:  sdk.NewInt(0),
 		},
 		{
 			Name:           "val2",
@@ -30,7 +38,7 @@ func (s *KeeperTestSuite) SetupDeleteValidator() DeleteValidatorTestCase {
 			CommissionRate: 2,
 			Weight:         0,
 			Status:         stakeibctypes.Validator_Active,
-			DelegationAmt:  0,
+			DelegationAmt:  sdk.NewInt(0),
 		},
 	}
 
@@ -107,32 +115,218 @@ func (s *KeeperTestSuite) TestDeleteValidator_AddressNotFound() {
 	s.Require().EqualError(err, errMsg)
 }
 
-func (s *KeeperTestSuite) TestDeleteValidator_NonZeroDelegation() {
+func (s *KeeperTestSuite) TestDeleteValidator_NonZeroWeight() {
 	tc := s.SetupDeleteValidator()
 
-	// Update val1 to have a non-zero delegation
+	// Update val1 to have a non-zero weight
 	hostZone := tc.hostZone
-	hostZone.Validators[0].DelegationAmt = 1
+	hostZone.Validators[0].Weight = 1
 	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), hostZone)
 
+	// Since there's no delegation, the validator should be removed right away
 	_, err := s.GetMsgServer().DeleteValidator(sdk.WrapSDKContext(s.Ctx()), &tc.validMsgs[0])
-	errMsg := "Validator (stride_VAL1) not removed from host zone (GAIA) "
-	errMsg += "| err: Validator (stride_VAL1) has non-zero delegation (1) or weight (0): "
-	errMsg += "validator not removed"
-	s.Require().EqualError(err, errMsg)
+	s.Require().NoError(err)
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), "GAIA")
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(1, len(hostZone.Validators), "number of validators should be 1")
+	s.Require().Equal("stride_VAL2", hostZone.Validators[0].Address, "remaining validator")
 }
 
-func (s *KeeperTestSuite) TestDeleteValidator_NonZeroWeight() {
+func (s *KeeperTestSuite) TestDeleteValidator_NonZeroDelegation() {
+	tc := s.SetupRebalanceValidators()
+
+	// get sequence ID for callbacks
+	portId := icatypes.PortPrefix + "GAIA.DELEGATION"
+	startSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx(), portId, tc.delegationChannel)
+	s.Require().True(found, "sequence number not found before delete")
+
+	// Delete val1, which has a delegation of 100
+	msg := stakeibctypes.MsgDeleteValidator{
+		Creator:  "stride_ADDRESS",
+		HostZone: "GAIA",
+		ValAddr:  "stride_VAL1",
+	}
+	_, err := s.GetMsgServer().DeleteValidator(sdk.WrapSDKContext(s.Ctx()), &msg)
+	s.Require().NoError(err)
+
+	// The validator should remain on the host zone until its stake is redelegated
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), "GAIA")
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(5, len(hostZone.Validators), "number of validators should be 5")
+	validator, _, found := stakeibckeeper.GetValidatorFromAddress(hostZone.Validators, "stride_VAL1")
+	s.Require().True(found, "validator should still be on the host zone")
+	s.Require().Equal(uint64(0), validator.Weight, "validator weight should be zero")
+	s.Require().Equal(sdk.NewInt(100), validator.DelegationAmt, "validator delegation should be unchanged")
+	s.Require().Equal(stakeibctypes.Validator_Removing, validator.Status, "validator should be marked as removing")
+
+	// A redelegation ICA should have been submitted with a rebalance callback
+	s.App.StakeibcKeeper.FlushICATxQueues(s.Ctx())
+	endSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx(), portId, tc.delegationChannel)
+	s.Require().True(found, "sequence number not found after delete")
+	s.Require().Equal(startSequence+1, endSequence, "one ICA tx should have been submitted")
+
+	callbackKey := icacallbackstypes.PacketID(portId, tc.delegationChannel, startSequence)
+	callbackData, found := s.App.StakeibcKeeper.ICACallbacksKeeper.GetCallbackData(s.Ctx(), callbackKey)
+	s.Require().True(found, "callback data should exist")
+	s.Require().Equal(stakeibckeeper.REBALANCE, callbackData.CallbackId, "callback id")
+
+	callbackArgs, err := s.App.StakeibcKeeper.UnmarshalRebalanceCallbackArgs(s.Ctx(), callbackData.CallbackArgs)
+	s.Require().NoError(err)
+	totalRedelegated := sdk.ZeroInt()
+	for _, rebalancing := range callbackArgs.Rebalancings {
+		s.Require().Equal("stride_VAL1", rebalancing.SrcValidator, "redelegation source")
+		s.Require().NotEqual("stride_VAL1", rebalancing.DstValidator, "redelegation destination")
+		totalRedelegated = totalRedelegated.Add(rebalancing.Amt)
+	}
+	s.Require().Equal(sdk.NewInt(100), totalRedelegated, "full delegation should be redelegated")
+}
+
+// Deletes val1 (which has a delegation of 100), flushes the queue, and returns the rebalance callback that was submitted
+func (s *KeeperTestSuite) deleteValidatorAndGetRebalanceCallback(tc RebalanceValidatorsTestCase) *stakeibctypes.RebalanceCallback {
+	portId := icatypes.PortPrefix + "GAIA.DELEGATION"
+	sequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx(), portId, tc.delegationChannel)
+	s.Require().True(found, "sequence number not found before delete")
+
+	msg := stakeibctypes.MsgDeleteValidator{
+		Creator:  "stride_ADDRESS",
+		HostZone: "GAIA",
+		ValAddr:  "stride_VAL1",
+	}
+	_, err := s.GetMsgServer().DeleteValidator(sdk.WrapSDKContext(s.Ctx()), &msg)
+	s.Require().NoError(err)
+	s.App.StakeibcKeeper.FlushICATxQueues(s.Ctx())
+
+	callbackKey := icacallbackstypes.PacketID(portId, tc.delegationChannel, sequence)
+	callbackData, found := s.App.StakeibcKeeper.ICACallbacksKeeper.GetCallbackData(s.Ctx(), callbackKey)
+	s.Require().True(found, "callback data should exist")
+	callbackArgs, err := s.App.StakeibcKeeper.UnmarshalRebalanceCallbackArgs(s.Ctx(), callbackData.CallbackArgs)
+	s.Require().NoError(err)
+	return callbackArgs
+}
+
+// Acks a rebalance with a successful MsgBeginRedelegateResponse for each of the given rebalancings
+func (s *KeeperTestSuite) ackRebalance(rebalancings []*stakeibctypes.Rebalancing) {
+	callbackArgs := stakeibctypes.RebalanceCallback{HostZoneId: "GAIA", Rebalancings: rebalancings}
+	args, err := s.App.StakeibcKeeper.MarshalRebalanceCallbackArgs(s.Ctx(), callbackArgs)
+	s.Require().NoError(err)
+
+	var redelegateResponse proto.Message = &stakingtypes.MsgBeginRedelegateResponse{CompletionTime: s.Ctx().BlockTime().Add(time.Hour)}
+	msgs := []sdk.Msg{}
+	for range rebalancings {
+		msgs = append(msgs, &stakingtypes.MsgBeginRedelegate{})
+	}
+	ack := s.ICAPacketAcknowledgement(msgs, &redelegateResponse)
+
+	err = stakeibckeeper.RebalanceCallback(s.App.StakeibcKeeper, s.Ctx(), channeltypes.Packet{}, &ack, args)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestDeleteValidator_RetryAfterTimeout() {
+	tc := s.SetupRebalanceValidators()
+
+	// The first redelegation times out, leaving the validator in removing status with its full delegation
+	callbackArgs := s.deleteValidatorAndGetRebalanceCallback(tc)
+	args, err := s.App.StakeibcKeeper.MarshalRebalanceCallbackArgs(s.Ctx(), *callbackArgs)
+	s.Require().NoError(err)
+	err = stakeibckeeper.RebalanceCallback(s.App.StakeibcKeeper, s.Ctx(), channeltypes.Packet{}, nil, args)
+	s.Require().NoError(err)
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), "GAIA")
+	s.Require().True(found, "host zone found")
+	validator, _, found := stakeibckeeper.GetValidatorFromAddress(hostZone.Validators, "stride_VAL1")
+	s.Require().True(found, "validator should still be on the host zone after the timeout")
+	s.Require().Equal(stakeibctypes.Validator_Removing, validator.Status, "validator should still be removing")
+	s.Require().Equal(sdk.NewInt(100), validator.DelegationAmt, "validator delegation should be unchanged")
+
+	// Retrying the delete re-submits the full redelegation
+	callbackArgs = s.deleteValidatorAndGetRebalanceCallback(tc)
+	totalRedelegated := sdk.ZeroInt()
+	for _, rebalancing := range callbackArgs.Rebalancings {
+		s.Require().Equal("stride_VAL1", rebalancing.SrcValidator, "redelegation source")
+		totalRedelegated = totalRedelegated.Add(rebalancing.Amt)
+	}
+	s.Require().Equal(sdk.NewInt(100), totalRedelegated, "full delegation should be redelegated on retry")
+
+	// Once the retry is acked, the validator is removed
+	s.ackRebalance(callbackArgs.Rebalancings)
+	hostZone, found = s.App.StakeibcKeeper.GetHostZone(s.Ctx(), "GAIA")
+	s.Require().True(found, "host zone found")
+	_, _, found = stakeibckeeper.GetValidatorFromAddress(hostZone.Validators, "stride_VAL1")
+	s.Require().False(found, "validator should be removed after the retry")
+	s.Require().Equal(4, len(hostZone.Validators), "number of validators should be 4")
+}
+
+func (s *KeeperTestSuite) TestDeleteValidator_RetryAfterPartialRedelegation() {
+	tc := s.SetupRebalanceValidators()
+
+	// The entries from val1 to val2 are full, so the first redelegation only moves the stake bound for the other validators
+	maxEntries := int(s.App.StakeibcKeeper.GetParam(s.Ctx(), stakeibctypes.KeyMaxRedelegationEntries))
+	s.addInFlightRedelegations("stride_VAL1", "stride_VAL2", maxEntries)
+
+	callbackArgs := s.deleteValidatorAndGetRebalanceCallback(tc)
+	firstRedelegated := sdk.ZeroInt()
+	for _, rebalancing := range callbackArgs.Rebalancings {
+		s.Require().NotEqual("stride_VAL2", rebalancing.DstValidator, "no redelegation to val2 while its entries are full")
+		firstRedelegated = firstRedelegated.Add(rebalancing.Amt)
+	}
+	s.ackRebalance(callbackArgs.Rebalancings)
+	remaining := sdk.NewInt(100).Sub(firstRedelegated)
+	s.Require().True(remaining.IsPositive(), "part of the delegation should remain")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), "GAIA")
+	s.Require().True(found, "host zone found")
+	validator, _, found := stakeibckeeper.GetValidatorFromAddress(hostZone.Validators, "stride_VAL1")
+	s.Require().True(found, "validator should still be on the host zone after the partial redelegation")
+	s.Require().Equal(stakeibctypes.Validator_Removing, validator.Status, "validator should still be removing")
+	s.Require().Equal(remaining, validator.DelegationAmt, "validator delegation after partial redelegation")
+
+	// Once the redelegations to val2 complete, retrying the delete only redelegates the remaining stake
+	s.App.StakeibcKeeper.RemoveRedelegation(s.Ctx(), "GAIA", "stride_VAL1", "stride_VAL2")
+	callbackArgs = s.deleteValidatorAndGetRebalanceCallback(tc)
+	totalRedelegated := sdk.ZeroInt()
+	for _, rebalancing := range callbackArgs.Rebalancings {
+		s.Require().Equal("stride_VAL1", rebalancing.SrcValidator, "redelegation source")
+		totalRedelegated = totalRedelegated.Add(rebalancing.Amt)
+	}
+	s.Require().Equal(remaining, totalRedelegated, "remaining delegation should be redelegated on retry")
+
+	// Once the retry is acked, the validator is removed
+	s.ackRebalance(callbackArgs.Rebalancings)
+	hostZone, found = s.App.StakeibcKeeper.GetHostZone(s.Ctx(), "GAIA")
+	s.Require().True(found, "host zone found")
+	_, _, found = stakeibckeeper.GetValidatorFromAddress(hostZone.Validators, "stride_VAL1")
+	s.Require().False(found, "validator should be removed after the retry")
+}
+
+func (s *KeeperTestSuite) TestDeleteValidator_RetryWhileRedelegationPending() {
+	tc := s.SetupRebalanceValidators()
+
+	// While the first redelegation is in flight, its stake is already accounted for, so a retry has nothing left to move
+	s.deleteValidatorAndGetRebalanceCallback(tc)
+
+	msg := stakeibctypes.MsgDeleteValidator{
+		Creator:  "stride_ADDRESS",
+		HostZone: "GAIA",
+		ValAddr:  "stride_VAL1",
+	}
+	_, err := s.GetMsgServer().DeleteValidator(sdk.WrapSDKContext(s.Ctx()), &msg)
+	s.Require().ErrorContains(err, "all redelegations are blocked by in-flight redelegations")
+}
+
+func (s *KeeperTestSuite) TestDeleteValidator_NonZeroDelegationMissingDelegationAccount() {
 	tc := s.SetupDeleteValidator()
 
-	// Update val1 to have a non-zero weight
+	// Update val1 to have a non-zero delegation (the host zone has a light client, but no delegation account)
 	hostZone := tc.hostZone
-	hostZone.Validators[0].Weight = 1
+	hostZone.ConnectionId = s.SetupGetLightClientSafely().connectionId
+	hostZone.Validators[0].DelegationAmt = sdk.NewInt(1)
+	hostZone.Validators[1].Weight = 1
 	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), hostZone)
 
 	_, err := s.GetMsgServer().DeleteValidator(sdk.WrapSDKContext(s.Ctx()), &tc.validMsgs[0])
 	errMsg := "Validator (stride_VAL1) not removed from host zone (GAIA) "
-	errMsg += "| err: Validator (stride_VAL1) has non-zero delegation (0) or weight (1): "
+	errMsg += "| err: Zone GAIA is missing a delegation address!: ICA acccount not found on host zone: "
 	errMsg += "validator not removed"
 	s.Require().EqualError(err, errMsg)
 }
@@ -6,9 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/spf13/cast"
 
-	epochtypes "github.com/Stride-Labs/stride/x/epochs/types"
 	"github.com/Stride-Labs/stride/x/stakeibc/types"
 )
 
@@ -26,23 +24,25 @@ func (k msgServer) LiquidStake(goCtx context.Context, msg *types.MsgLiquidStake)
 		k.Logger(ctx).Error(fmt.Sprintf("Host Zone not found for denom (%s)", msg.HostDenom))
 		return nil, sdkerrors.Wrapf(types.ErrInvalidHostZone, "no host zone found for denom (%s)", msg.HostDenom)
 	}
+	if hostZone.WindDownStatus != types.HostZone_Active {
+		k.Logger(ctx).Error(fmt.Sprintf("Host zone %s is winding down, status: %s", hostZone.ChainId, hostZone.WindDownStatus.String()))
+		return nil, sdkerrors.Wrapf(types.ErrHostZoneWindingDown, "host zone %s is not accepting liquid stakes", hostZone.ChainId)
+	}
 	// get the sender address
 	sender, _ := sdk.AccAddressFromBech32(msg.Creator)
-	// get the coins to send, they need to be in the format {amount}{denom}
-	// is safe. The converse is not true.
+	// get the coins to send
 	ibcDenom := hostZone.GetIBCDenom()
-	coinString := cast.ToString(msg.Amount) + ibcDenom
-	inCoin, err := sdk.ParseCoinNormalized(coinString)
+	inCoin, err := types.NewCoinFromAmount(ibcDenom, msg.Amount)
 	if err != nil {
-		k.Logger(ctx).Error(fmt.Sprintf("failed to parse coin (%s)", coinString))
-		return nil, sdkerrors.Wrapf(err, "failed to parse coin (%s)", coinString)
+		k.Logger(ctx).Error(fmt.Sprintf("invalid coin (%v%s)", msg.Amount, ibcDenom))
+		return nil, sdkerrors.Wrapf(err, "invalid coin (%v%s)", msg.Amount, ibcDenom)
 	}
 
 	// Creator owns at least "amount" of inCoin
 	balance := k.bankKeeper.GetBalance(ctx, sender, ibcDenom)
 	if balance.IsLT(inCoin) {
-		k.Logger(ctx).Error(fmt.Sprintf("balance is lower than staking amount. staking amount: %d, balance: %d", msg.Amount, balance.Amount.Int64()))
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "balance is lower than staking amount. staking amount: %d, balance: %d", msg.Amount, balance.Amount.Int64())
+		k.Logger(ctx).Error(fmt.Sprintf("balance is lower than staking amount. staking amount: %v, balance: %v", msg.Amount, balance.Amount))
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "balance is lower than staking amount. staking amount: %v, balance: %v", msg.Amount, balance.Amount)
 	}
 	// check that the token is an IBC token
 	isIbcToken := types.IsIBCToken(ibcDenom)
@@ -69,67 +69,69 @@ func (k msgServer) LiquidStake(goCtx context.Context, msg *types.MsgLiquidStake)
 	}
 	// mint user `amount` of the corresponding stAsset
 	// NOTE: We should ensure that denoms are unique - we don't want anyone spoofing denoms
-	err = k.MintStAsset(ctx, sender, msg.Amount, msg.HostDenom)
+	stAmount, err := k.MintStAsset(ctx, sender, msg.Amount, msg.HostDenom)
 	if err != nil {
 		k.Logger(ctx).Error("failed to send tokens from Account to Module")
 		return nil, sdkerrors.Wrapf(err, "failed to mint %s stAssets to user", msg.HostDenom)
 	}
 
 	// create a deposit record of these tokens (pending transfer)
-	strideEpochTracker, found := k.GetEpochTracker(ctx, epochtypes.STRIDE_EPOCH)
+	depositEpochIdentifier := k.GetEpochIdentifierParam(ctx, types.KeyDepositEpochIdentifier)
+	depositEpochTracker, found := k.GetEpochTracker(ctx, depositEpochIdentifier)
 	if !found {
-		k.Logger(ctx).Error("failed to find stride epoch")
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no epoch number for epoch (%s)", epochtypes.STRIDE_EPOCH)
+		k.Logger(ctx).Error("failed to find deposit epoch")
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no epoch number for epoch (%s)", depositEpochIdentifier)
 	}
 	// Does this use too much gas?
-	depositRecord, found := k.RecordsKeeper.GetDepositRecordByEpochAndChain(ctx, strideEpochTracker.EpochNumber, hostZone.ChainId)
+	depositRecord, found := k.RecordsKeeper.GetDepositRecordByEpochAndChain(ctx, depositEpochTracker.EpochNumber, hostZone.ChainId)
 	if !found {
 		k.Logger(ctx).Error("failed to find deposit record")
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, fmt.Sprintf("no deposit record for epoch (%d)", strideEpochTracker.EpochNumber))
-	}
-	msgAmt, err := cast.ToInt64E(msg.Amount)
-	if err != nil {
-		k.Logger(ctx).Error("failed to convert msg.Amount to int64")
-		return nil, sdkerrors.Wrapf(err, "failed to convert msg.Amount to int64")
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, fmt.Sprintf("no deposit record for epoch (%d)", depositEpochTracker.EpochNumber))
 	}
-	depositRecord.Amount += msgAmt
+	depositRecord.Amount = depositRecord.Amount.Add(msg.Amount)
 	k.RecordsKeeper.SetDepositRecord(ctx, *depositRecord)
 
+	k.EmitTypedEvent(ctx, &types.EventLiquidStake{
+		Creator:         msg.Creator,
+		HostZoneId:      hostZone.ChainId,
+		NativeDenom:     hostZone.HostDenom,
+		NativeAmount:    msg.Amount,
+		StAmount:        stAmount,
+		DepositRecordId: depositRecord.Id,
+	})
+
 	return &types.MsgLiquidStakeResponse{}, nil
 }
 
-func (k msgServer) MintStAsset(ctx sdk.Context, sender sdk.AccAddress, amount uint64, denom string) error {
+// MintStAsset mints stTokens for the given amount of native tokens at the host zone's redemption rate
+// and sends them to the user, returning the amount minted
+func (k msgServer) MintStAsset(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Int, denom string) (sdk.Int, error) {
 	stAssetDenom := types.StAssetDenomFromHostZoneDenom(denom)
 
 	// TODO(TEST-7): Add an exchange rate here! What object should we store the exchange rate on?
 	// How can we ensure that the exchange rate is not manipulated?
 	hz, _ := k.GetHostZoneFromHostDenom(ctx, denom)
-	amt, err := cast.ToInt64E(amount)
-	if err != nil {
-		k.Logger(ctx).Error("failed to convert amount to int64")
-		return sdkerrors.Wrapf(err, "failed to convert amount to int64")
-	}
-	amountToMint := (sdk.NewDec(amt).Quo(hz.RedemptionRate)).TruncateInt()
-	coinString := amountToMint.String() + stAssetDenom
-	stCoins, err := sdk.ParseCoinsNormalized(coinString)
+	amountToMint := (amount.ToDec().Quo(hz.RedemptionRate)).TruncateInt()
+	stCoin, err := types.NewCoinFromAmount(stAssetDenom, amountToMint)
 	if err != nil {
-		k.Logger(ctx).Error("Failed to parse coins")
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Failed to parse coins %s", coinString)
+		k.Logger(ctx).Error("Failed to build coins")
+		return sdk.ZeroInt(), sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Failed to build coins %v%s: %s", amountToMint, stAssetDenom, err.Error())
 	}
+	stCoins := sdk.NewCoins(stCoin)
 
 	// Mints coins to the module account, will error if the module account does not exist or is unauthorized.
 
 	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, stCoins)
 	if err != nil {
 		k.Logger(ctx).Error("Failed to mint coins")
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Failed to mint coins")
+		return sdk.ZeroInt(), sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Failed to mint coins")
 	}
 	// transfer those coins to the user
 	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, stCoins)
 	if err != nil {
 		k.Logger(ctx).Error("Failed to send coins from module to account")
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Failed to send %s from module to account", stCoins.GetDenomByIndex(0))
+		return sdk.ZeroInt(), sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Failed to send %s from module to account", stCoins.GetDenomByIndex(0))
 	}
 	k.Logger(ctx).Info(fmt.Sprintf("[MINT ST ASSET] success on %s.", hz.GetChainId()))
-	return nil
+	return amountToMint, nil
 }
@@ -19,7 +19,7 @@ type Account struct {
 }
 
 type LiquidStakeState struct {
-	depositRecordAmount int64
+	depositRecordAmount sdk.Int
 	hostZone            types.HostZone
 }
 
@@ -31,8 +31,8 @@ type LiquidStakeTestCase struct {
 }
 
 func (s *KeeperTestSuite) SetupLiquidStake() LiquidStakeTestCase {
-	stakeAmount := uint64(1_000_000)
-	initialDepositAmount := int64(1_000_000)
+	stakeAmount := sdk.NewInt(1_000_000)
+	initialDepositAmount := sdk.NewInt(1_000_000)
 	user := Account{
 		acc:           s.TestAccs[0],
 		atomBalance:   sdk.NewInt64Coin(IbcAtom, 10_000_000),
@@ -71,7 +71,7 @@ func (s *KeeperTestSuite) SetupLiquidStake() LiquidStakeTestCase {
 	}
 
 	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), hostZone)
-	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx(), epochTracker)
+	s.SetEpochTracker(epochTracker)
 	s.App.RecordsKeeper.SetDepositRecord(s.Ctx(), initialDepositRecord)
 
 	return LiquidStakeTestCase{
@@ -94,10 +94,11 @@ func (s *KeeperTestSuite) TestLiquidStake_Successful() {
 	user := tc.user
 	zoneAccount := tc.zoneAccount
 	msg := tc.validMsg
-	stakeAmount := sdk.NewInt(int64(msg.Amount))
+	stakeAmount := msg.Amount
 	initialStAtomSupply := s.App.BankKeeper.GetSupply(s.Ctx(), StAtom)
 
-	_, err := s.GetMsgServer().LiquidStake(sdk.WrapSDKContext(s.Ctx()), &msg)
+	ctx := s.Ctx()
+	_, err := s.GetMsgServer().LiquidStake(sdk.WrapSDKContext(ctx), &msg)
 	s.Require().NoError(err)
 
 	// Confirm balances
@@ -123,9 +124,19 @@ func (s *KeeperTestSuite) TestLiquidStake_Successful() {
 	records := s.App.RecordsKeeper.GetAllDepositRecord(s.Ctx())
 	s.Require().Len(records, 1, "number of deposit records")
 
-	expectedDepositRecordAmount := tc.initialState.depositRecordAmount + stakeAmount.Int64()
+	expectedDepositRecordAmount := tc.initialState.depositRecordAmount.Add(stakeAmount)
 	actualDepositRecordAmount := records[0].Amount
 	s.Require().Equal(expectedDepositRecordAmount, actualDepositRecordAmount, "deposit record amount")
+
+	// Confirm the liquid stake event was emitted
+	s.CheckTypedEventEmitted(ctx, &stakeibctypes.EventLiquidStake{
+		Creator:         msg.Creator,
+		HostZoneId:      HostChainId,
+		NativeDenom:     Atom,
+		NativeAmount:    stakeAmount,
+		StAmount:        stakeAmount,
+		DepositRecordId: records[0].Id,
+	})
 }
 
 func (s *KeeperTestSuite) TestLiquidStake_DifferentRedemptionRates() {
@@ -151,12 +162,49 @@ func (s *KeeperTestSuite) TestLiquidStake_DifferentRedemptionRates() {
 		endingStAtomBalance := s.App.BankKeeper.GetBalance(s.Ctx(), user.acc, StAtom).Amount.Int64()
 		actualStAtomMinted := endingStAtomBalance - startingStAtomBalance
 
-		expectedStAtomMinted := int64(float64(msg.Amount) / redemptionRateFloat)
+		expectedStAtomMinted := int64(float64(msg.Amount.Int64()) / redemptionRateFloat)
 		testDescription := fmt.Sprintf("st atom balance for redemption rate: %v", redemptionRateFloat)
 		s.Require().Equal(expectedStAtomMinted, actualStAtomMinted, testDescription)
 	}
 }
 
+func (s *KeeperTestSuite) TestLiquidStake_EighteenDecimalHost() {
+	tc := s.SetupLiquidStake()
+	user := tc.user
+
+	// Convert the host zone to an 18 decimal host with a non-unity redemption rate
+	hostZone := tc.initialState.hostZone
+	hostZone.HostDenom = Evmos
+	hostZone.IBCDenom = IbcEvmos
+	hostZone.DenomExponent = 18
+	hostZone.RedemptionRate = sdk.MustNewDecFromStr("1.5")
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), hostZone)
+
+	// 3,000,000.123456789012345679 evmos, well beyond the range of a uint64
+	stakeAmount, ok := sdk.NewIntFromString("3000000123456789012345679")
+	s.Require().True(ok, "stake amount")
+	s.FundAccount(user.acc, sdk.NewCoin(IbcEvmos, stakeAmount))
+
+	msg := tc.validMsg
+	msg.HostDenom = Evmos
+	msg.Amount = stakeAmount
+	_, err := s.GetMsgServer().LiquidStake(sdk.WrapSDKContext(s.Ctx()), &msg)
+	s.Require().NoError(err)
+
+	// The full amount should be escrowed, down to the last base unit
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx(), user.acc, IbcEvmos).Amount.IsZero(), "user ibc/aevmos balance")
+	s.Require().Equal(stakeAmount, s.App.BankKeeper.GetBalance(s.Ctx(), tc.zoneAccount.acc, IbcEvmos).Amount, "zone ibc/aevmos balance")
+
+	// stTokens are minted at the redemption rate (amount / 1.5), truncated to the base unit
+	expectedStAmount := stakeAmount.MulRaw(2).QuoRaw(3)
+	s.Require().Equal(expectedStAmount, s.App.BankKeeper.GetBalance(s.Ctx(), user.acc, StEvmos).Amount, "user staevmos balance")
+	s.Require().Equal(expectedStAmount, s.App.BankKeeper.GetSupply(s.Ctx(), StEvmos).Amount, "staevmos supply")
+
+	records := s.App.RecordsKeeper.GetAllDepositRecord(s.Ctx())
+	s.Require().Len(records, 1, "number of deposit records")
+	s.Require().Equal(tc.initialState.depositRecordAmount.Add(stakeAmount), records[0].Amount, "deposit record amount")
+}
+
 func (s *KeeperTestSuite) TestLiquidStake_RateBelowMinThreshold() {
 	tc := s.SetupLiquidStake()
 	msg := tc.validMsg
@@ -180,16 +228,16 @@ func (s *KeeperTestSuite) TestLiquidStake_HostZoneNotFound() {
 	s.Require().EqualError(err, "no host zone found for denom (ufakedenom): host zone not registered")
 }
 
-func (s *KeeperTestSuite) TestLiquidStake_IbcCoinParseError() {
+func (s *KeeperTestSuite) TestLiquidStake_InvalidIbcCoin() {
 	tc := s.SetupLiquidStake()
-	// Update hostzone with denom that can't be parsed
+	// Update hostzone with an invalid denom
 	badHostZone := tc.initialState.hostZone
-	badHostZone.IBCDenom = "ibc.0atom"
+	badHostZone.IBCDenom = "ibc/u@tom"
 	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), badHostZone)
 	_, err := s.GetMsgServer().LiquidStake(sdk.WrapSDKContext(s.Ctx()), &tc.validMsg)
 
-	badCoin := fmt.Sprintf("%d%s", tc.validMsg.Amount, badHostZone.IBCDenom)
-	s.Require().EqualError(err, fmt.Sprintf("failed to parse coin (%s): invalid decimal coin expression: %s", badCoin, badCoin))
+	badCoin := fmt.Sprintf("%v%s", tc.validMsg.Amount, badHostZone.IBCDenom)
+	s.Require().EqualError(err, fmt.Sprintf("invalid coin (%s): invalid denom: %s", badCoin, badHostZone.IBCDenom))
 }
 
 func (s *KeeperTestSuite) TestLiquidStake_NotIbcDenom() {
@@ -211,7 +259,7 @@ func (s *KeeperTestSuite) TestLiquidStake_InsufficientBalance() {
 	// Set liquid stake amount to value greater than account balance
 	invalidMsg := tc.validMsg
 	balance := tc.user.atomBalance.Amount.Int64()
-	invalidMsg.Amount = uint64(balance + 1000)
+	invalidMsg.Amount = sdk.NewIntFromUint64(uint64(balance + 1000))
 	_, err := s.GetMsgServer().LiquidStake(sdk.WrapSDKContext(s.Ctx()), &invalidMsg)
 
 	expectedErr := fmt.Sprintf("balance is lower than staking amount. staking amount: %d, balance: %d: insufficient funds", balance+1000, balance)
@@ -221,7 +269,7 @@ func (s *KeeperTestSuite) TestLiquidStake_InsufficientBalance() {
 func (s *KeeperTestSuite) TestLiquidStake_NoEpochTracker() {
 	tc := s.SetupLiquidStake()
 	// Remove epoch tracker
-	s.App.StakeibcKeeper.RemoveEpochTracker(s.Ctx(), epochtypes.STRIDE_EPOCH)
+	s.App.EpochsKeeper.DeleteEpochInfo(s.Ctx(), epochtypes.STRIDE_EPOCH)
 	_, err := s.GetMsgServer().LiquidStake(sdk.WrapSDKContext(s.Ctx()), &tc.validMsg)
 
 	s.Require().EqualError(err, fmt.Sprintf("no epoch number for epoch (%s): not found", epochtypes.STRIDE_EPOCH))
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	_ "github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -30,9 +32,9 @@ func (s *KeeperTestSuite) SetupRebalanceValidators() RebalanceValidatorsTestCase
 	epochTracker := stakeibctypes.EpochTracker{
 		EpochIdentifier:    epochtypes.STRIDE_EPOCH,
 		EpochNumber:        epochNumber,
-		NextEpochStartTime: uint64(s.Coordinator.CurrentTime.UnixNano() + 30_000_000_000), // dictates timeouts
+		NextEpochStartTime: uint64(s.Coordinator.CurrentTime.UnixNano() + 60_000_000_000), // dictates timeouts
 	}
-	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx(), epochTracker)
+	s.SetEpochTracker(epochTracker)
 
 	// define validators for host zone
 	initialValidators := []*stakeibctypes.Validator{
@@ -42,7 +44,7 @@ func (s *KeeperTestSuite) SetupRebalanceValidators() RebalanceValidatorsTestCase
 			CommissionRate: 1,
 			Weight:         100,
 			Status:         stakeibctypes.Validator_Active,
-			DelegationAmt:  100,
+			DelegationAmt:  sdk.NewInt(100),
 		},
 		{
 			Name:           "val2",
@@ -50,7 +52,7 @@ func (s *KeeperTestSuite) SetupRebalanceValidators() RebalanceValidatorsTestCase
 			CommissionRate: 2,
 			Weight:         500,
 			Status:         stakeibctypes.Validator_Active,
-			DelegationAmt:  500,
+			DelegationAmt:  sdk.NewInt(500),
 		},
 		{
 			Name:           "val3",
@@ -58,7 +60,7 @@ func (s *KeeperTestSuite) SetupRebalanceValidators() RebalanceValidatorsTestCase
 			CommissionRate: 2,
 			Weight:         200,
 			Status:         stakeibctypes.Validator_Active,
-			DelegationAmt:  200,
+			DelegationAmt:  sdk.NewInt(200),
 		},
 		{
 			Name:           "val4",
@@ -66,7 +68,7 @@ func (s *KeeperTestSuite) SetupRebalanceValidators() RebalanceValidatorsTestCase
 			CommissionRate: 2,
 			Weight:         400,
 			Status:         stakeibctypes.Validator_Active,
-			DelegationAmt:  400,
+			DelegationAmt:  sdk.NewInt(400),
 		},
 		{
 			Name:           "val5",
@@ -74,7 +76,7 @@ func (s *KeeperTestSuite) SetupRebalanceValidators() RebalanceValidatorsTestCase
 			CommissionRate: 2,
 			Weight:         400,
 			Status:         stakeibctypes.Validator_Active,
-			DelegationAmt:  400,
+			DelegationAmt:  sdk.NewInt(400),
 		},
 	}
 
@@ -82,7 +84,7 @@ func (s *KeeperTestSuite) SetupRebalanceValidators() RebalanceValidatorsTestCase
 	hostZone := stakeibctypes.HostZone{
 		ChainId:      "GAIA",
 		Validators:   initialValidators,
-		StakedBal:    1000,
+		StakedBal:    sdk.NewInt(1000),
 		ConnectionId: ibctesting.FirstConnectionID,
 		DelegationAccount: &stakeibctypes.ICAAccount{
 			Address: delegationAddr,
@@ -140,6 +142,9 @@ func (s *KeeperTestSuite) TestRebalanceValidators_Successful() {
 	_, err := s.GetMsgServer().RebalanceValidators(sdk.WrapSDKContext(s.Ctx()), &badMsg_rightWeights)
 	s.Require().NoError(err, "rebalancing with 2 validators should succeed")
 
+	// send the queued ICA tx
+	s.App.StakeibcKeeper.FlushICATxQueues(s.Ctx())
+
 	// get stored callback data
 	callbackKey := icacallbackstypes.PacketID(portId, tc.delegationChannel, startSequence)
 	callbackData, found := s.App.StakeibcKeeper.ICACallbacksKeeper.GetCallbackData(s.Ctx(), callbackKey)
@@ -152,13 +157,13 @@ func (s *KeeperTestSuite) TestRebalanceValidators_Successful() {
 	// verify callback rebalance is what we want
 	s.Require().Equal(2, len(callbackArgs.Rebalancings), "callback should have 2 rebalancing")
 	firstRebal := callbackArgs.Rebalancings[0]
-	s.Require().Equal(104, int(firstRebal.Amt), "first rebalance should rebalance 104 ATOM")
+	s.Require().Equal(sdk.NewInt(103), firstRebal.Amt, "first rebalance should rebalance 103 ATOM")
 	s.Require().Equal("stride_VAL1", firstRebal.DstValidator, "first rebalance moves to val1")
 	s.Require().Equal("stride_VAL3", firstRebal.SrcValidator, "first rebalance takes from val3")
 	secondRebal := callbackArgs.Rebalancings[1]
-	s.Require().Equal(13, int(secondRebal.Amt), "second rebalance should rebalance 13 ATOM")
+	s.Require().Equal(sdk.NewInt(15), secondRebal.Amt, "second rebalance should rebalance 15 ATOM")
 	s.Require().Equal("stride_VAL1", secondRebal.DstValidator, "second rebalance moves to val1")
-	s.Require().Equal("stride_VAL4", secondRebal.SrcValidator, "second rebalance takes from val4")
+	s.Require().Equal("stride_VAL2", secondRebal.SrcValidator, "second rebalance takes from val2")
 }
 
 func (s *KeeperTestSuite) TestRebalanceValidators_InvalidNumValidators() {
@@ -173,15 +178,6 @@ func (s *KeeperTestSuite) TestRebalanceValidators_InvalidNumValidators() {
 	_, err := s.GetMsgServer().RebalanceValidators(sdk.WrapSDKContext(s.Ctx()), &badMsg_tooFew)
 	expectedErrMsg := "invalid number of validators"
 	s.Require().EqualError(err, expectedErrMsg, "rebalancing 0 validators should fail")
-
-	// Rebalance with 5 validators should fail
-	badMsg_tooMany := stakeibctypes.MsgRebalanceValidators{
-		Creator:      "stride_ADDRESS",
-		HostZone:     "GAIA",
-		NumRebalance: 5,
-	}
-	_, err = s.GetMsgServer().RebalanceValidators(sdk.WrapSDKContext(s.Ctx()), &badMsg_tooMany)
-	s.Require().EqualError(err, expectedErrMsg, "rebalancing 5 validators should fail")
 }
 
 func (s *KeeperTestSuite) TestRebalanceValidators_InvalidNoChange() {
@@ -260,3 +256,203 @@ func (s *KeeperTestSuite) TestRebalanceValidators_InvalidNotEnoughDiff() {
 	expectedErrMsg := "validator weights haven't changed"
 	s.Require().EqualError(err, expectedErrMsg, "rebalancing without sufficient change should fail")
 }
+
+// Sets the validator weights such that val2 and val4 each need to redelegate 250,
+// and val1, val3 and val5 need to receive 200, 200, and 100 respectively
+func (s *KeeperTestSuite) SetupRebalanceValidatorsMultipleRedelegations() RebalanceValidatorsTestCase {
+	tc := s.SetupRebalanceValidators()
+
+	hz, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), "GAIA")
+	s.Require().True(found, "host zone should exist")
+	targetWeights := []uint64{300, 250, 400, 150, 500}
+	for i, validator := range hz.Validators {
+		validator.Weight = targetWeights[i]
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), hz)
+
+	return tc
+}
+
+// Submits a rebalance and returns the rebalancings from the callback data
+func (s *KeeperTestSuite) submitRebalance(tc RebalanceValidatorsTestCase, numRebalance uint64) []*stakeibctypes.Rebalancing {
+	portId := icatypes.PortPrefix + "GAIA.DELEGATION"
+	startSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx(), portId, tc.delegationChannel)
+	s.Require().True(found, "sequence number not found before rebalance")
+
+	msg := stakeibctypes.MsgRebalanceValidators{
+		Creator:      "stride_ADDRESS",
+		HostZone:     "GAIA",
+		NumRebalance: numRebalance,
+	}
+	_, err := s.GetMsgServer().RebalanceValidators(sdk.WrapSDKContext(s.Ctx()), &msg)
+	s.Require().NoError(err, "rebalance should succeed")
+
+	// send the queued ICA tx
+	s.App.StakeibcKeeper.FlushICATxQueues(s.Ctx())
+
+	callbackKey := icacallbackstypes.PacketID(portId, tc.delegationChannel, startSequence)
+	callbackData, found := s.App.StakeibcKeeper.ICACallbacksKeeper.GetCallbackData(s.Ctx(), callbackKey)
+	s.Require().True(found, "callback should exist")
+	callbackArgs, err := s.App.StakeibcKeeper.UnmarshalRebalanceCallbackArgs(s.Ctx(), callbackData.CallbackArgs)
+	s.Require().NoError(err, "unmarshalling callback args error for callback key (%s)", callbackKey)
+
+	return callbackArgs.Rebalancings
+}
+
+func (s *KeeperTestSuite) checkRebalancings(expected []stakeibctypes.Rebalancing, actual []*stakeibctypes.Rebalancing) {
+	s.Require().Len(actual, len(expected), "number of rebalancings")
+	for i, rebalancing := range actual {
+		s.Require().Equal(expected[i], *rebalancing, "rebalancing %d", i)
+	}
+}
+
+// Stores in-flight redelegation entries between two validators that complete after the current block
+func (s *KeeperTestSuite) addInFlightRedelegations(src string, dst string, numEntries int) {
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), "GAIA")
+	s.Require().True(found, "host zone found")
+
+	completionTime := uint64(s.Ctx().BlockTime().Add(time.Hour).UnixNano())
+	for i := 0; i < numEntries; i++ {
+		s.App.StakeibcKeeper.AddRedelegationEntry(s.Ctx(), hostZone, src, dst, stakeibctypes.RedelegationEntry{
+			Amount:         sdk.NewInt(1),
+			CompletionTime: completionTime,
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRebalanceValidators_MoreThanFourRedelegations() {
+	tc := s.SetupRebalanceValidatorsMultipleRedelegations()
+
+	rebalancings := s.submitRebalance(tc, 10)
+	s.checkRebalancings([]stakeibctypes.Rebalancing{
+		{SrcValidator: "stride_VAL2", DstValidator: "stride_VAL1", Amt: sdk.NewInt(200)},
+		{SrcValidator: "stride_VAL4", DstValidator: "stride_VAL3", Amt: sdk.NewInt(200)},
+		{SrcValidator: "stride_VAL2", DstValidator: "stride_VAL5", Amt: sdk.NewInt(50)},
+		{SrcValidator: "stride_VAL4", DstValidator: "stride_VAL5", Amt: sdk.NewInt(50)},
+	}, rebalancings)
+}
+
+func (s *KeeperTestSuite) TestRebalanceValidators_ChunkedByNumRebalance() {
+	tc := s.SetupRebalanceValidatorsMultipleRedelegations()
+
+	// Only the two largest redelegations should be issued, the rest are left for the next rebalance
+	rebalancings := s.submitRebalance(tc, 2)
+	s.checkRebalancings([]stakeibctypes.Rebalancing{
+		{SrcValidator: "stride_VAL2", DstValidator: "stride_VAL1", Amt: sdk.NewInt(200)},
+		{SrcValidator: "stride_VAL4", DstValidator: "stride_VAL3", Amt: sdk.NewInt(200)},
+	}, rebalancings)
+}
+
+func (s *KeeperTestSuite) TestRebalanceValidators_MaxRedelegationEntries() {
+	tc := s.SetupRebalanceValidatorsMultipleRedelegations()
+
+	// Fill up the entries from val2 to val1, so that val2 must redelegate elsewhere
+	maxEntries := int(s.App.StakeibcKeeper.GetParam(s.Ctx(), stakeibctypes.KeyMaxRedelegationEntries))
+	s.addInFlightRedelegations("stride_VAL2", "stride_VAL1", maxEntries)
+
+	rebalancings := s.submitRebalance(tc, 10)
+	s.checkRebalancings([]stakeibctypes.Rebalancing{
+		{SrcValidator: "stride_VAL2", DstValidator: "stride_VAL3", Amt: sdk.NewInt(200)},
+		{SrcValidator: "stride_VAL4", DstValidator: "stride_VAL1", Amt: sdk.NewInt(200)},
+		{SrcValidator: "stride_VAL2", DstValidator: "stride_VAL5", Amt: sdk.NewInt(50)},
+		{SrcValidator: "stride_VAL4", DstValidator: "stride_VAL5", Amt: sdk.NewInt(50)},
+	}, rebalancings)
+}
+
+func (s *KeeperTestSuite) TestRebalanceValidators_CompletedRedelegationsIgnored() {
+	tc := s.SetupRebalanceValidatorsMultipleRedelegations()
+
+	// Entries that have already completed should not count towards the limit or block transitive redelegations
+	s.App.StakeibcKeeper.SetRedelegation(s.Ctx(), stakeibctypes.Redelegation{
+		HostZoneId:   "GAIA",
+		SrcValidator: "stride_VAL5",
+		DstValidator: "stride_VAL2",
+		Entries: []*stakeibctypes.RedelegationEntry{
+			{Amount: sdk.NewInt(1), CompletionTime: uint64(s.Ctx().BlockTime().Add(-time.Hour).UnixNano())},
+		},
+	})
+
+	rebalancings := s.submitRebalance(tc, 10)
+	s.Require().Len(rebalancings, 4, "number of rebalancings")
+	s.Require().Equal("stride_VAL2", rebalancings[0].SrcValidator, "val2 should be used as a source")
+}
+
+func (s *KeeperTestSuite) TestRebalanceValidators_ActiveRedelegationsUseHostTime() {
+	tc := s.SetupRebalanceValidatorsMultipleRedelegations()
+
+	// Entries are only complete once the host's time (from the light client) has passed their completion time,
+	// even if stride's block time is already past it
+	lightClientTime, err := s.App.StakeibcKeeper.GetLightClientTimeSafely(s.Ctx(), tc.hostZone.ConnectionId)
+	s.Require().NoError(err)
+	s.App.StakeibcKeeper.SetRedelegation(s.Ctx(), stakeibctypes.Redelegation{
+		HostZoneId:   "GAIA",
+		SrcValidator: "stride_VAL5",
+		DstValidator: "stride_VAL2",
+		Entries: []*stakeibctypes.RedelegationEntry{
+			{Amount: sdk.NewInt(1), CompletionTime: lightClientTime},
+			{Amount: sdk.NewInt(1), CompletionTime: lightClientTime + 1},
+		},
+	})
+	ctx := s.Ctx().WithBlockTime(time.Unix(0, int64(lightClientTime+2)))
+
+	activeRedelegations, err := s.App.StakeibcKeeper.GetActiveRedelegationsForHostZone(ctx, tc.hostZone)
+	s.Require().NoError(err)
+	s.Require().Len(activeRedelegations, 1, "number of active redelegations")
+	s.Require().Len(activeRedelegations[0].Entries, 1, "only the entry that hasn't completed on the host should be active")
+	s.Require().Equal(lightClientTime+1, activeRedelegations[0].Entries[0].CompletionTime, "active entry completion time")
+}
+
+func (s *KeeperTestSuite) TestRebalanceValidators_PendingRedelegationsCountTowardsMaxEntries() {
+	tc := s.SetupRebalanceValidatorsMultipleRedelegations()
+
+	// Leave room for one more entry from val2 to val1, which the rebalance uses while its tx is in flight
+	maxEntries := int(s.App.StakeibcKeeper.GetParam(s.Ctx(), stakeibctypes.KeyMaxRedelegationEntries))
+	s.addInFlightRedelegations("stride_VAL2", "stride_VAL1", maxEntries-1)
+
+	rebalancings := s.submitRebalance(tc, 1)
+	s.checkRebalancings([]stakeibctypes.Rebalancing{
+		{SrcValidator: "stride_VAL2", DstValidator: "stride_VAL1", Amt: sdk.NewInt(200)},
+	}, rebalancings)
+
+	redelegation, found := s.App.StakeibcKeeper.GetRedelegation(s.Ctx(), "GAIA", "stride_VAL2", "stride_VAL1")
+	s.Require().True(found, "redelegation found")
+	s.Require().Len(redelegation.Entries, maxEntries, "number of entries")
+	s.Require().True(redelegation.Entries[maxEntries-1].Pending, "the submitted redelegation should be pending")
+
+	// Before the ack, another rebalance can't add a redelegation from val2 to val1
+	validatorDeltas := map[string]sdk.Int{"stride_VAL2": sdk.NewInt(-1000), "stride_VAL1": sdk.NewInt(1000)}
+	planned, err := s.App.StakeibcKeeper.PlanRedelegations(s.Ctx(), tc.hostZone, validatorDeltas, 10)
+	s.Require().NoError(err)
+	s.Require().Empty(planned, "no redelegations should be planned while the pair is full")
+}
+
+func (s *KeeperTestSuite) TestRebalanceValidators_TransitiveRedelegation() {
+	tc := s.SetupRebalanceValidatorsMultipleRedelegations()
+
+	// val2 has an incoming redelegation in progress, so it can't be redelegated from
+	s.addInFlightRedelegations("stride_VAL5", "stride_VAL2", 1)
+
+	rebalancings := s.submitRebalance(tc, 10)
+	s.checkRebalancings([]stakeibctypes.Rebalancing{
+		{SrcValidator: "stride_VAL4", DstValidator: "stride_VAL1", Amt: sdk.NewInt(200)},
+		{SrcValidator: "stride_VAL4", DstValidator: "stride_VAL3", Amt: sdk.NewInt(50)},
+	}, rebalancings)
+}
+
+func (s *KeeperTestSuite) TestRebalanceValidators_AllRedelegationsBlocked() {
+	s.SetupRebalanceValidatorsMultipleRedelegations()
+
+	// Both sources have incoming redelegations in progress
+	s.addInFlightRedelegations("stride_VAL5", "stride_VAL2", 1)
+	s.addInFlightRedelegations("stride_VAL5", "stride_VAL4", 1)
+
+	msg := stakeibctypes.MsgRebalanceValidators{
+		Creator:      "stride_ADDRESS",
+		HostZone:     "GAIA",
+		NumRebalance: 10,
+	}
+	_, err := s.GetMsgServer().RebalanceValidators(sdk.WrapSDKContext(s.Ctx()), &msg)
+	expectedErrMsg := "Unable to rebalance GAIA, all redelegations are blocked by in-flight redelegations: "
+	expectedErrMsg += "no redelegations possible within host limits"
+	s.Require().EqualError(err, expectedErrMsg)
+}
@@ -4,8 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/spf13/cast"
-
 	recordstypes "github.com/Stride-Labs/stride/x/records/types"
 	"github.com/Stride-Labs/stride/x/stakeibc/types"
 
@@ -29,10 +27,29 @@ func (k msgServer) RedeemStake(goCtx context.Context, msg *types.MsgRedeemStake)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrInvalidHostZone, "host zone is invalid: %s", msg.HostZone)
 	}
+	// once a host zone is unbonding all of its stake, redemptions are paid out of the wind-down unbonding
+	if hostZone.WindDownStatus == types.HostZone_UnbondingAll {
+		userRedemptionRecord, nativeAmount, err := k.RedeemWoundDownStake(ctx, hostZone, sender, msg)
+		if err != nil {
+			return nil, err
+		}
+		k.EmitTypedEvent(ctx, &types.EventRedeemStake{
+			Creator:                msg.Creator,
+			Receiver:               msg.Receiver,
+			HostZoneId:             hostZone.ChainId,
+			StAmount:               msg.Amount,
+			NativeAmount:           nativeAmount,
+			UserRedemptionRecordId: userRedemptionRecord.Id,
+			EpochNumber:            userRedemptionRecord.EpochNumber,
+		})
+		k.Logger(ctx).Info(fmt.Sprintf("executed wind-down redeem stake: %s", msg.String()))
+		return &types.MsgRedeemStakeResponse{}, nil
+	}
 	// first construct a user redemption record
-	epochTracker, found := k.GetEpochTracker(ctx, "day")
+	unbondingEpochIdentifier := k.GetEpochIdentifierParam(ctx, types.KeyUnbondingEpochIdentifier)
+	epochTracker, found := k.GetEpochTracker(ctx, unbondingEpochIdentifier)
 	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrEpochNotFound, "epoch tracker found: %s", "day")
+		return nil, sdkerrors.Wrapf(types.ErrEpochNotFound, "epoch tracker found: %s", unbondingEpochIdentifier)
 	}
 	senderAddr := sender.String()
 	redemptionId := recordstypes.UserRedemptionRecordKeyFormatter(hostZone.ChainId, epochTracker.EpochNumber, senderAddr)
@@ -48,14 +65,8 @@ func (k msgServer) RedeemStake(goCtx context.Context, msg *types.MsgRedeemStake)
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
 	}
 
-	if msg.Amount > hostZone.StakedBal {
-		return nil, sdkerrors.Wrapf(types.ErrInvalidAmount, "cannot unstake an amount g.t. staked balance on host zone: %d", msg.Amount)
-	}
-
-	amt, err := cast.ToInt64E(msg.Amount)
-	if err != nil {
-		k.Logger(ctx).Error(fmt.Sprintf("error casting RedeemStake msg.Amount to int64, err: %s", err.Error()))
-		return nil, sdkerrors.Wrapf(types.ErrInvalidAmount, fmt.Sprintf("invalid amount: %s", err.Error()))
+	if msg.Amount.GT(hostZone.StakedBal) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAmount, "cannot unstake an amount g.t. staked balance on host zone: %v", msg.Amount)
 	}
 
 	// safety check: redemption rate must be within safety bounds
@@ -67,31 +78,29 @@ func (k msgServer) RedeemStake(goCtx context.Context, msg *types.MsgRedeemStake)
 
 	// construct desired unstaking amount from host zone
 	coinDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
-	nativeAmount := sdk.NewDec(amt).Mul(hostZone.RedemptionRate).RoundInt()
-	// TODO(TEST-112) bigint safety
-	coinString := nativeAmount.String() + coinDenom
-	inCoin, err := sdk.ParseCoinNormalized(coinString)
+	nativeAmount := msg.Amount.ToDec().Mul(hostZone.RedemptionRate).RoundInt()
+	inCoin, err := types.NewCoinFromAmount(coinDenom, nativeAmount)
 	if err != nil {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "could not parse inCoin: %s. err: %s", coinString, err.Error())
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid inCoin: %v%s. err: %s", nativeAmount, coinDenom, err.Error())
 	}
 	// safety checks on the coin
 	// 	- Redemption amount must be positive
 	if !nativeAmount.IsPositive() {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amount must be greater than 0. found: %d", msg.Amount)
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amount must be greater than 0. found: %v", msg.Amount)
 	}
 	// 	- Creator owns at least "amount" stAssets
 	balance := k.bankKeeper.GetBalance(ctx, sender, coinDenom)
 	k.Logger(ctx).Info(fmt.Sprintf("Redemption issuer IBCDenom balance: %v%s", balance.Amount, balance.Denom))
 	k.Logger(ctx).Info(fmt.Sprintf("Redemption requested redemotion amount: %v%s", inCoin.Amount, inCoin.Denom))
-	if balance.Amount.LT(sdk.NewInt(amt)) {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "balance is lower than redemption amount. redemption amount: %d, balance %d: ", msg.Amount, balance.Amount)
+	if balance.Amount.LT(msg.Amount) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "balance is lower than redemption amount. redemption amount: %v, balance %v: ", msg.Amount, balance.Amount)
 	}
 	// UNBONDING RECORD KEEPING
 	userRedemptionRecord := recordstypes.UserRedemptionRecord{
 		Id:          redemptionId,
 		Sender:      senderAddr,
 		Receiver:    msg.Receiver,
-		Amount:      nativeAmount.Uint64(),
+		Amount:      nativeAmount,
 		Denom:       hostZone.HostDenom,
 		HostZoneId:  hostZone.ChainId,
 		EpochNumber: epochTracker.EpochNumber,
@@ -110,11 +119,11 @@ func (k msgServer) RedeemStake(goCtx context.Context, msg *types.MsgRedeemStake)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrInvalidHostZone, "host zone not found in unbondings: %s", hostZone.ChainId)
 	}
-	hostZoneUnbonding.NativeTokenAmount += nativeAmount.Uint64()
+	hostZoneUnbonding.NativeTokenAmount = hostZoneUnbonding.NativeTokenAmount.Add(nativeAmount)
 	hostZoneUnbonding.UserRedemptionRecords = append(hostZoneUnbonding.UserRedemptionRecords, userRedemptionRecord.Id)
 
 	// Escrow user's balance
-	redeemCoin := sdk.NewCoins(sdk.NewCoin(coinDenom, sdk.NewInt(amt)))
+	redeemCoin := sdk.NewCoins(sdk.NewCoin(coinDenom, msg.Amount))
 	bech32ZoneAddress, err := sdk.AccAddressFromBech32(hostZone.Address)
 	if err != nil {
 		return nil, fmt.Errorf("could not bech32 decode address %s of zone with id: %s", hostZone.Address, hostZone.ChainId)
@@ -122,17 +131,11 @@ func (k msgServer) RedeemStake(goCtx context.Context, msg *types.MsgRedeemStake)
 	err = k.bankKeeper.SendCoins(ctx, sender, bech32ZoneAddress, redeemCoin)
 	if err != nil {
 		k.Logger(ctx).Error("Failed to send sdk.NewCoins(inCoins) from account to module")
-		return nil, sdkerrors.Wrapf(types.ErrInsufficientFunds, "couldn't send %d derivative %s tokens to module account. err: %s", msg.Amount, hostZone.HostDenom, err.Error())
+		return nil, sdkerrors.Wrapf(types.ErrInsufficientFunds, "couldn't send %v derivative %s tokens to module account. err: %s", msg.Amount, hostZone.HostDenom, err.Error())
 	}
 
 	// record the number of stAssets that should be burned after unbonding
-	stTokenAmount, err := cast.ToUint64E(msg.Amount)
-	if err != nil {
-		errMsg := fmt.Sprintf("Could not convert redemption amount to int64 in redeem stake | %s", err.Error())
-		k.Logger(ctx).Error(errMsg)
-		return nil, sdkerrors.Wrapf(types.ErrIntCast, errMsg)
-	}
-	hostZoneUnbonding.StTokenAmount += stTokenAmount
+	hostZoneUnbonding.StTokenAmount = hostZoneUnbonding.StTokenAmount.Add(msg.Amount)
 
 	// Actually set the records, we wait until now to prevent any errors
 	k.RecordsKeeper.SetUserRedemptionRecord(ctx, userRedemptionRecord)
@@ -150,6 +153,16 @@ func (k msgServer) RedeemStake(goCtx context.Context, msg *types.MsgRedeemStake)
 	}
 	k.RecordsKeeper.SetEpochUnbondingRecord(ctx, *updatedEpochUnbondingRecord)
 
+	k.EmitTypedEvent(ctx, &types.EventRedeemStake{
+		Creator:                msg.Creator,
+		Receiver:               msg.Receiver,
+		HostZoneId:             hostZone.ChainId,
+		StAmount:               msg.Amount,
+		NativeAmount:           nativeAmount,
+		UserRedemptionRecordId: userRedemptionRecord.Id,
+		EpochNumber:            epochUnbondingRecord.EpochNumber,
+	})
+
 	k.Logger(ctx).Info(fmt.Sprintf("executed redeem stake: %s", msg.String()))
 	return &types.MsgRedeemStakeResponse{}, nil
 }
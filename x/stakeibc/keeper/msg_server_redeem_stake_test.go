@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/spf13/cast"
 	_ "github.com/stretchr/testify/suite"
 
 	epochtypes "github.com/Stride-Labs/stride/x/epochs/types"
@@ -14,8 +13,8 @@ import (
 
 type RedeemStakeState struct {
 	epochNumber                        uint64
-	initialNativeEpochUnbondingAmount  uint64
-	initialStTokenEpochUnbondingAmount uint64
+	initialNativeEpochUnbondingAmount  sdk.Int
+	initialStTokenEpochUnbondingAmount sdk.Int
 }
 type RedeemStakeTestCase struct {
 	user         Account
@@ -26,7 +25,7 @@ type RedeemStakeTestCase struct {
 }
 
 func (s *KeeperTestSuite) SetupRedeemStake() RedeemStakeTestCase {
-	redeemAmount := uint64(1_000_000)
+	redeemAmount := sdk.NewInt(1_000_000)
 	user := Account{
 		acc:           s.TestAccs[0],
 		atomBalance:   sdk.NewInt64Coin("ibc/uatom", 10_000_000),
@@ -51,7 +50,7 @@ func (s *KeeperTestSuite) SetupRedeemStake() RedeemStakeTestCase {
 		HostDenom:      "uatom",
 		Bech32Prefix:   "cosmos",
 		RedemptionRate: sdk.NewDec(1.0),
-		StakedBal:      1234567890,
+		StakedBal:      sdk.NewInt(1234567890),
 		Address:        zoneAddress.String(),
 	}
 
@@ -66,7 +65,7 @@ func (s *KeeperTestSuite) SetupRedeemStake() RedeemStakeTestCase {
 	}
 
 	hostZoneUnbonding := &recordtypes.HostZoneUnbonding{
-		NativeTokenAmount: uint64(0),
+		NativeTokenAmount: sdk.NewInt(0),
 		Denom:             "uatom",
 		HostZoneId:        HostChainId,
 		Status:            recordtypes.HostZoneUnbonding_UNBONDING_QUEUE,
@@ -74,7 +73,7 @@ func (s *KeeperTestSuite) SetupRedeemStake() RedeemStakeTestCase {
 	epochUnbondingRecord.HostZoneUnbondings = append(epochUnbondingRecord.HostZoneUnbondings, hostZoneUnbonding)
 
 	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), hostZone)
-	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx(), epochTrackerDay)
+	s.SetEpochTracker(epochTrackerDay)
 	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx(), epochUnbondingRecord)
 
 	return RedeemStakeTestCase{
@@ -83,8 +82,8 @@ func (s *KeeperTestSuite) SetupRedeemStake() RedeemStakeTestCase {
 		zoneAccount: zoneAccount,
 		initialState: RedeemStakeState{
 			epochNumber:                        epochTrackerDay.EpochNumber,
-			initialNativeEpochUnbondingAmount:  uint64(0),
-			initialStTokenEpochUnbondingAmount: uint64(0),
+			initialNativeEpochUnbondingAmount:  sdk.ZeroInt(),
+			initialStTokenEpochUnbondingAmount: sdk.ZeroInt(),
 		},
 		validMsg: stakeibctypes.MsgRedeemStake{
 			Creator:  user.acc.String(),
@@ -102,14 +101,11 @@ func (s *KeeperTestSuite) TestRedeemStake_Successful() {
 
 	msg := tc.validMsg
 	user := tc.user
-	amt, err := cast.ToInt64E(msg.Amount)
-	if err != nil {
-		panic(err)
-	}
-	redeemAmount := sdk.NewInt(amt)
+	redeemAmount := msg.Amount
 
 	// get the initial unbonding amount *before* calling liquid stake, so we can use it to calc expected vs actual in diff space
-	_, err = s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx()), &msg)
+	ctx := s.Ctx()
+	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(ctx), &msg)
 	s.Require().NoError(err)
 
 	// User STUATOM balance should have DECREASED by the amount to be redeemed
@@ -128,13 +124,13 @@ func (s *KeeperTestSuite) TestRedeemStake_Successful() {
 	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), msg.HostZone)
 	s.Require().True(found, "host zone")
 
-	nativeRedemptionAmount := (redeemAmount.Int64() * hostZone.RedemptionRate.TruncateInt().Int64())
-	stTokenBurnAmount := redeemAmount.Int64()
+	nativeRedemptionAmount := redeemAmount.Mul(hostZone.RedemptionRate.TruncateInt())
+	stTokenBurnAmount := redeemAmount
 
-	actualHostZoneUnbondingNativeAmount := int64(hostZoneUnbonding.NativeTokenAmount)
-	actualHostZoneUnbondingStTokenAmount := int64(hostZoneUnbonding.StTokenAmount)
-	expectedHostZoneUnbondingNativeAmount := int64(initialState.initialNativeEpochUnbondingAmount) + nativeRedemptionAmount
-	expectedHostZoneUnbondingStTokenAmount := int64(initialState.initialStTokenEpochUnbondingAmount) + stTokenBurnAmount
+	actualHostZoneUnbondingNativeAmount := hostZoneUnbonding.NativeTokenAmount
+	actualHostZoneUnbondingStTokenAmount := hostZoneUnbonding.StTokenAmount
+	expectedHostZoneUnbondingNativeAmount := initialState.initialNativeEpochUnbondingAmount.Add(nativeRedemptionAmount)
+	expectedHostZoneUnbondingStTokenAmount := initialState.initialStTokenEpochUnbondingAmount.Add(stTokenBurnAmount)
 
 	s.Require().Equal(expectedHostZoneUnbondingNativeAmount, actualHostZoneUnbondingNativeAmount, "host zone native unbonding amount")
 	s.Require().Equal(expectedHostZoneUnbondingStTokenAmount, actualHostZoneUnbondingStTokenAmount, "host zone stToken burn amount")
@@ -146,7 +142,7 @@ func (s *KeeperTestSuite) TestRedeemStake_Successful() {
 	userRedemptionRecord, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx(), userRedemptionRecordId)
 	s.Require().True(found)
 	// check amount
-	s.Require().Equal(expectedHostZoneUnbondingNativeAmount, int64(userRedemptionRecord.Amount), "redemption record amount")
+	s.Require().Equal(expectedHostZoneUnbondingNativeAmount, userRedemptionRecord.Amount, "redemption record amount")
 	// check sender
 	s.Require().Equal(msg.Creator, userRedemptionRecord.Sender, "redemption record sender")
 	// check receiver
@@ -156,6 +152,91 @@ func (s *KeeperTestSuite) TestRedeemStake_Successful() {
 	// check claimIsPending
 	s.Require().False(userRedemptionRecord.ClaimIsPending, "redemption record is not claimable")
 	s.Require().NotEqual(hostZoneUnbonding.Status, recordtypes.HostZoneUnbonding_CLAIMABLE, "host zone unbonding should NOT be marked as CLAIMABLE")
+
+	// Confirm the redeem event was emitted
+	s.CheckTypedEventEmitted(ctx, &stakeibctypes.EventRedeemStake{
+		Creator:                msg.Creator,
+		Receiver:               msg.Receiver,
+		HostZoneId:             msg.HostZone,
+		StAmount:               redeemAmount,
+		NativeAmount:           nativeRedemptionAmount,
+		UserRedemptionRecordId: userRedemptionRecordId,
+		EpochNumber:            epochTracker.EpochNumber,
+	})
+}
+
+func (s *KeeperTestSuite) TestRedeemStake_CustomUnbondingEpoch() {
+	tc := s.SetupRedeemStake()
+
+	// Drive unbondings from an hourly epoch, whose epoch number differs from the day epoch
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx())
+	params.UnbondingEpochIdentifier = "hour"
+	s.App.StakeibcKeeper.SetParams(s.Ctx(), params)
+
+	hourEpochNumber := uint64(7)
+	s.SetEpochTracker(stakeibctypes.EpochTracker{EpochIdentifier: "hour", EpochNumber: hourEpochNumber})
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx(), recordtypes.EpochUnbondingRecord{
+		EpochNumber: hourEpochNumber,
+		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+			{Denom: "uatom", HostZoneId: HostChainId, Status: recordtypes.HostZoneUnbonding_UNBONDING_QUEUE},
+		},
+	})
+
+	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx()), &tc.validMsg)
+	s.Require().NoError(err)
+
+	// The redemption should be recorded against the hour epoch
+	redemptionId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, hourEpochNumber, tc.user.acc.String())
+	_, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx(), redemptionId)
+	s.Require().True(found, "user redemption record should be created for the hour epoch")
+
+	hostZoneUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx(), hourEpochNumber, HostChainId)
+	s.Require().True(found, "host zone unbonding for the hour epoch")
+	s.Require().Equal(tc.validMsg.Amount, hostZoneUnbonding.StTokenAmount, "hour epoch st token amount")
+
+	dayHostZoneUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx(), tc.initialState.epochNumber, HostChainId)
+	s.Require().True(found, "host zone unbonding for the day epoch")
+	s.Require().True(dayHostZoneUnbonding.StTokenAmount.IsZero(), "day epoch st token amount")
+}
+
+func (s *KeeperTestSuite) TestRedeemStake_EighteenDecimalHost() {
+	tc := s.SetupRedeemStake()
+	user := tc.user
+
+	// Convert the host zone to an 18 decimal host with a non-unity redemption rate
+	stakedBal, ok := sdk.NewIntFromString("10000000000000000000000000")
+	s.Require().True(ok, "staked balance")
+	hostZone := tc.hostZone
+	hostZone.HostDenom = Evmos
+	hostZone.DenomExponent = 18
+	hostZone.RedemptionRate = sdk.MustNewDecFromStr("1.25")
+	hostZone.StakedBal = stakedBal
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), hostZone)
+
+	// 2,000,000.123456789012345676 stevmos, well beyond the range of a uint64
+	redeemAmount, ok := sdk.NewIntFromString("2000000123456789012345676")
+	s.Require().True(ok, "redeem amount")
+	s.FundAccount(user.acc, sdk.NewCoin(StEvmos, redeemAmount))
+
+	msg := tc.validMsg
+	msg.Amount = redeemAmount
+	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx()), &msg)
+	s.Require().NoError(err)
+
+	// The full stToken amount should be escrowed
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx(), user.acc, StEvmos).Amount.IsZero(), "user staevmos balance")
+
+	// The native amount is the stToken amount at the redemption rate (amount * 1.25), exact to the base unit
+	expectedNativeAmount := redeemAmount.MulRaw(5).QuoRaw(4)
+	hostZoneUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx(), tc.initialState.epochNumber, HostChainId)
+	s.Require().True(found, "host zone unbonding")
+	s.Require().Equal(expectedNativeAmount, hostZoneUnbonding.NativeTokenAmount, "host zone unbonding native amount")
+	s.Require().Equal(redeemAmount, hostZoneUnbonding.StTokenAmount, "host zone unbonding st token amount")
+
+	userRedemptionRecords := s.App.RecordsKeeper.GetAllUserRedemptionRecord(s.Ctx())
+	s.Require().Len(userRedemptionRecords, 1, "number of user redemption records")
+	s.Require().Equal(expectedNativeAmount, userRedemptionRecords[0].Amount, "user redemption record amount")
+	s.Require().Equal(Evmos, userRedemptionRecords[0].Denom, "user redemption record denom")
 }
 
 func (s *KeeperTestSuite) TestRedeemStake_InvalidCreatorAddress() {
@@ -234,10 +315,10 @@ func (s *KeeperTestSuite) TestRedeemStake_RedeemMoreThanStaked() {
 	tc := s.SetupRedeemStake()
 
 	invalidMsg := tc.validMsg
-	invalidMsg.Amount = uint64(1_000_000_000_000_000)
+	invalidMsg.Amount = sdk.NewInt(1_000_000_000_000_000)
 	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx()), &invalidMsg)
 
-	s.Require().EqualError(err, fmt.Sprintf("cannot unstake an amount g.t. staked balance on host zone: %d: invalid amount", invalidMsg.Amount))
+	s.Require().EqualError(err, fmt.Sprintf("cannot unstake an amount g.t. staked balance on host zone: %v: invalid amount", invalidMsg.Amount))
 }
 
 func (s *KeeperTestSuite) TestRedeemStake_NoEpochTrackerDay() {
@@ -269,7 +350,7 @@ func (s *KeeperTestSuite) TestRedeemStake_HostZoneNoUnbondings() {
 		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{},
 	}
 	hostZoneUnbonding := &recordtypes.HostZoneUnbonding{
-		NativeTokenAmount: uint64(0),
+		NativeTokenAmount: sdk.NewInt(0),
 		Denom:             "uatom",
 		HostZoneId:        "NOT_GAIA",
 	}
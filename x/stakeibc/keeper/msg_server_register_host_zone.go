@@ -4,14 +4,13 @@ import (
 	"context"
 	"fmt"
 
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-
-	epochtypes "github.com/Stride-Labs/stride/x/epochs/types"
 	recordstypes "github.com/Stride-Labs/stride/x/records/types"
 	"github.com/Stride-Labs/stride/x/stakeibc/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 )
 
 func (k msgServer) RegisterHostZone(goCtx context.Context, msg *types.MsgRegisterHostZone) (*types.MsgRegisterHostZoneResponse, error) {
@@ -53,16 +52,36 @@ func (k msgServer) RegisterHostZone(goCtx context.Context, msg *types.MsgRegiste
 		}
 	}
 
+	// the transfer channel must be open and on the host zone's connection
+	transferChannel, found := k.IBCKeeper.ChannelKeeper.GetChannel(ctx, ibctransfertypes.PortID, msg.TransferChannelId)
+	if !found {
+		errMsg := fmt.Sprintf("transfer channel %s not found", msg.TransferChannelId)
+		k.Logger(ctx).Error(errMsg)
+		return nil, sdkerrors.Wrapf(types.ErrFailedToRegisterHostZone, errMsg)
+	}
+	if transferChannel.State != channeltypes.OPEN {
+		errMsg := fmt.Sprintf("transfer channel %s is not open, state: %s", msg.TransferChannelId, transferChannel.State.String())
+		k.Logger(ctx).Error(errMsg)
+		return nil, sdkerrors.Wrapf(types.ErrFailedToRegisterHostZone, errMsg)
+	}
+	if len(transferChannel.ConnectionHops) == 0 || transferChannel.ConnectionHops[0] != msg.ConnectionId {
+		errMsg := fmt.Sprintf("transfer channel %s is not on connection %s, connection hops: %v",
+			msg.TransferChannelId, msg.ConnectionId, transferChannel.ConnectionHops)
+		k.Logger(ctx).Error(errMsg)
+		return nil, sdkerrors.Wrapf(types.ErrFailedToRegisterHostZone, errMsg)
+	}
+
+	// the ibc denom must be the host denom as received over the transfer channel
+	expectedIbcDenom := types.IBCDenomFromHostDenom(msg.TransferChannelId, msg.HostDenom)
+	if msg.IbcDenom != expectedIbcDenom {
+		errMsg := fmt.Sprintf("ibc denom %s does not match %s transferred over %s (expected %s)",
+			msg.IbcDenom, msg.HostDenom, msg.TransferChannelId, expectedIbcDenom)
+		k.Logger(ctx).Error(errMsg)
+		return nil, sdkerrors.Wrapf(types.ErrFailedToRegisterHostZone, errMsg)
+	}
+
 	// create and save the zones's module account to the account keeper
-	zoneAddress := types.NewZoneAddress(chainId)
-	acc := k.accountKeeper.NewAccount(
-		ctx,
-		authtypes.NewModuleAccount(
-			authtypes.NewBaseAccountWithAddress(zoneAddress),
-			zoneAddress.String(),
-		),
-	)
-	k.accountKeeper.SetAccount(ctx, acc)
+	zoneAddress := k.SetZoneModuleAccount(ctx, chainId)
 
 	// set the zone
 	zone := types.HostZone{
@@ -77,10 +96,24 @@ func (k msgServer) RegisterHostZone(goCtx context.Context, msg *types.MsgRegiste
 		LastRedemptionRate: sdk.NewDec(1),
 		UnbondingFrequency: msg.UnbondingFrequency,
 		Address:            zoneAddress.String(),
+		StakedBal:          sdk.ZeroInt(),
+		DenomExponent:      msg.DenomExponent,
 	}
+	// stagger the zone's pipelines against the zones registered before it, so that a stage that runs every few
+	// epochs doesn't send every zone's ICA txs on the same epoch
+	zone.StaggerPipelineSchedules(uint64(len(k.GetAllHostZone(ctx))))
 	// write the zone back to the store
 	k.SetHostZone(ctx, zone)
 
+	// register the stToken's denom metadata so clients can display amounts at the host's precision
+	stTokenMetadata := types.StTokenMetadata(zone.HostDenom, msg.DisplayDenom, zone.DenomExponent)
+	if err := stTokenMetadata.Validate(); err != nil {
+		errMsg := fmt.Sprintf("invalid stToken metadata for %s, err: %s", zone.HostDenom, err.Error())
+		k.Logger(ctx).Error(errMsg)
+		return nil, sdkerrors.Wrapf(types.ErrFailedToRegisterHostZone, errMsg)
+	}
+	k.bankKeeper.SetDenomMetaData(ctx, stTokenMetadata)
+
 	// generate delegate account
 	// NOTE: in the future, if we implement proxy governance, we'll need many more delegate accounts
 	delegateAccount := types.FormatICAAccountOwner(chainId, types.ICAAccountType_DELEGATION)
@@ -116,19 +149,20 @@ func (k msgServer) RegisterHostZone(goCtx context.Context, msg *types.MsgRegiste
 
 	// add this host zone to unbonding hostZones, otherwise users won't be able to unbond
 	// for this host zone until the following day
-	dayEpochTracker, found := k.GetEpochTracker(ctx, epochtypes.DAY_EPOCH)
+	unbondingEpochIdentifier := k.GetEpochIdentifierParam(ctx, types.KeyUnbondingEpochIdentifier)
+	unbondingEpochTracker, found := k.GetEpochTracker(ctx, unbondingEpochIdentifier)
 	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrEpochNotFound, "epoch tracker (%s) not found", epochtypes.DAY_EPOCH)
+		return nil, sdkerrors.Wrapf(types.ErrEpochNotFound, "epoch tracker (%s) not found", unbondingEpochIdentifier)
 	}
-	epochUnbondingRecord, found := k.RecordsKeeper.GetEpochUnbondingRecord(ctx, dayEpochTracker.EpochNumber)
+	epochUnbondingRecord, found := k.RecordsKeeper.GetEpochUnbondingRecord(ctx, unbondingEpochTracker.EpochNumber)
 	if !found {
 		errMsg := "unable to find latest epoch unbonding record"
 		k.Logger(ctx).Error(errMsg)
 		return nil, sdkerrors.Wrapf(recordstypes.ErrEpochUnbondingRecordNotFound, errMsg)
 	}
 	hostZoneUnbonding := &recordstypes.HostZoneUnbonding{
-		NativeTokenAmount: 0,
-		StTokenAmount:     0,
+		NativeTokenAmount: sdk.ZeroInt(),
+		StTokenAmount:     sdk.ZeroInt(),
 		Denom:             zone.HostDenom,
 		HostZoneId:        zone.ChainId,
 		Status:            recordstypes.HostZoneUnbonding_UNBONDING_QUEUE,
@@ -142,34 +176,26 @@ func (k msgServer) RegisterHostZone(goCtx context.Context, msg *types.MsgRegiste
 	k.RecordsKeeper.SetEpochUnbondingRecord(ctx, *updatedEpochUnbondingRecord)
 
 	// create an empty deposit record for the host zone
-	strideEpochTracker, found := k.GetEpochTracker(ctx, epochtypes.STRIDE_EPOCH)
+	depositEpochIdentifier := k.GetEpochIdentifierParam(ctx, types.KeyDepositEpochIdentifier)
+	depositEpochTracker, found := k.GetEpochTracker(ctx, depositEpochIdentifier)
 	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrEpochNotFound, "epoch tracker (%s) not found", epochtypes.STRIDE_EPOCH)
+		return nil, sdkerrors.Wrapf(types.ErrEpochNotFound, "epoch tracker (%s) not found", depositEpochIdentifier)
 	}
 	depositRecord := recordstypes.DepositRecord{
 		Id:                 0,
-		Amount:             0,
+		Amount:             sdk.ZeroInt(),
 		Denom:              zone.HostDenom,
 		HostZoneId:         zone.ChainId,
 		Status:             recordstypes.DepositRecord_TRANSFER_QUEUE,
-		DepositEpochNumber: strideEpochTracker.EpochNumber,
+		DepositEpochNumber: depositEpochTracker.EpochNumber,
 	}
 	k.RecordsKeeper.AppendDepositRecord(ctx, depositRecord)
 
-	// emit events
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			sdk.EventTypeMessage,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-		),
-	)
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeRegisterZone,
-			sdk.NewAttribute(types.AttributeKeyConnectionId, msg.ConnectionId),
-			sdk.NewAttribute(types.AttributeKeyRecipientChain, chainId),
-		),
-	)
+	k.EmitTypedEvent(ctx, &types.EventHostZoneRegistered{
+		HostZoneId:   chainId,
+		ConnectionId: msg.ConnectionId,
+		HostDenom:    msg.HostDenom,
+	})
 
 	return &types.MsgRegisterHostZoneResponse{}, nil
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 	_ "github.com/stretchr/testify/suite"
 
@@ -35,12 +36,12 @@ func (s *KeeperTestSuite) SetupRegisterHostZone() RegisterHostZoneTestCase {
 
 	s.CreateTransferChannel(HostChainId)
 
-	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx(), stakeibctypes.EpochTracker{
+	s.SetEpochTracker(stakeibctypes.EpochTracker{
 		EpochIdentifier: epochtypes.DAY_EPOCH,
 		EpochNumber:     epochUnbondingRecordNumber,
 	})
 
-	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx(), stakeibctypes.EpochTracker{
+	s.SetEpochTracker(stakeibctypes.EpochTracker{
 		EpochIdentifier: epochtypes.STRIDE_EPOCH,
 		EpochNumber:     strideEpochNumber,
 	})
@@ -55,9 +56,11 @@ func (s *KeeperTestSuite) SetupRegisterHostZone() RegisterHostZoneTestCase {
 		ConnectionId:       ibctesting.FirstConnectionID,
 		Bech32Prefix:       GaiaPrefix,
 		HostDenom:          Atom,
-		IbcDenom:           IbcAtom,
+		IbcDenom:           s.GetIBCDenomTrace(Atom).IBCDenom(),
 		TransferChannelId:  ibctesting.FirstChannelID,
 		UnbondingFrequency: unbondingFrequency,
+		DenomExponent:      6,
+		DisplayDenom:       "atom",
 	}
 
 	return RegisterHostZoneTestCase{
@@ -111,8 +114,14 @@ func (s *KeeperTestSuite) TestRegisterHostZone_Success() {
 	msg := tc.validMsg
 
 	// Register host zone
-	_, err := s.GetMsgServer().RegisterHostZone(sdk.WrapSDKContext(s.Ctx()), &msg)
+	ctx := s.Ctx()
+	_, err := s.GetMsgServer().RegisterHostZone(sdk.WrapSDKContext(ctx), &msg)
 	s.Require().NoError(err, "able to successfully register host zone")
+	s.CheckTypedEventEmitted(ctx, &stakeibctypes.EventHostZoneRegistered{
+		HostZoneId:   HostChainId,
+		ConnectionId: msg.ConnectionId,
+		HostDenom:    msg.HostDenom,
+	})
 
 	// Confirm host zone unbonding was added
 	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
@@ -120,6 +129,15 @@ func (s *KeeperTestSuite) TestRegisterHostZone_Success() {
 	s.Require().Equal(tc.defaultRedemptionRate, hostZone.RedemptionRate, "redemption rate set to default: 1")
 	s.Require().Equal(tc.defaultRedemptionRate, hostZone.LastRedemptionRate, "last redemption rate set to default: 1")
 	s.Require().Equal(tc.unbondingFrequency, hostZone.UnbondingFrequency, "unbonding frequency set to default: 3")
+	s.Require().Equal(uint32(6), hostZone.DenomExponent, "denom exponent")
+
+	// Confirm the stToken denom metadata was registered
+	metadata, found := s.App.BankKeeper.GetDenomMetaData(s.Ctx(), StAtom)
+	s.Require().True(found, "stToken metadata found")
+	s.Require().Equal(StAtom, metadata.Base, "stToken metadata base")
+	s.Require().Equal("statom", metadata.Display, "stToken metadata display")
+	s.Require().Len(metadata.DenomUnits, 2, "stToken metadata denom units")
+	s.Require().Equal(uint32(6), metadata.DenomUnits[1].Exponent, "stToken metadata display exponent")
 
 	// Confirm host zone unbonding record was created
 	epochUnbondingRecord, found := s.App.RecordsKeeper.GetEpochUnbondingRecord(s.Ctx(), tc.epochUnbondingRecordNumber)
@@ -129,7 +147,7 @@ func (s *KeeperTestSuite) TestRegisterHostZone_Success() {
 	// Confirm host zone unbonding was added
 	hostZoneUnbonding := epochUnbondingRecord.HostZoneUnbondings[0]
 	s.Require().Equal(HostChainId, hostZoneUnbonding.HostZoneId, "host zone unbonding set for this host zone")
-	s.Require().Equal(uint64(0), hostZoneUnbonding.NativeTokenAmount, "host zone unbonding set to 0 tokens")
+	s.Require().Equal(sdk.ZeroInt(), hostZoneUnbonding.NativeTokenAmount, "host zone unbonding set to 0 tokens")
 	s.Require().Equal(recordstypes.HostZoneUnbonding_UNBONDING_QUEUE, hostZoneUnbonding.Status, "host zone unbonding set to bonded")
 
 	// Confirm a module account was created
@@ -141,7 +159,7 @@ func (s *KeeperTestSuite) TestRegisterHostZone_Success() {
 	// Confirm an empty deposit record was created
 	expectedDepositRecord := recordstypes.DepositRecord{
 		Id:                 uint64(0),
-		Amount:             int64(0),
+		Amount:             sdk.NewInt(0),
 		HostZoneId:         hostZone.ChainId,
 		Denom:              hostZone.HostDenom,
 		Status:             recordstypes.DepositRecord_TRANSFER_QUEUE,
@@ -153,6 +171,108 @@ func (s *KeeperTestSuite) TestRegisterHostZone_Success() {
 	s.Require().Equal(expectedDepositRecord, depositRecords[0], "deposit record")
 }
 
+func (s *KeeperTestSuite) TestRegisterHostZone_StaggersPipelineSchedules() {
+	tc := s.SetupRegisterHostZone()
+	msg := tc.validMsg
+
+	// Two host zones are already registered
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), stakeibctypes.HostZone{ChainId: "OSMO", ConnectionId: "connection-10", HostDenom: Osmo, Bech32Prefix: OsmoPrefix})
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), stakeibctypes.HostZone{ChainId: "JUNO", ConnectionId: "connection-11", HostDenom: "ujuno", Bech32Prefix: "juno"})
+
+	_, err := s.GetMsgServer().RegisterHostZone(sdk.WrapSDKContext(s.Ctx()), &msg)
+	s.Require().NoError(err, "able to successfully register host zone")
+
+	// Each pipeline is offset by the number of host zones registered before it, and keeps the param interval
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
+	s.Require().True(found, "host zone found")
+	for pipeline := range stakeibctypes.PipelineIntervalKeys {
+		schedule := hostZone.GetPipelineSchedule(pipeline)
+		s.Require().NotNil(schedule, "%s schedule", pipeline)
+		s.Require().Equal(uint64(2), schedule.Offset, "%s offset", pipeline)
+		s.Require().Equal(uint64(0), schedule.Interval, "%s interval", pipeline)
+	}
+}
+
+func (s *KeeperTestSuite) TestRegisterHostZone_EighteenDecimalHost() {
+	tc := s.SetupRegisterHostZone()
+	msg := tc.validMsg
+	msg.HostDenom = Evmos
+	msg.IbcDenom = s.GetIBCDenomTrace(Evmos).IBCDenom()
+	msg.Bech32Prefix = EvmosPrefix
+	msg.DenomExponent = 18
+	msg.DisplayDenom = "evmos"
+
+	_, err := s.GetMsgServer().RegisterHostZone(sdk.WrapSDKContext(s.Ctx()), &msg)
+	s.Require().NoError(err, "able to successfully register host zone")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(uint32(18), hostZone.DenomExponent, "denom exponent")
+
+	// Confirm one display unit of the stToken is 1e18 base units
+	metadata, found := s.App.BankKeeper.GetDenomMetaData(s.Ctx(), StEvmos)
+	s.Require().True(found, "stToken metadata found")
+	s.Require().Equal(StEvmos, metadata.Base, "stToken metadata base")
+	s.Require().Equal("stevmos", metadata.Display, "stToken metadata display")
+	s.Require().Equal([]*banktypes.DenomUnit{
+		{Denom: StEvmos, Exponent: 0},
+		{Denom: "stevmos", Exponent: 18},
+	}, metadata.DenomUnits, "stToken metadata denom units")
+}
+
+func (s *KeeperTestSuite) TestRegisterHostZone_IbcDenomMismatch() {
+	tc := s.SetupRegisterHostZone()
+	msg := tc.validMsg
+	msg.IbcDenom = IbcAtom // not the hash of transfer/channel-0/uatom
+
+	_, err := s.GetMsgServer().RegisterHostZone(sdk.WrapSDKContext(s.Ctx()), &msg)
+	expectedErrMsg := fmt.Sprintf("ibc denom ibc/uatom does not match uatom transferred over channel-0 (expected %s): ",
+		s.GetIBCDenomTrace(Atom).IBCDenom())
+	expectedErrMsg += "failed to register host zone"
+	s.Require().EqualError(err, expectedErrMsg, "registering host zone with a mismatched ibc denom should fail")
+}
+
+func (s *KeeperTestSuite) TestRegisterHostZone_TransferChannelNotFound() {
+	tc := s.SetupRegisterHostZone()
+	msg := tc.validMsg
+	msg.TransferChannelId = "channel-10"
+	msg.IbcDenom = stakeibctypes.IBCDenomFromHostDenom("channel-10", Atom)
+
+	_, err := s.GetMsgServer().RegisterHostZone(sdk.WrapSDKContext(s.Ctx()), &msg)
+	expectedErrMsg := "transfer channel channel-10 not found: failed to register host zone"
+	s.Require().EqualError(err, expectedErrMsg, "registering host zone with a missing transfer channel should fail")
+}
+
+func (s *KeeperTestSuite) TestRegisterHostZone_TransferChannelNotOpen() {
+	tc := s.SetupRegisterHostZone()
+	msg := tc.validMsg
+
+	// Close the transfer channel
+	channel, found := s.App.IBCKeeper.ChannelKeeper.GetChannel(s.Ctx(), ibctesting.TransferPort, ibctesting.FirstChannelID)
+	s.Require().True(found, "transfer channel found")
+	channel.State = channeltypes.CLOSED
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx(), ibctesting.TransferPort, ibctesting.FirstChannelID, channel)
+
+	_, err := s.GetMsgServer().RegisterHostZone(sdk.WrapSDKContext(s.Ctx()), &msg)
+	expectedErrMsg := "transfer channel channel-0 is not open, state: STATE_CLOSED: failed to register host zone"
+	s.Require().EqualError(err, expectedErrMsg, "registering host zone with a closed transfer channel should fail")
+}
+
+func (s *KeeperTestSuite) TestRegisterHostZone_TransferChannelConnectionMismatch() {
+	tc := s.SetupRegisterHostZone()
+
+	// Register a new host on connection-1, but point it at the transfer channel on connection-0
+	msg := s.createNewHostZoneMessage("OSMO", Osmo, OsmoPrefix)
+	msg.TransferChannelId = ibctesting.FirstChannelID
+	msg.IbcDenom = s.GetIBCDenomTrace(Osmo).IBCDenom()
+	msg.UnbondingFrequency = tc.unbondingFrequency
+
+	_, err := s.GetMsgServer().RegisterHostZone(sdk.WrapSDKContext(s.Ctx()), &msg)
+	expectedErrMsg := "transfer channel channel-0 is not on connection connection-1, connection hops: [connection-0]: "
+	expectedErrMsg += "failed to register host zone"
+	s.Require().EqualError(err, expectedErrMsg, "registering host zone with a transfer channel on another connection should fail")
+}
+
 func (s *KeeperTestSuite) TestRegisterHostZone_InvalidConnectionId() {
 	tc := s.SetupRegisterHostZone()
 	msg := tc.validMsg
@@ -259,7 +379,7 @@ func (s *KeeperTestSuite) TestRegisterHostZone_CannotFindDayEpochTracker() {
 	msg := tc.validMsg
 
 	// delete the epoch tracker
-	s.App.StakeibcKeeper.RemoveEpochTracker(s.Ctx(), epochtypes.DAY_EPOCH)
+	s.App.EpochsKeeper.DeleteEpochInfo(s.Ctx(), epochtypes.DAY_EPOCH)
 
 	_, err := s.GetMsgServer().RegisterHostZone(sdk.WrapSDKContext(s.Ctx()), &msg)
 	expectedErrMsg := "epoch tracker (day) not found: epoch not found"
@@ -272,7 +392,7 @@ func (s *KeeperTestSuite) TestRegisterHostZone_CannotFindStrideEpochTracker() {
 	msg := tc.validMsg
 
 	// delete the epoch tracker
-	s.App.StakeibcKeeper.RemoveEpochTracker(s.Ctx(), epochtypes.STRIDE_EPOCH)
+	s.App.EpochsKeeper.DeleteEpochInfo(s.Ctx(), epochtypes.STRIDE_EPOCH)
 
 	_, err := s.GetMsgServer().RegisterHostZone(sdk.WrapSDKContext(s.Ctx()), &msg)
 	expectedErrMsg := "epoch tracker (stride_epoch) not found: epoch not found"
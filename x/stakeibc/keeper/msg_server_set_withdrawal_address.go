@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+// SetWithdrawalAddress verifies the withdrawal address of a host zone's delegation ICA, re-setting it if it differs
+// from the withdrawal ICA (e.g. if it was changed on the host)
+func (k msgServer) SetWithdrawalAddress(goCtx context.Context, msg *types.MsgSetWithdrawalAddress) (*types.MsgSetWithdrawalAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hostZone, found := k.GetHostZone(ctx, msg.HostZone)
+	if !found {
+		errMsg := fmt.Sprintf("Host Zone not found: %s", msg.HostZone)
+		k.Logger(ctx).Error(errMsg)
+		return nil, sdkerrors.Wrapf(types.ErrInvalidHostZone, errMsg)
+	}
+
+	if err := k.QueryWithdrawalAddressIcq(ctx, hostZone); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetWithdrawalAddressResponse{}, nil
+}
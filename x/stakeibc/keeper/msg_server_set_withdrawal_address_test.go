@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	_ "github.com/stretchr/testify/suite"
+
+	icqtypes "github.com/Stride-Labs/stride/x/interchainquery/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+// Returns the withdrawal address queries submitted to the ICQ module
+func (s *KeeperTestSuite) getWithdrawalAddressQueries() (queries []icqtypes.Query) {
+	for _, query := range s.App.InterchainqueryKeeper.AllQueries(s.Ctx()) {
+		if query.CallbackId == "withdrawaladdress" {
+			queries = append(queries, query)
+		}
+	}
+	return queries
+}
+
+// Checks that a single MsgSetWithdrawAddress, pointing at the withdrawal ICA, is queued on the delegation ICA
+func (s *KeeperTestSuite) checkSetWithdrawAddressQueued(tc ICATxQueueTestCase) {
+	queue := s.App.StakeibcKeeper.GetICATxQueue(s.Ctx(), HostChainId, stakeibctypes.ICAAccountType_DELEGATION)
+	s.Require().Len(queue, 1, "one tx queued")
+	s.Require().Len(queue[0].Msgs, 1, "one msg queued")
+
+	var msg distributiontypes.MsgSetWithdrawAddress
+	s.Require().NoError(s.App.AppCodec().Unmarshal(queue[0].Msgs[0].Value, &msg))
+	s.Require().Equal(tc.delegationAccount, msg.DelegatorAddress, "delegator address")
+	s.Require().Equal(tc.withdrawalAccount, msg.WithdrawAddress, "withdraw address")
+}
+
+func (s *KeeperTestSuite) TestSetWithdrawalAddress_Successful() {
+	tc := s.SetupICATxQueue()
+
+	msg := stakeibctypes.MsgSetWithdrawalAddress{HostZone: HostChainId}
+	_, err := s.GetMsgServer().SetWithdrawalAddress(sdk.WrapSDKContext(s.Ctx()), &msg)
+	s.Require().NoError(err, "no error expected when setting the withdrawal address")
+
+	// the address is queried rather than set directly
+	queries := s.getWithdrawalAddressQueries()
+	s.Require().Len(queries, 1, "one withdrawal address query")
+	s.Require().Equal(icqtypes.DISTRIBUTION_STORE_QUERY_WITH_PROOF, queries[0].QueryType, "query type")
+	s.Require().Equal(tc.hostZone.ConnectionId, queries[0].ConnectionId, "query connection")
+
+	_, delegatorAddr, err := bech32.DecodeAndConvert(tc.delegationAccount)
+	s.Require().NoError(err)
+	s.Require().Equal(distributiontypes.GetDelegatorWithdrawAddrKey(delegatorAddr), queries[0].Request, "query request")
+
+	s.Require().Empty(s.App.StakeibcKeeper.GetICATxQueue(s.Ctx(), HostChainId, stakeibctypes.ICAAccountType_DELEGATION), "no tx queued")
+}
+
+func (s *KeeperTestSuite) TestSetWithdrawalAddress_HostZoneNotFound() {
+	s.SetupICATxQueue()
+
+	msg := stakeibctypes.MsgSetWithdrawalAddress{HostZone: "fake_host_zone"}
+	_, err := s.GetMsgServer().SetWithdrawalAddress(sdk.WrapSDKContext(s.Ctx()), &msg)
+	s.Require().ErrorIs(err, stakeibctypes.ErrInvalidHostZone)
+	s.Require().Empty(s.getWithdrawalAddressQueries(), "no query submitted")
+}
+
+func (s *KeeperTestSuite) TestSetWithdrawalAddress_MissingWithdrawalAccount() {
+	tc := s.SetupICATxQueue()
+	tc.hostZone.WithdrawalAccount = nil
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), tc.hostZone)
+
+	msg := stakeibctypes.MsgSetWithdrawalAddress{HostZone: HostChainId}
+	_, err := s.GetMsgServer().SetWithdrawalAddress(sdk.WrapSDKContext(s.Ctx()), &msg)
+	s.Require().ErrorIs(err, stakeibctypes.ErrICAAccountNotFound)
+}
+
+func (s *KeeperTestSuite) TestWithdrawalAddressCallback_AddressDiffers() {
+	tc := s.SetupICATxQueue()
+
+	// an empty result means the host defaults the withdrawal address to the delegator
+	query := icqtypes.Query{ChainId: HostChainId}
+	err := stakeibckeeper.WithdrawalAddressCallback(s.App.StakeibcKeeper, s.Ctx(), []byte{}, query)
+	s.Require().NoError(err, "no error expected during callback")
+
+	s.checkSetWithdrawAddressQueued(tc)
+}
+
+func (s *KeeperTestSuite) TestWithdrawalAddressCallback_AddressMatches() {
+	tc := s.SetupICATxQueue()
+
+	_, withdrawalAddr, err := bech32.DecodeAndConvert(tc.withdrawalAccount)
+	s.Require().NoError(err)
+
+	query := icqtypes.Query{ChainId: HostChainId}
+	err = stakeibckeeper.WithdrawalAddressCallback(s.App.StakeibcKeeper, s.Ctx(), withdrawalAddr, query)
+	s.Require().NoError(err, "no error expected during callback")
+
+	s.Require().Empty(s.App.StakeibcKeeper.GetICATxQueue(s.Ctx(), HostChainId, stakeibctypes.ICAAccountType_DELEGATION), "no tx queued")
+}
+
+func (s *KeeperTestSuite) TestWithdrawalAddressCallback_HostZoneNotFound() {
+	s.SetupICATxQueue()
+
+	query := icqtypes.Query{ChainId: "fake_host_zone"}
+	err := stakeibckeeper.WithdrawalAddressCallback(s.App.StakeibcKeeper, s.Ctx(), []byte{}, query)
+	s.Require().ErrorIs(err, stakeibctypes.ErrHostZoneNotFound)
+}
+
+func (s *KeeperTestSuite) TestSetWithdrawalAddressOnChannelOpen_Registered() {
+	tc := s.SetupICATxQueue()
+
+	err := s.App.StakeibcKeeper.SetWithdrawalAddressOnChannelOpen(s.Ctx(), tc.hostZone, false)
+	s.Require().NoError(err)
+
+	s.checkSetWithdrawAddressQueued(tc)
+	s.Require().Empty(s.getWithdrawalAddressQueries(), "no query submitted")
+}
+
+func (s *KeeperTestSuite) TestSetWithdrawalAddressOnChannelOpen_Restored() {
+	tc := s.SetupICATxQueue()
+
+	err := s.App.StakeibcKeeper.SetWithdrawalAddressOnChannelOpen(s.Ctx(), tc.hostZone, true)
+	s.Require().NoError(err)
+
+	s.Require().Len(s.getWithdrawalAddressQueries(), 1, "one withdrawal address query")
+	s.Require().Empty(s.App.StakeibcKeeper.GetICATxQueue(s.Ctx(), HostChainId, stakeibctypes.ICAAccountType_DELEGATION), "no tx queued")
+}
+
+func (s *KeeperTestSuite) TestSetWithdrawalAddressOnChannelOpen_MissingAccount() {
+	tc := s.SetupICATxQueue()
+	tc.hostZone.DelegationAccount = nil
+
+	err := s.App.StakeibcKeeper.SetWithdrawalAddressOnChannelOpen(s.Ctx(), tc.hostZone, false)
+	s.Require().NoError(err, "waiting on the other account isn't an error")
+
+	s.Require().Empty(s.App.StakeibcKeeper.GetICATxQueue(s.Ctx(), HostChainId, stakeibctypes.ICAAccountType_DELEGATION), "no tx queued")
+	s.Require().Empty(s.getWithdrawalAddressQueries(), "no query submitted")
+}
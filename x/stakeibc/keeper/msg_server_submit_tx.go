@@ -50,7 +50,7 @@ func (k Keeper) DelegateOnHost(ctx sdk.Context, hostZone types.HostZone, amt sdk
 	}
 
 	// Construct the transaction
-	targetDelegatedAmts, err := k.GetTargetValAmtsForHostZone(ctx, hostZone, amt.Amount.Uint64())
+	targetDelegatedAmts, err := k.GetTargetValAmtsForHostZone(ctx, hostZone, amt.Amount)
 	if err != nil {
 		k.Logger(ctx).Error(fmt.Sprintf("Error getting target delegation amounts for host zone %s", hostZone.ChainId))
 		return err
@@ -58,7 +58,7 @@ func (k Keeper) DelegateOnHost(ctx sdk.Context, hostZone types.HostZone, amt sdk
 
 	var splitDelegations []*types.SplitDelegation
 	for _, validator := range hostZone.GetValidators() {
-		relativeAmount := sdk.NewCoin(amt.Denom, sdk.NewIntFromUint64(targetDelegatedAmts[validator.GetAddress()]))
+		relativeAmount := sdk.NewCoin(amt.Denom, targetDelegatedAmts[validator.GetAddress()])
 		if relativeAmount.Amount.IsPositive() {
 			k.Logger(ctx).Info(fmt.Sprintf("Appending MsgDelegate to msgs, DelegatorAddress: %s, ValidatorAddress: %s, relativeAmount: %v",
 				delegationIca.GetAddress(), validator.GetAddress(), relativeAmount))
@@ -71,7 +71,7 @@ func (k Keeper) DelegateOnHost(ctx sdk.Context, hostZone types.HostZone, amt sdk
 		}
 		splitDelegations = append(splitDelegations, &types.SplitDelegation{
 			Validator: validator.GetAddress(),
-			Amount:    relativeAmount.Amount.Uint64(),
+			Amount:    relativeAmount.Amount,
 		})
 	}
 
@@ -87,14 +87,17 @@ func (k Keeper) DelegateOnHost(ctx sdk.Context, hostZone types.HostZone, amt sdk
 		return err
 	}
 
-	// Send the transaction through SubmitTx
-	_, err = k.SubmitTxsStrideEpoch(ctx, connectionId, msgs, *delegationIca, DELEGATE, marshalledCallbackArgs)
+	// Queue the transaction, to be sent at the end of the block, and update the record state to DELEGATION_IN_PROGRESS
+	delegateEpochIdentifier := k.GetEpochIdentifierParam(ctx, types.KeyDelegateEpochIdentifier)
+	err = k.QueueTxsWithRecordUpdates(ctx, connectionId, msgs, *delegationIca, delegateEpochIdentifier, DELEGATE, marshalledCallbackArgs,
+		func(ctx sdk.Context) error {
+			depositRecord.Status = recordstypes.DepositRecord_DELEGATION_IN_PROGRESS
+			k.RecordsKeeper.SetDepositRecord(ctx, depositRecord)
+			return nil
+		})
 	if err != nil {
-		return sdkerrors.Wrapf(err, "Failed to SubmitTxs for connectionId %s on %s. Messages: %s", connectionId, hostZone.ChainId, msgs)
+		return sdkerrors.Wrapf(err, "Failed to queue txs for connectionId %s on %s. Messages: %s", connectionId, hostZone.ChainId, msgs)
 	}
-	// update the record state to DELEGATION_IN_PROGRESS
-	depositRecord.Status = recordstypes.DepositRecord_DELEGATION_IN_PROGRESS
-	k.RecordsKeeper.SetDepositRecord(ctx, depositRecord)
 	return nil
 }
 
@@ -128,14 +131,82 @@ func (k Keeper) SetWithdrawalAddressOnHost(ctx sdk.Context, hostZone types.HostZ
 	k.Logger(ctx).Info(fmt.Sprintf("Setting withdrawal address on host zone. DelegatorAddress: %s WithdrawAddress: %s ConnectionID: %s", delegationIca.GetAddress(), withdrawalIcaAddr, connectionId))
 	// construct the msg
 	msgs = append(msgs, &distributiontypes.MsgSetWithdrawAddress{DelegatorAddress: delegationIca.GetAddress(), WithdrawAddress: withdrawalIcaAddr})
-	// Send the transaction through SubmitTx
-	_, err = k.SubmitTxsStrideEpoch(ctx, connectionId, msgs, *delegationIca, "", nil)
+	// Queue the transaction, to be sent at the end of the block
+	err = k.QueueTxsStrideEpoch(ctx, connectionId, msgs, *delegationIca, "", nil)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Failed to queue txs for %s, %s, %s", connectionId, hostZone.ChainId, msgs)
+	}
+	return nil
+}
+
+// query the delegation ICA's withdrawal address in the host's distribution store, so it's only re-set
+// if it differs from the withdrawal ICA (see WithdrawalAddressCallback)
+func (k Keeper) QueryWithdrawalAddressIcq(ctx sdk.Context, hostZone types.HostZone) error {
+	delegationIca := hostZone.GetDelegationAccount()
+	if delegationIca == nil || delegationIca.Address == "" {
+		errMsg := fmt.Sprintf("Zone %s is missing a delegation address!", hostZone.ChainId)
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(types.ErrICAAccountNotFound, errMsg)
+	}
+	withdrawalIca := hostZone.GetWithdrawalAccount()
+	if withdrawalIca == nil || withdrawalIca.Address == "" {
+		errMsg := fmt.Sprintf("Zone %s is missing a withdrawal address!", hostZone.ChainId)
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(types.ErrICAAccountNotFound, errMsg)
+	}
+	_, delegatorAddr, err := bech32.DecodeAndConvert(delegationIca.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegation account address, could not decode (%s)", err.Error())
+	}
+	data := distributiontypes.GetDelegatorWithdrawAddrKey(delegatorAddr)
+
+	// get ttl
+	ttl, err := k.GetStartTimeNextEpoch(ctx, epochstypes.STRIDE_EPOCH)
+	if err != nil {
+		errMsg := fmt.Sprintf("could not get start time for next epoch: %s", err.Error())
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, errMsg)
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("Querying withdrawal address of %s on %s", delegationIca.Address, hostZone.ChainId))
+	err = k.InterchainQueryKeeper.MakeRequest(
+		ctx,
+		hostZone.ConnectionId,
+		hostZone.ChainId,
+		// use "distribution" store to access the withdrawal address which lives in the distribution module
+		// use "key" suffix to retrieve a proof alongside the query result
+		icqtypes.DISTRIBUTION_STORE_QUERY_WITH_PROOF,
+		data,
+		sdk.NewInt(-1),
+		types.ModuleName,
+		"withdrawaladdress",
+		ttl, // ttl
+		0,   // height always 0 (which means current height)
+	)
 	if err != nil {
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Failed to SubmitTxs for %s, %s, %s", connectionId, hostZone.ChainId, msgs)
+		errMsg := fmt.Sprintf("Error querying for withdrawal address, error: %s", err.Error())
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(types.ErrICQFailed, errMsg)
 	}
 	return nil
 }
 
+// SetWithdrawalAddressOnChannelOpen sets the withdrawal address once a host zone's delegation and withdrawal ICAs
+// are both registered, rather than every epoch. If one of the accounts was restored after its channel closed,
+// the address was likely already set, so it's verified with an ICQ first
+func (k Keeper) SetWithdrawalAddressOnChannelOpen(ctx sdk.Context, hostZone types.HostZone, restored bool) error {
+	delegationIca := hostZone.GetDelegationAccount()
+	withdrawalIca := hostZone.GetWithdrawalAccount()
+	if delegationIca == nil || delegationIca.Address == "" || withdrawalIca == nil || withdrawalIca.Address == "" {
+		k.Logger(ctx).Info(fmt.Sprintf("Waiting on the delegation and withdrawal ICAs of %s to set the withdrawal address", hostZone.ChainId))
+		return nil
+	}
+	if restored {
+		return k.QueryWithdrawalAddressIcq(ctx, hostZone)
+	}
+	return k.SetWithdrawalAddressOnHost(ctx, hostZone)
+}
+
 // Simple balance query helper using new ICQ module
 func (k Keeper) UpdateWithdrawalBalance(ctx sdk.Context, zoneInfo types.HostZone) error {
 	k.Logger(ctx).Info(fmt.Sprintf("\tUpdating withdrawal balances on %s", zoneInfo.ChainId))
@@ -149,8 +220,8 @@ func (k Keeper) UpdateWithdrawalBalance(ctx sdk.Context, zoneInfo types.HostZone
 	_, addr, _ := bech32.DecodeAndConvert(withdrawalIca.GetAddress())
 	data := bankTypes.CreateAccountBalancesPrefix(addr)
 
-	// get ttl, the end of the ICA buffer window
-	epochType := epochstypes.STRIDE_EPOCH
+	// get ttl, the end of the ICA buffer window of the reinvest epoch
+	epochType := k.GetEpochIdentifierParam(ctx, types.KeyReinvestEpochIdentifier)
 	ttl, err := k.GetICATimeoutNanos(ctx, epochType)
 	if err != nil {
 		errMsg := fmt.Sprintf("Failed to get ICA timeout nanos for epochType %s using param, error: %s", epochType, err.Error())
@@ -190,60 +261,6 @@ func (k Keeper) GetStartTimeNextEpoch(ctx sdk.Context, epochType string) (uint64
 	return epochTracker.NextEpochStartTime, nil
 }
 
-func (k Keeper) SubmitTxsDayEpoch(
-	ctx sdk.Context,
-	connectionId string,
-	msgs []sdk.Msg,
-	account types.ICAAccount,
-	callbackId string,
-	callbackArgs []byte,
-) (uint64, error) {
-	k.Logger(ctx).Info(fmt.Sprintf("SubmitTxsDayEpoch %v", msgs))
-	sequence, err := k.SubmitTxsEpoch(ctx, connectionId, msgs, account, epochstypes.DAY_EPOCH, callbackId, callbackArgs)
-	if err != nil {
-		return 0, err
-	}
-	return sequence, nil
-}
-
-func (k Keeper) SubmitTxsStrideEpoch(
-	ctx sdk.Context,
-	connectionId string,
-	msgs []sdk.Msg,
-	account types.ICAAccount,
-	callbackId string,
-	callbackArgs []byte,
-) (uint64, error) {
-	k.Logger(ctx).Info(fmt.Sprintf("SubmitTxsStrideEpoch %v", msgs))
-	sequence, err := k.SubmitTxsEpoch(ctx, connectionId, msgs, account, epochstypes.STRIDE_EPOCH, callbackId, callbackArgs)
-	if err != nil {
-		return 0, err
-	}
-	return sequence, nil
-}
-
-func (k Keeper) SubmitTxsEpoch(
-	ctx sdk.Context,
-	connectionId string,
-	msgs []sdk.Msg,
-	account types.ICAAccount,
-	epochType string,
-	callbackId string,
-	callbackArgs []byte,
-) (uint64, error) {
-	timeoutNanosUint64, err := k.GetICATimeoutNanos(ctx, epochType)
-	if err != nil {
-		k.Logger(ctx).Error(fmt.Sprintf("Failed to get ICA timeout nanos for epochType %s using param, error: %s", epochType, err.Error()))
-		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Failed to convert timeoutNanos to uint64, error: %s", err.Error())
-	}
-	sequence, err := k.SubmitTxs(ctx, connectionId, msgs, account, timeoutNanosUint64, callbackId, callbackArgs)
-	if err != nil {
-		return 0, err
-	}
-	k.Logger(ctx).Info(fmt.Sprintf("Submitted Txs, connectionId: %s, sequence: %d, block: %d", connectionId, sequence, ctx.BlockHeight()))
-	return sequence, nil
-}
-
 // SubmitTxs submits an ICA transaction containing multiple messages
 func (k Keeper) SubmitTxs(
 	ctx sdk.Context,
@@ -270,6 +287,14 @@ func (k Keeper) SubmitTxs(
 		return 0, sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel for port %s", portID)
 	}
 
+	// ordered ICA channels close when a packet times out, so records are held until the channel is re-opened
+	channel, found := k.IBCKeeper.ChannelKeeper.GetChannel(ctx, portID, channelID)
+	if !found || channel.State != channeltypes.OPEN {
+		errMsg := fmt.Sprintf("ICA channel %s for port %s is not open", channelID, portID)
+		k.Logger(ctx).Error(errMsg)
+		return 0, sdkerrors.Wrapf(types.ErrICAChannelClosed, errMsg)
+	}
+
 	chanCap, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
 	if !found {
 		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
@@ -285,7 +310,13 @@ func (k Keeper) SubmitTxs(
 		Data: data,
 	}
 
-	sequence, err := k.ICAControllerKeeper.SendTx(ctx, chanCap, connectionId, portID, packetData, timeoutTimestamp)
+	// the host zone checks the timeout against its own clock, so it's measured from the light client's time
+	hostTimeoutTimestamp, err := k.GetHostTimeoutTimestamp(ctx, connectionId, timeoutTimestamp)
+	if err != nil {
+		return 0, err
+	}
+
+	sequence, err := k.ICAControllerKeeper.SendTx(ctx, chanCap, connectionId, portID, packetData, hostTimeoutTimestamp)
 	if err != nil {
 		return 0, err
 	}
@@ -378,8 +409,8 @@ func (k Keeper) QueryValidatorExchangeRate(ctx sdk.Context, msg *types.MsgUpdate
 	}
 	data := stakingtypes.GetValidatorKey(valAddr)
 
-	// get ttl
-	ttl, err := k.GetStartTimeNextEpoch(ctx, epochstypes.STRIDE_EPOCH)
+	// get ttl, the start of the next redemption rate epoch, which the query must land ahead of
+	ttl, err := k.GetStartTimeNextEpoch(ctx, k.GetEpochIdentifierParam(ctx, types.KeyRedemptionRateEpochIdentifier))
 	if err != nil {
 		errMsg := fmt.Sprintf("could not get start time for next epoch: %s", err.Error())
 		k.Logger(ctx).Error(errMsg)
@@ -408,6 +439,48 @@ func (k Keeper) QueryValidatorExchangeRate(ctx sdk.Context, msg *types.MsgUpdate
 	return &types.MsgUpdateValidatorSharesExchRateResponse{}, nil
 }
 
+// query a validator's staking record on the host so it can be checked against the min validator requirements
+// the validator is stored as pending until the query returns (see ValidatorRequirementsCallback)
+func (k Keeper) QueryValidatorRequirementsIcq(ctx sdk.Context, hostZone types.HostZone, validator types.Validator) error {
+	_, valAddr, err := bech32.DecodeAndConvert(validator.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid validator operator address, could not decode (%s)", err.Error())
+	}
+	data := stakingtypes.GetValidatorKey(valAddr)
+
+	// get ttl
+	ttl, err := k.GetStartTimeNextEpoch(ctx, epochstypes.STRIDE_EPOCH)
+	if err != nil {
+		errMsg := fmt.Sprintf("could not get start time for next epoch: %s", err.Error())
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, errMsg)
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("Querying validator %s on %s to check min validator requirements", validator.Address, hostZone.ChainId))
+	err = k.InterchainQueryKeeper.MakeRequest(
+		ctx,
+		hostZone.ConnectionId,
+		hostZone.ChainId,
+		// use "staking" store to access validator which lives in the staking module
+		// use "key" suffix to retrieve a proof alongside the query result
+		icqtypes.STAKING_STORE_QUERY_WITH_PROOF,
+		data,
+		sdk.NewInt(-1),
+		types.ModuleName,
+		"validatorrequirements",
+		ttl, // ttl
+		0,   // height always 0 (which means current height)
+	)
+	if err != nil {
+		errMsg := fmt.Sprintf("Error querying for validator requirements, error: %s", err.Error())
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(types.ErrICQFailed, errMsg)
+	}
+
+	k.SetPendingValidator(ctx, hostZone.ChainId, validator, ttl)
+	return nil
+}
+
 // to icq delegation amounts, this fn is executed after validator exch rates are icq'd
 func (k Keeper) QueryDelegationsIcq(ctx sdk.Context, hostZone types.HostZone, valoper string) error {
 	// ensure ICQ can be issued now! else fail the callback
@@ -429,8 +502,8 @@ func (k Keeper) QueryDelegationsIcq(ctx sdk.Context, hostZone types.HostZone, va
 	_, delAddr, _ := bech32.DecodeAndConvert(delegationAcctAddr)
 	data := stakingtypes.GetDelegationKey(delAddr, valAddr)
 
-	// get ttl
-	ttl, err := k.GetStartTimeNextEpoch(ctx, epochstypes.STRIDE_EPOCH)
+	// get ttl, the start of the next redemption rate epoch, which the query must land ahead of
+	ttl, err := k.GetStartTimeNextEpoch(ctx, k.GetEpochIdentifierParam(ctx, types.KeyRedemptionRateEpochIdentifier))
 	if err != nil {
 		errMsg := fmt.Sprintf("could not get start time for next epoch: %s", err.Error())
 		k.Logger(ctx).Error(errMsg)
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+// UpdateHostZoneSchedule overrides how often a pipeline stage runs for a host zone
+// An interval and offset of 0 removes the override, so that the stage follows the module param again
+func (k msgServer) UpdateHostZoneSchedule(goCtx context.Context, msg *types.MsgUpdateHostZoneSchedule) (*types.MsgUpdateHostZoneScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hostZone, found := k.GetHostZone(ctx, msg.HostZone)
+	if !found {
+		k.Logger(ctx).Error(fmt.Sprintf("Host Zone %s not found", msg.HostZone))
+		return nil, types.ErrInvalidHostZone
+	}
+
+	var schedule *types.PipelineSchedule
+	if msg.Interval != 0 || msg.Offset != 0 {
+		schedule = &types.PipelineSchedule{
+			Interval: msg.Interval,
+			Offset:   msg.Offset,
+		}
+	}
+	if err := hostZone.SetPipelineSchedule(msg.Pipeline, schedule); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	k.SetHostZone(ctx, hostZone)
+
+	k.Logger(ctx).Info(fmt.Sprintf("Updated %s schedule for host zone %s to interval %d, offset %d", msg.Pipeline, msg.HostZone, msg.Interval, msg.Offset))
+	return &types.MsgUpdateHostZoneScheduleResponse{}, nil
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+// UpdateMaxLightClientAge overrides how stale a host zone's light client can be before time-dependent pipelines are skipped
+// A max age of 0 removes the override, so that the host zone follows the module param again
+func (k msgServer) UpdateMaxLightClientAge(goCtx context.Context, msg *types.MsgUpdateMaxLightClientAge) (*types.MsgUpdateMaxLightClientAgeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hostZone, found := k.GetHostZone(ctx, msg.HostZone)
+	if !found {
+		k.Logger(ctx).Error(fmt.Sprintf("Host Zone %s not found", msg.HostZone))
+		return nil, types.ErrInvalidHostZone
+	}
+
+	hostZone.MaxLightClientAgeNanos = msg.MaxLightClientAgeNanos
+	k.SetHostZone(ctx, hostZone)
+
+	k.Logger(ctx).Info(fmt.Sprintf("Updated max light client age for host zone %s to %d", msg.HostZone, msg.MaxLightClientAgeNanos))
+	return &types.MsgUpdateMaxLightClientAgeResponse{}, nil
+}
@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/stretchr/testify/suite"
+
+	stakeibctypes "github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) SetupUpdateMaxLightClientAge() stakeibctypes.MsgUpdateMaxLightClientAge {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), stakeibctypes.HostZone{ChainId: HostChainId})
+	return stakeibctypes.MsgUpdateMaxLightClientAge{
+		Creator:                s.TestAccs[0].String(),
+		HostZone:               HostChainId,
+		MaxLightClientAgeNanos: 1_000,
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateMaxLightClientAge_Successful() {
+	msg := s.SetupUpdateMaxLightClientAge()
+
+	_, err := s.GetMsgServer().UpdateMaxLightClientAge(sdk.WrapSDKContext(s.Ctx()), &msg)
+	s.Require().NoError(err, "no error expected when updating the max light client age")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(uint64(1_000), hostZone.MaxLightClientAgeNanos, "max light client age override")
+
+	// An age of 0 removes the override
+	msg.MaxLightClientAgeNanos = 0
+	_, err = s.GetMsgServer().UpdateMaxLightClientAge(sdk.WrapSDKContext(s.Ctx()), &msg)
+	s.Require().NoError(err, "no error expected when removing the override")
+
+	hostZone, _ = s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
+	s.Require().Equal(stakeibctypes.DefaultMaxLightClientAgeNanos, s.App.StakeibcKeeper.GetMaxLightClientAgeNanos(s.Ctx(), hostZone), "param used after removing the override")
+}
+
+func (s *KeeperTestSuite) TestUpdateMaxLightClientAge_HostZoneNotFound() {
+	msg := s.SetupUpdateMaxLightClientAge()
+	msg.HostZone = "fake_host_zone"
+
+	_, err := s.GetMsgServer().UpdateMaxLightClientAge(sdk.WrapSDKContext(s.Ctx()), &msg)
+	s.Require().ErrorIs(err, stakeibctypes.ErrInvalidHostZone)
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+// WindDownHostZone starts sunsetting a host zone by rejecting new liquid stakes
+// The remaining stake is undelegated at the next unbonding epoch once the pending deposits have been delegated
+func (k msgServer) WindDownHostZone(goCtx context.Context, msg *types.MsgWindDownHostZone) (*types.MsgWindDownHostZoneResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hostZone, found := k.GetHostZone(ctx, msg.HostZone)
+	if !found {
+		errMsg := fmt.Sprintf("Host Zone not found: %s", msg.HostZone)
+		k.Logger(ctx).Error(errMsg)
+		return nil, sdkerrors.Wrapf(types.ErrInvalidHostZone, errMsg)
+	}
+
+	if hostZone.WindDownStatus != types.HostZone_Active {
+		errMsg := fmt.Sprintf("Host zone %s is already winding down, status: %s", hostZone.ChainId, hostZone.WindDownStatus.String())
+		k.Logger(ctx).Error(errMsg)
+		return nil, sdkerrors.Wrapf(types.ErrHostZoneWindingDown, errMsg)
+	}
+
+	hostZone.WindDownStatus = types.HostZone_WindingDown
+	k.SetHostZone(ctx, hostZone)
+
+	k.EmitTypedEvent(ctx, &types.EventWindDownStarted{
+		HostZoneId: hostZone.ChainId,
+	})
+
+	return &types.MsgWindDownHostZoneResponse{}, nil
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/stretchr/testify/suite"
+
+	stakeibctypes "github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) SetupWindDownHostZone() stakeibctypes.MsgWindDownHostZone {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), stakeibctypes.HostZone{
+		ChainId:        HostChainId,
+		HostDenom:      Atom,
+		IBCDenom:       IbcAtom,
+		RedemptionRate: sdk.OneDec(),
+	})
+	return stakeibctypes.MsgWindDownHostZone{
+		Creator:  s.TestAccs[0].String(),
+		HostZone: HostChainId,
+	}
+}
+
+func (s *KeeperTestSuite) TestWindDownHostZone_Successful() {
+	msg := s.SetupWindDownHostZone()
+
+	_, err := s.GetMsgServer().WindDownHostZone(sdk.WrapSDKContext(s.Ctx()), &msg)
+	s.Require().NoError(err, "no error expected when winding down the host zone")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(stakeibctypes.HostZone_WindingDown, hostZone.WindDownStatus, "wind down status")
+}
+
+func (s *KeeperTestSuite) TestWindDownHostZone_RejectsLiquidStake() {
+	msg := s.SetupWindDownHostZone()
+
+	_, err := s.GetMsgServer().WindDownHostZone(sdk.WrapSDKContext(s.Ctx()), &msg)
+	s.Require().NoError(err, "no error expected when winding down the host zone")
+
+	liquidStakeMsg := stakeibctypes.MsgLiquidStake{
+		Creator:   s.TestAccs[0].String(),
+		Amount:    sdk.NewInt(1_000_000),
+		HostDenom: Atom,
+	}
+	_, err = s.GetMsgServer().LiquidStake(sdk.WrapSDKContext(s.Ctx()), &liquidStakeMsg)
+	s.Require().EqualError(err, "host zone GAIA is not accepting liquid stakes: host zone is winding down")
+}
+
+func (s *KeeperTestSuite) TestWindDownHostZone_AlreadyWindingDown() {
+	msg := s.SetupWindDownHostZone()
+
+	_, err := s.GetMsgServer().WindDownHostZone(sdk.WrapSDKContext(s.Ctx()), &msg)
+	s.Require().NoError(err, "no error expected when winding down the host zone")
+
+	_, err = s.GetMsgServer().WindDownHostZone(sdk.WrapSDKContext(s.Ctx()), &msg)
+	s.Require().EqualError(err, "Host zone GAIA is already winding down, status: WindingDown: host zone is winding down")
+}
+
+func (s *KeeperTestSuite) TestWindDownHostZone_HostZoneNotFound() {
+	msg := s.SetupWindDownHostZone()
+	msg.HostZone = "fake_host_zone"
+
+	_, err := s.GetMsgServer().WindDownHostZone(sdk.WrapSDKContext(s.Ctx()), &msg)
+	s.Require().EqualError(err, "Host Zone not found: fake_host_zone: host zone not registered")
+}
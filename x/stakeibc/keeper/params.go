@@ -1,8 +1,12 @@
 package keeper
 
 import (
+	"encoding/json"
+	"fmt"
+
 	"github.com/Stride-Labs/stride/x/stakeibc/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // GetParams get all parameters as types.Params
@@ -21,3 +25,46 @@ func (k *Keeper) GetParam(ctx sdk.Context, key []byte) uint64 {
 	k.paramstore.Get(ctx, key, &out)
 	return out
 }
+
+// GetEpochIdentifierParam returns the identifier of the epoch that drives a stakeibc pipeline
+func (k *Keeper) GetEpochIdentifierParam(ctx sdk.Context, key []byte) string {
+	var out string
+	k.paramstore.Get(ctx, key, &out)
+	return out
+}
+
+// ValidateParamChange checks a governance change of a stakeibc param against the module's state
+// Epoch unbonding and user redemption records are keyed by the number of the unbonding epoch, so the unbonding
+// epoch identifier can't be changed while those records are in use, since the new epoch's numbers could collide with them
+func (k Keeper) ValidateParamChange(ctx sdk.Context, key string, value string) error {
+	if key != string(types.KeyUnbondingEpochIdentifier) {
+		return nil
+	}
+
+	var newEpochIdentifier string
+	if err := json.Unmarshal([]byte(value), &newEpochIdentifier); err != nil {
+		errMsg := fmt.Sprintf("invalid value for %s: %s", key, err.Error())
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(types.ErrInvalidParamChange, errMsg)
+	}
+	if newEpochIdentifier == k.GetEpochIdentifierParam(ctx, types.KeyUnbondingEpochIdentifier) {
+		return nil
+	}
+
+	if userRedemptionRecords := k.RecordsKeeper.GetAllUserRedemptionRecord(ctx); len(userRedemptionRecords) > 0 {
+		errMsg := fmt.Sprintf("cannot change %s while %d user redemption records exist", key, len(userRedemptionRecords))
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(types.ErrInvalidParamChange, errMsg)
+	}
+	for _, epochUnbondingRecord := range k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx) {
+		for _, hostZoneUnbonding := range epochUnbondingRecord.HostZoneUnbondings {
+			if hostZoneUnbonding.NativeTokenAmount.IsPositive() || hostZoneUnbonding.StTokenAmount.IsPositive() {
+				errMsg := fmt.Sprintf("cannot change %s while epoch unbonding record %d has an unbonding for %s",
+					key, epochUnbondingRecord.EpochNumber, hostZoneUnbonding.HostZoneId)
+				k.Logger(ctx).Error(errMsg)
+				return sdkerrors.Wrapf(types.ErrInvalidParamChange, errMsg)
+			}
+		}
+	}
+	return nil
+}
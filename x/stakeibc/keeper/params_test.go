@@ -1,11 +1,18 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/stretchr/testify/require"
+
 	testkeeper "github.com/Stride-Labs/stride/testutil/keeper"
+	epochtypes "github.com/Stride-Labs/stride/x/epochs/types"
+	recordtypes "github.com/Stride-Labs/stride/x/records/types"
+	"github.com/Stride-Labs/stride/x/stakeibc"
 	"github.com/Stride-Labs/stride/x/stakeibc/types"
-	"github.com/stretchr/testify/require"
 )
 
 func TestGetParams(t *testing.T) {
@@ -16,3 +23,60 @@ func TestGetParams(t *testing.T) {
 
 	require.EqualValues(t, params, k.GetParams(ctx))
 }
+
+func TestGetEpochIdentifierParam(t *testing.T) {
+	k, ctx := testkeeper.StakeibcKeeper(t)
+	params := types.DefaultParams()
+	params.UnbondingEpochIdentifier = "hour"
+
+	k.SetParams(ctx, params)
+
+	require.Equal(t, "hour", k.GetEpochIdentifierParam(ctx, types.KeyUnbondingEpochIdentifier))
+	require.Equal(t, types.DefaultDepositEpochIdentifier, k.GetEpochIdentifierParam(ctx, types.KeyDepositEpochIdentifier))
+}
+
+func (s *KeeperTestSuite) TestValidateParamChange_UnbondingEpochIdentifier() {
+	handler := stakeibc.NewParamChangeProposalHandler(s.App.StakeibcKeeper, s.App.ParamsKeeper)
+	proposal := func(epochIdentifier string) *paramproposal.ParameterChangeProposal {
+		return paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+			{Subspace: types.ModuleName, Key: string(types.KeyUnbondingEpochIdentifier), Value: fmt.Sprintf("%q", epochIdentifier)},
+		})
+	}
+
+	// An empty unbonding record doesn't block the change
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx(), recordtypes.EpochUnbondingRecord{
+		EpochNumber: 1,
+		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+			{HostZoneId: HostChainId, NativeTokenAmount: sdk.ZeroInt(), StTokenAmount: sdk.ZeroInt()},
+		},
+	})
+	err := handler(s.Ctx(), proposal(epochtypes.STRIDE_EPOCH))
+	s.Require().NoError(err, "no error changing the unbonding epoch without unbondings")
+	s.Require().Equal(epochtypes.STRIDE_EPOCH, s.App.StakeibcKeeper.GetEpochIdentifierParam(s.Ctx(), types.KeyUnbondingEpochIdentifier))
+
+	// But an unbonding in progress does
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx(), recordtypes.EpochUnbondingRecord{
+		EpochNumber: 2,
+		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+			{HostZoneId: HostChainId, NativeTokenAmount: sdk.NewInt(1000), StTokenAmount: sdk.NewInt(1000)},
+		},
+	})
+	err = handler(s.Ctx(), proposal(epochtypes.DAY_EPOCH))
+	s.Require().ErrorIs(err, types.ErrInvalidParamChange)
+	s.Require().Equal(epochtypes.STRIDE_EPOCH, s.App.StakeibcKeeper.GetEpochIdentifierParam(s.Ctx(), types.KeyUnbondingEpochIdentifier))
+
+	// As does a user redemption record
+	s.App.RecordsKeeper.RemoveEpochUnbondingRecord(s.Ctx(), 2)
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx(), recordtypes.UserRedemptionRecord{Id: "GAIA.1.stride_SENDER", HostZoneId: HostChainId, Amount: sdk.NewInt(1000)})
+	err = handler(s.Ctx(), proposal(epochtypes.DAY_EPOCH))
+	s.Require().ErrorIs(err, types.ErrInvalidParamChange)
+
+	// Re-setting the current identifier and changing other params is still allowed
+	err = handler(s.Ctx(), proposal(epochtypes.STRIDE_EPOCH))
+	s.Require().NoError(err, "no error re-setting the current unbonding epoch")
+	err = handler(s.Ctx(), paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+		{Subspace: types.ModuleName, Key: string(types.KeyRebalanceInterval), Value: `"7"`},
+	}))
+	s.Require().NoError(err, "no error changing another param")
+	s.Require().Equal(uint64(7), s.App.StakeibcKeeper.GetParam(s.Ctx(), types.KeyRebalanceInterval))
+}
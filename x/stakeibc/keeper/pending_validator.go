@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+// SetPendingValidator stores a validator that is waiting on the ICQ of its host staking record
+// before it can be added to the host zone, until the query expires
+func (k Keeper) SetPendingValidator(ctx sdk.Context, chainId string, validator types.Validator, expirationTime uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingValidatorKeyPrefix))
+	pendingValidator := types.PendingValidator{
+		ChainId:        chainId,
+		Validator:      validator,
+		ExpirationTime: expirationTime,
+	}
+	b := k.cdc.MustMarshal(&pendingValidator)
+	store.Set(types.PendingValidatorKey(chainId, validator.Address), b)
+}
+
+// GetPendingValidator returns a pending validator from its host zone and address
+func (k Keeper) GetPendingValidator(ctx sdk.Context, chainId string, validatorAddress string) (val types.Validator, found bool) {
+	pendingValidator, found := k.getPendingValidatorEntry(ctx, chainId, validatorAddress)
+	return pendingValidator.Validator, found
+}
+
+// getPendingValidatorEntry returns a pending validator, along with its host zone and expiration, from its host zone and address
+func (k Keeper) getPendingValidatorEntry(ctx sdk.Context, chainId string, validatorAddress string) (val types.PendingValidator, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingValidatorKeyPrefix))
+	b := store.Get(types.PendingValidatorKey(chainId, validatorAddress))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllPendingValidators returns all pending validators across host zones
+func (k Keeper) GetAllPendingValidators(ctx sdk.Context) (list []types.PendingValidator) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingValidatorKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PendingValidator
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RemovePendingValidator removes a pending validator from the store
+func (k Keeper) RemovePendingValidator(ctx sdk.Context, chainId string, validatorAddress string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingValidatorKeyPrefix))
+	store.Delete(types.PendingValidatorKey(chainId, validatorAddress))
+}
+
+// RejectPendingValidator drops a validator that failed the min validator requirements and emits an event with the reason
+func (k Keeper) RejectPendingValidator(ctx sdk.Context, hostZone types.HostZone, validator types.Validator, reason string) {
+	k.Logger(ctx).Error(fmt.Sprintf("Validator %s rejected from host zone %s: %s", validator.Address, hostZone.ChainId, reason))
+	k.RemovePendingValidator(ctx, hostZone.ChainId, validator.Address)
+
+	k.EmitTypedEvent(ctx, &types.EventValidatorRejected{
+		HostZoneId:       hostZone.ChainId,
+		ValidatorAddress: validator.Address,
+		Reason:           reason,
+	})
+}
+
+// ExpirePendingValidator drops a pending validator if its requirements query expired without a response
+// (e.g. the query timed out, the validator doesn't exist on the host, or the callback failed),
+// and returns whether it was dropped
+func (k Keeper) ExpirePendingValidator(ctx sdk.Context, pendingValidator types.PendingValidator) bool {
+	if pendingValidator.ExpirationTime > cast.ToUint64(ctx.BlockTime().UnixNano()) {
+		return false
+	}
+
+	reason := fmt.Sprintf("validator requirements query expired at %d without a response", pendingValidator.ExpirationTime)
+	hostZone, found := k.GetHostZone(ctx, pendingValidator.ChainId)
+	if !found {
+		k.Logger(ctx).Error(fmt.Sprintf("Validator %s dropped from removed host zone %s: %s", pendingValidator.Validator.Address, pendingValidator.ChainId, reason))
+		k.RemovePendingValidator(ctx, pendingValidator.ChainId, pendingValidator.Validator.Address)
+		return true
+	}
+	k.RejectPendingValidator(ctx, hostZone, pendingValidator.Validator, reason)
+	return true
+}
+
+// ExpirePendingValidators drops the pending validators whose requirements query expired without a response
+func (k Keeper) ExpirePendingValidators(ctx sdk.Context) {
+	for _, pendingValidator := range k.GetAllPendingValidators(ctx) {
+		k.ExpirePendingValidator(ctx, pendingValidator)
+	}
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/utils"
+	recordstypes "github.com/Stride-Labs/stride/x/records/types"
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+// IsPipelineEpoch returns whether a pipeline stage is due for a host zone in the given epoch
+// The stage runs every `interval` epochs, shifted by the host zone's offset, where the interval
+// comes from the host zone's schedule override if set, or the module param otherwise
+// The offset staggers host zones across epochs, not blocks: it has no effect with an interval of 1,
+// and every host zone that's due in an epoch still runs in that epoch's first block
+func (k Keeper) IsPipelineEpoch(ctx sdk.Context, hostZone types.HostZone, pipeline string, epochNumber uint64) bool {
+	interval := k.GetParam(ctx, types.PipelineIntervalKeys[pipeline])
+	offset := uint64(0)
+	if schedule := hostZone.GetPipelineSchedule(pipeline); schedule != nil {
+		if schedule.Interval > 0 {
+			interval = schedule.Interval
+		}
+		offset = schedule.Offset
+	}
+	if interval == 0 {
+		return false
+	}
+	return epochNumber%interval == offset%interval
+}
+
+// GetHostZonesForPipeline returns the host zones for which a pipeline stage is due in the given epoch
+// Host zones unbonding all of their stake are excluded, which freezes their redemption rate, as are host zones
+// sweeping their ICA accounts (the rewards that build up in the withdrawal account are swept instead of reinvested)
+func (k Keeper) GetHostZonesForPipeline(ctx sdk.Context, pipeline string, epochNumber uint64) []types.HostZone {
+	hostZones := []types.HostZone{}
+	for _, hostZone := range k.GetAllHostZone(ctx) {
+		if hostZone.WindDownStatus == types.HostZone_UnbondingAll || hostZone.WindDownStatus == types.HostZone_Sweeping {
+			continue
+		}
+		if k.IsPipelineEpoch(ctx, hostZone, pipeline, epochNumber) {
+			hostZones = append(hostZones, hostZone)
+		}
+	}
+	return hostZones
+}
+
+// FilterDepositRecordsForHostZones returns the deposit records that belong to one of the given host zones
+func FilterDepositRecordsForHostZones(depositRecords []recordstypes.DepositRecord, hostZones []types.HostZone) []recordstypes.DepositRecord {
+	chainIds := make(map[string]bool)
+	for _, hostZone := range hostZones {
+		chainIds[hostZone.ChainId] = true
+	}
+	return utils.FilterDepositRecords(depositRecords, func(record recordstypes.DepositRecord) (condition bool) {
+		return chainIds[record.HostZoneId]
+	})
+}
@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	_ "github.com/stretchr/testify/suite"
+
+	epochtypes "github.com/Stride-Labs/stride/x/epochs/types"
+	recordtypes "github.com/Stride-Labs/stride/x/records/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+const scheduleAdmin = "stride1k8c2m5cn322akk5wy8lpt87dd2f4yh9azg7jlh"
+
+func (s *KeeperTestSuite) TestIsPipelineEpoch() {
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx())
+	params.DepositInterval = 2
+	s.App.StakeibcKeeper.SetParams(s.Ctx(), params)
+
+	testCases := []struct {
+		name          string
+		schedule      *stakeibctypes.PipelineSchedule
+		dueEpochs     []uint64
+		skippedEpochs []uint64
+	}{
+		{
+			name:          "no override uses the param",
+			schedule:      nil,
+			dueEpochs:     []uint64{0, 2, 4, 6},
+			skippedEpochs: []uint64{1, 3, 5},
+		},
+		{
+			name:          "interval override",
+			schedule:      &stakeibctypes.PipelineSchedule{Interval: 3},
+			dueEpochs:     []uint64{0, 3, 6},
+			skippedEpochs: []uint64{1, 2, 4, 5},
+		},
+		{
+			name:          "interval and offset override",
+			schedule:      &stakeibctypes.PipelineSchedule{Interval: 3, Offset: 2},
+			dueEpochs:     []uint64{2, 5, 8},
+			skippedEpochs: []uint64{0, 1, 3, 4, 6},
+		},
+		{
+			name:          "offset with the param interval",
+			schedule:      &stakeibctypes.PipelineSchedule{Offset: 1},
+			dueEpochs:     []uint64{1, 3, 5},
+			skippedEpochs: []uint64{0, 2, 4},
+		},
+		{
+			name:          "offset larger than the param interval wraps around",
+			schedule:      &stakeibctypes.PipelineSchedule{Offset: 3},
+			dueEpochs:     []uint64{1, 3, 5},
+			skippedEpochs: []uint64{0, 2, 4},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			hostZone := stakeibctypes.HostZone{ChainId: HostChainId, DepositSchedule: tc.schedule}
+			for _, epoch := range tc.dueEpochs {
+				s.Require().True(s.App.StakeibcKeeper.IsPipelineEpoch(s.Ctx(), hostZone, stakeibctypes.PipelineDeposit, epoch), "epoch %d should be due", epoch)
+			}
+			for _, epoch := range tc.skippedEpochs {
+				s.Require().False(s.App.StakeibcKeeper.IsPipelineEpoch(s.Ctx(), hostZone, stakeibctypes.PipelineDeposit, epoch), "epoch %d should be skipped", epoch)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGetHostZonesForPipeline() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), stakeibctypes.HostZone{ChainId: "GAIA"})
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), stakeibctypes.HostZone{
+		ChainId:          "JUNO",
+		DelegateSchedule: &stakeibctypes.PipelineSchedule{Interval: 2, Offset: 1},
+	})
+
+	chainIds := func(hostZones []stakeibctypes.HostZone) []string {
+		ids := []string{}
+		for _, hostZone := range hostZones {
+			ids = append(ids, hostZone.ChainId)
+		}
+		return ids
+	}
+
+	// The default delegate interval is 1, so GAIA is due every epoch, while JUNO is staggered to odd epochs
+	s.Require().Equal([]string{"GAIA"}, chainIds(s.App.StakeibcKeeper.GetHostZonesForPipeline(s.Ctx(), stakeibctypes.PipelineDelegate, 4)))
+	s.Require().Equal([]string{"GAIA", "JUNO"}, chainIds(s.App.StakeibcKeeper.GetHostZonesForPipeline(s.Ctx(), stakeibctypes.PipelineDelegate, 5)))
+
+	// Other pipelines are unaffected by JUNO's delegate schedule
+	s.Require().Equal([]string{"GAIA", "JUNO"}, chainIds(s.App.StakeibcKeeper.GetHostZonesForPipeline(s.Ctx(), stakeibctypes.PipelineDeposit, 4)))
+}
+
+func (s *KeeperTestSuite) TestFilterDepositRecordsForHostZones() {
+	depositRecords := []recordtypes.DepositRecord{
+		{Id: 1, HostZoneId: "GAIA"},
+		{Id: 2, HostZoneId: "JUNO"},
+		{Id: 3, HostZoneId: "GAIA"},
+	}
+	hostZones := []stakeibctypes.HostZone{{ChainId: "GAIA"}}
+
+	filtered := stakeibckeeper.FilterDepositRecordsForHostZones(depositRecords, hostZones)
+	s.Require().Equal([]recordtypes.DepositRecord{depositRecords[0], depositRecords[2]}, filtered)
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZoneSchedule() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), stakeibctypes.HostZone{ChainId: HostChainId})
+
+	// Set a schedule
+	msg := stakeibctypes.NewMsgUpdateHostZoneSchedule(scheduleAdmin, HostChainId, stakeibctypes.PipelineReinvest, 4, 3)
+	s.Require().NoError(msg.ValidateBasic())
+	_, err := s.GetMsgServer().UpdateHostZoneSchedule(sdk.WrapSDKContext(s.Ctx()), msg)
+	s.Require().NoError(err)
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
+	s.Require().True(found, "host zone should exist")
+	s.Require().Equal(&stakeibctypes.PipelineSchedule{Interval: 4, Offset: 3}, hostZone.ReinvestSchedule, "reinvest schedule")
+	s.Require().Nil(hostZone.DepositSchedule, "deposit schedule should be unchanged")
+
+	// Clear the schedule
+	msg = stakeibctypes.NewMsgUpdateHostZoneSchedule(scheduleAdmin, HostChainId, stakeibctypes.PipelineReinvest, 0, 0)
+	_, err = s.GetMsgServer().UpdateHostZoneSchedule(sdk.WrapSDKContext(s.Ctx()), msg)
+	s.Require().NoError(err)
+
+	hostZone, found = s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
+	s.Require().True(found, "host zone should exist")
+	s.Require().Nil(hostZone.ReinvestSchedule, "reinvest schedule should be cleared")
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZoneSchedule_HostZoneNotFound() {
+	msg := stakeibctypes.NewMsgUpdateHostZoneSchedule(scheduleAdmin, "fake_host_zone", stakeibctypes.PipelineReinvest, 4, 3)
+	_, err := s.GetMsgServer().UpdateHostZoneSchedule(sdk.WrapSDKContext(s.Ctx()), msg)
+	s.Require().ErrorIs(err, stakeibctypes.ErrInvalidHostZone)
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZoneSchedule_InvalidMsg() {
+	invalidPipeline := stakeibctypes.NewMsgUpdateHostZoneSchedule(scheduleAdmin, HostChainId, "unbonding", 4, 3)
+	s.Require().ErrorIs(invalidPipeline.ValidateBasic(), sdkerrors.ErrInvalidRequest, "invalid pipeline")
+
+	offsetTooLarge := stakeibctypes.NewMsgUpdateHostZoneSchedule(scheduleAdmin, HostChainId, stakeibctypes.PipelineDeposit, 4, 4)
+	s.Require().ErrorContains(offsetTooLarge.ValidateBasic(), "offset (4) must be less than the interval (4)")
+
+	missingHostZone := stakeibctypes.NewMsgUpdateHostZoneSchedule(scheduleAdmin, "", stakeibctypes.PipelineDeposit, 4, 3)
+	s.Require().ErrorIs(missingHostZone.ValidateBasic(), sdkerrors.ErrInvalidRequest, "missing host zone")
+}
+
+func (s *KeeperTestSuite) TestBeforeEpochStart_RebalanceSchedule() {
+	tc := s.SetupRebalanceValidatorsMultipleRedelegations()
+	ctx := s.Ctx()
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(ctx, HostChainId)
+	s.Require().True(found, "host zone should exist")
+	hostZone.RebalanceSchedule = &stakeibctypes.PipelineSchedule{Interval: 3, Offset: 1}
+	s.App.StakeibcKeeper.SetHostZone(ctx, hostZone)
+
+	epochInfo := epochtypes.EpochInfo{
+		Identifier:            epochtypes.STRIDE_EPOCH,
+		Duration:              time.Hour,
+		CurrentEpochStartTime: ctx.BlockTime(),
+	}
+
+	// Epoch 3 would be due without the offset
+	startSequence := s.getNextDelegationSequence(ctx, tc)
+	epochInfo.CurrentEpoch = 3
+	s.App.StakeibcKeeper.BeforeEpochStart(ctx, epochInfo)
+	_, found = s.getSubmittedRebalancings(ctx, tc, startSequence)
+	s.Require().False(found, "no rebalance should be submitted in epoch 3")
+
+	// Epoch 4 is due with the offset
+	startSequence = s.getNextDelegationSequence(ctx, tc)
+	epochInfo.CurrentEpoch = 4
+	s.App.StakeibcKeeper.BeforeEpochStart(ctx, epochInfo)
+	_, found = s.getSubmittedRebalancings(ctx, tc, startSequence)
+	s.Require().True(found, "rebalance should be submitted in epoch 4")
+}
@@ -0,0 +1,269 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/spf13/cast"
+
+	"github.com/Stride-Labs/stride/utils"
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+// rebalanceBalance is the remaining amount a validator needs to send or receive while planning a rebalance
+type rebalanceBalance struct {
+	valAddr string
+	amount  sdk.Int
+}
+
+// RebalanceHostZone plans and submits the redelegations that move a host zone's delegations towards the targets
+// set by the validator weights, provided the largest validator drift exceeds the rebalancing threshold
+// At most maxNumRebalance redelegations are issued; any remaining imbalance is picked up by the next rebalance
+func (k Keeper) RebalanceHostZone(ctx sdk.Context, hostZone types.HostZone, maxNumRebalance int) ([]*types.Rebalancing, error) {
+	validatorDeltas, err := k.GetValidatorDelegationAmtDifferences(ctx, hostZone)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Error getting validator deltas for Host Zone %s: %s", hostZone.ChainId, err))
+		return nil, err
+	}
+
+	// check if there is a large enough rebalance, if not, just exit
+	totalDelegation := k.GetTotalValidatorDelegations(hostZone)
+	if totalDelegation.IsZero() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "no validator delegations found for Host Zone %s, cannot rebalance 0 delegations!", hostZone.ChainId)
+	}
+
+	maxDelta := sdk.ZeroDec()
+	for _, deltaAmt := range validatorDeltas {
+		maxDelta = sdk.MaxDec(maxDelta, deltaAmt.Abs().ToDec().Quo(totalDelegation.ToDec()))
+	}
+	rebalanceThreshold := sdk.NewDecFromInt(sdk.NewIntFromUint64(k.GetParam(ctx, types.KeyValidatorRebalancingThreshold))).QuoInt64(10000)
+	if maxDelta.LT(rebalanceThreshold) {
+		k.Logger(ctx).Error("Not enough validator disruption to rebalance")
+		return nil, types.ErrWeightsNotDifferent
+	}
+
+	rebalancings, err := k.PlanRedelegations(ctx, hostZone, validatorDeltas, maxNumRebalance)
+	if err != nil {
+		return nil, err
+	}
+	if len(rebalancings) == 0 {
+		errMsg := fmt.Sprintf("Unable to rebalance %s, all redelegations are blocked by in-flight redelegations", hostZone.ChainId)
+		k.Logger(ctx).Error(errMsg)
+		return nil, sdkerrors.Wrapf(types.ErrNoRedelegationsPossible, errMsg)
+	}
+
+	if err := k.SubmitRebalancings(ctx, hostZone, rebalancings); err != nil {
+		return nil, err
+	}
+
+	return rebalancings, nil
+}
+
+// RebalanceAllHostZones automatically rebalances each host zone whose validator drift exceeds the rebalancing threshold
+// The number of redelegations per host zone is capped by MaxRebalanceRedelegations, so large rebalances are
+// spread across multiple rebalance intervals
+func (k Keeper) RebalanceAllHostZones(ctx sdk.Context) {
+	k.RebalanceHostZones(ctx, k.GetAllHostZone(ctx))
+}
+
+// RebalanceHostZones automatically rebalances each of the given host zones whose validator drift exceeds the rebalancing threshold
+func (k Keeper) RebalanceHostZones(ctx sdk.Context, hostZones []types.HostZone) {
+	maxNumRebalance := cast.ToInt(k.GetParam(ctx, types.KeyMaxRebalanceRedelegations))
+
+	for _, hostZone := range hostZones {
+		// only rebalance host zones that have a delegation account and delegations to move
+		if hostZone.GetDelegationAccount() == nil || hostZone.GetDelegationAccount().GetAddress() == "" {
+			k.Logger(ctx).Info(fmt.Sprintf("Delegation account not registered for host zone %s, skipping rebalance", hostZone.ChainId))
+			continue
+		}
+		if k.GetTotalValidatorDelegations(hostZone).IsZero() || k.GetTotalValidatorWeight(hostZone) == 0 {
+			continue
+		}
+
+		var rebalancings []*types.Rebalancing
+		err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) (err error) {
+			rebalancings, err = k.RebalanceHostZone(ctx, hostZone, maxNumRebalance)
+			return err
+		})
+		if err != nil {
+			if types.ErrWeightsNotDifferent.Is(err) {
+				k.Logger(ctx).Info(fmt.Sprintf("Validator drift on host zone %s is within the rebalancing threshold", hostZone.ChainId))
+			} else {
+				k.Logger(ctx).Error(fmt.Sprintf("Unable to rebalance host zone %s: %s", hostZone.ChainId, err.Error()))
+			}
+			continue
+		}
+		k.EmitRebalanceEvent(ctx, hostZone, rebalancings, types.AttributeValueRebalanceAutomatic)
+	}
+}
+
+// EmitRebalanceEvent emits an event summarizing the redelegations submitted to rebalance a host zone
+func (k Keeper) EmitRebalanceEvent(ctx sdk.Context, hostZone types.HostZone, rebalancings []*types.Rebalancing, trigger string) {
+	totalAmount := sdk.ZeroInt()
+	for _, rebalancing := range rebalancings {
+		totalAmount = totalAmount.Add(rebalancing.Amt)
+	}
+
+	k.EmitTypedEvent(ctx, &types.EventRebalance{
+		HostZoneId:       hostZone.ChainId,
+		NumRedelegations: uint64(len(rebalancings)),
+		Amount:           totalAmount,
+		Trigger:          trigger,
+	})
+}
+
+// PlanRedelegations greedily matches validators that need to give up stake (negative delta) with validators
+// that need to receive stake (positive delta), always pairing the largest remaining amounts so that each
+// redelegation settles at least one side. This issues at most (#sources + #destinations - 1) redelegations
+//
+// The plan respects the host's redelegation limits, based on the redelegations still in flight (including the ones
+// whose tx hasn't been acknowledged yet):
+//   - a (src, dst) pair may not exceed MaxRedelegationEntries in-flight entries
+//   - a validator with an incoming redelegation in flight can't be used as a source (transitive redelegation)
+//
+// Pending redelegations aren't reflected in the validators' delegations until they're acknowledged, so the
+// amounts they move are netted out of the deltas to avoid redelegating the same stake twice
+func (k Keeper) PlanRedelegations(ctx sdk.Context, hostZone types.HostZone, validatorDeltas map[string]sdk.Int, maxRebalancings int) ([]*types.Rebalancing, error) {
+	chainId := hostZone.ChainId
+	maxEntries := cast.ToInt(k.GetParam(ctx, types.KeyMaxRedelegationEntries))
+
+	activeRedelegations, err := k.GetActiveRedelegationsForHostZone(ctx, hostZone)
+	if err != nil {
+		return nil, err
+	}
+
+	// tally the in-flight entries per validator pair, and which validators are receiving a redelegation
+	entriesPerPair := make(map[string]int)
+	hasIncomingRedelegation := make(map[string]bool)
+	netDeltas := make(map[string]sdk.Int)
+	for valAddr, delta := range validatorDeltas {
+		netDeltas[valAddr] = delta
+	}
+	for _, redelegation := range activeRedelegations {
+		pairKey := string(types.RedelegationKey(chainId, redelegation.SrcValidator, redelegation.DstValidator))
+		entriesPerPair[pairKey] += len(redelegation.Entries)
+		hasIncomingRedelegation[redelegation.DstValidator] = true
+
+		for _, entry := range redelegation.Entries {
+			if !entry.Pending {
+				continue
+			}
+			if srcDelta, ok := netDeltas[redelegation.SrcValidator]; ok {
+				netDeltas[redelegation.SrcValidator] = srcDelta.Add(entry.Amount)
+			}
+			if dstDelta, ok := netDeltas[redelegation.DstValidator]; ok {
+				netDeltas[redelegation.DstValidator] = dstDelta.Sub(entry.Amount)
+			}
+		}
+	}
+	validatorDeltas = netDeltas
+
+	// split the validators into sources and destinations
+	sources := []*rebalanceBalance{}
+	destinations := []*rebalanceBalance{}
+	for _, valAddr := range utils.StringToIntMapKeys(validatorDeltas) {
+		delta := validatorDeltas[valAddr]
+		if delta.IsNegative() {
+			if hasIncomingRedelegation[valAddr] {
+				k.Logger(ctx).Info(fmt.Sprintf("Validator %s has an incoming redelegation in progress, skipping it as a rebalance source", valAddr))
+				continue
+			}
+			sources = append(sources, &rebalanceBalance{valAddr: valAddr, amount: delta.Neg()})
+		} else if delta.IsPositive() {
+			destinations = append(destinations, &rebalanceBalance{valAddr: valAddr, amount: delta})
+		}
+	}
+
+	rebalancings := []*types.Rebalancing{}
+	for len(rebalancings) < maxRebalancings {
+		sortRebalanceBalances(sources)
+		sortRebalanceBalances(destinations)
+
+		// find the largest source/destination pair that's still within the host's entry limit
+		var source, destination *rebalanceBalance
+		for _, src := range sources {
+			for _, dst := range destinations {
+				pairKey := string(types.RedelegationKey(chainId, src.valAddr, dst.valAddr))
+				if src.amount.IsPositive() && dst.amount.IsPositive() && entriesPerPair[pairKey] < maxEntries {
+					source, destination = src, dst
+					break
+				}
+			}
+			if source != nil {
+				break
+			}
+		}
+		if source == nil {
+			break
+		}
+
+		amount := sdk.MinInt(source.amount, destination.amount)
+		source.amount = source.amount.Sub(amount)
+		destination.amount = destination.amount.Sub(amount)
+		entriesPerPair[string(types.RedelegationKey(chainId, source.valAddr, destination.valAddr))]++
+
+		rebalancings = append(rebalancings, &types.Rebalancing{
+			SrcValidator: source.valAddr,
+			DstValidator: destination.valAddr,
+			Amt:          amount,
+		})
+	}
+
+	return rebalancings, nil
+}
+
+// SubmitRebalancings submits an ICA tx from the host zone's delegation account with a redelegation for each rebalancing
+// Each redelegation is tracked as pending while the tx is in flight, and the rebalance callback replaces it with the
+// host's completion time (and updates the validator delegations) once the tx succeeds
+func (k Keeper) SubmitRebalancings(ctx sdk.Context, hostZone types.HostZone, rebalancings []*types.Rebalancing) error {
+	delegationIca := hostZone.GetDelegationAccount()
+	if delegationIca == nil || delegationIca.GetAddress() == "" {
+		errMsg := fmt.Sprintf("Zone %s is missing a delegation address!", hostZone.ChainId)
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrap(types.ErrICAAccountNotFound, errMsg)
+	}
+
+	var msgs []sdk.Msg
+	for _, rebalancing := range rebalancings {
+		k.Logger(ctx).Info(fmt.Sprintf("Redelegating %v%s from %s to %s", rebalancing.Amt, hostZone.HostDenom, rebalancing.SrcValidator, rebalancing.DstValidator))
+		msgs = append(msgs, &stakingtypes.MsgBeginRedelegate{
+			DelegatorAddress:    delegationIca.GetAddress(),
+			ValidatorSrcAddress: rebalancing.SrcValidator,
+			ValidatorDstAddress: rebalancing.DstValidator,
+			Amount:              sdk.NewCoin(hostZone.HostDenom, rebalancing.Amt),
+		})
+	}
+
+	rebalanceCallback := types.RebalanceCallback{
+		HostZoneId:   hostZone.ChainId,
+		Rebalancings: rebalancings,
+	}
+	marshalledCallbackArgs, err := k.MarshalRebalanceCallbackArgs(ctx, rebalanceCallback)
+	if err != nil {
+		return err
+	}
+
+	rebalanceEpochIdentifier := k.GetEpochIdentifierParam(ctx, types.KeyRebalanceEpochIdentifier)
+	err = k.QueueTxs(ctx, hostZone.ConnectionId, msgs, *delegationIca, rebalanceEpochIdentifier, REBALANCE, marshalledCallbackArgs)
+	if err != nil {
+		errMsg := fmt.Sprintf("Failed to queue txs for %s, %s, %s, %s", hostZone.ConnectionId, hostZone.ChainId, msgs, err.Error())
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrap(types.ErrICATxFailed, errMsg)
+	}
+	k.AddPendingRedelegationEntries(ctx, hostZone, rebalancings)
+
+	return nil
+}
+
+// sortRebalanceBalances sorts by remaining amount, largest first, breaking ties by address
+func sortRebalanceBalances(balances []*rebalanceBalance) {
+	sort.SliceStable(balances, func(i, j int) bool {
+		if !balances[i].amount.Equal(balances[j].amount) {
+			return balances[i].amount.GT(balances[j].amount)
+		}
+		return balances[i].valAddr < balances[j].valAddr
+	})
+}
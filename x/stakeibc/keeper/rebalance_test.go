@@ -0,0 +1,171 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	"github.com/gogo/protobuf/proto"
+	_ "github.com/stretchr/testify/suite"
+
+	epochtypes "github.com/Stride-Labs/stride/x/epochs/types"
+	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
+	stakeibctypes "github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+// Sends the queued ICA txs and returns the rebalancings submitted in the ICA with the given sequence,
+// or false if no ICA was submitted
+func (s *KeeperTestSuite) getSubmittedRebalancings(ctx sdk.Context, tc RebalanceValidatorsTestCase, sequence uint64) ([]*stakeibctypes.Rebalancing, bool) {
+	s.App.StakeibcKeeper.FlushICATxQueues(ctx)
+
+	portId := icatypes.PortPrefix + "GAIA.DELEGATION"
+	callbackKey := icacallbackstypes.PacketID(portId, tc.delegationChannel, sequence)
+	callbackData, found := s.App.StakeibcKeeper.ICACallbacksKeeper.GetCallbackData(ctx, callbackKey)
+	if !found {
+		return nil, false
+	}
+	callbackArgs, err := s.App.StakeibcKeeper.UnmarshalRebalanceCallbackArgs(ctx, callbackData.CallbackArgs)
+	s.Require().NoError(err, "unmarshalling callback args error for callback key (%s)", callbackKey)
+	return callbackArgs.Rebalancings, true
+}
+
+func (s *KeeperTestSuite) getNextDelegationSequence(ctx sdk.Context, tc RebalanceValidatorsTestCase) uint64 {
+	portId := icatypes.PortPrefix + "GAIA.DELEGATION"
+	sequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(ctx, portId, tc.delegationChannel)
+	s.Require().True(found, "sequence number not found")
+	return sequence
+}
+
+func (s *KeeperTestSuite) TestRebalanceAllHostZones_Successful() {
+	tc := s.SetupRebalanceValidatorsMultipleRedelegations()
+	ctx := s.Ctx()
+	startSequence := s.getNextDelegationSequence(ctx, tc)
+
+	s.App.StakeibcKeeper.RebalanceAllHostZones(ctx)
+
+	rebalancings, found := s.getSubmittedRebalancings(ctx, tc, startSequence)
+	s.Require().True(found, "rebalance ICA should have been submitted")
+	s.Require().Len(rebalancings, 4, "number of rebalancings")
+
+	// Check that the rebalance event was emitted
+	s.CheckTypedEventEmitted(ctx, &stakeibctypes.EventRebalance{
+		HostZoneId:       "GAIA",
+		NumRedelegations: 4,
+		Amount:           sdk.NewInt(500),
+		Trigger:          stakeibctypes.AttributeValueRebalanceAutomatic,
+	})
+}
+
+func (s *KeeperTestSuite) TestRebalanceAllHostZones_RateLimited() {
+	tc := s.SetupRebalanceValidatorsMultipleRedelegations()
+	ctx := s.Ctx()
+	startSequence := s.getNextDelegationSequence(ctx, tc)
+
+	// Cap the number of redelegations per rebalance
+	params := s.App.StakeibcKeeper.GetParams(ctx)
+	params.MaxRebalanceRedelegations = 2
+	s.App.StakeibcKeeper.SetParams(ctx, params)
+
+	s.App.StakeibcKeeper.RebalanceAllHostZones(ctx)
+
+	rebalancings, found := s.getSubmittedRebalancings(ctx, tc, startSequence)
+	s.Require().True(found, "rebalance ICA should have been submitted")
+	s.Require().Len(rebalancings, 2, "number of rebalancings")
+}
+
+func (s *KeeperTestSuite) TestRebalanceAllHostZones_WithinThreshold() {
+	tc := s.SetupRebalanceValidators()
+	ctx := s.Ctx()
+	startSequence := s.getNextDelegationSequence(ctx, tc)
+
+	// The weights in the setup match the delegations, so there's no drift
+	s.App.StakeibcKeeper.RebalanceAllHostZones(ctx)
+
+	_, found := s.getSubmittedRebalancings(ctx, tc, startSequence)
+	s.Require().False(found, "no rebalance ICA should have been submitted")
+	s.Require().Equal(startSequence, s.getNextDelegationSequence(ctx, tc), "sequence number should not change")
+	for _, event := range ctx.EventManager().Events() {
+		s.Require().NotEqual(proto.MessageName(&stakeibctypes.EventRebalance{}), event.Type, "no rebalance event should be emitted")
+	}
+}
+
+func (s *KeeperTestSuite) TestRebalanceAllHostZones_NoDelegationAccount() {
+	tc := s.SetupRebalanceValidatorsMultipleRedelegations()
+	ctx := s.Ctx()
+	startSequence := s.getNextDelegationSequence(ctx, tc)
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(ctx, "GAIA")
+	s.Require().True(found, "host zone should exist")
+	hostZone.DelegationAccount = nil
+	s.App.StakeibcKeeper.SetHostZone(ctx, hostZone)
+
+	s.App.StakeibcKeeper.RebalanceAllHostZones(ctx)
+
+	_, found = s.getSubmittedRebalancings(ctx, tc, startSequence)
+	s.Require().False(found, "no rebalance ICA should have been submitted")
+}
+
+func (s *KeeperTestSuite) TestBeforeEpochStart_RebalanceInterval() {
+	tc := s.SetupRebalanceValidatorsMultipleRedelegations()
+	ctx := s.Ctx()
+
+	params := s.App.StakeibcKeeper.GetParams(ctx)
+	params.RebalanceInterval = 3
+	s.App.StakeibcKeeper.SetParams(ctx, params)
+
+	epochInfo := epochtypes.EpochInfo{
+		Identifier:            epochtypes.STRIDE_EPOCH,
+		Duration:              time.Hour,
+		CurrentEpochStartTime: ctx.BlockTime(),
+	}
+
+	// No rebalance on an epoch that's not a multiple of the interval
+	startSequence := s.getNextDelegationSequence(ctx, tc)
+	epochInfo.CurrentEpoch = 2
+	s.App.StakeibcKeeper.BeforeEpochStart(ctx, epochInfo)
+	_, found := s.getSubmittedRebalancings(ctx, tc, startSequence)
+	s.Require().False(found, "no rebalance should be submitted in epoch 2")
+
+	// Rebalance on an epoch that's a multiple of the interval
+	startSequence = s.getNextDelegationSequence(ctx, tc)
+	epochInfo.CurrentEpoch = 3
+	s.App.StakeibcKeeper.BeforeEpochStart(ctx, epochInfo)
+	rebalancings, found := s.getSubmittedRebalancings(ctx, tc, startSequence)
+	s.Require().True(found, "rebalance should be submitted in epoch 3")
+	s.Require().Len(rebalancings, 4, "number of rebalancings")
+}
+
+func (s *KeeperTestSuite) TestBeforeEpochStart_RebalanceEpochIdentifier() {
+	tc := s.SetupRebalanceValidatorsMultipleRedelegations()
+	ctx := s.Ctx()
+
+	params := s.App.StakeibcKeeper.GetParams(ctx)
+	params.RebalanceInterval = 1
+	params.RebalanceEpochIdentifier = "hour"
+	s.App.StakeibcKeeper.SetParams(ctx, params)
+	s.SetEpochTracker(stakeibctypes.EpochTracker{
+		EpochIdentifier:    "hour",
+		EpochNumber:        1,
+		NextEpochStartTime: uint64(s.Coordinator.CurrentTime.UnixNano() + 60_000_000_000), // dictates timeouts
+	})
+
+	epochInfo := epochtypes.EpochInfo{
+		Duration:              time.Hour,
+		CurrentEpoch:          1,
+		CurrentEpochStartTime: ctx.BlockTime(),
+	}
+
+	// The stride epoch no longer triggers a rebalance
+	startSequence := s.getNextDelegationSequence(ctx, tc)
+	epochInfo.Identifier = epochtypes.STRIDE_EPOCH
+	s.App.StakeibcKeeper.BeforeEpochStart(ctx, epochInfo)
+	_, found := s.getSubmittedRebalancings(ctx, tc, startSequence)
+	s.Require().False(found, "no rebalance should be submitted on the stride epoch")
+
+	// The configured epoch does
+	startSequence = s.getNextDelegationSequence(ctx, tc)
+	epochInfo.Identifier = "hour"
+	s.App.StakeibcKeeper.BeforeEpochStart(ctx, epochInfo)
+	_, found = s.getSubmittedRebalancings(ctx, tc, startSequence)
+	s.Require().True(found, "rebalance should be submitted on the hour epoch")
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+// SetRedelegation stores the in-flight redelegations between a pair of validators
+func (k Keeper) SetRedelegation(ctx sdk.Context, redelegation types.Redelegation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedelegationKeyPrefix))
+	b := k.cdc.MustMarshal(&redelegation)
+	store.Set(types.RedelegationKey(redelegation.HostZoneId, redelegation.SrcValidator, redelegation.DstValidator), b)
+}
+
+// GetRedelegation returns the in-flight redelegations between a pair of validators
+func (k Keeper) GetRedelegation(ctx sdk.Context, chainId string, srcValidator string, dstValidator string) (val types.Redelegation, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedelegationKeyPrefix))
+	b := store.Get(types.RedelegationKey(chainId, srcValidator, dstValidator))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveRedelegation removes the in-flight redelegations between a pair of validators
+func (k Keeper) RemoveRedelegation(ctx sdk.Context, chainId string, srcValidator string, dstValidator string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedelegationKeyPrefix))
+	store.Delete(types.RedelegationKey(chainId, srcValidator, dstValidator))
+}
+
+// GetAllRedelegations returns all in-flight redelegations
+func (k Keeper) GetAllRedelegations(ctx sdk.Context) (list []types.Redelegation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedelegationKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Redelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetActiveRedelegationsForHostZone returns the redelegations for a host zone that have not yet completed,
+// dropping any entries whose completion time has passed on the host (according to its light client)
+// Redelegations that are still pending on the host are always included
+func (k Keeper) GetActiveRedelegationsForHostZone(ctx sdk.Context, hostZone types.HostZone) (list []types.Redelegation, err error) {
+	hostTime, err := k.GetFreshLightClientTime(ctx, hostZone, types.PipelineRebalance)
+	if err != nil {
+		return nil, err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedelegationKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.RedelegationHostZonePrefix(hostZone.ChainId))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Redelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		val.Entries = filterActiveRedelegationEntries(val.Entries, hostTime)
+		if len(val.Entries) > 0 {
+			list = append(list, val)
+		}
+	}
+
+	return list, nil
+}
+
+// AddPendingRedelegationEntries records each rebalancing as a pending redelegation when its tx is submitted,
+// so that it counts towards the host's limits until the rebalance callback resolves it
+func (k Keeper) AddPendingRedelegationEntries(ctx sdk.Context, hostZone types.HostZone, rebalancings []*types.Rebalancing) {
+	for _, rebalancing := range rebalancings {
+		k.AddRedelegationEntry(ctx, hostZone, rebalancing.SrcValidator, rebalancing.DstValidator, types.RedelegationEntry{
+			Amount:  rebalancing.Amt,
+			Pending: true,
+		})
+	}
+}
+
+// RemovePendingRedelegationEntries removes the pending entry of each rebalancing once the host has acknowledged
+// (or timed out) its redelegation tx
+func (k Keeper) RemovePendingRedelegationEntries(ctx sdk.Context, chainId string, rebalancings []*types.Rebalancing) {
+	for _, rebalancing := range rebalancings {
+		redelegation, found := k.GetRedelegation(ctx, chainId, rebalancing.SrcValidator, rebalancing.DstValidator)
+		if !found {
+			continue
+		}
+		for i, entry := range redelegation.Entries {
+			if entry.Pending && entry.Amount.Equal(rebalancing.Amt) {
+				redelegation.Entries = append(redelegation.Entries[:i], redelegation.Entries[i+1:]...)
+				break
+			}
+		}
+		if len(redelegation.Entries) == 0 {
+			k.RemoveRedelegation(ctx, chainId, rebalancing.SrcValidator, rebalancing.DstValidator)
+		} else {
+			k.SetRedelegation(ctx, redelegation)
+		}
+	}
+}
+
+// AddRedelegationEntry records a redelegation between a pair of validators
+// Completed entries for the same validator pair are pruned at the same time, using the host's time from its light client
+// (if the light client can't be read, they're left in place and filtered out when the redelegations are next read)
+func (k Keeper) AddRedelegationEntry(ctx sdk.Context, hostZone types.HostZone, srcValidator string, dstValidator string, entry types.RedelegationEntry) {
+	redelegation, found := k.GetRedelegation(ctx, hostZone.ChainId, srcValidator, dstValidator)
+	if !found {
+		redelegation = types.Redelegation{
+			HostZoneId:   hostZone.ChainId,
+			SrcValidator: srcValidator,
+			DstValidator: dstValidator,
+		}
+	}
+	if hostTime, err := k.GetLightClientTimeSafely(ctx, hostZone.ConnectionId); err == nil {
+		redelegation.Entries = filterActiveRedelegationEntries(redelegation.Entries, hostTime)
+	}
+	redelegation.Entries = append(redelegation.Entries, &entry)
+	k.SetRedelegation(ctx, redelegation)
+}
+
+// filterActiveRedelegationEntries returns only the entries that are pending or have not yet completed as of the host's time
+func filterActiveRedelegationEntries(entries []*types.RedelegationEntry, hostTime uint64) []*types.RedelegationEntry {
+	activeEntries := []*types.RedelegationEntry{}
+	for _, entry := range entries {
+		if entry.Pending || entry.CompletionTime > hostTime {
+			activeEntries = append(activeEntries, entry)
+		}
+	}
+	return activeEntries
+}
@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"fmt"
-	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -20,8 +19,8 @@ func (k Keeper) CreateEpochUnbondingRecord(ctx sdk.Context, epochNumber uint64)
 	hostZoneUnbondings := []*recordstypes.HostZoneUnbonding{}
 	addEpochUndelegation := func(ctx sdk.Context, index int64, hostZone types.HostZone) error {
 		hostZoneUnbonding := recordstypes.HostZoneUnbonding{
-			NativeTokenAmount: uint64(0),
-			StTokenAmount:     uint64(0),
+			NativeTokenAmount: sdk.ZeroInt(),
+			StTokenAmount:     sdk.ZeroInt(),
 			Denom:             hostZone.HostDenom,
 			HostZoneId:        hostZone.ChainId,
 			Status:            recordstypes.HostZoneUnbonding_UNBONDING_QUEUE,
@@ -41,9 +40,10 @@ func (k Keeper) CreateEpochUnbondingRecord(ctx sdk.Context, epochNumber uint64)
 	return true
 }
 
-func (k Keeper) GetHostZoneUnbondingMsgs(ctx sdk.Context, hostZone types.HostZone) (msgs []sdk.Msg, totalAmtToUnbond uint64, marshalledCallbackArgs []byte, epochUnbondingRecordIds []uint64, err error) {
+func (k Keeper) GetHostZoneUnbondingMsgs(ctx sdk.Context, hostZone types.HostZone) (msgs []sdk.Msg, totalAmtToUnbond sdk.Int, marshalledCallbackArgs []byte, epochUnbondingRecordIds []uint64, err error) {
 	// this function goes and processes all unbonded records for this hostZone
 	// regardless of what epoch they belong to
+	totalAmtToUnbond = sdk.ZeroInt()
 	for _, epochUnbonding := range k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx) {
 		hostZoneRecord, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochUnbonding.EpochNumber, hostZone.ChainId)
 		if !found {
@@ -53,8 +53,8 @@ func (k Keeper) GetHostZoneUnbondingMsgs(ctx sdk.Context, hostZone types.HostZon
 			continue
 		}
 		// mark the epoch unbonding record for processing if it's bonded and the host zone unbonding has an amount g.t. zero
-		if hostZoneRecord.Status == recordstypes.HostZoneUnbonding_UNBONDING_QUEUE && hostZoneRecord.NativeTokenAmount > 0 {
-			totalAmtToUnbond += hostZoneRecord.NativeTokenAmount
+		if hostZoneRecord.Status == recordstypes.HostZoneUnbonding_UNBONDING_QUEUE && hostZoneRecord.NativeTokenAmount.IsPositive() {
+			totalAmtToUnbond = totalAmtToUnbond.Add(hostZoneRecord.NativeTokenAmount)
 			epochUnbondingRecordIds = append(epochUnbondingRecordIds, epochUnbonding.EpochNumber)
 			k.Logger(ctx).Info(fmt.Sprintf("[SendHostZoneUnbondings] Sending unbondings, host zone: %s, epochUnbonding: %v", hostZone.ChainId, epochUnbonding))
 
@@ -64,89 +64,58 @@ func (k Keeper) GetHostZoneUnbondingMsgs(ctx sdk.Context, hostZone types.HostZon
 	if delegationAccount == nil || delegationAccount.GetAddress() == "" {
 		errMsg := fmt.Sprintf("Zone %s is missing a delegation address!", hostZone.ChainId)
 		k.Logger(ctx).Error(errMsg)
-		return nil, 0, nil, nil, sdkerrors.Wrap(types.ErrHostZoneICAAccountNotFound, errMsg)
+		return nil, sdk.ZeroInt(), nil, nil, sdkerrors.Wrap(types.ErrHostZoneICAAccountNotFound, errMsg)
 	}
 	validators := hostZone.GetValidators()
-	if totalAmtToUnbond == 0 {
-		return nil, 0, nil, nil, nil
+	if totalAmtToUnbond.IsZero() {
+		return nil, sdk.ZeroInt(), nil, nil, nil
 	}
 	// we distribute the unbonding based on our target weights
 	newUnbondingToValidator, err := k.GetTargetValAmtsForHostZone(ctx, hostZone, totalAmtToUnbond)
 	if err != nil {
-		errMsg := fmt.Sprintf("Error getting target val amts for host zone %s %d: %s", hostZone.ChainId, totalAmtToUnbond, err)
+		errMsg := fmt.Sprintf("Error getting target val amts for host zone %s %v: %s", hostZone.ChainId, totalAmtToUnbond, err)
 		k.Logger(ctx).Error(errMsg)
-		return nil, 0, nil, nil, sdkerrors.Wrap(types.ErrNoValidatorAmts, errMsg)
+		return nil, sdk.ZeroInt(), nil, nil, sdkerrors.Wrap(types.ErrNoValidatorAmts, errMsg)
 	}
-	valAddrToUnbondAmt := make(map[string]int64)
-	overflowAmt := uint64(0)
+	valAddrToUnbondAmt := make(map[string]sdk.Int)
+	overflowAmt := sdk.ZeroInt()
 	for _, validator := range validators {
 		valAddr := validator.GetAddress()
 		valUnbondAmt := newUnbondingToValidator[valAddr]
-		currentAmtStaked := validator.GetDelegationAmt()
-		if err != nil {
-			errMsg := fmt.Sprintf("Error fetching validator staked amount %d: %s", currentAmtStaked, err.Error())
-			k.Logger(ctx).Error(errMsg)
-			return nil, 0, nil, nil, sdkerrors.Wrap(types.ErrNoValidatorAmts, errMsg)
-		}
-		if valUnbondAmt > currentAmtStaked { // if we don't have enough assets to unbond
-			overflowAmt += valUnbondAmt - currentAmtStaked
+		currentAmtStaked := validator.DelegationAmt
+		if valUnbondAmt.GT(currentAmtStaked) { // if we don't have enough assets to unbond
+			overflowAmt = overflowAmt.Add(valUnbondAmt.Sub(currentAmtStaked))
 			valUnbondAmt = currentAmtStaked
 		}
-		valUnbondAmtInt64, err := cast.ToInt64E(valUnbondAmt)
-		if err != nil {
-			errMsg := fmt.Sprintf("Error casting validator staked amount %d: %s", validator.GetDelegationAmt(), err.Error())
-			k.Logger(ctx).Error(errMsg)
-			return nil, 0, nil, nil, sdkerrors.Wrap(types.ErrIntCast, errMsg)
-		}
-		valAddrToUnbondAmt[valAddr] = valUnbondAmtInt64
+		valAddrToUnbondAmt[valAddr] = valUnbondAmt
 	}
-	if overflowAmt > 0 { // if we need to reallocate any weights
+	if overflowAmt.IsPositive() { // if we need to reallocate any weights
 		for _, validator := range validators {
 			valAddr := validator.GetAddress()
-			valUnbondAmt, err := cast.ToUint64E(valAddrToUnbondAmt[valAddr])
-			if err != nil {
-				errMsg := fmt.Sprintf("Error casting validator staked amount %d: %s", validator.GetDelegationAmt(), err.Error())
-				k.Logger(ctx).Error(errMsg)
-				return nil, 0, nil, nil, sdkerrors.Wrap(types.ErrIntCast, errMsg)
-			}
-			currentAmtStaked := validator.GetDelegationAmt()
 			// store how many more tokens we could unbond, if needed
-			curAmtStaked := currentAmtStaked
-			amtToPotentiallyUnbond := curAmtStaked - valUnbondAmt
-			if amtToPotentiallyUnbond > 0 { // if we can afford to unbond more
-				if amtToPotentiallyUnbond > overflowAmt { // we can fully cover the overflow
-					overflowAmtInt64, err := cast.ToInt64E(overflowAmt)
-					if err != nil {
-						errMsg := fmt.Sprintf("Error casting overflow amount %d: %s", overflowAmt, err.Error())
-						k.Logger(ctx).Error(errMsg)
-						return nil, 0, nil, nil, sdkerrors.Wrap(types.ErrIntCast, errMsg)
-					}
-					valAddrToUnbondAmt[valAddr] += overflowAmtInt64
-					overflowAmt = 0
+			amtToPotentiallyUnbond := validator.DelegationAmt.Sub(valAddrToUnbondAmt[valAddr])
+			if amtToPotentiallyUnbond.IsPositive() { // if we can afford to unbond more
+				if amtToPotentiallyUnbond.GT(overflowAmt) { // we can fully cover the overflow
+					valAddrToUnbondAmt[valAddr] = valAddrToUnbondAmt[valAddr].Add(overflowAmt)
+					overflowAmt = sdk.ZeroInt()
 					break
 				} else {
-					amtToPotentiallyUnbondInt64, err := cast.ToInt64E(amtToPotentiallyUnbond)
-					if err != nil {
-						errMsg := fmt.Sprintf("Error casting overflow amount %d: %s", amtToPotentiallyUnbond, err.Error())
-						k.Logger(ctx).Error(errMsg)
-						return nil, 0, nil, nil, sdkerrors.Wrap(types.ErrIntCast, errMsg)
-					}
-					valAddrToUnbondAmt[valAddr] += amtToPotentiallyUnbondInt64
-					overflowAmt -= amtToPotentiallyUnbond
+					valAddrToUnbondAmt[valAddr] = valAddrToUnbondAmt[valAddr].Add(amtToPotentiallyUnbond)
+					overflowAmt = overflowAmt.Sub(amtToPotentiallyUnbond)
 				}
 			}
 		}
 	}
-	if overflowAmt > 0 { // what?? we still can't cover the overflow? something is very wrong
-		errMsg := fmt.Sprintf("Could not unbond %d on Host Zone %s, unable to balance the unbond amount across validators",
+	if overflowAmt.IsPositive() { // what?? we still can't cover the overflow? something is very wrong
+		errMsg := fmt.Sprintf("Could not unbond %v on Host Zone %s, unable to balance the unbond amount across validators",
 			totalAmtToUnbond, hostZone.ChainId)
 		k.Logger(ctx).Error(errMsg)
-		return nil, 0, nil, nil, sdkerrors.Wrap(sdkerrors.ErrNotFound, errMsg)
+		return nil, sdk.ZeroInt(), nil, nil, sdkerrors.Wrap(sdkerrors.ErrNotFound, errMsg)
 	}
 	var splitDelegations []*types.SplitDelegation
 	for _, valAddr := range utils.StringToIntMapKeys(valAddrToUnbondAmt) {
 		valUnbondAmt := valAddrToUnbondAmt[valAddr]
-		stakeAmt := sdk.NewInt64Coin(hostZone.HostDenom, valUnbondAmt)
+		stakeAmt := sdk.NewCoin(hostZone.HostDenom, valUnbondAmt)
 
 		msgs = append(msgs, &stakingtypes.MsgUndelegate{
 			DelegatorAddress: delegationAccount.GetAddress(),
@@ -156,7 +125,7 @@ func (k Keeper) GetHostZoneUnbondingMsgs(ctx sdk.Context, hostZone types.HostZon
 
 		splitDelegations = append(splitDelegations, &types.SplitDelegation{
 			Validator: valAddr,
-			Amount:    stakeAmt.Amount.Uint64(),
+			Amount:    stakeAmt.Amount,
 		})
 	}
 
@@ -169,13 +138,13 @@ func (k Keeper) GetHostZoneUnbondingMsgs(ctx sdk.Context, hostZone types.HostZon
 	marshalledCallbackArgs, err = k.MarshalUndelegateCallbackArgs(ctx, undelegateCallback)
 	if err != nil {
 		k.Logger(ctx).Error(err.Error())
-		return nil, 0, nil, nil, sdkerrors.Wrap(sdkerrors.ErrNotFound, err.Error())
+		return nil, sdk.ZeroInt(), nil, nil, sdkerrors.Wrap(sdkerrors.ErrNotFound, err.Error())
 	}
 
 	return msgs, totalAmtToUnbond, marshalledCallbackArgs, epochUnbondingRecordIds, nil
 }
 
-func (k Keeper) SubmitHostZoneUnbondingMsg(ctx sdk.Context, msgs []sdk.Msg, totalAmtToUnbond uint64, marshalledCallbackArgs []byte, hostZone types.HostZone) error {
+func (k Keeper) SubmitHostZoneUnbondingMsg(ctx sdk.Context, msgs []sdk.Msg, totalAmtToUnbond sdk.Int, marshalledCallbackArgs []byte, hostZone types.HostZone, epochUnbondingRecordIds []uint64) error {
 	delegationAccount := hostZone.GetDelegationAccount()
 
 	// safety check: if msgs is nil, error
@@ -183,20 +152,23 @@ func (k Keeper) SubmitHostZoneUnbondingMsg(ctx sdk.Context, msgs []sdk.Msg, tota
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no msgs to submit for host zone unbondings")
 	}
 
-	_, err := k.SubmitTxsDayEpoch(ctx, hostZone.GetConnectionId(), msgs, *delegationAccount, UNDELEGATE, marshalledCallbackArgs)
+	// queue the undelegations (timed out by the unbonding epoch) and mark the host zone unbondings as UNBONDING_IN_PROGRESS
+	unbondingEpochIdentifier := k.GetEpochIdentifierParam(ctx, types.KeyUnbondingEpochIdentifier)
+	err := k.QueueTxsWithRecordUpdates(ctx, hostZone.GetConnectionId(), msgs, *delegationAccount, unbondingEpochIdentifier, UNDELEGATE, marshalledCallbackArgs,
+		func(ctx sdk.Context) error {
+			return k.RecordsKeeper.SetHostZoneUnbondings(ctx, hostZone, epochUnbondingRecordIds, recordstypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS)
+		})
 	if err != nil {
-		errMsg := fmt.Sprintf("Error submitting unbonding tx: %s", err)
+		errMsg := fmt.Sprintf("Error queueing unbonding tx: %s", err)
 		k.Logger(ctx).Error(errMsg)
 		return sdkerrors.Wrap(sdkerrors.ErrNotFound, errMsg)
 	}
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			sdk.EventTypeMessage,
-			sdk.NewAttribute("hostZone", hostZone.ChainId),
-			sdk.NewAttribute("newAmountUnbonding", strconv.FormatUint(totalAmtToUnbond, 10)),
-		),
-	)
+	k.EmitTypedEvent(ctx, &types.EventUndelegation{
+		HostZoneId:    hostZone.ChainId,
+		Amount:        totalAmtToUnbond,
+		NumValidators: uint64(len(msgs)),
+	})
 
 	return nil
 }
@@ -215,26 +187,22 @@ func (k Keeper) InitiateAllHostZoneUnbondings(ctx sdk.Context, dayNumber uint64)
 		// we only send the ICA call if this hostZone is supposed to be triggered
 		if dayNumber%hostZone.UnbondingFrequency == 0 {
 			k.Logger(ctx).Info(fmt.Sprintf("Sending unbondings for host zone %s", hostZone.ChainId))
-			msgs, totalAmtToUnbond, marshalledCallbackArgs, epochUnbondingRecordIds, err := k.GetHostZoneUnbondingMsgs(ctx, hostZone)
-			if err != nil {
-				errMsg := fmt.Sprintf("Error getting unbonding msgs for host zone %s: %s", hostZone.ChainId, err.Error())
-				k.Logger(ctx).Error(errMsg)
-				success = false
-				failedUnbondings = append(failedUnbondings, hostZone.ChainId)
-				continue
-			}
-			err = k.SubmitHostZoneUnbondingMsg(ctx, msgs, totalAmtToUnbond, marshalledCallbackArgs, hostZone)
-			if err != nil {
-				errMsg := fmt.Sprintf("Error submitting unbonding tx for host zone %s: %s", hostZone.ChainId, err.Error())
-				k.Logger(ctx).Error(errMsg)
-				success = false
-				failedUnbondings = append(failedUnbondings, hostZone.ChainId)
-				continue
-			}
-			err = k.RecordsKeeper.SetHostZoneUnbondings(ctx, hostZone, epochUnbondingRecordIds, recordstypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS)
+			// each host zone's unbonding is committed only if every step succeeds
+			err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+				msgs, totalAmtToUnbond, marshalledCallbackArgs, epochUnbondingRecordIds, err := k.GetHostZoneUnbondingMsgs(ctx, hostZone)
+				if err != nil {
+					return fmt.Errorf("Error getting unbonding msgs for host zone %s: %s", hostZone.ChainId, err.Error())
+				}
+				err = k.SubmitHostZoneUnbondingMsg(ctx, msgs, totalAmtToUnbond, marshalledCallbackArgs, hostZone, epochUnbondingRecordIds)
+				if err != nil {
+					return fmt.Errorf("Error submitting unbonding tx for host zone %s: %s", hostZone.ChainId, err.Error())
+				}
+				return nil
+			})
 			if err != nil {
 				k.Logger(ctx).Error(err.Error())
 				success = false
+				failedUnbondings = append(failedUnbondings, hostZone.ChainId)
 				continue
 			}
 			successfulUnbondings = append(successfulUnbondings, hostZone.ChainId)
@@ -254,7 +222,7 @@ func (k Keeper) CleanupEpochUnbondingRecords(ctx sdk.Context, epochNumber uint64
 			k.Logger(ctx).Info(fmt.Sprintf("processing hostZoneUnbonding %v", hostZoneUnbonding))
 			// if an EpochUnbondingRecord has any HostZoneUnbonding with non-zero balances, we don't delete the EpochUnbondingRecord
 			// because it has outstanding tokens that need to be claimed
-			if hostZoneUnbonding.GetNativeTokenAmount() != 0 {
+			if !hostZoneUnbonding.NativeTokenAmount.IsZero() {
 				shouldDeleteEpochUnbondingRecord = false
 				break
 			}
@@ -267,10 +235,18 @@ func (k Keeper) CleanupEpochUnbondingRecords(ctx sdk.Context, epochNumber uint64
 	return true
 }
 
-func (k Keeper) SweepAllUnbondedTokensForHostZone(ctx sdk.Context, hostZone types.HostZone, epochUnbondingRecords []recordstypes.EpochUnbondingRecord) (success bool, sweepAmount int64) {
+func (k Keeper) SweepAllUnbondedTokensForHostZone(ctx sdk.Context, hostZone types.HostZone, epochUnbondingRecords []recordstypes.EpochUnbondingRecord) (success bool, sweepAmount sdk.Int) {
 	k.Logger(ctx).Info(fmt.Sprintf("sweepUnbondedTokens for host zone %s", hostZone.ChainId))
 
-	totalAmtTransferToRedemptionAcct := int64(0)
+	// get latest blockTime from light client
+	// if the light client is stale, unbondings would look incomplete, so the sweep is skipped until it's updated
+	blockTime, err := k.GetFreshLightClientTime(ctx, hostZone, types.PipelineSweep)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("\tCould not get a fresh blockTime for host zone %s: %s", hostZone.ChainId, err.Error()))
+		return true, sdk.ZeroInt()
+	}
+
+	totalAmtTransferToRedemptionAcct := sdk.ZeroInt()
 	epochUnbondingRecordIds := []uint64{}
 	for _, epochUnbondingRecord := range epochUnbondingRecords {
 		k.Logger(ctx).Info(fmt.Sprintf("processing epochUnbondingRecord %v", epochUnbondingRecord.EpochNumber))
@@ -284,14 +260,6 @@ func (k Keeper) SweepAllUnbondedTokensForHostZone(ctx sdk.Context, hostZone type
 		}
 		k.Logger(ctx).Info(fmt.Sprintf("\tProcessing batch SweepAllUnbondedTokens for host zone %s", hostZone.ChainId))
 
-		// get latest blockTime from light client
-		blockTime, err := k.GetLightClientTimeSafely(ctx, hostZone.ConnectionId)
-		if err != nil {
-			errMsg := fmt.Sprintf("\tCould not find blockTime for host zone %s", hostZone.ChainId)
-			k.Logger(ctx).Error(errMsg)
-			continue
-		}
-
 		shouldProcess := hostZoneUnbonding.Status == recordstypes.HostZoneUnbonding_EXIT_TRANSFER_QUEUE
 		k.Logger(ctx).Info(fmt.Sprintf("\tUnbonding time:  %d blockTime %d, shouldProcess %v", hostZoneUnbonding.UnbondingTime, blockTime, shouldProcess))
 
@@ -302,39 +270,33 @@ func (k Keeper) SweepAllUnbondedTokensForHostZone(ctx sdk.Context, hostZone type
 		// 3. the host zone is in the EXIT_TRANSFER_QUEUE state, meaning it's ready to be transferred
 		if (hostZoneUnbonding.UnbondingTime > 0 && hostZoneUnbonding.UnbondingTime < blockTime) && shouldProcess {
 			// we have a match, so we can process this unbonding
-			logMsg := fmt.Sprintf("\t\tAdding %d to amt to batch transfer from delegation acct to rewards acct for host zone %s, epoch %v",
+			logMsg := fmt.Sprintf("\t\tAdding %v to amt to batch transfer from delegation acct to rewards acct for host zone %s, epoch %v",
 				hostZoneUnbonding.NativeTokenAmount, hostZone.ChainId, epochUnbondingRecord.EpochNumber)
 			k.Logger(ctx).Info(logMsg)
 
-			nativeTokenAmount, err := cast.ToInt64E(hostZoneUnbonding.NativeTokenAmount)
-			if err != nil {
-				errMsg := fmt.Sprintf("Could not convert native token amount to int64 | %s", err.Error())
-				k.Logger(ctx).Error(errMsg)
-				continue
-			}
-			totalAmtTransferToRedemptionAcct += nativeTokenAmount
+			totalAmtTransferToRedemptionAcct = totalAmtTransferToRedemptionAcct.Add(hostZoneUnbonding.NativeTokenAmount)
 			epochUnbondingRecordIds = append(epochUnbondingRecordIds, epochUnbondingRecord.EpochNumber)
 		}
 	}
 	// if we have any amount to sweep, then we can send the ICA call to sweep them
-	if totalAmtTransferToRedemptionAcct > 0 {
-		k.Logger(ctx).Info(fmt.Sprintf("\tSending batch SweepAllUnbondedTokens for %d amt to host zone %s", totalAmtTransferToRedemptionAcct, hostZone.ChainId))
+	if totalAmtTransferToRedemptionAcct.IsPositive() {
+		k.Logger(ctx).Info(fmt.Sprintf("\tSending batch SweepAllUnbondedTokens for %v amt to host zone %s", totalAmtTransferToRedemptionAcct, hostZone.ChainId))
 		// Issue ICA bank send from delegation account to redemption account
 		if (&hostZone).DelegationAccount != nil && (&hostZone).RedemptionAccount != nil { // only process host zones once withdrawal accounts are registered
 			// get the delegation account and rewards account
 			delegationAccount := hostZone.GetDelegationAccount()
 			if delegationAccount == nil || delegationAccount.Address == "" {
 				k.Logger(ctx).Error(fmt.Sprintf("Zone %s is missing a delegation address!", hostZone.ChainId))
-				return false, 0
+				return false, sdk.ZeroInt()
 			}
 			redemptionAccount := hostZone.GetRedemptionAccount()
 			if redemptionAccount == nil || redemptionAccount.Address == "" {
 				k.Logger(ctx).Error(fmt.Sprintf("Zone %s is missing a redemption address!", hostZone.ChainId))
-				return false, 0
+				return false, sdk.ZeroInt()
 			}
 
 			// build transfer message from delegation account to redemption account
-			sweepCoin := sdk.NewCoin(hostZone.HostDenom, sdk.NewInt(totalAmtTransferToRedemptionAcct))
+			sweepCoin := sdk.NewCoin(hostZone.HostDenom, totalAmtTransferToRedemptionAcct)
 			var msgs []sdk.Msg
 			msgs = append(msgs, &banktypes.MsgSend{
 				FromAddress: delegationAccount.GetAddress(),
@@ -352,23 +314,29 @@ func (k Keeper) SweepAllUnbondedTokensForHostZone(ctx sdk.Context, hostZone type
 			marshalledCallbackArgs, err := k.MarshalRedemptionCallbackArgs(ctx, redemptionCallback)
 			if err != nil {
 				k.Logger(ctx).Error(err.Error())
-				return false, 0
+				return false, sdk.ZeroInt()
 			}
 
-			// Send the transaction through SubmitTx
-			_, err = k.SubmitTxsDayEpoch(ctx, hostZone.ConnectionId, msgs, *delegationAccount, REDEMPTION, marshalledCallbackArgs)
+			// Queue the transaction (timed out by the sweep epoch), to be sent at the end of the block,
+			// and mark the host zone unbondings as EXIT_TRANSFER_IN_PROGRESS
+			sweepEpochIdentifier := k.GetEpochIdentifierParam(ctx, types.KeySweepEpochIdentifier)
+			err = k.QueueTxsWithRecordUpdates(ctx, hostZone.ConnectionId, msgs, *delegationAccount, sweepEpochIdentifier, REDEMPTION, marshalledCallbackArgs,
+				func(ctx sdk.Context) error {
+					return k.RecordsKeeper.SetHostZoneUnbondings(ctx, hostZone, epochUnbondingRecordIds, recordstypes.HostZoneUnbonding_EXIT_TRANSFER_IN_PROGRESS)
+				})
 			if err != nil {
-				k.Logger(ctx).Info(fmt.Sprintf("Failed to SubmitTxs, transfer to redemption account on %s", hostZone.ChainId))
-			}
-			err = k.RecordsKeeper.SetHostZoneUnbondings(ctx, hostZone, epochUnbondingRecordIds, recordstypes.HostZoneUnbonding_EXIT_TRANSFER_IN_PROGRESS)
-			if err != nil {
-				k.Logger(ctx).Error(err.Error())
-				return false, 0
+				k.Logger(ctx).Error(fmt.Sprintf("Failed to queue txs, transfer to redemption account on %s: %s", hostZone.ChainId, err.Error()))
+				return false, sdk.ZeroInt()
 			}
 			k.Logger(ctx).Info(fmt.Sprintf("Successfully completed unbonded token sweep ICA call for %s, %s, %v", hostZone.ConnectionId, hostZone.ChainId, msgs))
+			k.EmitTypedEvent(ctx, &types.EventSweep{
+				HostZoneId:              hostZone.ChainId,
+				Amount:                  sweepCoin.Amount,
+				EpochUnbondingRecordIds: epochUnbondingRecordIds,
+			})
 		} else {
 			k.Logger(ctx).Info(fmt.Sprintf("\tNot sweeping tokens for host zone %s because redemption/delegation accounts aren't registered", hostZone.ChainId))
-			return false, 0
+			return false, sdk.ZeroInt()
 		}
 	} else {
 		k.Logger(ctx).Info(fmt.Sprintf("\tNo unbonded tokens this day to sweep for host zone %s", hostZone.ChainId))
@@ -376,22 +344,31 @@ func (k Keeper) SweepAllUnbondedTokensForHostZone(ctx sdk.Context, hostZone type
 	return true, totalAmtTransferToRedemptionAcct
 }
 
-func (k Keeper) SweepAllUnbondedTokens(ctx sdk.Context) (success bool, successfulSweeps []string, sweepAmounts []int64, failedSweeps []string) {
+func (k Keeper) SweepAllUnbondedTokens(ctx sdk.Context) (success bool, successfulSweeps []string, sweepAmounts []sdk.Int, failedSweeps []string) {
 	// this function returns true if all chains succeeded, false otherwise
 	// it also returns a list of successful chains (arg 2), tokens swept (arg 3), and failed chains (arg 4)
 	success = true
 	successfulSweeps = []string{}
-	sweepAmounts = []int64{}
+	sweepAmounts = []sdk.Int{}
 	failedSweeps = []string{}
 	hostZones := k.GetAllHostZone(ctx)
 
 	epochUnbondingRecords := k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx)
 	for _, hostZone := range hostZones {
-		hostZoneSuccess, sweepAmount := k.SweepAllUnbondedTokensForHostZone(ctx, hostZone, epochUnbondingRecords)
-		if hostZoneSuccess {
+		sweepAmount := sdk.ZeroInt()
+		err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			var hostZoneSuccess bool
+			hostZoneSuccess, sweepAmount = k.SweepAllUnbondedTokensForHostZone(ctx, hostZone, epochUnbondingRecords)
+			if !hostZoneSuccess {
+				return fmt.Errorf("failed to sweep unbonded tokens for host zone %s", hostZone.ChainId)
+			}
+			return nil
+		})
+		if err == nil {
 			successfulSweeps = append(successfulSweeps, hostZone.ChainId)
 			sweepAmounts = append(sweepAmounts, sweepAmount)
 		} else {
+			k.Logger(ctx).Error(err.Error())
 			success = false
 			failedSweeps = append(failedSweeps, hostZone.ChainId)
 		}
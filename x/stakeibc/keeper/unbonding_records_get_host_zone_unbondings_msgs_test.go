@@ -44,18 +44,18 @@ func (s *KeeperTestSuite) SetupGetHostZoneUnbondingMsgs() GetHostZoneUnbondingMs
 	validators := []*stakeibc.Validator{
 		{
 			Address:       hostVal1Addr,
-			DelegationAmt: amtVal1,
+			DelegationAmt: sdk.NewIntFromUint64(amtVal1),
 			Weight:        wgtVal1,
 		},
 		{
 			Address:       hostVal2Addr,
-			DelegationAmt: amtVal2,
+			DelegationAmt: sdk.NewIntFromUint64(amtVal2),
 			Weight:        wgtVal2,
 		},
 		{
 			Address: hostVal3Addr,
 			// DelegationAmt and Weight are the same as Val2, to test tie breaking
-			DelegationAmt: amtVal2,
+			DelegationAmt: sdk.NewIntFromUint64(amtVal2),
 			Weight:        wgtVal2,
 		},
 	}
@@ -88,7 +88,7 @@ func (s *KeeperTestSuite) SetupGetHostZoneUnbondingMsgs() GetHostZoneUnbondingMs
 	// for each epoch unbonding record, add a host zone unbonding record and append the record
 	for _, epochUnbondingRecord := range epochUnbondingRecords {
 		hostZoneUnbonding := &recordtypes.HostZoneUnbonding{
-			NativeTokenAmount: amtToUnbond,
+			NativeTokenAmount: sdk.NewIntFromUint64(amtToUnbond),
 			Denom:             "uatom",
 			HostZoneId:        "GAIA",
 			UnbondingTime:     unbondingTime, // 2022-08-12T19:52
@@ -128,10 +128,10 @@ func (s *KeeperTestSuite) TestGetHostZoneUnbondingMsgs_Successful() {
 	// the number of unbonding messages should be (number of validators) * (records to unbond)
 	s.Require().Equal(len(tc.valNames), len(actualUnbondMsgs), "number of unbonding messages should be number of records to unbond")
 
-	s.Require().Equal(int64(tc.amtToUnbond)*int64(len(tc.epochUnbondingRecords)), int64(actualAmtToUnbond), "total amount to unbond should match input amtToUnbond")
+	s.Require().Equal(sdk.NewIntFromUint64(tc.amtToUnbond).MulRaw(int64(len(tc.epochUnbondingRecords))), actualAmtToUnbond, "total amount to unbond should match input amtToUnbond")
 
 	totalWgt := sdk.NewDec(int64(tc.totalWgt))
-	actualAmtToUnbondDec := sdk.NewDec(int64(actualAmtToUnbond))
+	actualAmtToUnbondDec := actualAmtToUnbond.ToDec()
 	actualUnbondMsg1 := actualUnbondMsgs[0].String()
 	actualUnbondMsg2 := actualUnbondMsgs[1].String()
 	val1Fraction := sdk.NewDec(int64(tc.hostZone.Validators[0].Weight)).Quo(totalWgt)
@@ -158,7 +158,7 @@ func (s *KeeperTestSuite) TestGetHostZoneUnbondingMsgs_WrongChainId() {
 	// no messages should be sent
 	s.Require().Equal(0, len(msgs), "no messages should be sent")
 	// no value should be unbonded
-	s.Require().Equal(int64(0), int64(totalAmtToUnbond), "no value should be unbonded")
+	s.Require().Equal(sdk.ZeroInt(), totalAmtToUnbond, "no value should be unbonded")
 }
 
 func (s *KeeperTestSuite) TestGetHostZoneUnbondingMsgs_NoEpochUnbondingRecords() {
@@ -178,7 +178,7 @@ func (s *KeeperTestSuite) TestGetHostZoneUnbondingMsgs_NoEpochUnbondingRecords()
 	// no messages should be sent
 	s.Require().Equal(0, len(msgs), "no messages should be sent")
 	// no value should be unbonded
-	s.Require().Equal(int64(0), int64(totalAmtToUnbond), "no value should be unbonded")
+	s.Require().Equal(sdk.ZeroInt(), totalAmtToUnbond, "no value should be unbonded")
 }
 
 func (s *KeeperTestSuite) TestGetHostZoneUnbondingMsgs_UnbondingTooMuch() {
@@ -186,7 +186,7 @@ func (s *KeeperTestSuite) TestGetHostZoneUnbondingMsgs_UnbondingTooMuch() {
 
 	// iterate the validators and set all their delegated amounts to 0
 	for i := range tc.hostZone.Validators {
-		tc.hostZone.Validators[i].DelegationAmt = 0
+		tc.hostZone.Validators[i].DelegationAmt = sdk.NewInt(0)
 	}
 	// write the host zone with zero-delegation validators back to the store
 	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), tc.hostZone)
@@ -201,22 +201,23 @@ func (s *KeeperTestSuite) TestGetTargetValAmtsForHostZone_Success() {
 
 	// verify the total amount is expected
 	unbond := uint64(1_000_000)
-	totalAmt, err := s.App.StakeibcKeeper.GetTargetValAmtsForHostZone(s.Ctx(), tc.hostZone, unbond)
+	totalAmt, err := s.App.StakeibcKeeper.GetTargetValAmtsForHostZone(s.Ctx(), tc.hostZone, sdk.NewIntFromUint64(unbond))
 	s.Require().Nil(err)
 
 	// sum up totalAmt
-	actualAmount := uint64(0)
+	actualAmount := sdk.ZeroInt()
 	for _, amt := range totalAmt {
-		actualAmount += amt
+		actualAmount = actualAmount.Add(amt)
+	}
+	s.Require().Equal(sdk.NewIntFromUint64(unbond), actualAmount, "total amount unbonded matches input")
+
+	// verify each validator's share is exactly proportional to its weight (1:2:2)
+	s.Require().Equal(sdk.NewInt(200_000), totalAmt[tc.valNames[0]], "validator 1 amount")
+	s.Require().Equal(sdk.NewInt(400_000), totalAmt[tc.valNames[1]], "validator 2 amount")
+	s.Require().Equal(sdk.NewInt(400_000), totalAmt[tc.valNames[2]], "validator 3 amount")
+
+	// verify the host zone's validators were not reordered
+	for i, validator := range tc.hostZone.Validators {
+		s.Require().Equal(tc.valNames[i], validator.Address, "validator %d address", i)
 	}
-	s.Require().Equal(unbond, actualAmount, "total amount unbonded matches input")
-
-	// verify the order of the validators is expected
-	// GetTargetValAmtsForHostZone first reverses the list, then sorts by weight using SliceStable
-	// E.g. given A:1, B:2, C:2
-	// 1. C:2, B:2, A:1
-	// 2. A:1, C:2, B:2
-	s.Require().Equal(tc.valNames[0], tc.hostZone.Validators[0].Address)
-	s.Require().Equal(tc.valNames[1], tc.hostZone.Validators[2].Address)
-	s.Require().Equal(tc.valNames[2], tc.hostZone.Validators[1].Address)
 }
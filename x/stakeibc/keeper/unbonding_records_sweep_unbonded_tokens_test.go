@@ -1,9 +1,12 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/gogo/protobuf/proto"
 	_ "github.com/stretchr/testify/suite"
 
+	epochtypes "github.com/Stride-Labs/stride/x/epochs/types"
 	recordtypes "github.com/Stride-Labs/stride/x/records/types"
 
 	stakeibc "github.com/Stride-Labs/stride/x/stakeibc/types"
@@ -21,7 +24,7 @@ func (s *KeeperTestSuite) SetupSweepUnbondedTokens() SweepUnbondedTokensTestCase
 	gaiaValidators := []*stakeibc.Validator{
 		{
 			Address:       "cosmos_VALIDATOR",
-			DelegationAmt: uint64(5_000_000),
+			DelegationAmt: sdk.NewInt(5_000_000),
 			Weight:        uint64(10),
 		},
 	}
@@ -36,7 +39,7 @@ func (s *KeeperTestSuite) SetupSweepUnbondedTokens() SweepUnbondedTokensTestCase
 	osmoValidators := []*stakeibc.Validator{
 		{
 			Address:       "osmo_VALIDATOR",
-			DelegationAmt: uint64(5_000_000),
+			DelegationAmt: sdk.NewInt(5_000_000),
 			Weight:        uint64(10),
 		},
 	}
@@ -57,7 +60,7 @@ func (s *KeeperTestSuite) SetupSweepUnbondedTokens() SweepUnbondedTokensTestCase
 			Validators:         gaiaValidators,
 			DelegationAccount:  &gaiaDelegationAccount,
 			RedemptionAccount:  &gaiaRedemptionAccount,
-			StakedBal:          uint64(5_000_000),
+			StakedBal:          sdk.NewInt(5_000_000),
 			ConnectionId:       ibctesting.FirstConnectionID,
 		},
 		{
@@ -68,7 +71,7 @@ func (s *KeeperTestSuite) SetupSweepUnbondedTokens() SweepUnbondedTokensTestCase
 			Validators:         osmoValidators,
 			DelegationAccount:  &osmoDelegationAccount,
 			RedemptionAccount:  &osmoRedemptionAccount,
-			StakedBal:          uint64(5_000_000),
+			StakedBal:          sdk.NewInt(5_000_000),
 			ConnectionId:       ibctesting.FirstConnectionID,
 		},
 	}
@@ -82,13 +85,13 @@ func (s *KeeperTestSuite) SetupSweepUnbondedTokens() SweepUnbondedTokensTestCase
 			HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
 				{
 					HostZoneId:        HostChainId,
-					NativeTokenAmount: 1_000_000,
+					NativeTokenAmount: sdk.NewInt(1_000_000),
 					Status:            recordtypes.HostZoneUnbonding_UNBONDING_QUEUE,
 					UnbondingTime:     unbondingTime,
 				},
 				{
 					HostZoneId:        OsmoChainId,
-					NativeTokenAmount: 1_000_000,
+					NativeTokenAmount: sdk.NewInt(1_000_000),
 					Status:            recordtypes.HostZoneUnbonding_EXIT_TRANSFER_QUEUE,
 					UnbondingTime:     unbondingTime,
 				},
@@ -99,13 +102,13 @@ func (s *KeeperTestSuite) SetupSweepUnbondedTokens() SweepUnbondedTokensTestCase
 			HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
 				{
 					HostZoneId:        HostChainId,
-					NativeTokenAmount: 2_000_000,
+					NativeTokenAmount: sdk.NewInt(2_000_000),
 					Status:            recordtypes.HostZoneUnbonding_EXIT_TRANSFER_QUEUE,
 					UnbondingTime:     unbondingTime,
 				},
 				{
 					HostZoneId:        OsmoChainId,
-					NativeTokenAmount: 2_000_000,
+					NativeTokenAmount: sdk.NewInt(2_000_000),
 					Status:            recordtypes.HostZoneUnbonding_EXIT_TRANSFER_QUEUE,
 					UnbondingTime:     unbondingTime,
 				},
@@ -116,12 +119,12 @@ func (s *KeeperTestSuite) SetupSweepUnbondedTokens() SweepUnbondedTokensTestCase
 			HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
 				{
 					HostZoneId:        HostChainId,
-					NativeTokenAmount: 5_000_000,
+					NativeTokenAmount: sdk.NewInt(5_000_000),
 					Status:            recordtypes.HostZoneUnbonding_CLAIMABLE,
 				},
 				{
 					HostZoneId:        OsmoChainId,
-					NativeTokenAmount: 5_000_000,
+					NativeTokenAmount: sdk.NewInt(5_000_000),
 					Status:            recordtypes.HostZoneUnbonding_UNBONDING_QUEUE,
 				},
 			},
@@ -135,6 +138,13 @@ func (s *KeeperTestSuite) SetupSweepUnbondedTokens() SweepUnbondedTokensTestCase
 		s.App.StakeibcKeeper.SetHostZone(s.Ctx(), hostZone)
 	}
 
+	s.SetEpochTracker(stakeibc.EpochTracker{
+		EpochIdentifier:    epochtypes.DAY_EPOCH,
+		EpochNumber:        1,
+		NextEpochStartTime: uint64(s.Coordinator.CurrentTime.UnixNano() + 60_000_000_000), // dictates timeouts
+		Duration:           uint64(1_000_000_000_000),
+	})
+
 	return SweepUnbondedTokensTestCase{
 		epochUnbondingRecords: epochUnbondingRecords,
 		hostZones:             hostZones,
@@ -150,7 +160,7 @@ func (s *KeeperTestSuite) TestSweepUnbondedTokens_Successful() {
 	s.Require().Len(successfulSweeps, 2, "sweep all tokens succeeds for 2 host zones")
 	s.Require().Len(sweepAmounts, 2, "sweep all tokens succeeds for 2 host zones")
 	s.Require().Len(failedSweeps, 0, "sweep all tokens fails for no host zone")
-	s.Require().Equal([]int64{2_000_000, 3_000_000}, sweepAmounts, "correct amount of tokens swept for each host zone")
+	s.Require().Equal([]sdk.Int{sdk.NewInt(2_000_000), sdk.NewInt(3_000_000)}, sweepAmounts, "correct amount of tokens swept for each host zone")
 }
 
 func (s *KeeperTestSuite) TestSweepUnbondedTokens_HostZoneUnbondingMissing() {
@@ -168,7 +178,7 @@ func (s *KeeperTestSuite) TestSweepUnbondedTokens_HostZoneUnbondingMissing() {
 	s.Require().Len(successfulSweeps, 2, "sweep all tokens succeeds for 2 host zones")
 	s.Require().Len(sweepAmounts, 2, "sweep all tokens succeeds for 2 host zone")
 	s.Require().Len(failedSweeps, 0, "sweep all tokens fails for 0 host zone")
-	s.Require().Equal([]int64{2_000_000, 0}, sweepAmounts, "correct amount of tokens swept for each host zone")
+	s.Require().Equal([]sdk.Int{sdk.NewInt(2_000_000), sdk.ZeroInt()}, sweepAmounts, "correct amount of tokens swept for each host zone")
 }
 
 func (s *KeeperTestSuite) TestSweepUnbondedTokens_RedemptionAccountMissing() {
@@ -183,7 +193,7 @@ func (s *KeeperTestSuite) TestSweepUnbondedTokens_RedemptionAccountMissing() {
 	s.Require().Len(sweepAmounts, 1, "sweep all tokens succeeds for 1 host zone")
 	s.Require().Len(failedSweeps, 1, "sweep all tokens fails for 1 host zone")
 	s.Require().Equal("GAIA", failedSweeps[0], "sweep all tokens fails for gaia")
-	s.Require().Equal([]int64{3_000_000}, sweepAmounts, "correct amount of tokens swept for each host zone")
+	s.Require().Equal([]sdk.Int{sdk.NewInt(3_000_000)}, sweepAmounts, "correct amount of tokens swept for each host zone")
 }
 
 func (s *KeeperTestSuite) TestSweepUnbondedTokens_DelegationAccountAddressMissing() {
@@ -198,5 +208,29 @@ func (s *KeeperTestSuite) TestSweepUnbondedTokens_DelegationAccountAddressMissin
 	s.Require().Len(sweepAmounts, 1, "sweep all tokens succeeds for 1 host zone")
 	s.Require().Len(failedSweeps, 1, "sweep all tokens fails for 1 host zone")
 	s.Require().Equal("OSMO", failedSweeps[0], "sweep all tokens fails for osmo")
-	s.Require().Equal([]int64{2_000_000}, sweepAmounts, "correct amount of tokens swept for each host zone")
+	s.Require().Equal([]sdk.Int{sdk.NewInt(2_000_000)}, sweepAmounts, "correct amount of tokens swept for each host zone")
+}
+
+func (s *KeeperTestSuite) TestSweepUnbondedTokens_LightClientStale() {
+	s.SetupSweepUnbondedTokens()
+
+	// The light client lags stride by a block, so a 1ns max age makes it stale
+	hostZone, _ := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), "GAIA")
+	hostZone.MaxLightClientAgeNanos = 1
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), hostZone)
+
+	ctx := s.Ctx()
+	success, successfulSweeps, sweepAmounts, failedSweeps := s.App.StakeibcKeeper.SweepAllUnbondedTokens(ctx)
+	s.Require().True(success, "a stale light client skips the sweep without failing")
+	s.Require().Len(successfulSweeps, 2, "sweep all tokens succeeds for 2 host zones")
+	s.Require().Len(failedSweeps, 0, "sweep all tokens fails for no host zone")
+	s.Require().Equal([]sdk.Int{sdk.ZeroInt(), sdk.NewInt(3_000_000)}, sweepAmounts, "nothing swept for the stale host zone")
+
+	eventFound := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == proto.MessageName(&stakeibc.EventLightClientStale{}) {
+			eventFound = true
+		}
+	}
+	s.Require().True(eventFound, "stale light client event should be emitted")
 }
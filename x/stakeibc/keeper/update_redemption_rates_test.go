@@ -29,7 +29,7 @@ func (s *KeeperTestSuite) SetupUpdateRedemptionRates(
 	//    to comprise the undelegated delegation account balance i.e. "to be staked"
 	toBeStakedDepositRecord := recordtypes.DepositRecord{
 		HostZoneId: "GAIA",
-		Amount:     int64(undelegatedBal),
+		Amount:     sdk.NewInt(int64(undelegatedBal)),
 		Status:     recordtypes.DepositRecord_DELEGATION_QUEUE,
 	}
 	s.App.RecordsKeeper.AppendDepositRecord(s.Ctx(), toBeStakedDepositRecord)
@@ -38,7 +38,7 @@ func (s *KeeperTestSuite) SetupUpdateRedemptionRates(
 	//    to comprise the stakeibc module account balance i.e. "to be transferred"
 	toBeTransferedDepositRecord := recordtypes.DepositRecord{
 		HostZoneId: "GAIA",
-		Amount:     int64(justDepositedBal),
+		Amount:     sdk.NewInt(int64(justDepositedBal)),
 		Status:     recordtypes.DepositRecord_TRANSFER_QUEUE,
 	}
 	s.App.RecordsKeeper.AppendDepositRecord(s.Ctx(), toBeTransferedDepositRecord)
@@ -54,7 +54,7 @@ func (s *KeeperTestSuite) SetupUpdateRedemptionRates(
 	hostZone := stakeibctypes.HostZone{
 		ChainId:        "GAIA",
 		HostDenom:      "uatom",
-		StakedBal:      stakedBal,
+		StakedBal:      sdk.NewIntFromUint64(stakedBal),
 		RedemptionRate: initialRedemptionRate,
 	}
 	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), hostZone)
@@ -78,7 +78,8 @@ func (s *KeeperTestSuite) TestUpdateRedemptionRatesSuccessful() {
 	s.Require().Equal(initialRedemptionRate, sdk.NewDec(1), "t0 rr")
 
 	records := tc.allRecords
-	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx(), records)
+	ctx := s.Ctx()
+	s.App.StakeibcKeeper.UpdateRedemptionRates(ctx, records)
 
 	hz, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), tc.hostZone.ChainId)
 	s.Require().True(found, "hz found")
@@ -86,6 +87,14 @@ func (s *KeeperTestSuite) TestUpdateRedemptionRatesSuccessful() {
 
 	expectedNewRate := sdk.NewDec(5 + 3 + 3).Quo(sdk.NewDec(10))
 	s.Require().Equal(rrNew, expectedNewRate, "rr as expected")
+
+	// Confirm the rate update event was emitted
+	s.CheckTypedEventEmitted(ctx, &stakeibctypes.EventRedemptionRateUpdate{
+		HostZoneId:   tc.hostZone.ChainId,
+		PreviousRate: initialRedemptionRate,
+		NewRate:      expectedNewRate,
+		StSupply:     sdk.NewIntFromUint64(stSupply),
+	})
 }
 
 func (s *KeeperTestSuite) TestUpdateRedemptionRatesRandomized() {
@@ -280,3 +289,29 @@ func (s *KeeperTestSuite) TestUpdateRedemptionRateRandominitialRedemptionRate()
 	expectedNewRate := sdk.NewDec(3 + 3 + 5).Quo(sdk.NewDec(10))
 	s.Require().Equal(rrNew, expectedNewRate, "rr as expected")
 }
+
+func (s *KeeperTestSuite) TestUpdateRedemptionRates_FailingHostZoneIsolated() {
+	initialRedemptionRate := sdk.NewDec(1)
+	tc := s.SetupUpdateRedemptionRates(5, 3, 3, 10, initialRedemptionRate)
+
+	// an unset staked balance causes the redemption rate update to fail for this zone
+	brokenHostZone := stakeibctypes.HostZone{
+		ChainId:        "OSMO",
+		HostDenom:      "uosmo",
+		RedemptionRate: initialRedemptionRate,
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), brokenHostZone)
+	s.FundAccount(s.TestAccs[0], sdk.NewInt64Coin("stuosmo", 10))
+
+	// the broken zone is processed first, and should not prevent the next zone from being updated
+	hostZones := []stakeibctypes.HostZone{brokenHostZone, tc.hostZone}
+	s.App.StakeibcKeeper.UpdateRedemptionRatesForHostZones(s.Ctx(), hostZones, tc.allRecords)
+
+	brokenHostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), "OSMO")
+	s.Require().True(found, "broken host zone found")
+	s.Require().Equal(initialRedemptionRate, brokenHostZone.RedemptionRate, "broken host zone rate unchanged")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), tc.hostZone.ChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(sdk.NewDec(5+3+3).Quo(sdk.NewDec(10)), hostZone.RedemptionRate, "host zone rate updated")
+}
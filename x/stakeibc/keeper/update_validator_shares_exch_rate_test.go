@@ -43,7 +43,7 @@ func (s *KeeperTestSuite) SetupQueryValidatorExchangeRate() QueryValidatorExchan
 		Duration:           10_000_000_000,                                               // 10 second epochs
 		NextEpochStartTime: uint64(s.Coordinator.CurrentTime.UnixNano() + 1_000_000_000), // epoch ends in 1 second
 	}
-	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx(), strideEpochTracker)
+	s.SetEpochTracker(strideEpochTracker)
 
 	// This will make the current time 50% through the day
 	dayEpochTracker := types.EpochTracker{
@@ -52,7 +52,7 @@ func (s *KeeperTestSuite) SetupQueryValidatorExchangeRate() QueryValidatorExchan
 		Duration:           40_000_000_000,                                                // 40 second epochs
 		NextEpochStartTime: uint64(s.Coordinator.CurrentTime.UnixNano() + 20_000_000_000), // day ends in 20 second
 	}
-	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx(), dayEpochTracker)
+	s.SetEpochTracker(dayEpochTracker)
 
 	return QueryValidatorExchangeRateTestCase{
 		msg: types.MsgUpdateValidatorSharesExchRate{
@@ -85,7 +85,7 @@ func (s *KeeperTestSuite) TestQueryValidatorExchangeRate_BeforeBufferWindow() {
 	// set the time to be 50% through the stride_epoch
 	strideEpochTracker := tc.strideEpochTracker
 	strideEpochTracker.NextEpochStartTime = uint64(s.Coordinator.CurrentTime.UnixNano() + int64(strideEpochTracker.Duration)/2) // 50% through the epoch
-	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx(), strideEpochTracker)
+	s.SetEpochTracker(strideEpochTracker)
 
 	resp, err := s.App.StakeibcKeeper.QueryValidatorExchangeRate(s.Ctx(), &tc.msg)
 	s.Require().ErrorContains(err, "outside the buffer time during which ICQs are allowed")
@@ -166,7 +166,7 @@ func (s *KeeperTestSuite) SetupQueryDelegationsIcq() QueryDelegationsIcqTestCase
 		Duration:           10_000_000_000,                                               // 10 second epochs
 		NextEpochStartTime: uint64(s.Coordinator.CurrentTime.UnixNano() + 1_000_000_000), // epoch ends in 1 second
 	}
-	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx(), strideEpochTracker)
+	s.SetEpochTracker(strideEpochTracker)
 
 	// This will make the current time 50% through the day
 	dayEpochTracker := types.EpochTracker{
@@ -175,7 +175,7 @@ func (s *KeeperTestSuite) SetupQueryDelegationsIcq() QueryDelegationsIcqTestCase
 		Duration:           40_000_000_000,                                                // 40 second epochs
 		NextEpochStartTime: uint64(s.Coordinator.CurrentTime.UnixNano() + 20_000_000_000), // day ends in 20 second
 	}
-	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx(), dayEpochTracker)
+	s.SetEpochTracker(dayEpochTracker)
 
 	return QueryDelegationsIcqTestCase{
 		hostZone:           hostZone,
@@ -219,7 +219,7 @@ func (s *KeeperTestSuite) TestQueryDelegationsIcq_BeforeBufferWindow() {
 	// set the time to be 50% through the stride_epoch
 	strideEpochTracker := tc.strideEpochTracker
 	strideEpochTracker.NextEpochStartTime = uint64(s.Coordinator.CurrentTime.UnixNano() + int64(strideEpochTracker.Duration)/2) // 50% through the epoch
-	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx(), strideEpochTracker)
+	s.SetEpochTracker(strideEpochTracker)
 
 	err := s.App.StakeibcKeeper.QueryDelegationsIcq(s.Ctx(), tc.hostZone, tc.valoperAddr)
 	s.Require().ErrorContains(err, "outside the buffer time during which ICQs are allowed")
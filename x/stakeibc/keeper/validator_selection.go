@@ -5,12 +5,12 @@ import (
 	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/spf13/cast"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/Stride-Labs/stride/x/stakeibc/types"
 )
 
-func (k Keeper) GetValidatorDelegationAmtDifferences(ctx sdk.Context, hostZone types.HostZone) (map[string]int64, error) {
+func (k Keeper) GetValidatorDelegationAmtDifferences(ctx sdk.Context, hostZone types.HostZone) (map[string]sdk.Int, error) {
 	/*
 		This function returns a map from Validator Address to how many extra tokens
 		need to be given to that validator
@@ -19,7 +19,7 @@ func (k Keeper) GetValidatorDelegationAmtDifferences(ctx sdk.Context, hostZone t
 		negative impleis tokens need to be taken away
 	*/
 	validators := hostZone.GetValidators()
-	delegationDelta := make(map[string]int64)
+	delegationDelta := make(map[string]sdk.Int)
 	totalDelegatedAmt := k.GetTotalValidatorDelegations(hostZone)
 	targetDelegation, err := k.GetTargetValAmtsForHostZone(ctx, hostZone, totalDelegatedAmt)
 	if err != nil {
@@ -27,72 +27,101 @@ func (k Keeper) GetValidatorDelegationAmtDifferences(ctx sdk.Context, hostZone t
 		return nil, err
 	}
 	for _, validator := range validators {
-		targetDelForVal, err := cast.ToInt64E(targetDelegation[validator.GetAddress()])
-		if err != nil {
-			k.Logger(ctx).Error(fmt.Sprintf("Error getting target weight for host zone %s, targetDelForVal: %d", hostZone.ChainId, targetDelForVal))
-			return nil, err
-		}
-		delAmt, err := cast.ToInt64E(validator.DelegationAmt)
-		if err != nil {
-			k.Logger(ctx).Error(fmt.Sprintf("Error getting target delAmt for host zone %s, amt: %d", hostZone.ChainId, delAmt))
-			return nil, err
-		}
-		delegationDelta[validator.GetAddress()] = targetDelForVal - delAmt
+		delegationDelta[validator.GetAddress()] = targetDelegation[validator.GetAddress()].Sub(validator.DelegationAmt)
 	}
 	return delegationDelta, nil
 }
 
-func (k Keeper) GetTargetValAmtsForHostZone(ctx sdk.Context, hostZone types.HostZone, finalDelegation uint64) (map[string]uint64, error) {
+func (k Keeper) GetTargetValAmtsForHostZone(ctx sdk.Context, hostZone types.HostZone, finalDelegation sdk.Int) (map[string]sdk.Int, error) {
 	// This will get the target validator delegation for the given hostZone
 	// such that the total validator delegation is equal to the finalDelegation
 	// output key is ADDRESS not NAME
+	// The host zone's validators are not modified
 
 	totalWeight := k.GetTotalValidatorWeight(hostZone)
 	if totalWeight == 0 {
 		k.Logger(ctx).Error(fmt.Sprintf("No non-zero validators found for host zone %s", hostZone.ChainId))
 		return nil, types.ErrNoValidatorWeights
 	}
-	if finalDelegation == 0 {
+	if finalDelegation.IsZero() {
 		k.Logger(ctx).Error(fmt.Sprintf("Cannot calculate target delegation if final amount is 0 %s", hostZone.ChainId))
 		return nil, types.ErrNoValidatorWeights
 	}
-	targetAmount := make(map[string]uint64)
-	allocatedAmt := uint64(0)
 
-	// sort validators by weight ascending, this is inplace sorting!
 	validators := hostZone.GetValidators()
+	weights := make([]sdk.Int, len(validators))
+	for i, validator := range validators {
+		weights[i] = sdk.NewIntFromUint64(validator.Weight)
+	}
+	allocations, err := AllocateByWeight(finalDelegation, weights)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Error getting target weights for host zone %s: %s", hostZone.ChainId, err.Error()))
+		return nil, err
+	}
 
-	for i, j := 0, len(validators)-1; i < j; i, j = i+1, j-1 {
-		validators[i], validators[j] = validators[j], validators[i]
+	targetAmount := make(map[string]sdk.Int)
+	for i, validator := range validators {
+		targetAmount[validator.GetAddress()] = allocations[i]
 	}
+	return targetAmount, nil
+}
 
-	// Do not use `Slice` here, it is stochastic
-	sort.SliceStable(validators, func(i, j int) bool {
-		return validators[i].Weight < validators[j].Weight
-	})
+// AllocateByWeight splits totalAmount across the given weights using the largest remainder method
+// Each entry first receives floor(totalAmount * weight / totalWeight), and the units left over from rounding down
+// are then handed out one at a time to the entries with the largest remainders (ties go to the earlier entry)
+// The allocation is exact integer math, so it's deterministic, sums to totalAmount, and each share is within
+// one unit of its exact proportional value
+func AllocateByWeight(totalAmount sdk.Int, weights []sdk.Int) ([]sdk.Int, error) {
+	if totalAmount.IsNegative() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot allocate a negative amount (%v)", totalAmount)
+	}
+	totalWeight := sdk.ZeroInt()
+	for _, weight := range weights {
+		if weight.IsNegative() {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot allocate to a negative weight (%v)", weight)
+		}
+		totalWeight = totalWeight.Add(weight)
+	}
+	if totalWeight.IsZero() {
+		return nil, types.ErrNoValidatorWeights
+	}
+
+	allocations := make([]sdk.Int, len(weights))
+	remainders := make([]sdk.Int, len(weights))
+	allocated := sdk.ZeroInt()
+	for i, weight := range weights {
+		product := totalAmount.Mul(weight)
+		allocations[i] = product.Quo(totalWeight)
+		remainders[i] = product.Mod(totalWeight)
+		allocated = allocated.Add(allocations[i])
+	}
 
-	for i, validator := range hostZone.Validators {
-		if i == len(hostZone.Validators)-1 {
-			// for the last element, we need to make sure that the allocatedAmt is equal to the finalDelegation
-			targetAmount[validator.GetAddress()] = finalDelegation - allocatedAmt
-		} else {
-			delegateAmt, err := cast.ToUint64E(float64(validator.Weight*finalDelegation) / float64(totalWeight))
-			if err != nil {
-				k.Logger(ctx).Error(fmt.Sprintf("Error getting target weights for host zone %s", hostZone.ChainId))
-				return nil, err
-			}
-			allocatedAmt += delegateAmt
-			targetAmount[validator.GetAddress()] = delegateAmt
+	// Hand out the leftover units by largest remainder
+	// Since each remainder is less than the total weight, there are fewer leftover units than entries
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return remainders[order[i]].GT(remainders[order[j]])
+	})
+	leftover := totalAmount.Sub(allocated)
+	for _, i := range order {
+		if !leftover.IsPositive() {
+			break
 		}
+		allocations[i] = allocations[i].AddRaw(1)
+		leftover = leftover.SubRaw(1)
 	}
-	return targetAmount, nil
+
+	return allocations, nil
 }
 
-func (k Keeper) GetTotalValidatorDelegations(hostZone types.HostZone) uint64 {
+func (k Keeper) GetTotalValidatorDelegations(hostZone types.HostZone) sdk.Int {
 	validators := hostZone.GetValidators()
-	total_delegation := uint64(0)
+	total_delegation := sdk.ZeroInt()
 	for _, validator := range validators {
-		total_delegation += validator.DelegationAmt
+		total_delegation = total_delegation.Add(validator.DelegationAmt)
 	}
 	return total_delegation
 }
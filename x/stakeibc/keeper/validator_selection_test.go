@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/x/stakeibc/keeper"
+)
+
+func intsFromUint64s(values ...uint64) []sdk.Int {
+	ints := make([]sdk.Int, len(values))
+	for i, value := range values {
+		ints[i] = sdk.NewIntFromUint64(value)
+	}
+	return ints
+}
+
+func TestAllocateByWeight(t *testing.T) {
+	testCases := []struct {
+		name     string
+		amount   uint64
+		weights  []uint64
+		expected []uint64
+	}{
+		{
+			name:     "even split",
+			amount:   100,
+			weights:  []uint64{1, 1, 2},
+			expected: []uint64{25, 25, 50},
+		},
+		{
+			name:     "remainder goes to largest fraction",
+			amount:   10,
+			weights:  []uint64{1, 2},
+			expected: []uint64{3, 7}, // 3.33, 6.67
+		},
+		{
+			name:     "tied remainders go to earlier entries",
+			amount:   5,
+			weights:  []uint64{1, 1, 1},
+			expected: []uint64{2, 2, 1},
+		},
+		{
+			name:     "zero weight receives nothing",
+			amount:   7,
+			weights:  []uint64{0, 1, 0, 1},
+			expected: []uint64{0, 4, 0, 3},
+		},
+		{
+			name:     "zero amount",
+			amount:   0,
+			weights:  []uint64{1, 2, 3},
+			expected: []uint64{0, 0, 0},
+		},
+		{
+			name:     "product overflows uint64",
+			amount:   1 << 63,
+			weights:  []uint64{1 << 62, 1 << 62},
+			expected: []uint64{1 << 62, 1 << 62},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			allocations, err := keeper.AllocateByWeight(sdk.NewIntFromUint64(tc.amount), intsFromUint64s(tc.weights...))
+			require.NoError(t, err)
+			require.Equal(t, intsFromUint64s(tc.expected...), allocations)
+		})
+	}
+}
+
+func TestAllocateByWeight_InvalidInputs(t *testing.T) {
+	_, err := keeper.AllocateByWeight(sdk.NewInt(10), intsFromUint64s(0, 0))
+	require.ErrorContains(t, err, "no non-zero validator weights")
+
+	_, err = keeper.AllocateByWeight(sdk.NewInt(10), []sdk.Int{})
+	require.ErrorContains(t, err, "no non-zero validator weights")
+
+	_, err = keeper.AllocateByWeight(sdk.NewInt(10), []sdk.Int{sdk.NewInt(1), sdk.NewInt(-1)})
+	require.ErrorContains(t, err, "cannot allocate to a negative weight")
+
+	_, err = keeper.AllocateByWeight(sdk.NewInt(-10), intsFromUint64s(1))
+	require.ErrorContains(t, err, "cannot allocate a negative amount")
+}
+
+// Checks the allocator's invariants across randomly generated amounts and weights
+func TestAllocateByWeight_Properties(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 2000; i++ {
+		amount := sdk.NewIntFromUint64(r.Uint64() >> uint(r.Intn(64)))
+		weights := make([]sdk.Int, 1+r.Intn(20))
+		for j := range weights {
+			// include zero weights and weights large enough to overflow a uint64 product
+			if r.Intn(5) == 0 {
+				weights[j] = sdk.ZeroInt()
+			} else {
+				weights[j] = sdk.NewIntFromUint64(r.Uint64() >> uint(r.Intn(64)))
+			}
+		}
+		weights[r.Intn(len(weights))] = sdk.NewIntFromUint64(1 + r.Uint64()>>1)
+
+		weightsCopy := make([]sdk.Int, len(weights))
+		copy(weightsCopy, weights)
+
+		allocations, err := keeper.AllocateByWeight(amount, weights)
+		require.NoError(t, err)
+		require.Len(t, allocations, len(weights))
+
+		// the input is not modified
+		require.Equal(t, weightsCopy, weights, "weights modified")
+
+		// the result is deterministic
+		allocationsAgain, err := keeper.AllocateByWeight(amount, weights)
+		require.NoError(t, err)
+		require.Equal(t, allocations, allocationsAgain, "allocation not deterministic")
+
+		totalWeight := sdk.ZeroInt()
+		for _, weight := range weights {
+			totalWeight = totalWeight.Add(weight)
+		}
+
+		totalAllocated := sdk.ZeroInt()
+		for j, allocation := range allocations {
+			totalAllocated = totalAllocated.Add(allocation)
+
+			// each allocation is the exact share, rounded either down or up
+			floor := amount.Mul(weights[j]).Quo(totalWeight)
+			require.True(t, allocation.GTE(floor), "allocation below exact share")
+			require.True(t, allocation.LTE(floor.AddRaw(1)), "allocation more than one above exact share")
+
+			// zero weights never receive anything
+			if weights[j].IsZero() {
+				require.True(t, allocation.IsZero(), "zero weight received an allocation")
+			}
+
+			// a larger weight never receives less
+			for k := range weights {
+				if weights[j].GT(weights[k]) {
+					require.True(t, allocation.GTE(allocations[k]), "larger weight received less")
+				}
+			}
+		}
+
+		// the allocations sum exactly to the amount
+		require.Equal(t, amount, totalAllocated, "allocations don't sum to the amount")
+	}
+}
@@ -0,0 +1,452 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+
+	"github.com/Stride-Labs/stride/utils"
+	icqtypes "github.com/Stride-Labs/stride/x/interchainquery/types"
+	recordstypes "github.com/Stride-Labs/stride/x/records/types"
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+// A host zone is wound down in four stages:
+//   1. WindingDown: liquid stakes are rejected, while the deposits already received are still delegated
+//   2. UnbondingAll: once no deposits or undelegations are in flight, the remaining stake is queued on the
+//      current epoch unbonding record and the redemption rate is frozen. The existing unbonding pipeline
+//      undelegates it and sweeps it to the redemption ICA, and holders redeem against it at the frozen rate
+//   3. Sweeping: once every stToken has been redeemed and claimed (apart from dust that's worth less than a
+//      single native token at the frozen rate, and so can't be redeemed), the balance of each ICA account (e.g. the
+//      rewards left in the withdrawal account, and the rounding left in the redemption account) is queried
+//      and transferred back to stride, until every account is confirmed to be empty
+//   4. The ICA channels are closed, the records, callbacks and queries pruned, and the host zone removed
+
+// StartWindDownUnbondings queues the remaining stake of each winding down host zone for unbonding
+// on the given epoch's unbonding record, if the host zone has no deposits or undelegations in flight
+func (k Keeper) StartWindDownUnbondings(ctx sdk.Context, epochNumber uint64) {
+	for _, hostZone := range k.GetAllHostZone(ctx) {
+		if hostZone.WindDownStatus != types.HostZone_WindingDown {
+			continue
+		}
+		if ready, reason := k.isReadyToUnbondAll(ctx, hostZone); !ready {
+			k.Logger(ctx).Info(fmt.Sprintf("Host zone %s is not ready to unbond its remaining stake: %s", hostZone.ChainId, reason))
+			continue
+		}
+		err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.StartWindDownUnbonding(ctx, hostZone, epochNumber)
+		})
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to unbond the remaining stake of host zone %s: %s", hostZone.ChainId, err.Error()))
+		}
+	}
+}
+
+// isReadyToUnbondAll checks that every deposit has been delegated and no undelegation is in flight,
+// so that the host zone's staked balance is final
+func (k Keeper) isReadyToUnbondAll(ctx sdk.Context, hostZone types.HostZone) (ready bool, reason string) {
+	for _, depositRecord := range k.RecordsKeeper.GetAllDepositRecord(ctx) {
+		if depositRecord.HostZoneId == hostZone.ChainId && depositRecord.Amount.IsPositive() {
+			return false, fmt.Sprintf("deposit record %d has not been delegated", depositRecord.Id)
+		}
+	}
+	for _, epochUnbondingRecord := range k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx) {
+		hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochUnbondingRecord.EpochNumber, hostZone.ChainId)
+		if found && hostZoneUnbonding.Status == recordstypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS {
+			return false, fmt.Sprintf("unbonding from epoch %d is in progress", epochUnbondingRecord.EpochNumber)
+		}
+	}
+	return true, ""
+}
+
+// StartWindDownUnbonding adds the host zone's stake that isn't already queued for unbonding to its unbonding
+// on the given epoch, and freezes the redemption rate that the remaining stTokens are redeemed at
+func (k Keeper) StartWindDownUnbonding(ctx sdk.Context, hostZone types.HostZone, epochNumber uint64) error {
+	// stake that's already queued belongs to the redemptions made before the wind-down
+	queuedAmount := sdk.ZeroInt()
+	for _, epochUnbondingRecord := range k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx) {
+		hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochUnbondingRecord.EpochNumber, hostZone.ChainId)
+		if found && hostZoneUnbonding.Status == recordstypes.HostZoneUnbonding_UNBONDING_QUEUE {
+			queuedAmount = queuedAmount.Add(hostZoneUnbonding.NativeTokenAmount)
+		}
+	}
+	remainingAmount := hostZone.StakedBal.Sub(queuedAmount)
+	if remainingAmount.IsNegative() {
+		errMsg := fmt.Sprintf("Queued unbondings (%v) exceed the staked balance (%v) of host zone %s", queuedAmount, hostZone.StakedBal, hostZone.ChainId)
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(types.ErrInvalidAmount, errMsg)
+	}
+
+	hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochNumber, hostZone.ChainId)
+	if !found {
+		errMsg := fmt.Sprintf("Host zone unbonding not found (%s) in epoch unbonding record: %d", hostZone.ChainId, epochNumber)
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(recordstypes.ErrEpochUnbondingRecordNotFound, errMsg)
+	}
+	hostZoneUnbonding.NativeTokenAmount = hostZoneUnbonding.NativeTokenAmount.Add(remainingAmount)
+	updatedEpochUnbondingRecord, success := k.RecordsKeeper.AddHostZoneToEpochUnbondingRecord(ctx, epochNumber, hostZone.ChainId, hostZoneUnbonding)
+	if !success {
+		errMsg := fmt.Sprintf("Failed to set host zone epoch unbonding record: epochNumber %d, chainId %s, hostZoneUnbonding %v", epochNumber, hostZone.ChainId, hostZoneUnbonding)
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(types.ErrEpochNotFound, errMsg)
+	}
+	k.RecordsKeeper.SetEpochUnbondingRecord(ctx, *updatedEpochUnbondingRecord)
+
+	hostZone.WindDownStatus = types.HostZone_UnbondingAll
+	hostZone.WindDownEpochNumber = epochNumber
+	k.SetHostZone(ctx, hostZone)
+
+	k.EmitTypedEvent(ctx, &types.EventWindDownUnbonding{
+		HostZoneId:     hostZone.ChainId,
+		EpochNumber:    epochNumber,
+		Amount:         remainingAmount,
+		RedemptionRate: hostZone.RedemptionRate,
+	})
+
+	return nil
+}
+
+// RedeemWoundDownStake redeems stTokens of a host zone that's unbonding all of its stake
+// The native tokens are paid out of the wind-down unbonding at the frozen redemption rate, so the stTokens are
+// burned immediately, and the redemption is claimable as soon as the wind-down unbonding has been swept
+func (k Keeper) RedeemWoundDownStake(ctx sdk.Context, hostZone types.HostZone, sender sdk.AccAddress, msg *types.MsgRedeemStake) (*recordstypes.UserRedemptionRecord, sdk.Int, error) {
+	if _, err := utils.AccAddressFromBech32(msg.Receiver, hostZone.Bech32Prefix); err != nil {
+		return nil, sdk.ZeroInt(), sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
+	}
+
+	stDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
+	balance := k.bankKeeper.GetBalance(ctx, sender, stDenom)
+	if balance.Amount.LT(msg.Amount) {
+		return nil, sdk.ZeroInt(), sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "balance is lower than redemption amount. redemption amount: %v, balance %v: ", msg.Amount, balance.Amount)
+	}
+
+	// round down so the redemptions never add up to more than the wind-down unbonding
+	nativeAmount := msg.Amount.ToDec().Mul(hostZone.RedemptionRate).TruncateInt()
+	if !nativeAmount.IsPositive() {
+		return nil, sdk.ZeroInt(), sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amount must be greater than 0. found: %v", msg.Amount)
+	}
+
+	hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, hostZone.WindDownEpochNumber, hostZone.ChainId)
+	if !found {
+		errMsg := fmt.Sprintf("Wind-down unbonding not found (%s) in epoch unbonding record: %d", hostZone.ChainId, hostZone.WindDownEpochNumber)
+		k.Logger(ctx).Error(errMsg)
+		return nil, sdk.ZeroInt(), sdkerrors.Wrapf(recordstypes.ErrEpochUnbondingRecordNotFound, errMsg)
+	}
+
+	// the unclaimed redemptions are still counted in the unbonding's amount
+	redeemedAmount := sdk.ZeroInt()
+	for _, userRedemptionRecordId := range hostZoneUnbonding.UserRedemptionRecords {
+		if userRedemptionRecord, found := k.RecordsKeeper.GetUserRedemptionRecord(ctx, userRedemptionRecordId); found {
+			redeemedAmount = redeemedAmount.Add(userRedemptionRecord.Amount)
+		}
+	}
+	if nativeAmount.GT(hostZoneUnbonding.NativeTokenAmount.Sub(redeemedAmount)) {
+		errMsg := fmt.Sprintf("Redemption of %v exceeds the unredeemed wind-down unbonding of host zone %s (%v)",
+			nativeAmount, hostZone.ChainId, hostZoneUnbonding.NativeTokenAmount.Sub(redeemedAmount))
+		k.Logger(ctx).Error(errMsg)
+		return nil, sdk.ZeroInt(), sdkerrors.Wrapf(types.ErrInvalidAmount, errMsg)
+	}
+
+	// a holder's redemptions are merged into a single record on the wind-down epoch
+	redemptionId := recordstypes.UserRedemptionRecordKeyFormatter(hostZone.ChainId, hostZone.WindDownEpochNumber, sender.String())
+	userRedemptionRecord, found := k.RecordsKeeper.GetUserRedemptionRecord(ctx, redemptionId)
+	if found {
+		if userRedemptionRecord.ClaimIsPending || userRedemptionRecord.Receiver != msg.Receiver {
+			return nil, sdk.ZeroInt(), sdkerrors.Wrapf(recordstypes.ErrRedemptionAlreadyExists,
+				"user redemption record %s is being claimed or has a different receiver", redemptionId)
+		}
+		userRedemptionRecord.Amount = userRedemptionRecord.Amount.Add(nativeAmount)
+	} else {
+		userRedemptionRecord = recordstypes.UserRedemptionRecord{
+			Id:          redemptionId,
+			Sender:      sender.String(),
+			Receiver:    msg.Receiver,
+			Amount:      nativeAmount,
+			Denom:       hostZone.HostDenom,
+			HostZoneId:  hostZone.ChainId,
+			EpochNumber: hostZone.WindDownEpochNumber,
+		}
+	}
+	if !utils.ContainsString(hostZoneUnbonding.UserRedemptionRecords, redemptionId) {
+		hostZoneUnbonding.UserRedemptionRecords = append(hostZoneUnbonding.UserRedemptionRecords, redemptionId)
+		updatedEpochUnbondingRecord, success := k.RecordsKeeper.AddHostZoneToEpochUnbondingRecord(ctx, hostZone.WindDownEpochNumber, hostZone.ChainId, hostZoneUnbonding)
+		if !success {
+			errMsg := fmt.Sprintf("Failed to set host zone epoch unbonding record: epochNumber %d, chainId %s, hostZoneUnbonding %v",
+				hostZone.WindDownEpochNumber, hostZone.ChainId, hostZoneUnbonding)
+			k.Logger(ctx).Error(errMsg)
+			return nil, sdk.ZeroInt(), sdkerrors.Wrapf(types.ErrEpochNotFound, errMsg)
+		}
+		k.RecordsKeeper.SetEpochUnbondingRecord(ctx, *updatedEpochUnbondingRecord)
+	}
+	k.RecordsKeeper.SetUserRedemptionRecord(ctx, userRedemptionRecord)
+
+	// the stTokens are escrowed on the host zone address, from where they're burned
+	bech32ZoneAddress, err := sdk.AccAddressFromBech32(hostZone.Address)
+	if err != nil {
+		return nil, sdk.ZeroInt(), fmt.Errorf("could not bech32 decode address %s of zone with id: %s", hostZone.Address, hostZone.ChainId)
+	}
+	redeemCoin := sdk.NewCoins(sdk.NewCoin(stDenom, msg.Amount))
+	if err := k.bankKeeper.SendCoins(ctx, sender, bech32ZoneAddress, redeemCoin); err != nil {
+		return nil, sdk.ZeroInt(), sdkerrors.Wrapf(types.ErrInsufficientFunds, "couldn't send %v derivative %s tokens to module account. err: %s", msg.Amount, hostZone.HostDenom, err.Error())
+	}
+	if err := k.BurnTokens(ctx, hostZone, msg.Amount); err != nil {
+		return nil, sdk.ZeroInt(), err
+	}
+
+	return &userRedemptionRecord, nativeAmount, nil
+}
+
+// RemoveWoundDownHostZones moves each host zone that's unbonding all of its stake to sweeping once every
+// stToken has been redeemed and claimed, and removes each sweeping host zone once its ICA accounts are empty
+func (k Keeper) RemoveWoundDownHostZones(ctx sdk.Context) {
+	for _, hostZone := range k.GetAllHostZone(ctx) {
+		if hostZone.WindDownStatus == types.HostZone_UnbondingAll {
+			if woundDown, reason := k.isWoundDown(ctx, hostZone); !woundDown {
+				k.Logger(ctx).Info(fmt.Sprintf("Host zone %s is still winding down: %s", hostZone.ChainId, reason))
+				continue
+			}
+			hostZone.WindDownStatus = types.HostZone_Sweeping
+			k.SetHostZone(ctx, hostZone)
+		}
+		if hostZone.WindDownStatus != types.HostZone_Sweeping {
+			continue
+		}
+
+		err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			if !isSwept(hostZone) {
+				return k.SweepWoundDownHostZone(ctx, hostZone)
+			}
+			return k.RemoveWoundDownHostZone(ctx, hostZone)
+		})
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to remove wound down host zone %s: %s", hostZone.ChainId, err.Error()))
+		}
+	}
+}
+
+// isWoundDown checks that the remaining stToken supply is worth less than a single native token at the frozen
+// redemption rate, every redemption has been claimed, and no unbondings or ICA txs are in flight
+// Redemptions are rounded down, so stToken dust (whether held on stride or on another chain) can never be redeemed
+func (k Keeper) isWoundDown(ctx sdk.Context, hostZone types.HostZone) (woundDown bool, reason string) {
+	stDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
+	supply := k.bankKeeper.GetSupply(ctx, stDenom)
+	if supply.Amount.ToDec().Mul(hostZone.RedemptionRate).TruncateInt().IsPositive() {
+		return false, fmt.Sprintf("%v%s remains in supply", supply.Amount, stDenom)
+	}
+	for _, userRedemptionRecord := range k.RecordsKeeper.GetAllUserRedemptionRecord(ctx) {
+		if userRedemptionRecord.HostZoneId == hostZone.ChainId {
+			return false, fmt.Sprintf("user redemption record %s has not been claimed", userRedemptionRecord.Id)
+		}
+	}
+	for _, epochUnbondingRecord := range k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx) {
+		hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochUnbondingRecord.EpochNumber, hostZone.ChainId)
+		if !found || hostZoneUnbonding.Status == recordstypes.HostZoneUnbonding_CLAIMABLE {
+			continue
+		}
+		if hostZoneUnbonding.Status != recordstypes.HostZoneUnbonding_UNBONDING_QUEUE || hostZoneUnbonding.NativeTokenAmount.IsPositive() {
+			return false, fmt.Sprintf("unbonding from epoch %d has status %s", epochUnbondingRecord.EpochNumber, hostZoneUnbonding.Status.String())
+		}
+	}
+	for _, accountType := range types.HostZoneICAAccountTypes {
+		if queue := k.GetICATxQueue(ctx, hostZone.ChainId, accountType); len(queue) > 0 {
+			return false, fmt.Sprintf("%d %s ICA txs are queued", len(queue), accountType.String())
+		}
+	}
+	return true, ""
+}
+
+// isSwept checks that every registered ICA account of the host zone has been confirmed to be empty
+func isSwept(hostZone types.HostZone) bool {
+	for _, accountType := range types.HostZoneICAAccountTypes {
+		account := hostZone.GetICAAccount(accountType)
+		if account != nil && account.Address != "" && !isAccountSwept(hostZone, accountType) {
+			return false
+		}
+	}
+	return true
+}
+
+func isAccountSwept(hostZone types.HostZone, accountType types.ICAAccountType) bool {
+	for _, sweptAccountType := range hostZone.WindDownSweptAccounts {
+		if sweptAccountType == accountType {
+			return true
+		}
+	}
+	return false
+}
+
+// SweepWoundDownHostZone queries the balance of each ICA account that hasn't been confirmed to be empty,
+// unless a balance query or sweep is already in flight for the account
+// The balance callback transfers any residual balance to stride, and the account is confirmed to be empty
+// once a later query finds no balance
+func (k Keeper) SweepWoundDownHostZone(ctx sdk.Context, hostZone types.HostZone) error {
+	for _, accountType := range types.HostZoneICAAccountTypes {
+		account := hostZone.GetICAAccount(accountType)
+		if account == nil || account.Address == "" || isAccountSwept(hostZone, accountType) {
+			continue
+		}
+		if inFlight, reason := k.isSweepInFlight(ctx, hostZone, accountType); inFlight {
+			k.Logger(ctx).Info(fmt.Sprintf("Waiting on the sweep of the %s account of host zone %s: %s", accountType.String(), hostZone.ChainId, reason))
+			continue
+		}
+		if err := k.QueryWindDownBalance(ctx, hostZone, *account); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isSweepInFlight checks for a queued or pending ICA tx from the account, or an outstanding query of its balance
+func (k Keeper) isSweepInFlight(ctx sdk.Context, hostZone types.HostZone, accountType types.ICAAccountType) (inFlight bool, reason string) {
+	if queue := k.GetICATxQueue(ctx, hostZone.ChainId, accountType); len(queue) > 0 {
+		return true, fmt.Sprintf("%d ICA txs are queued", len(queue))
+	}
+
+	portId, err := icatypes.NewControllerPortID(types.FormatICAAccountOwner(hostZone.ChainId, accountType))
+	if err != nil {
+		return true, err.Error()
+	}
+	for _, callback := range k.GetPendingCallbacks(ctx, hostZone) {
+		if callback.PortId == portId {
+			return true, fmt.Sprintf("ICA tx %s is pending", callback.CallbackKey)
+		}
+	}
+
+	for _, query := range k.GetOutstandingQueries(ctx, hostZone) {
+		if query.CallbackId != WIND_DOWN_BALANCE {
+			continue
+		}
+		if queriedAccountType, found := getBalanceQueryAccountType(hostZone, query.Request); found && queriedAccountType == accountType {
+			return true, fmt.Sprintf("balance query %s is outstanding", query.Id)
+		}
+	}
+	return false, ""
+}
+
+// QueryWindDownBalance submits an ICQ for the host denom balance of one of a sweeping host zone's ICA accounts
+func (k Keeper) QueryWindDownBalance(ctx sdk.Context, hostZone types.HostZone, account types.ICAAccount) error {
+	_, addr, err := bech32.DecodeAndConvert(account.Address)
+	if err != nil {
+		errMsg := fmt.Sprintf("Invalid %s address %s on host zone %s: %s", account.Target.String(), account.Address, hostZone.ChainId, err.Error())
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(types.ErrInvalidInterchainAccountAddress, errMsg)
+	}
+	data := banktypes.CreateAccountBalancesPrefix(addr)
+
+	// get ttl, the end of the ICA buffer window of the unbonding epoch, which drives the wind-down
+	epochType := k.GetEpochIdentifierParam(ctx, types.KeyUnbondingEpochIdentifier)
+	ttl, err := k.GetICATimeoutNanos(ctx, epochType)
+	if err != nil {
+		errMsg := fmt.Sprintf("Failed to get ICA timeout nanos for epochType %s using param, error: %s", epochType, err.Error())
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, errMsg)
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("Querying the %s balance of the %s account on host zone %s", hostZone.HostDenom, account.Target.String(), hostZone.ChainId))
+	return k.InterchainQueryKeeper.MakeRequest(
+		ctx,
+		hostZone.ConnectionId,
+		hostZone.ChainId,
+		icqtypes.BANK_STORE_QUERY_WITH_PROOF,
+		append(data, []byte(hostZone.HostDenom)...),
+		sdk.NewInt(-1),
+		types.ModuleName,
+		WIND_DOWN_BALANCE,
+		ttl,
+		0,
+	)
+}
+
+// getBalanceQueryAccountType returns which of the host zone's ICA accounts a bank balance query is for
+func getBalanceQueryAccountType(hostZone types.HostZone, request []byte) (accountType types.ICAAccountType, found bool) {
+	for _, accountType := range types.HostZoneICAAccountTypes {
+		account := hostZone.GetICAAccount(accountType)
+		if account == nil || account.Address == "" {
+			continue
+		}
+		_, addr, err := bech32.DecodeAndConvert(account.Address)
+		if err != nil {
+			continue
+		}
+		if bytes.HasPrefix(request, banktypes.CreateAccountBalancesPrefix(addr)) {
+			return accountType, true
+		}
+	}
+	return 0, false
+}
+
+// RemoveWoundDownHostZone closes a host zone's ICA channels, prunes its records, callbacks and queries,
+// and removes the host zone
+func (k Keeper) RemoveWoundDownHostZone(ctx sdk.Context, hostZone types.HostZone) error {
+	for _, accountType := range types.HostZoneICAAccountTypes {
+		if err := k.CloseICAChannel(ctx, hostZone, accountType); err != nil {
+			return err
+		}
+		k.RemoveICARecovery(ctx, hostZone.ChainId, accountType)
+	}
+
+	for _, depositRecord := range k.RecordsKeeper.GetAllDepositRecord(ctx) {
+		if depositRecord.HostZoneId == hostZone.ChainId {
+			k.RecordsKeeper.RemoveDepositRecord(ctx, depositRecord.Id)
+		}
+	}
+	for _, epochUnbondingRecord := range k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx) {
+		hostZoneUnbondings := []*recordstypes.HostZoneUnbonding{}
+		for _, hostZoneUnbonding := range epochUnbondingRecord.HostZoneUnbondings {
+			if hostZoneUnbonding.HostZoneId != hostZone.ChainId {
+				hostZoneUnbondings = append(hostZoneUnbondings, hostZoneUnbonding)
+			}
+		}
+		epochUnbondingRecord.HostZoneUnbondings = hostZoneUnbondings
+		k.RecordsKeeper.SetEpochUnbondingRecord(ctx, epochUnbondingRecord)
+	}
+
+	for _, pendingValidator := range k.GetAllPendingValidators(ctx) {
+		if pendingValidator.ChainId == hostZone.ChainId {
+			k.RemovePendingValidator(ctx, hostZone.ChainId, pendingValidator.Validator.Address)
+		}
+	}
+	for _, redelegation := range k.GetAllRedelegations(ctx) {
+		if redelegation.HostZoneId == hostZone.ChainId {
+			k.RemoveRedelegation(ctx, hostZone.ChainId, redelegation.SrcValidator, redelegation.DstValidator)
+		}
+	}
+
+	// nothing should fire against the host zone once it's removed
+	for _, callback := range k.GetPendingCallbacks(ctx, hostZone) {
+		k.ICACallbacksKeeper.RemoveCallbackData(ctx, callback.CallbackKey)
+	}
+	for _, query := range k.GetOutstandingQueries(ctx, hostZone) {
+		k.InterchainQueryKeeper.DeleteQuery(ctx, query.Id)
+	}
+
+	k.RemoveHostZone(ctx, hostZone.ChainId)
+
+	k.EmitTypedEvent(ctx, &types.EventHostZoneRemoved{
+		HostZoneId: hostZone.ChainId,
+	})
+
+	return nil
+}
+
+// CloseICAChannel closes the channel behind a host zone's ICA account, if it's still open
+func (k Keeper) CloseICAChannel(ctx sdk.Context, hostZone types.HostZone, accountType types.ICAAccountType) error {
+	portId, channelId, channel, found := k.GetICAChannel(ctx, hostZone, accountType)
+	if !found || channel.State == channeltypes.CLOSED {
+		return nil
+	}
+	channelCap, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portId, channelId))
+	if !found {
+		errMsg := fmt.Sprintf("Channel capability not found for %s ICA channel %s on host zone %s", accountType.String(), channelId, hostZone.ChainId)
+		k.Logger(ctx).Error(errMsg)
+		return sdkerrors.Wrapf(types.ErrICAAccountNotFound, errMsg)
+	}
+	return k.IBCKeeper.ChannelKeeper.ChanCloseInit(ctx, portId, channelId, channelCap)
+}
@@ -0,0 +1,433 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	_ "github.com/stretchr/testify/suite"
+
+	epochtypes "github.com/Stride-Labs/stride/x/epochs/types"
+	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
+	icqtypes "github.com/Stride-Labs/stride/x/interchainquery/types"
+	recordtypes "github.com/Stride-Labs/stride/x/records/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+const windDownEpochNumber = uint64(2)
+
+// Stores a winding down host zone with 1_000_000 staked, of which 100_000 is already queued for unbonding
+// on epoch 1, along with an empty unbonding on the wind-down epoch
+func (s *KeeperTestSuite) SetupStartWindDown() stakeibctypes.HostZone {
+	hostZone := stakeibctypes.HostZone{
+		ChainId:        HostChainId,
+		HostDenom:      Atom,
+		RedemptionRate: sdk.MustNewDecFromStr("1.5"),
+		StakedBal:      sdk.NewInt(1_000_000),
+		WindDownStatus: stakeibctypes.HostZone_WindingDown,
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), hostZone)
+
+	for epochNumber, amount := range map[uint64]int64{1: 100_000, windDownEpochNumber: 0} {
+		s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx(), recordtypes.EpochUnbondingRecord{
+			EpochNumber: epochNumber,
+			HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{{
+				HostZoneId:        HostChainId,
+				Denom:             Atom,
+				NativeTokenAmount: sdk.NewInt(amount),
+				StTokenAmount:     sdk.NewInt(amount),
+				Status:            recordtypes.HostZoneUnbonding_UNBONDING_QUEUE,
+			}},
+		})
+	}
+
+	// deposit records that have been emptied don't hold up the wind-down
+	s.App.RecordsKeeper.AppendDepositRecord(s.Ctx(), recordtypes.DepositRecord{
+		Amount:     sdk.ZeroInt(),
+		Denom:      Atom,
+		HostZoneId: HostChainId,
+		Status:     recordtypes.DepositRecord_TRANSFER_QUEUE,
+	})
+
+	return hostZone
+}
+
+func (s *KeeperTestSuite) getHostZoneUnbonding(epochNumber uint64) *recordtypes.HostZoneUnbonding {
+	hostZoneUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx(), epochNumber, HostChainId)
+	s.Require().True(found, "host zone unbonding found for epoch %d", epochNumber)
+	return hostZoneUnbonding
+}
+
+func (s *KeeperTestSuite) TestStartWindDownUnbondings_Successful() {
+	s.SetupStartWindDown()
+
+	s.App.StakeibcKeeper.StartWindDownUnbondings(s.Ctx(), windDownEpochNumber)
+
+	// the stake that isn't already queued is unbonded on the wind-down epoch
+	s.Require().Equal(sdk.NewInt(900_000), s.getHostZoneUnbonding(windDownEpochNumber).NativeTokenAmount, "wind-down unbonding amount")
+	s.Require().Equal(sdk.ZeroInt(), s.getHostZoneUnbonding(windDownEpochNumber).StTokenAmount, "wind-down unbonding st amount")
+	s.Require().Equal(sdk.NewInt(100_000), s.getHostZoneUnbonding(1).NativeTokenAmount, "queued unbonding amount")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(stakeibctypes.HostZone_UnbondingAll, hostZone.WindDownStatus, "wind down status")
+	s.Require().Equal(windDownEpochNumber, hostZone.WindDownEpochNumber, "wind down epoch number")
+
+	// the host zone no longer runs through the pipelines, which freezes its redemption rate
+	s.Require().Empty(s.App.StakeibcKeeper.GetHostZonesForPipeline(s.Ctx(), stakeibctypes.PipelineRedemptionRate, 0), "redemption rate pipeline")
+}
+
+func (s *KeeperTestSuite) TestStartWindDownUnbondings_PendingDeposit() {
+	s.SetupStartWindDown()
+	s.App.RecordsKeeper.AppendDepositRecord(s.Ctx(), recordtypes.DepositRecord{
+		Amount:     sdk.NewInt(1_000),
+		Denom:      Atom,
+		HostZoneId: HostChainId,
+		Status:     recordtypes.DepositRecord_DELEGATION_QUEUE,
+	})
+
+	s.App.StakeibcKeeper.StartWindDownUnbondings(s.Ctx(), windDownEpochNumber)
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(stakeibctypes.HostZone_WindingDown, hostZone.WindDownStatus, "wind down status")
+	s.Require().Equal(sdk.ZeroInt(), s.getHostZoneUnbonding(windDownEpochNumber).NativeTokenAmount, "wind-down unbonding amount")
+}
+
+func (s *KeeperTestSuite) TestStartWindDownUnbondings_UnbondingInProgress() {
+	s.SetupStartWindDown()
+	hostZoneUnbonding := s.getHostZoneUnbonding(1)
+	hostZoneUnbonding.Status = recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS
+	epochUnbondingRecord, success := s.App.RecordsKeeper.AddHostZoneToEpochUnbondingRecord(s.Ctx(), 1, HostChainId, hostZoneUnbonding)
+	s.Require().True(success, "host zone unbonding updated")
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx(), *epochUnbondingRecord)
+
+	s.App.StakeibcKeeper.StartWindDownUnbondings(s.Ctx(), windDownEpochNumber)
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(stakeibctypes.HostZone_WindingDown, hostZone.WindDownStatus, "wind down status")
+}
+
+type RedeemWoundDownStakeTestCase struct {
+	user     sdk.AccAddress
+	hostZone stakeibctypes.HostZone
+	msg      stakeibctypes.MsgRedeemStake
+}
+
+// Stores a host zone that's unbonding its remaining 900_000 stake at a frozen redemption rate of 1.5,
+// with a holder of 1_000_000 stTokens
+func (s *KeeperTestSuite) SetupRedeemWoundDownStake() RedeemWoundDownStakeTestCase {
+	zoneAddress := stakeibctypes.NewZoneAddress(HostChainId)
+	hostZone := stakeibctypes.HostZone{
+		ChainId:             HostChainId,
+		HostDenom:           Atom,
+		Bech32Prefix:        GaiaPrefix,
+		Address:             zoneAddress.String(),
+		RedemptionRate:      sdk.MustNewDecFromStr("1.5"),
+		WindDownStatus:      stakeibctypes.HostZone_UnbondingAll,
+		WindDownEpochNumber: windDownEpochNumber,
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), hostZone)
+
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx(), recordtypes.EpochUnbondingRecord{
+		EpochNumber: windDownEpochNumber,
+		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{{
+			HostZoneId:        HostChainId,
+			Denom:             Atom,
+			NativeTokenAmount: sdk.NewInt(900_000),
+			StTokenAmount:     sdk.ZeroInt(),
+			Status:            recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS,
+		}},
+	})
+
+	user := s.TestAccs[0]
+	s.FundAccount(user, sdk.NewInt64Coin(StAtom, 1_000_000))
+
+	return RedeemWoundDownStakeTestCase{
+		user:     user,
+		hostZone: hostZone,
+		msg: stakeibctypes.MsgRedeemStake{
+			Creator:  user.String(),
+			Amount:   sdk.NewInt(200_000),
+			HostZone: HostChainId,
+			Receiver: "cosmos1g6qdx6kdhpf000afvvpte7hp0vnpzapuyxp8uf",
+		},
+	}
+}
+
+func (s *KeeperTestSuite) TestRedeemWoundDownStake_Successful() {
+	tc := s.SetupRedeemWoundDownStake()
+
+	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx()), &tc.msg)
+	s.Require().NoError(err, "no error expected when redeeming")
+
+	// the stTokens are burned right away
+	s.Require().Equal(sdk.NewInt(800_000), s.App.BankKeeper.GetBalance(s.Ctx(), tc.user, StAtom).Amount, "user st balance")
+	s.Require().Equal(sdk.NewInt(800_000), s.App.BankKeeper.GetSupply(s.Ctx(), StAtom).Amount, "st supply")
+
+	// the redemption is recorded against the wind-down unbonding, at the frozen rate
+	redemptionId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, windDownEpochNumber, tc.user.String())
+	userRedemptionRecord, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx(), redemptionId)
+	s.Require().True(found, "user redemption record found")
+	s.Require().Equal(sdk.NewInt(300_000), userRedemptionRecord.Amount, "user redemption amount")
+	s.Require().Equal(windDownEpochNumber, userRedemptionRecord.EpochNumber, "user redemption epoch")
+
+	hostZoneUnbonding := s.getHostZoneUnbonding(windDownEpochNumber)
+	s.Require().Equal(sdk.NewInt(900_000), hostZoneUnbonding.NativeTokenAmount, "wind-down unbonding amount unchanged")
+	s.Require().Equal([]string{redemptionId}, hostZoneUnbonding.UserRedemptionRecords, "wind-down unbonding redemptions")
+
+	// a second redemption is merged into the same record
+	tc.msg.Amount = sdk.NewInt(100_000)
+	_, err = s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx()), &tc.msg)
+	s.Require().NoError(err, "no error expected when redeeming again")
+
+	userRedemptionRecord, found = s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx(), redemptionId)
+	s.Require().True(found, "user redemption record found")
+	s.Require().Equal(sdk.NewInt(450_000), userRedemptionRecord.Amount, "merged user redemption amount")
+	s.Require().Len(s.getHostZoneUnbonding(windDownEpochNumber).UserRedemptionRecords, 1, "wind-down unbonding redemptions")
+}
+
+func (s *KeeperTestSuite) TestRedeemWoundDownStake_ExceedsUnbonding() {
+	tc := s.SetupRedeemWoundDownStake()
+
+	// 700_000 * 1.5 = 1_050_000, which is more than the 900_000 being unbonded
+	tc.msg.Amount = sdk.NewInt(700_000)
+	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx()), &tc.msg)
+	s.Require().EqualError(err, "Redemption of 1050000 exceeds the unredeemed wind-down unbonding of host zone GAIA (900000): invalid amount")
+}
+
+func (s *KeeperTestSuite) TestRedeemWoundDownStake_ClaimPending() {
+	tc := s.SetupRedeemWoundDownStake()
+
+	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx()), &tc.msg)
+	s.Require().NoError(err, "no error expected when redeeming")
+
+	redemptionId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, windDownEpochNumber, tc.user.String())
+	userRedemptionRecord, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx(), redemptionId)
+	s.Require().True(found, "user redemption record found")
+	userRedemptionRecord.ClaimIsPending = true
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx(), userRedemptionRecord)
+
+	_, err = s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx()), &tc.msg)
+	s.Require().ErrorContains(err, "is being claimed or has a different receiver")
+}
+
+// Stores a wound down host zone with an open delegation ICA channel, alongside records of another host zone
+func (s *KeeperTestSuite) SetupRemoveWoundDownHostZone() (portId string, channelId string) {
+	delegationOwner := stakeibctypes.FormatICAAccountOwner(HostChainId, stakeibctypes.ICAAccountType_DELEGATION)
+	channelId = s.CreateICAChannel(delegationOwner)
+	portId, err := icatypes.NewControllerPortID(delegationOwner)
+	s.Require().NoError(err)
+
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), stakeibctypes.HostZone{
+		ChainId:             HostChainId,
+		ConnectionId:        ibctesting.FirstConnectionID,
+		TransferChannelId:   ibctesting.FirstChannelID,
+		HostDenom:           Atom,
+		RedemptionRate:      sdk.OneDec(),
+		DelegationAccount:   &stakeibctypes.ICAAccount{Address: s.IcaAddresses[delegationOwner], Target: stakeibctypes.ICAAccountType_DELEGATION},
+		WindDownStatus:      stakeibctypes.HostZone_UnbondingAll,
+		WindDownEpochNumber: windDownEpochNumber,
+	})
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), stakeibctypes.HostZone{
+		ChainId:        OsmoChainId,
+		HostDenom:      Osmo,
+		RedemptionRate: sdk.OneDec(),
+	})
+
+	for _, chainId := range []string{HostChainId, OsmoChainId} {
+		s.App.RecordsKeeper.AppendDepositRecord(s.Ctx(), recordtypes.DepositRecord{
+			Amount:     sdk.ZeroInt(),
+			HostZoneId: chainId,
+			Status:     recordtypes.DepositRecord_TRANSFER_QUEUE,
+		})
+	}
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx(), recordtypes.EpochUnbondingRecord{
+		EpochNumber: windDownEpochNumber,
+		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+			{HostZoneId: HostChainId, NativeTokenAmount: sdk.NewInt(1), StTokenAmount: sdk.ZeroInt(), Status: recordtypes.HostZoneUnbonding_CLAIMABLE},
+			{HostZoneId: OsmoChainId, NativeTokenAmount: sdk.NewInt(1_000), StTokenAmount: sdk.NewInt(1_000), Status: recordtypes.HostZoneUnbonding_UNBONDING_QUEUE},
+		},
+	})
+	s.App.StakeibcKeeper.SetRedelegation(s.Ctx(), stakeibctypes.Redelegation{HostZoneId: HostChainId, SrcValidator: "val1", DstValidator: "val2"})
+
+	s.SetEpochTracker(stakeibctypes.EpochTracker{
+		EpochIdentifier:    epochtypes.DAY_EPOCH,
+		EpochNumber:        1,
+		NextEpochStartTime: uint64(s.Coordinator.CurrentTime.UnixNano() + 60_000_000_000), // dictates timeouts
+	})
+
+	return portId, channelId
+}
+
+// Returns the outstanding wind-down balance queries
+func (s *KeeperTestSuite) getWindDownBalanceQueries() []icqtypes.Query {
+	queries := []icqtypes.Query{}
+	for _, query := range s.App.InterchainqueryKeeper.AllQueries(s.Ctx()) {
+		if query.CallbackId == stakeibckeeper.WIND_DOWN_BALANCE {
+			queries = append(queries, query)
+		}
+	}
+	return queries
+}
+
+// Answers the outstanding wind-down balance query with the given balance of the delegation account
+func (s *KeeperTestSuite) answerWindDownBalanceQuery(amount int64) error {
+	queries := s.getWindDownBalanceQueries()
+	s.Require().Len(queries, 1, "one outstanding balance query")
+	s.App.InterchainqueryKeeper.DeleteQuery(s.Ctx(), queries[0].Id)
+	return stakeibckeeper.WindDownBalanceCallback(s.App.StakeibcKeeper, s.Ctx(), s.CreateBalanceQueryResponse(amount, Atom), queries[0])
+}
+
+func (s *KeeperTestSuite) TestRemoveWoundDownHostZones_StartsSweep() {
+	portId, channelId := s.SetupRemoveWoundDownHostZone()
+
+	s.App.StakeibcKeeper.RemoveWoundDownHostZones(s.Ctx())
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
+	s.Require().True(found, "host zone kept while sweeping")
+	s.Require().Equal(stakeibctypes.HostZone_Sweeping, hostZone.WindDownStatus, "wind down status")
+
+	channel, found := s.App.IBCKeeper.ChannelKeeper.GetChannel(s.Ctx(), portId, channelId)
+	s.Require().True(found, "delegation channel found")
+	s.Require().Equal(channeltypes.OPEN, channel.State, "delegation channel open")
+
+	queries := s.getWindDownBalanceQueries()
+	s.Require().Len(queries, 1, "balance of the delegation account queried")
+	s.Require().Equal(HostChainId, queries[0].ChainId, "balance query chain id")
+
+	// The query is still outstanding, so it isn't submitted again
+	s.App.StakeibcKeeper.RemoveWoundDownHostZones(s.Ctx())
+	s.Require().Len(s.getWindDownBalanceQueries(), 1, "no duplicate balance query")
+}
+
+func (s *KeeperTestSuite) TestRemoveWoundDownHostZones_SweepsResidualBalance() {
+	s.SetupRemoveWoundDownHostZone()
+	s.App.StakeibcKeeper.RemoveWoundDownHostZones(s.Ctx())
+
+	// The residual balance is transferred back to stride
+	ctx := s.Ctx()
+	queries := s.getWindDownBalanceQueries()
+	s.Require().Len(queries, 1, "one outstanding balance query")
+	s.App.InterchainqueryKeeper.DeleteQuery(ctx, queries[0].Id)
+	err := stakeibckeeper.WindDownBalanceCallback(s.App.StakeibcKeeper, ctx, s.CreateBalanceQueryResponse(500, Atom), queries[0])
+	s.Require().NoError(err, "no error expected when sweeping the residual balance")
+
+	queue := s.App.StakeibcKeeper.GetICATxQueue(s.Ctx(), HostChainId, stakeibctypes.ICAAccountType_DELEGATION)
+	s.Require().Len(queue, 1, "sweep queued on the delegation account")
+	s.Require().Equal(stakeibckeeper.WIND_DOWN_SWEEP, queue[0].CallbackId, "sweep callback")
+
+	var msg sdk.Msg
+	s.Require().NoError(s.App.AppCodec().UnpackAny(queue[0].Msgs[0], &msg))
+	transferMsg, ok := msg.(*transfertypes.MsgTransfer)
+	s.Require().True(ok, "sweep is an ibc transfer")
+	s.Require().Equal(sdk.NewInt64Coin(Atom, 500), transferMsg.Token, "sweep amount")
+	s.Require().Equal(stakeibctypes.FeeAccount, transferMsg.Receiver, "sweep receiver")
+
+	s.CheckTypedEventEmitted(ctx, &stakeibctypes.EventWindDownSweep{
+		HostZoneId:  HostChainId,
+		AccountType: stakeibctypes.ICAAccountType_DELEGATION.String(),
+		Amount:      sdk.NewInt(500),
+	})
+
+	// The account isn't swept until it's confirmed to be empty, and isn't queried while the sweep is in flight
+	s.App.StakeibcKeeper.RemoveWoundDownHostZones(s.Ctx())
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
+	s.Require().True(found, "host zone kept while the sweep is in flight")
+	s.Require().Empty(hostZone.WindDownSweptAccounts, "no swept accounts")
+	s.Require().Empty(s.getWindDownBalanceQueries(), "no balance query while the sweep is queued")
+}
+
+func (s *KeeperTestSuite) TestRemoveWoundDownHostZones_Successful() {
+	portId, channelId := s.SetupRemoveWoundDownHostZone()
+
+	// The first epoch queries the balance of the delegation account, which is confirmed to be empty
+	s.App.StakeibcKeeper.RemoveWoundDownHostZones(s.Ctx())
+	s.Require().NoError(s.answerWindDownBalanceQuery(0), "no error expected when answering the balance query")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
+	s.Require().True(found, "host zone kept until the next epoch")
+	s.Require().Equal([]stakeibctypes.ICAAccountType{stakeibctypes.ICAAccountType_DELEGATION}, hostZone.WindDownSweptAccounts, "swept accounts")
+
+	// Callbacks and queries of the host zone are pruned along with it
+	s.App.IcacallbacksKeeper.SetCallbackData(s.Ctx(), icacallbackstypes.CallbackData{CallbackKey: "key1", PortId: portId, ChannelId: channelId, Sequence: 1})
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx(), icqtypes.Query{Id: "query1", ChainId: HostChainId, Period: sdk.ZeroInt(), LastHeight: sdk.ZeroInt()})
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx(), icqtypes.Query{Id: "query2", ChainId: OsmoChainId, Period: sdk.ZeroInt(), LastHeight: sdk.ZeroInt()})
+
+	s.App.StakeibcKeeper.RemoveWoundDownHostZones(s.Ctx())
+
+	_, found = s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
+	s.Require().False(found, "wound down host zone removed")
+	_, found = s.App.StakeibcKeeper.GetHostZone(s.Ctx(), OsmoChainId)
+	s.Require().True(found, "other host zone kept")
+
+	channel, found := s.App.IBCKeeper.ChannelKeeper.GetChannel(s.Ctx(), portId, channelId)
+	s.Require().True(found, "delegation channel found")
+	s.Require().Equal(channeltypes.CLOSED, channel.State, "delegation channel closed")
+
+	depositRecords := s.App.RecordsKeeper.GetAllDepositRecord(s.Ctx())
+	s.Require().Len(depositRecords, 1, "one deposit record remaining")
+	s.Require().Equal(OsmoChainId, depositRecords[0].HostZoneId, "remaining deposit record host zone")
+
+	epochUnbondingRecord, found := s.App.RecordsKeeper.GetEpochUnbondingRecord(s.Ctx(), windDownEpochNumber)
+	s.Require().True(found, "epoch unbonding record found")
+	s.Require().Len(epochUnbondingRecord.HostZoneUnbondings, 1, "one host zone unbonding remaining")
+	s.Require().Equal(OsmoChainId, epochUnbondingRecord.HostZoneUnbondings[0].HostZoneId, "remaining host zone unbonding")
+
+	s.Require().Empty(s.App.StakeibcKeeper.GetAllRedelegations(s.Ctx()), "redelegations pruned")
+
+	s.Require().Empty(s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx()), "callback data pruned")
+	queries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx())
+	s.Require().Len(queries, 1, "one query remaining")
+	s.Require().Equal("query2", queries[0].Id, "remaining query")
+}
+
+func (s *KeeperTestSuite) TestRemoveWoundDownHostZones_SupplyRemaining() {
+	portId, channelId := s.SetupRemoveWoundDownHostZone()
+	s.FundAccount(s.TestAccs[0], sdk.NewInt64Coin(StAtom, 1))
+
+	s.App.StakeibcKeeper.RemoveWoundDownHostZones(s.Ctx())
+
+	_, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
+	s.Require().True(found, "host zone kept")
+
+	channel, found := s.App.IBCKeeper.ChannelKeeper.GetChannel(s.Ctx(), portId, channelId)
+	s.Require().True(found, "delegation channel found")
+	s.Require().Equal(channeltypes.OPEN, channel.State, "delegation channel open")
+	s.Require().Len(s.App.RecordsKeeper.GetAllDepositRecord(s.Ctx()), 2, "deposit records kept")
+}
+
+func (s *KeeperTestSuite) TestRemoveWoundDownHostZones_DustSupplyRemaining() {
+	s.SetupRemoveWoundDownHostZone()
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
+	s.Require().True(found, "host zone found")
+	hostZone.RedemptionRate = sdk.MustNewDecFromStr("0.5")
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx(), hostZone)
+
+	// 1 stAtom is worth half an atom at the frozen rate, so it can't be redeemed and doesn't hold up the wind-down
+	s.FundAccount(s.TestAccs[0], sdk.NewInt64Coin(StAtom, 1))
+
+	s.App.StakeibcKeeper.RemoveWoundDownHostZones(s.Ctx())
+
+	hostZone, found = s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
+	s.Require().True(found, "host zone kept while sweeping")
+	s.Require().Equal(stakeibctypes.HostZone_Sweeping, hostZone.WindDownStatus, "wind down status")
+}
+
+func (s *KeeperTestSuite) TestRemoveWoundDownHostZones_RedemptionUnclaimed() {
+	s.SetupRemoveWoundDownHostZone()
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx(), recordtypes.UserRedemptionRecord{
+		Id:         recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, windDownEpochNumber, s.TestAccs[0].String()),
+		Sender:     s.TestAccs[0].String(),
+		HostZoneId: HostChainId,
+		Amount:     sdk.NewInt(1),
+	})
+
+	s.App.StakeibcKeeper.RemoveWoundDownHostZones(s.Ctx())
+
+	_, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx(), HostChainId)
+	s.Require().True(found, "host zone kept")
+}
@@ -0,0 +1,38 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+// EpochTrackerKeyPrefix is the prefix the epoch trackers were stored under before they were
+// derived from x/epochs
+const EpochTrackerKeyPrefix = "EpochTracker/value/"
+
+// MigrateStore removes the stored epoch trackers, which are now derived from x/epochs,
+// and sets any params added since v1 to their defaults
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, paramstore paramtypes.Subspace) error {
+	store := prefix.NewStore(ctx.KVStore(storeKey), []byte(EpochTrackerKeyPrefix))
+
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	defaultParams := types.DefaultParams()
+	for _, pair := range defaultParams.ParamSetPairs() {
+		if !paramstore.Has(ctx, pair.Key) {
+			paramstore.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+	return nil
+}
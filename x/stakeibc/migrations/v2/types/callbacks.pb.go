@@ -0,0 +1,1462 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stakeibc/migrations/v2/callbacks.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type SplitDelegation struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Amount    uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *SplitDelegation) Reset()         { *m = SplitDelegation{} }
+func (m *SplitDelegation) String() string { return proto.CompactTextString(m) }
+func (*SplitDelegation) ProtoMessage()    {}
+func (*SplitDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb485fe0920851d3, []int{0}
+}
+func (m *SplitDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SplitDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SplitDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SplitDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SplitDelegation.Merge(m, src)
+}
+func (m *SplitDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SplitDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SplitDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SplitDelegation proto.InternalMessageInfo
+
+func (m *SplitDelegation) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *SplitDelegation) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type DelegateCallback struct {
+	HostZoneId       string             `protobuf:"bytes,1,opt,name=hostZoneId,proto3" json:"hostZoneId,omitempty"`
+	DepositRecordId  uint64             `protobuf:"varint,2,opt,name=depositRecordId,proto3" json:"depositRecordId,omitempty"`
+	SplitDelegations []*SplitDelegation `protobuf:"bytes,3,rep,name=splitDelegations,proto3" json:"splitDelegations,omitempty"`
+}
+
+func (m *DelegateCallback) Reset()         { *m = DelegateCallback{} }
+func (m *DelegateCallback) String() string { return proto.CompactTextString(m) }
+func (*DelegateCallback) ProtoMessage()    {}
+func (*DelegateCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb485fe0920851d3, []int{1}
+}
+func (m *DelegateCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegateCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegateCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateCallback.Merge(m, src)
+}
+func (m *DelegateCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegateCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegateCallback proto.InternalMessageInfo
+
+func (m *DelegateCallback) GetHostZoneId() string {
+	if m != nil {
+		return m.HostZoneId
+	}
+	return ""
+}
+
+func (m *DelegateCallback) GetDepositRecordId() uint64 {
+	if m != nil {
+		return m.DepositRecordId
+	}
+	return 0
+}
+
+func (m *DelegateCallback) GetSplitDelegations() []*SplitDelegation {
+	if m != nil {
+		return m.SplitDelegations
+	}
+	return nil
+}
+
+type UndelegateCallback struct {
+	HostZoneId              string             `protobuf:"bytes,1,opt,name=hostZoneId,proto3" json:"hostZoneId,omitempty"`
+	SplitDelegations        []*SplitDelegation `protobuf:"bytes,2,rep,name=splitDelegations,proto3" json:"splitDelegations,omitempty"`
+	EpochUnbondingRecordIds []uint64           `protobuf:"varint,3,rep,packed,name=epochUnbondingRecordIds,proto3" json:"epochUnbondingRecordIds,omitempty"`
+}
+
+func (m *UndelegateCallback) Reset()         { *m = UndelegateCallback{} }
+func (m *UndelegateCallback) String() string { return proto.CompactTextString(m) }
+func (*UndelegateCallback) ProtoMessage()    {}
+func (*UndelegateCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb485fe0920851d3, []int{2}
+}
+func (m *UndelegateCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UndelegateCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UndelegateCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UndelegateCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UndelegateCallback.Merge(m, src)
+}
+func (m *UndelegateCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *UndelegateCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_UndelegateCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UndelegateCallback proto.InternalMessageInfo
+
+func (m *UndelegateCallback) GetHostZoneId() string {
+	if m != nil {
+		return m.HostZoneId
+	}
+	return ""
+}
+
+func (m *UndelegateCallback) GetSplitDelegations() []*SplitDelegation {
+	if m != nil {
+		return m.SplitDelegations
+	}
+	return nil
+}
+
+func (m *UndelegateCallback) GetEpochUnbondingRecordIds() []uint64 {
+	if m != nil {
+		return m.EpochUnbondingRecordIds
+	}
+	return nil
+}
+
+type Rebalancing struct {
+	SrcValidator string `protobuf:"bytes,1,opt,name=srcValidator,proto3" json:"srcValidator,omitempty"`
+	DstValidator string `protobuf:"bytes,2,opt,name=dstValidator,proto3" json:"dstValidator,omitempty"`
+	Amt          uint64 `protobuf:"varint,3,opt,name=amt,proto3" json:"amt,omitempty"`
+}
+
+func (m *Rebalancing) Reset()         { *m = Rebalancing{} }
+func (m *Rebalancing) String() string { return proto.CompactTextString(m) }
+func (*Rebalancing) ProtoMessage()    {}
+func (*Rebalancing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb485fe0920851d3, []int{3}
+}
+func (m *Rebalancing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Rebalancing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Rebalancing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Rebalancing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Rebalancing.Merge(m, src)
+}
+func (m *Rebalancing) XXX_Size() int {
+	return m.Size()
+}
+func (m *Rebalancing) XXX_DiscardUnknown() {
+	xxx_messageInfo_Rebalancing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Rebalancing proto.InternalMessageInfo
+
+func (m *Rebalancing) GetSrcValidator() string {
+	if m != nil {
+		return m.SrcValidator
+	}
+	return ""
+}
+
+func (m *Rebalancing) GetDstValidator() string {
+	if m != nil {
+		return m.DstValidator
+	}
+	return ""
+}
+
+func (m *Rebalancing) GetAmt() uint64 {
+	if m != nil {
+		return m.Amt
+	}
+	return 0
+}
+
+type RebalanceCallback struct {
+	HostZoneId   string         `protobuf:"bytes,1,opt,name=hostZoneId,proto3" json:"hostZoneId,omitempty"`
+	Rebalancings []*Rebalancing `protobuf:"bytes,2,rep,name=rebalancings,proto3" json:"rebalancings,omitempty"`
+}
+
+func (m *RebalanceCallback) Reset()         { *m = RebalanceCallback{} }
+func (m *RebalanceCallback) String() string { return proto.CompactTextString(m) }
+func (*RebalanceCallback) ProtoMessage()    {}
+func (*RebalanceCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb485fe0920851d3, []int{4}
+}
+func (m *RebalanceCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RebalanceCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RebalanceCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RebalanceCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebalanceCallback.Merge(m, src)
+}
+func (m *RebalanceCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *RebalanceCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebalanceCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebalanceCallback proto.InternalMessageInfo
+
+func (m *RebalanceCallback) GetHostZoneId() string {
+	if m != nil {
+		return m.HostZoneId
+	}
+	return ""
+}
+
+func (m *RebalanceCallback) GetRebalancings() []*Rebalancing {
+	if m != nil {
+		return m.Rebalancings
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SplitDelegation)(nil), "Stridelabs.stride.stakeibc.v2.SplitDelegation")
+	proto.RegisterType((*DelegateCallback)(nil), "Stridelabs.stride.stakeibc.v2.DelegateCallback")
+	proto.RegisterType((*UndelegateCallback)(nil), "Stridelabs.stride.stakeibc.v2.UndelegateCallback")
+	proto.RegisterType((*Rebalancing)(nil), "Stridelabs.stride.stakeibc.v2.Rebalancing")
+	proto.RegisterType((*RebalanceCallback)(nil), "Stridelabs.stride.stakeibc.v2.RebalanceCallback")
+}
+
+func init() {
+	proto.RegisterFile("stakeibc/migrations/v2/callbacks.proto", fileDescriptor_cb485fe0920851d3)
+}
+
+var fileDescriptor_cb485fe0920851d3 = []byte{
+	// 396 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xcd, 0xca, 0xd3, 0x40,
+	0x14, 0xed, 0x34, 0x1f, 0x1f, 0x7c, 0xb7, 0x85, 0xd6, 0x59, 0x68, 0x16, 0x1a, 0x4a, 0x16, 0x12,
+	0x04, 0x27, 0x50, 0x37, 0x2e, 0x5c, 0xa9, 0x20, 0x05, 0x71, 0x91, 0xd2, 0x2e, 0xba, 0x9b, 0x3f,
+	0xd2, 0xa1, 0xc9, 0x4c, 0xc8, 0x4c, 0x8b, 0xae, 0x7d, 0x01, 0x1f, 0xc7, 0x47, 0x10, 0x57, 0x5d,
+	0xba, 0x94, 0xf6, 0x45, 0xa4, 0xf9, 0xa1, 0x3f, 0x5a, 0x7f, 0xc0, 0xdd, 0xcd, 0xc9, 0xbd, 0x67,
+	0xce, 0x39, 0x97, 0x0b, 0x8f, 0xad, 0xa3, 0x2b, 0xa9, 0x18, 0x8f, 0x73, 0x95, 0x96, 0xd4, 0x29,
+	0xa3, 0x6d, 0xbc, 0x19, 0xc7, 0x9c, 0x66, 0x19, 0xa3, 0x7c, 0x65, 0x49, 0x51, 0x1a, 0x67, 0xf0,
+	0xa3, 0xa9, 0x2b, 0x95, 0x90, 0x19, 0x65, 0x96, 0xd8, 0xaa, 0x24, 0xed, 0x24, 0xd9, 0x8c, 0xc3,
+	0x37, 0x30, 0x98, 0x16, 0x99, 0x72, 0xaf, 0x65, 0x26, 0xd3, 0x8a, 0x05, 0x3f, 0x84, 0xbb, 0x0d,
+	0xcd, 0x94, 0xa0, 0xce, 0x94, 0x3e, 0x1a, 0xa1, 0xe8, 0x2e, 0x39, 0x02, 0xf8, 0x3e, 0xdc, 0xd2,
+	0xdc, 0xac, 0xb5, 0xf3, 0xbb, 0x23, 0x14, 0xdd, 0x24, 0xcd, 0x57, 0xf8, 0x19, 0xc1, 0xb0, 0x21,
+	0x91, 0xaf, 0x1a, 0x0d, 0x38, 0x00, 0x58, 0x1a, 0xeb, 0x16, 0x46, 0xcb, 0x89, 0x68, 0xb8, 0x4e,
+	0x10, 0x1c, 0xc1, 0x40, 0xc8, 0xc2, 0x58, 0xe5, 0x12, 0xc9, 0x4d, 0x29, 0x26, 0xa2, 0x61, 0xbd,
+	0x84, 0xf1, 0x02, 0x86, 0xf6, 0x5c, 0xa7, 0xf5, 0xbd, 0x91, 0x17, 0xf5, 0xc6, 0x84, 0xfc, 0xd6,
+	0x21, 0xb9, 0xb0, 0x97, 0xfc, 0xc4, 0x13, 0x7e, 0x45, 0x80, 0x67, 0x5a, 0xfc, 0xab, 0xf8, 0x5f,
+	0x49, 0xea, 0xfe, 0x1f, 0x49, 0xf8, 0x39, 0x3c, 0x90, 0x85, 0xe1, 0xcb, 0x99, 0x66, 0x46, 0x0b,
+	0xa5, 0xd3, 0x36, 0x88, 0xda, 0xf5, 0x4d, 0x72, 0xed, 0x77, 0x98, 0x42, 0x2f, 0x91, 0x8c, 0x66,
+	0x54, 0x73, 0xa5, 0x53, 0x1c, 0x42, 0xdf, 0x96, 0x7c, 0x7e, 0xb1, 0xcf, 0x33, 0xec, 0xd0, 0x23,
+	0xac, 0x3b, 0xf6, 0x74, 0xeb, 0x9e, 0x53, 0x0c, 0x0f, 0xc1, 0xa3, 0xb9, 0xf3, 0xbd, 0x6a, 0x3b,
+	0x87, 0x32, 0xfc, 0x88, 0xe0, 0x5e, 0xfb, 0xd2, 0xdf, 0x87, 0xf6, 0x0e, 0xfa, 0xe5, 0x51, 0x5e,
+	0x1b, 0xd8, 0x93, 0x3f, 0x04, 0x76, 0xe2, 0x28, 0x39, 0x9b, 0x7f, 0x39, 0xff, 0xb2, 0x0b, 0xd0,
+	0x76, 0x17, 0xa0, 0xef, 0xbb, 0x00, 0x7d, 0xda, 0x07, 0x9d, 0xed, 0x3e, 0xe8, 0x7c, 0xdb, 0x07,
+	0x9d, 0xc5, 0x8b, 0x54, 0xb9, 0xe5, 0x9a, 0x11, 0x6e, 0xf2, 0xb8, 0x66, 0x7f, 0xfa, 0x96, 0x32,
+	0x1b, 0xd7, 0xf4, 0xf1, 0xfb, 0xf8, 0xca, 0x01, 0xb9, 0x0f, 0x85, 0xb4, 0xec, 0xb6, 0xba, 0x9e,
+	0x67, 0x3f, 0x06, 0x00, 0xb1, 0x00, 0x65, 0x35, 0x67, 0x03, 0x00, 0x00,
+}
+
+func (m *SplitDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SplitDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SplitDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintCallbacks(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SplitDelegations) > 0 {
+		for iNdEx := len(m.SplitDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SplitDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCallbacks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.DepositRecordId != 0 {
+		i = encodeVarintCallbacks(dAtA, i, uint64(m.DepositRecordId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.HostZoneId) > 0 {
+		i -= len(m.HostZoneId)
+		copy(dAtA[i:], m.HostZoneId)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.HostZoneId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UndelegateCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UndelegateCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UndelegateCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochUnbondingRecordIds) > 0 {
+		dAtA2 := make([]byte, len(m.EpochUnbondingRecordIds)*10)
+		var j1 int
+		for _, num := range m.EpochUnbondingRecordIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintCallbacks(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SplitDelegations) > 0 {
+		for iNdEx := len(m.SplitDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SplitDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCallbacks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.HostZoneId) > 0 {
+		i -= len(m.HostZoneId)
+		copy(dAtA[i:], m.HostZoneId)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.HostZoneId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Rebalancing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Rebalancing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Rebalancing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amt != 0 {
+		i = encodeVarintCallbacks(dAtA, i, uint64(m.Amt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DstValidator) > 0 {
+		i -= len(m.DstValidator)
+		copy(dAtA[i:], m.DstValidator)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.DstValidator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SrcValidator) > 0 {
+		i -= len(m.SrcValidator)
+		copy(dAtA[i:], m.SrcValidator)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.SrcValidator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RebalanceCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RebalanceCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RebalanceCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rebalancings) > 0 {
+		for iNdEx := len(m.Rebalancings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rebalancings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCallbacks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.HostZoneId) > 0 {
+		i -= len(m.HostZoneId)
+		copy(dAtA[i:], m.HostZoneId)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.HostZoneId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCallbacks(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCallbacks(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SplitDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovCallbacks(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *DelegateCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HostZoneId)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	if m.DepositRecordId != 0 {
+		n += 1 + sovCallbacks(uint64(m.DepositRecordId))
+	}
+	if len(m.SplitDelegations) > 0 {
+		for _, e := range m.SplitDelegations {
+			l = e.Size()
+			n += 1 + l + sovCallbacks(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UndelegateCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HostZoneId)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	if len(m.SplitDelegations) > 0 {
+		for _, e := range m.SplitDelegations {
+			l = e.Size()
+			n += 1 + l + sovCallbacks(uint64(l))
+		}
+	}
+	if len(m.EpochUnbondingRecordIds) > 0 {
+		l = 0
+		for _, e := range m.EpochUnbondingRecordIds {
+			l += sovCallbacks(uint64(e))
+		}
+		n += 1 + sovCallbacks(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *Rebalancing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SrcValidator)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	l = len(m.DstValidator)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	if m.Amt != 0 {
+		n += 1 + sovCallbacks(uint64(m.Amt))
+	}
+	return n
+}
+
+func (m *RebalanceCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HostZoneId)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	if len(m.Rebalancings) > 0 {
+		for _, e := range m.Rebalancings {
+			l = e.Size()
+			n += 1 + l + sovCallbacks(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovCallbacks(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCallbacks(x uint64) (n int) {
+	return sovCallbacks(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SplitDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCallbacks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SplitDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SplitDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCallbacks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCallbacks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositRecordId", wireType)
+			}
+			m.DepositRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SplitDelegations = append(m.SplitDelegations, &SplitDelegation{})
+			if err := m.SplitDelegations[len(m.SplitDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCallbacks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UndelegateCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCallbacks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UndelegateCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UndelegateCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SplitDelegations = append(m.SplitDelegations, &SplitDelegation{})
+			if err := m.SplitDelegations[len(m.SplitDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCallbacks
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.EpochUnbondingRecordIds = append(m.EpochUnbondingRecordIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCallbacks
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCallbacks
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCallbacks
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.EpochUnbondingRecordIds) == 0 {
+					m.EpochUnbondingRecordIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCallbacks
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.EpochUnbondingRecordIds = append(m.EpochUnbondingRecordIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochUnbondingRecordIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCallbacks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Rebalancing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCallbacks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Rebalancing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Rebalancing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcValidator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcValidator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstValidator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstValidator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amt", wireType)
+			}
+			m.Amt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCallbacks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RebalanceCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCallbacks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RebalanceCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RebalanceCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rebalancings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rebalancings = append(m.Rebalancings, &Rebalancing{})
+			if err := m.Rebalancings[len(m.Rebalancings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCallbacks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCallbacks(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCallbacks
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCallbacks
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCallbacks
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCallbacks
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCallbacks        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCallbacks          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCallbacks = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1693 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stakeibc/migrations/v2/host_zone.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type HostZone struct {
+	ChainId                string                                 `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	ConnectionId           string                                 `protobuf:"bytes,2,opt,name=connectionId,proto3" json:"connectionId,omitempty"`
+	Bech32Prefix           string                                 `protobuf:"bytes,17,opt,name=bech32prefix,proto3" json:"bech32prefix,omitempty"`
+	TransferChannelId      string                                 `protobuf:"bytes,12,opt,name=transferChannelId,proto3" json:"transferChannelId,omitempty"`
+	Validators             []*Validator                           `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators,omitempty"`
+	BlacklistedValidators  []*Validator                           `protobuf:"bytes,4,rep,name=blacklistedValidators,proto3" json:"blacklistedValidators,omitempty"`
+	WithdrawalAccount      *ICAAccount                            `protobuf:"bytes,5,opt,name=withdrawalAccount,proto3" json:"withdrawalAccount,omitempty"`
+	FeeAccount             *ICAAccount                            `protobuf:"bytes,6,opt,name=feeAccount,proto3" json:"feeAccount,omitempty"`
+	DelegationAccount      *ICAAccount                            `protobuf:"bytes,7,opt,name=delegationAccount,proto3" json:"delegationAccount,omitempty"`
+	RedemptionAccount      *ICAAccount                            `protobuf:"bytes,16,opt,name=redemptionAccount,proto3" json:"redemptionAccount,omitempty"`
+	IBCDenom               string                                 `protobuf:"bytes,8,opt,name=IBCDenom,proto3" json:"IBCDenom,omitempty"`
+	HostDenom              string                                 `protobuf:"bytes,9,opt,name=HostDenom,proto3" json:"HostDenom,omitempty"`
+	LastRedemptionRate     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=LastRedemptionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"LastRedemptionRate"`
+	RedemptionRate         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=RedemptionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"RedemptionRate"`
+	UnbondingFrequency     uint64                                 `protobuf:"varint,14,opt,name=unbondingFrequency,proto3" json:"unbondingFrequency,omitempty"`
+	StakedBal              uint64                                 `protobuf:"varint,13,opt,name=stakedBal,proto3" json:"stakedBal,omitempty"`
+	Address                string                                 `protobuf:"bytes,18,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	DepositSchedule        *PipelineSchedule                      `protobuf:"bytes,19,opt,name=depositSchedule,proto3" json:"depositSchedule,omitempty"`
+	DelegateSchedule       *PipelineSchedule                      `protobuf:"bytes,20,opt,name=delegateSchedule,proto3" json:"delegateSchedule,omitempty"`
+	ReinvestSchedule       *PipelineSchedule                      `protobuf:"bytes,21,opt,name=reinvestSchedule,proto3" json:"reinvestSchedule,omitempty"`
+	RedemptionRateSchedule *PipelineSchedule                      `protobuf:"bytes,22,opt,name=redemptionRateSchedule,proto3" json:"redemptionRateSchedule,omitempty"`
+	RebalanceSchedule      *PipelineSchedule                      `protobuf:"bytes,23,opt,name=rebalanceSchedule,proto3" json:"rebalanceSchedule,omitempty"`
+}
+
+func (m *HostZone) Reset()         { *m = HostZone{} }
+func (m *HostZone) String() string { return proto.CompactTextString(m) }
+func (*HostZone) ProtoMessage()    {}
+func (*HostZone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edd4d70639caacf1, []int{0}
+}
+func (m *HostZone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostZone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostZone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HostZone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostZone.Merge(m, src)
+}
+func (m *HostZone) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostZone) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostZone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostZone proto.InternalMessageInfo
+
+func (m *HostZone) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *HostZone) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *HostZone) GetBech32Prefix() string {
+	if m != nil {
+		return m.Bech32Prefix
+	}
+	return ""
+}
+
+func (m *HostZone) GetTransferChannelId() string {
+	if m != nil {
+		return m.TransferChannelId
+	}
+	return ""
+}
+
+func (m *HostZone) GetValidators() []*Validator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *HostZone) GetBlacklistedValidators() []*Validator {
+	if m != nil {
+		return m.BlacklistedValidators
+	}
+	return nil
+}
+
+func (m *HostZone) GetWithdrawalAccount() *ICAAccount {
+	if m != nil {
+		return m.WithdrawalAccount
+	}
+	return nil
+}
+
+func (m *HostZone) GetFeeAccount() *ICAAccount {
+	if m != nil {
+		return m.FeeAccount
+	}
+	return nil
+}
+
+func (m *HostZone) GetDelegationAccount() *ICAAccount {
+	if m != nil {
+		return m.DelegationAccount
+	}
+	return nil
+}
+
+func (m *HostZone) GetRedemptionAccount() *ICAAccount {
+	if m != nil {
+		return m.RedemptionAccount
+	}
+	return nil
+}
+
+func (m *HostZone) GetIBCDenom() string {
+	if m != nil {
+		return m.IBCDenom
+	}
+	return ""
+}
+
+func (m *HostZone) GetHostDenom() string {
+	if m != nil {
+		return m.HostDenom
+	}
+	return ""
+}
+
+func (m *HostZone) GetUnbondingFrequency() uint64 {
+	if m != nil {
+		return m.UnbondingFrequency
+	}
+	return 0
+}
+
+func (m *HostZone) GetStakedBal() uint64 {
+	if m != nil {
+		return m.StakedBal
+	}
+	return 0
+}
+
+func (m *HostZone) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *HostZone) GetDepositSchedule() *PipelineSchedule {
+	if m != nil {
+		return m.DepositSchedule
+	}
+	return nil
+}
+
+func (m *HostZone) GetDelegateSchedule() *PipelineSchedule {
+	if m != nil {
+		return m.DelegateSchedule
+	}
+	return nil
+}
+
+func (m *HostZone) GetReinvestSchedule() *PipelineSchedule {
+	if m != nil {
+		return m.ReinvestSchedule
+	}
+	return nil
+}
+
+func (m *HostZone) GetRedemptionRateSchedule() *PipelineSchedule {
+	if m != nil {
+		return m.RedemptionRateSchedule
+	}
+	return nil
+}
+
+func (m *HostZone) GetRebalanceSchedule() *PipelineSchedule {
+	if m != nil {
+		return m.RebalanceSchedule
+	}
+	return nil
+}
+
+type PipelineSchedule struct {
+	Interval uint64 `protobuf:"varint,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	Offset   uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (m *PipelineSchedule) Reset()         { *m = PipelineSchedule{} }
+func (m *PipelineSchedule) String() string { return proto.CompactTextString(m) }
+func (*PipelineSchedule) ProtoMessage()    {}
+func (*PipelineSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edd4d70639caacf1, []int{1}
+}
+func (m *PipelineSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PipelineSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PipelineSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PipelineSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PipelineSchedule.Merge(m, src)
+}
+func (m *PipelineSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *PipelineSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_PipelineSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PipelineSchedule proto.InternalMessageInfo
+
+func (m *PipelineSchedule) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *PipelineSchedule) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*HostZone)(nil), "Stridelabs.stride.stakeibc.v2.HostZone")
+	proto.RegisterType((*PipelineSchedule)(nil), "Stridelabs.stride.stakeibc.v2.PipelineSchedule")
+}
+
+func init() {
+	proto.RegisterFile("stakeibc/migrations/v2/host_zone.proto", fileDescriptor_edd4d70639caacf1)
+}
+
+var fileDescriptor_edd4d70639caacf1 = []byte{
+	// 711 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xcd, 0x4e, 0x1b, 0x31,
+	0x10, 0xc7, 0x93, 0x92, 0x42, 0x30, 0x14, 0x82, 0x0b, 0x74, 0x8b, 0xda, 0x10, 0xe5, 0x80, 0x52,
+	0x09, 0x76, 0xa5, 0x70, 0xab, 0xb8, 0x10, 0x10, 0x22, 0x15, 0x87, 0x6a, 0x91, 0xa8, 0x4a, 0xd5,
+	0x22, 0xaf, 0x3d, 0x49, 0x2c, 0x36, 0x76, 0xba, 0x76, 0x02, 0xf4, 0x05, 0x7a, 0xed, 0xc3, 0xf4,
+	0x21, 0x38, 0xa2, 0x9e, 0xaa, 0x1e, 0x50, 0x05, 0x6f, 0xd0, 0x27, 0xa8, 0xd6, 0xbb, 0xf9, 0x20,
+	0x21, 0x45, 0x89, 0x7a, 0xda, 0x9d, 0x99, 0xff, 0xfc, 0x66, 0x64, 0x7b, 0x34, 0x68, 0x4d, 0x69,
+	0x72, 0x0a, 0xdc, 0xa3, 0x4e, 0x9d, 0x57, 0x03, 0xa2, 0xb9, 0x14, 0xca, 0x69, 0x15, 0x9d, 0x9a,
+	0x54, 0xfa, 0xe4, 0x8b, 0x14, 0x60, 0x37, 0x02, 0xa9, 0x25, 0x7e, 0x79, 0xa8, 0x03, 0xce, 0xc0,
+	0x27, 0x9e, 0xb2, 0x95, 0xf9, 0xb5, 0xdb, 0x99, 0x76, 0xab, 0xb8, 0x32, 0x0c, 0xd3, 0x22, 0x3e,
+	0x67, 0x44, 0xcb, 0x20, 0xc2, 0xac, 0x14, 0x86, 0xe8, 0x38, 0x25, 0x27, 0x84, 0x52, 0xd9, 0x14,
+	0x3a, 0x56, 0x2e, 0x56, 0x65, 0x55, 0x9a, 0x5f, 0x27, 0xfc, 0x8b, 0xbd, 0xcf, 0xa9, 0x54, 0x75,
+	0xa9, 0x4e, 0xa2, 0x40, 0x64, 0x44, 0xa1, 0xfc, 0xd7, 0x59, 0x94, 0xde, 0x97, 0x4a, 0x1f, 0x4b,
+	0x01, 0xd8, 0x42, 0x53, 0xb4, 0x46, 0xb8, 0x28, 0x33, 0x2b, 0x99, 0x4b, 0x16, 0xa6, 0xdd, 0xb6,
+	0x89, 0xf3, 0x68, 0x96, 0x4a, 0x21, 0x80, 0x86, 0xb5, 0xcb, 0xcc, 0x7a, 0x64, 0xc2, 0x77, 0x7c,
+	0xa1, 0xc6, 0x03, 0x5a, 0xdb, 0x2c, 0x36, 0x02, 0xa8, 0xf0, 0x73, 0x6b, 0x21, 0xd2, 0xf4, 0xfa,
+	0xf0, 0x3a, 0x5a, 0xd0, 0x01, 0x11, 0xaa, 0x02, 0xc1, 0x4e, 0x8d, 0x08, 0x01, 0x7e, 0x99, 0x59,
+	0xb3, 0x46, 0x38, 0x18, 0xc0, 0xfb, 0x08, 0x75, 0x8e, 0x42, 0x59, 0x13, 0xb9, 0x89, 0xc2, 0x4c,
+	0xb1, 0x60, 0xff, 0xf3, 0x4c, 0xed, 0xa3, 0x76, 0x82, 0xdb, 0x93, 0x8b, 0x3f, 0xa1, 0x25, 0xcf,
+	0x27, 0xf4, 0xd4, 0xe7, 0x4a, 0x03, 0x3b, 0xea, 0x42, 0x53, 0x23, 0x42, 0xef, 0xc7, 0xe0, 0x77,
+	0x68, 0xe1, 0x8c, 0xeb, 0x1a, 0x0b, 0xc8, 0x19, 0xf1, 0xb7, 0xa3, 0x2b, 0xb1, 0x1e, 0xe7, 0x92,
+	0x85, 0x99, 0xe2, 0xab, 0x07, 0xd8, 0xe5, 0x9d, 0xed, 0x38, 0xc1, 0x1d, 0x64, 0xe0, 0x32, 0x42,
+	0x15, 0x80, 0x36, 0x71, 0x72, 0x54, 0x62, 0x4f, 0x72, 0xd8, 0x23, 0x03, 0x1f, 0xaa, 0xe6, 0xfd,
+	0xb4, 0x89, 0x53, 0x23, 0xf7, 0x38, 0xc0, 0x08, 0xc1, 0x01, 0x30, 0xa8, 0x37, 0x7a, 0xc1, 0x99,
+	0x91, 0xc1, 0x03, 0x0c, 0xbc, 0x82, 0xd2, 0xe5, 0xd2, 0xce, 0x2e, 0x08, 0x59, 0xb7, 0xd2, 0xe6,
+	0x91, 0x74, 0x6c, 0xfc, 0x02, 0x4d, 0x87, 0xef, 0x36, 0x0a, 0x4e, 0x9b, 0x60, 0xd7, 0x81, 0x7d,
+	0x84, 0x0f, 0x88, 0xd2, 0x6e, 0x07, 0xe9, 0x12, 0x0d, 0x16, 0x0a, 0x65, 0xa5, 0xad, 0xcb, 0xeb,
+	0xd5, 0xc4, 0xaf, 0xeb, 0xd5, 0xb5, 0x2a, 0xd7, 0xb5, 0xa6, 0x67, 0x53, 0x59, 0x8f, 0x67, 0x22,
+	0xfe, 0x6c, 0x28, 0x76, 0xea, 0xe8, 0x8b, 0x06, 0x28, 0x7b, 0x17, 0xe8, 0x8f, 0xef, 0x1b, 0x28,
+	0xf2, 0x87, 0x96, 0x7b, 0x0f, 0x17, 0x33, 0x34, 0xd7, 0x57, 0x69, 0xe6, 0x3f, 0x54, 0xea, 0x63,
+	0x62, 0x1b, 0xe1, 0xa6, 0xf0, 0xa4, 0x60, 0x5c, 0x54, 0xf7, 0x02, 0xf8, 0xdc, 0x04, 0x41, 0x2f,
+	0xac, 0xb9, 0x5c, 0xb2, 0x90, 0x72, 0xef, 0x89, 0x84, 0x27, 0x64, 0x8e, 0x9a, 0x95, 0x88, 0x6f,
+	0x3d, 0x31, 0xb2, 0xae, 0x03, 0xaf, 0xa3, 0x29, 0xc2, 0x58, 0x00, 0x4a, 0x59, 0xd8, 0x34, 0x8b,
+	0xff, 0x5c, 0xaf, 0xce, 0x5d, 0x90, 0xba, 0xff, 0x3a, 0x1f, 0x07, 0xf2, 0x6e, 0x5b, 0x82, 0xdf,
+	0xa3, 0x79, 0x06, 0x0d, 0xa9, 0xb8, 0x3e, 0xa4, 0x35, 0x60, 0x4d, 0x1f, 0xac, 0xa7, 0xe6, 0x82,
+	0x9d, 0x07, 0x2e, 0xf8, 0x2d, 0x6f, 0x80, 0xcf, 0x05, 0xb4, 0xd3, 0xdc, 0x7e, 0x0e, 0xfe, 0x80,
+	0x32, 0xf1, 0x93, 0xea, 0x88, 0xac, 0xc5, 0xf1, 0xd8, 0x03, 0xa0, 0x10, 0x1e, 0x00, 0x17, 0x2d,
+	0x50, 0xdd, 0xc6, 0x97, 0xc6, 0x84, 0xf7, 0x83, 0x70, 0x15, 0x2d, 0x07, 0x77, 0xae, 0xa8, 0x53,
+	0x62, 0x79, 0xbc, 0x12, 0x43, 0x70, 0xf8, 0x63, 0x38, 0x60, 0x1e, 0xf1, 0x89, 0xa0, 0xdd, 0x1a,
+	0xcf, 0xc6, 0xab, 0x31, 0x48, 0x7a, 0x93, 0x4a, 0xcf, 0x67, 0x32, 0xf9, 0x3d, 0x94, 0xe9, 0x17,
+	0x87, 0x03, 0xc8, 0x85, 0x86, 0xa0, 0x45, 0x7c, 0xb3, 0x11, 0x52, 0x6e, 0xc7, 0xc6, 0xcb, 0x68,
+	0x52, 0x56, 0x2a, 0x0a, 0xb4, 0x59, 0x06, 0x29, 0x37, 0xb6, 0x4a, 0x47, 0x97, 0x37, 0xd9, 0xe4,
+	0xd5, 0x4d, 0x36, 0xf9, 0xfb, 0x26, 0x9b, 0xfc, 0x76, 0x9b, 0x4d, 0x5c, 0xdd, 0x66, 0x13, 0x3f,
+	0x6f, 0xb3, 0x89, 0xe3, 0xad, 0x9e, 0x31, 0x88, 0xba, 0xde, 0x38, 0x20, 0x9e, 0x72, 0xa2, 0xb6,
+	0x9d, 0x73, 0x67, 0xc8, 0x9a, 0x33, 0x03, 0xe2, 0x4d, 0x9a, 0x85, 0xb5, 0xf9, 0x77, 0x00, 0x1b,
+	0x6d, 0x32, 0x2d, 0x7c, 0x07, 0x00, 0x00,
+}
+
+func (m *HostZone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostZone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RebalanceSchedule != nil {
+		{
+			size, err := m.RebalanceSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.RedemptionRateSchedule != nil {
+		{
+			size, err := m.RedemptionRateSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.ReinvestSchedule != nil {
+		{
+			size, err := m.ReinvestSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.DelegateSchedule != nil {
+		{
+			size, err := m.DelegateSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.DepositSchedule != nil {
+		{
+			size, err := m.DepositSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintHostZone(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Bech32Prefix) > 0 {
+		i -= len(m.Bech32Prefix)
+		copy(dAtA[i:], m.Bech32Prefix)
+		i = encodeVarintHostZone(dAtA, i, uint64(len(m.Bech32Prefix)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.RedemptionAccount != nil {
+		{
+			size, err := m.RedemptionAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.UnbondingFrequency != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.UnbondingFrequency))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.StakedBal != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.StakedBal))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.TransferChannelId) > 0 {
+		i -= len(m.TransferChannelId)
+		copy(dAtA[i:], m.TransferChannelId)
+		i = encodeVarintHostZone(dAtA, i, uint64(len(m.TransferChannelId)))
+		i--
+		dAtA[i] = 0x62
+	}
+	{
+		size := m.RedemptionRate.Size()
+		i -= size
+		if _, err := m.RedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.LastRedemptionRate.Size()
+		i -= size
+		if _, err := m.LastRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.HostDenom) > 0 {
+		i -= len(m.HostDenom)
+		copy(dAtA[i:], m.HostDenom)
+		i = encodeVarintHostZone(dAtA, i, uint64(len(m.HostDenom)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.IBCDenom) > 0 {
+		i -= len(m.IBCDenom)
+		copy(dAtA[i:], m.IBCDenom)
+		i = encodeVarintHostZone(dAtA, i, uint64(len(m.IBCDenom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.DelegationAccount != nil {
+		{
+			size, err := m.DelegationAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.FeeAccount != nil {
+		{
+			size, err := m.FeeAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.WithdrawalAccount != nil {
+		{
+			size, err := m.WithdrawalAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BlacklistedValidators) > 0 {
+		for iNdEx := len(m.BlacklistedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlacklistedValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHostZone(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHostZone(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintHostZone(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintHostZone(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PipelineSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PipelineSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PipelineSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Interval != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHostZone(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHostZone(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HostZone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovHostZone(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovHostZone(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovHostZone(uint64(l))
+		}
+	}
+	if len(m.BlacklistedValidators) > 0 {
+		for _, e := range m.BlacklistedValidators {
+			l = e.Size()
+			n += 1 + l + sovHostZone(uint64(l))
+		}
+	}
+	if m.WithdrawalAccount != nil {
+		l = m.WithdrawalAccount.Size()
+		n += 1 + l + sovHostZone(uint64(l))
+	}
+	if m.FeeAccount != nil {
+		l = m.FeeAccount.Size()
+		n += 1 + l + sovHostZone(uint64(l))
+	}
+	if m.DelegationAccount != nil {
+		l = m.DelegationAccount.Size()
+		n += 1 + l + sovHostZone(uint64(l))
+	}
+	l = len(m.IBCDenom)
+	if l > 0 {
+		n += 1 + l + sovHostZone(uint64(l))
+	}
+	l = len(m.HostDenom)
+	if l > 0 {
+		n += 1 + l + sovHostZone(uint64(l))
+	}
+	l = m.LastRedemptionRate.Size()
+	n += 1 + l + sovHostZone(uint64(l))
+	l = m.RedemptionRate.Size()
+	n += 1 + l + sovHostZone(uint64(l))
+	l = len(m.TransferChannelId)
+	if l > 0 {
+		n += 1 + l + sovHostZone(uint64(l))
+	}
+	if m.StakedBal != 0 {
+		n += 1 + sovHostZone(uint64(m.StakedBal))
+	}
+	if m.UnbondingFrequency != 0 {
+		n += 1 + sovHostZone(uint64(m.UnbondingFrequency))
+	}
+	if m.RedemptionAccount != nil {
+		l = m.RedemptionAccount.Size()
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	l = len(m.Bech32Prefix)
+	if l > 0 {
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	if m.DepositSchedule != nil {
+		l = m.DepositSchedule.Size()
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	if m.DelegateSchedule != nil {
+		l = m.DelegateSchedule.Size()
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	if m.ReinvestSchedule != nil {
+		l = m.ReinvestSchedule.Size()
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	if m.RedemptionRateSchedule != nil {
+		l = m.RedemptionRateSchedule.Size()
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	if m.RebalanceSchedule != nil {
+		l = m.RebalanceSchedule.Size()
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	return n
+}
+
+func (m *PipelineSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Interval != 0 {
+		n += 1 + sovHostZone(uint64(m.Interval))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovHostZone(uint64(m.Offset))
+	}
+	return n
+}
+
+func sovHostZone(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHostZone(x uint64) (n int) {
+	return sovHostZone(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HostZone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHostZone
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostZone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostZone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistedValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlacklistedValidators = append(m.BlacklistedValidators, &Validator{})
+			if err := m.BlacklistedValidators[len(m.BlacklistedValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WithdrawalAccount == nil {
+				m.WithdrawalAccount = &ICAAccount{}
+			}
+			if err := m.WithdrawalAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeAccount == nil {
+				m.FeeAccount = &ICAAccount{}
+			}
+			if err := m.FeeAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DelegationAccount == nil {
+				m.DelegationAccount = &ICAAccount{}
+			}
+			if err := m.DelegationAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IBCDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRedemptionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedBal", wireType)
+			}
+			m.StakedBal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakedBal |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingFrequency", wireType)
+			}
+			m.UnbondingFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingFrequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RedemptionAccount == nil {
+				m.RedemptionAccount = &ICAAccount{}
+			}
+			if err := m.RedemptionAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bech32Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bech32Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DepositSchedule == nil {
+				m.DepositSchedule = &PipelineSchedule{}
+			}
+			if err := m.DepositSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DelegateSchedule == nil {
+				m.DelegateSchedule = &PipelineSchedule{}
+			}
+			if err := m.DelegateSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReinvestSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReinvestSchedule == nil {
+				m.ReinvestSchedule = &PipelineSchedule{}
+			}
+			if err := m.ReinvestSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRateSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RedemptionRateSchedule == nil {
+				m.RedemptionRateSchedule = &PipelineSchedule{}
+			}
+			if err := m.RedemptionRateSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalanceSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RebalanceSchedule == nil {
+				m.RebalanceSchedule = &PipelineSchedule{}
+			}
+			if err := m.RebalanceSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHostZone(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PipelineSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHostZone
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PipelineSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PipelineSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHostZone(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHostZone(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHostZone
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHostZone
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHostZone
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHostZone
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHostZone        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHostZone          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHostZone = fmt.Errorf("proto: unexpected end of group")
+)
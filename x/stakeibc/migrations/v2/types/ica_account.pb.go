@@ -0,0 +1,726 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stakeibc/migrations/v2/ica_account.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ICAAccountType int32
+
+const (
+	ICAAccountType_DELEGATION ICAAccountType = 0
+	ICAAccountType_FEE        ICAAccountType = 1
+	ICAAccountType_WITHDRAWAL ICAAccountType = 2
+	ICAAccountType_REDEMPTION ICAAccountType = 3
+)
+
+var ICAAccountType_name = map[int32]string{
+	0: "DELEGATION",
+	1: "FEE",
+	2: "WITHDRAWAL",
+	3: "REDEMPTION",
+}
+
+var ICAAccountType_value = map[string]int32{
+	"DELEGATION": 0,
+	"FEE":        1,
+	"WITHDRAWAL": 2,
+	"REDEMPTION": 3,
+}
+
+func (x ICAAccountType) String() string {
+	return proto.EnumName(ICAAccountType_name, int32(x))
+}
+
+func (ICAAccountType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5b756dd08c71e1bd, []int{0}
+}
+
+type Delegation struct {
+	DelegateAcctAddress string     `protobuf:"bytes,1,opt,name=delegateAcctAddress,proto3" json:"delegateAcctAddress,omitempty"`
+	Validator           *Validator `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	Amt                 int64      `protobuf:"varint,3,opt,name=amt,proto3" json:"amt,omitempty"`
+}
+
+func (m *Delegation) Reset()         { *m = Delegation{} }
+func (m *Delegation) String() string { return proto.CompactTextString(m) }
+func (*Delegation) ProtoMessage()    {}
+func (*Delegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b756dd08c71e1bd, []int{0}
+}
+func (m *Delegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Delegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Delegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Delegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Delegation.Merge(m, src)
+}
+func (m *Delegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Delegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Delegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Delegation proto.InternalMessageInfo
+
+func (m *Delegation) GetDelegateAcctAddress() string {
+	if m != nil {
+		return m.DelegateAcctAddress
+	}
+	return ""
+}
+
+func (m *Delegation) GetValidator() *Validator {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
+func (m *Delegation) GetAmt() int64 {
+	if m != nil {
+		return m.Amt
+	}
+	return 0
+}
+
+type ICAAccount struct {
+	Address     string         `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Delegations []*Delegation  `protobuf:"bytes,2,rep,name=delegations,proto3" json:"delegations,omitempty"`
+	Target      ICAAccountType `protobuf:"varint,3,opt,name=target,proto3,enum=Stridelabs.stride.stakeibc.v2.ICAAccountType" json:"target,omitempty"`
+}
+
+func (m *ICAAccount) Reset()         { *m = ICAAccount{} }
+func (m *ICAAccount) String() string { return proto.CompactTextString(m) }
+func (*ICAAccount) ProtoMessage()    {}
+func (*ICAAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b756dd08c71e1bd, []int{1}
+}
+func (m *ICAAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ICAAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ICAAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ICAAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ICAAccount.Merge(m, src)
+}
+func (m *ICAAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ICAAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ICAAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ICAAccount proto.InternalMessageInfo
+
+func (m *ICAAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ICAAccount) GetDelegations() []*Delegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *ICAAccount) GetTarget() ICAAccountType {
+	if m != nil {
+		return m.Target
+	}
+	return ICAAccountType_DELEGATION
+}
+
+func init() {
+	proto.RegisterEnum("Stridelabs.stride.stakeibc.v2.ICAAccountType", ICAAccountType_name, ICAAccountType_value)
+	proto.RegisterType((*Delegation)(nil), "Stridelabs.stride.stakeibc.v2.Delegation")
+	proto.RegisterType((*ICAAccount)(nil), "Stridelabs.stride.stakeibc.v2.ICAAccount")
+}
+
+func init() {
+	proto.RegisterFile("stakeibc/migrations/v2/ica_account.proto", fileDescriptor_5b756dd08c71e1bd)
+}
+
+var fileDescriptor_5b756dd08c71e1bd = []byte{
+	// 400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
+	0x14, 0xc6, 0x33, 0x0d, 0xdc, 0xcb, 0x9d, 0x42, 0x09, 0xa3, 0x8b, 0x78, 0xc1, 0x10, 0xee, 0x42,
+	0xa2, 0xd0, 0x8c, 0xc4, 0xad, 0x9b, 0x5c, 0x93, 0x6a, 0xb0, 0xfe, 0x61, 0x2c, 0x2d, 0xb8, 0x29,
+	0x93, 0xc9, 0x10, 0x83, 0x4d, 0xa7, 0x64, 0xa6, 0xc5, 0xbe, 0x85, 0x4b, 0x1f, 0xc4, 0x87, 0x70,
+	0x59, 0xc4, 0x85, 0x4b, 0x69, 0x5f, 0x44, 0x92, 0xe9, 0x3f, 0xc1, 0xde, 0xee, 0xe6, 0xe3, 0x3b,
+	0xdf, 0x99, 0xdf, 0x39, 0x1c, 0xe8, 0x49, 0x45, 0x3f, 0xf3, 0x22, 0x65, 0xb8, 0x2c, 0xf2, 0x8a,
+	0xaa, 0x42, 0x4c, 0x25, 0x5e, 0x04, 0xb8, 0x60, 0x74, 0x4c, 0x19, 0x13, 0xf3, 0xa9, 0xf2, 0x67,
+	0x95, 0x50, 0x02, 0x3d, 0xfc, 0xa0, 0xaa, 0x22, 0xe3, 0x13, 0x9a, 0x4a, 0x5f, 0x36, 0x4f, 0x7f,
+	0x97, 0xf5, 0x17, 0xc1, 0xf5, 0xa3, 0x13, 0x8d, 0x16, 0x74, 0x52, 0x64, 0x54, 0x89, 0x4a, 0xb7,
+	0xb9, 0x7e, 0xc0, 0x84, 0x2c, 0x85, 0x1c, 0x37, 0x0a, 0x6b, 0xa1, 0xad, 0x9b, 0x6f, 0x00, 0xc2,
+	0x88, 0x4f, 0x78, 0xde, 0xa4, 0xd1, 0x53, 0x78, 0x2f, 0xd3, 0x8a, 0x87, 0x8c, 0xa9, 0x30, 0xcb,
+	0x2a, 0x2e, 0xa5, 0x0d, 0x5c, 0xe0, 0x5d, 0x91, 0xff, 0x59, 0xa8, 0x07, 0xaf, 0xf6, 0xdf, 0xd9,
+	0x2d, 0x17, 0x78, 0xed, 0xc0, 0xf3, 0xef, 0xc4, 0xf6, 0x87, 0xbb, 0x7a, 0x72, 0x88, 0x22, 0x0b,
+	0x9a, 0xb4, 0x54, 0xb6, 0xe9, 0x02, 0xcf, 0x24, 0xf5, 0xf3, 0xe6, 0x17, 0x80, 0x30, 0x79, 0x11,
+	0x86, 0x7a, 0x23, 0x28, 0x80, 0x97, 0xf4, 0x18, 0xe7, 0xd6, 0xfe, 0xf9, 0xbd, 0x7b, 0x7f, 0x3b,
+	0xcc, 0x96, 0xa6, 0xfe, 0x77, 0x9a, 0x93, 0x5d, 0x21, 0x7a, 0x0d, 0xdb, 0xd9, 0x7e, 0x38, 0x69,
+	0xb7, 0x5c, 0xd3, 0x6b, 0x07, 0x8f, 0xcf, 0xe0, 0x1d, 0xd6, 0x41, 0x8e, 0xd3, 0x28, 0x86, 0x17,
+	0x8a, 0x56, 0x39, 0xd7, 0x90, 0x9d, 0xa0, 0x7b, 0xa6, 0xcf, 0x81, 0x7d, 0xb0, 0x9c, 0x71, 0xb2,
+	0x0d, 0x3f, 0x49, 0x60, 0xe7, 0x5f, 0x07, 0x75, 0x20, 0x8c, 0xe2, 0x7e, 0xfc, 0x32, 0x1c, 0x24,
+	0xef, 0xde, 0x5a, 0x06, 0xba, 0x84, 0x66, 0x2f, 0x8e, 0x2d, 0x50, 0x1b, 0xa3, 0x64, 0xf0, 0x2a,
+	0x22, 0xe1, 0x28, 0xec, 0x5b, 0xad, 0x5a, 0x93, 0x38, 0x8a, 0xdf, 0xbc, 0x6f, 0x0a, 0xcd, 0xdb,
+	0xe1, 0x8f, 0xb5, 0x03, 0x56, 0x6b, 0x07, 0xfc, 0x59, 0x3b, 0xe0, 0xeb, 0xc6, 0x31, 0x56, 0x1b,
+	0xc7, 0xf8, 0xbd, 0x71, 0x8c, 0x8f, 0xcf, 0xf3, 0x42, 0x7d, 0x9a, 0xa7, 0x3e, 0x13, 0x25, 0xd6,
+	0x94, 0xdd, 0x3e, 0x4d, 0x25, 0xd6, 0x98, 0xf8, 0x0b, 0x3e, 0x71, 0x39, 0x6a, 0x39, 0xe3, 0x32,
+	0xbd, 0x68, 0x6e, 0xe3, 0xd9, 0xdf, 0x01, 0x00, 0x8e, 0x76, 0x3d, 0xc6, 0xa9, 0x02, 0x00, 0x00,
+}
+
+func (m *Delegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Delegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amt != 0 {
+		i = encodeVarintIcaAccount(dAtA, i, uint64(m.Amt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Validator != nil {
+		{
+			size, err := m.Validator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIcaAccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegateAcctAddress) > 0 {
+		i -= len(m.DelegateAcctAddress)
+		copy(dAtA[i:], m.DelegateAcctAddress)
+		i = encodeVarintIcaAccount(dAtA, i, uint64(len(m.DelegateAcctAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ICAAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ICAAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ICAAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Target != 0 {
+		i = encodeVarintIcaAccount(dAtA, i, uint64(m.Target))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIcaAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintIcaAccount(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIcaAccount(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIcaAccount(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Delegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegateAcctAddress)
+	if l > 0 {
+		n += 1 + l + sovIcaAccount(uint64(l))
+	}
+	if m.Validator != nil {
+		l = m.Validator.Size()
+		n += 1 + l + sovIcaAccount(uint64(l))
+	}
+	if m.Amt != 0 {
+		n += 1 + sovIcaAccount(uint64(m.Amt))
+	}
+	return n
+}
+
+func (m *ICAAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovIcaAccount(uint64(l))
+	}
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovIcaAccount(uint64(l))
+		}
+	}
+	if m.Target != 0 {
+		n += 1 + sovIcaAccount(uint64(m.Target))
+	}
+	return n
+}
+
+func sovIcaAccount(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIcaAccount(x uint64) (n int) {
+	return sovIcaAccount(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Delegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcaAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateAcctAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateAcctAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIcaAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Validator == nil {
+				m.Validator = &Validator{}
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amt", wireType)
+			}
+			m.Amt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcaAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcaAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ICAAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcaAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ICAAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ICAAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIcaAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, &Delegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			m.Target = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Target |= ICAAccountType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcaAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcaAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIcaAccount(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIcaAccount
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIcaAccount
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIcaAccount
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIcaAccount
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIcaAccount
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIcaAccount
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIcaAccount        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIcaAccount          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIcaAccount = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1046 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stakeibc/migrations/v2/validator.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type Validator_ValidatorStatus int32
+
+const (
+	Validator_Active   Validator_ValidatorStatus = 0
+	Validator_Inactive Validator_ValidatorStatus = 1
+	Validator_Removing Validator_ValidatorStatus = 2
+)
+
+var Validator_ValidatorStatus_name = map[int32]string{
+	0: "Active",
+	1: "Inactive",
+	2: "Removing",
+}
+
+var Validator_ValidatorStatus_value = map[string]int32{
+	"Active":   0,
+	"Inactive": 1,
+	"Removing": 2,
+}
+
+func (x Validator_ValidatorStatus) String() string {
+	return proto.EnumName(Validator_ValidatorStatus_name, int32(x))
+}
+
+func (Validator_ValidatorStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_de32a7616abbd200, []int{1, 0}
+}
+
+type ValidatorExchangeRate struct {
+	InternalTokensToSharesRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=internalTokensToSharesRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"internalTokensToSharesRate"`
+	EpochNumber                uint64                                 `protobuf:"varint,2,opt,name=epochNumber,proto3" json:"epochNumber,omitempty"`
+}
+
+func (m *ValidatorExchangeRate) Reset()         { *m = ValidatorExchangeRate{} }
+func (m *ValidatorExchangeRate) String() string { return proto.CompactTextString(m) }
+func (*ValidatorExchangeRate) ProtoMessage()    {}
+func (*ValidatorExchangeRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de32a7616abbd200, []int{0}
+}
+func (m *ValidatorExchangeRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorExchangeRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorExchangeRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorExchangeRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorExchangeRate.Merge(m, src)
+}
+func (m *ValidatorExchangeRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorExchangeRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorExchangeRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorExchangeRate proto.InternalMessageInfo
+
+func (m *ValidatorExchangeRate) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+type Validator struct {
+	Name                 string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address              string                    `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Status               Validator_ValidatorStatus `protobuf:"varint,3,opt,name=status,proto3,enum=Stridelabs.stride.stakeibc.v2.Validator_ValidatorStatus" json:"status,omitempty"`
+	CommissionRate       uint64                    `protobuf:"varint,4,opt,name=commissionRate,proto3" json:"commissionRate,omitempty"`
+	DelegationAmt        uint64                    `protobuf:"varint,5,opt,name=delegationAmt,proto3" json:"delegationAmt,omitempty"`
+	Weight               uint64                    `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	InternalExchangeRate *ValidatorExchangeRate    `protobuf:"bytes,7,opt,name=internalExchangeRate,proto3" json:"internalExchangeRate,omitempty"`
+}
+
+func (m *Validator) Reset()         { *m = Validator{} }
+func (m *Validator) String() string { return proto.CompactTextString(m) }
+func (*Validator) ProtoMessage()    {}
+func (*Validator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de32a7616abbd200, []int{1}
+}
+func (m *Validator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Validator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Validator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Validator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Validator.Merge(m, src)
+}
+func (m *Validator) XXX_Size() int {
+	return m.Size()
+}
+func (m *Validator) XXX_DiscardUnknown() {
+	xxx_messageInfo_Validator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Validator proto.InternalMessageInfo
+
+func (m *Validator) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Validator) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Validator) GetStatus() Validator_ValidatorStatus {
+	if m != nil {
+		return m.Status
+	}
+	return Validator_Active
+}
+
+func (m *Validator) GetCommissionRate() uint64 {
+	if m != nil {
+		return m.CommissionRate
+	}
+	return 0
+}
+
+func (m *Validator) GetDelegationAmt() uint64 {
+	if m != nil {
+		return m.DelegationAmt
+	}
+	return 0
+}
+
+func (m *Validator) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *Validator) GetInternalExchangeRate() *ValidatorExchangeRate {
+	if m != nil {
+		return m.InternalExchangeRate
+	}
+	return nil
+}
+
+type PendingValidator struct {
+	ChainId   string    `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Validator Validator `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator"`
+}
+
+func (m *PendingValidator) Reset()         { *m = PendingValidator{} }
+func (m *PendingValidator) String() string { return proto.CompactTextString(m) }
+func (*PendingValidator) ProtoMessage()    {}
+func (*PendingValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de32a7616abbd200, []int{2}
+}
+func (m *PendingValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingValidator.Merge(m, src)
+}
+func (m *PendingValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingValidator proto.InternalMessageInfo
+
+func (m *PendingValidator) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *PendingValidator) GetValidator() Validator {
+	if m != nil {
+		return m.Validator
+	}
+	return Validator{}
+}
+
+func init() {
+	proto.RegisterEnum("Stridelabs.stride.stakeibc.v2.Validator_ValidatorStatus", Validator_ValidatorStatus_name, Validator_ValidatorStatus_value)
+	proto.RegisterType((*ValidatorExchangeRate)(nil), "Stridelabs.stride.stakeibc.v2.ValidatorExchangeRate")
+	proto.RegisterType((*Validator)(nil), "Stridelabs.stride.stakeibc.v2.Validator")
+	proto.RegisterType((*PendingValidator)(nil), "Stridelabs.stride.stakeibc.v2.PendingValidator")
+}
+
+func init() {
+	proto.RegisterFile("stakeibc/migrations/v2/validator.proto", fileDescriptor_de32a7616abbd200)
+}
+
+var fileDescriptor_de32a7616abbd200 = []byte{
+	// 523 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x6b, 0x13, 0x41,
+	0x14, 0xcf, 0xb6, 0x31, 0x31, 0x2f, 0x5a, 0xc3, 0x10, 0x65, 0x0d, 0xb8, 0x0d, 0x41, 0x42, 0x2e,
+	0xd9, 0x85, 0xd5, 0x83, 0x42, 0x2f, 0x09, 0xf5, 0x50, 0x28, 0x52, 0x36, 0xa5, 0x07, 0x2f, 0x32,
+	0x3b, 0x3b, 0xcc, 0x0e, 0xc9, 0xce, 0x84, 0x9d, 0x49, 0xac, 0xe2, 0x87, 0xf0, 0xa3, 0x78, 0x28,
+	0xf8, 0x15, 0x7a, 0x2c, 0x3d, 0x89, 0x87, 0x22, 0xc9, 0x17, 0x91, 0xcc, 0xee, 0x26, 0xb1, 0x68,
+	0xe9, 0x69, 0xdf, 0xdf, 0xdf, 0xfe, 0xde, 0xfb, 0xbd, 0x81, 0xae, 0xd2, 0x78, 0x4c, 0x79, 0x48,
+	0xbc, 0x84, 0xb3, 0x14, 0x6b, 0x2e, 0x85, 0xf2, 0xe6, 0xbe, 0x37, 0xc7, 0x13, 0x1e, 0x61, 0x2d,
+	0x53, 0x77, 0x9a, 0x4a, 0x2d, 0xd1, 0x8b, 0x91, 0x4e, 0x79, 0x44, 0x27, 0x38, 0x54, 0xae, 0x32,
+	0xa6, 0x5b, 0x74, 0xba, 0x73, 0xbf, 0xf5, 0x9c, 0x48, 0x95, 0x48, 0xf5, 0xd1, 0x14, 0x7b, 0x99,
+	0x93, 0x75, 0xb6, 0x9a, 0x4c, 0x32, 0x99, 0xc5, 0x57, 0x56, 0x16, 0xed, 0xfc, 0xb0, 0xe0, 0xe9,
+	0x59, 0xf1, 0x8f, 0x77, 0xe7, 0x24, 0xc6, 0x82, 0xd1, 0x00, 0x6b, 0x8a, 0xbe, 0x42, 0x8b, 0x0b,
+	0x4d, 0x53, 0x81, 0x27, 0xa7, 0x72, 0x4c, 0x85, 0x3a, 0x95, 0xa3, 0x18, 0xa7, 0x54, 0xad, 0xb2,
+	0xb6, 0xd5, 0xb6, 0x7a, 0xb5, 0xe1, 0xc1, 0xe5, 0xcd, 0x7e, 0xe9, 0xd7, 0xcd, 0x7e, 0x97, 0x71,
+	0x1d, 0xcf, 0x42, 0x97, 0xc8, 0x24, 0xff, 0x69, 0xfe, 0xe9, 0xab, 0x68, 0xec, 0xe9, 0xcf, 0x53,
+	0xaa, 0xdc, 0x43, 0x4a, 0xae, 0x2f, 0xfa, 0x90, 0x73, 0x3a, 0xa4, 0x24, 0xb8, 0x03, 0x1f, 0xb5,
+	0xa1, 0x4e, 0xa7, 0x92, 0xc4, 0xef, 0x67, 0x49, 0x48, 0x53, 0x7b, 0xa7, 0x6d, 0xf5, 0xca, 0xc1,
+	0x76, 0xa8, 0xf3, 0x7d, 0x17, 0x6a, 0x6b, 0xe6, 0x08, 0x41, 0x59, 0xe0, 0x24, 0xe7, 0x15, 0x18,
+	0x1b, 0xf9, 0x50, 0xc5, 0x51, 0x94, 0x52, 0xa5, 0x4c, 0x7f, 0x6d, 0x68, 0x5f, 0x5f, 0xf4, 0x9b,
+	0x39, 0x81, 0x41, 0x96, 0x59, 0xad, 0x53, 0xb0, 0xa0, 0x28, 0x44, 0x27, 0x50, 0x51, 0x1a, 0xeb,
+	0x99, 0xb2, 0x77, 0xdb, 0x56, 0x6f, 0xcf, 0x7f, 0xe3, 0xde, 0xb9, 0x70, 0x77, 0xcd, 0x60, 0x63,
+	0x8d, 0x4c, 0x7f, 0x90, 0xe3, 0xa0, 0x2e, 0xec, 0x11, 0x99, 0x24, 0x5c, 0x29, 0x2e, 0x85, 0xd9,
+	0x5d, 0xd9, 0x0c, 0x73, 0x2b, 0x8a, 0x5e, 0xc2, 0xe3, 0x88, 0x4e, 0x28, 0x33, 0xda, 0x0f, 0x12,
+	0x6d, 0x3f, 0x30, 0x65, 0x7f, 0x07, 0xd1, 0x33, 0xa8, 0x7c, 0xa2, 0x9c, 0xc5, 0xda, 0xae, 0x98,
+	0x74, 0xee, 0xa1, 0x18, 0x9a, 0xc5, 0x36, 0xb7, 0x55, 0xb4, 0xab, 0x6d, 0xab, 0x57, 0xf7, 0x5f,
+	0xdf, 0x77, 0x8a, 0xed, 0xde, 0xe0, 0x9f, 0x88, 0x9d, 0xb7, 0xf0, 0xe4, 0xd6, 0xa8, 0x08, 0xa0,
+	0x32, 0x20, 0x9a, 0xcf, 0x69, 0xa3, 0x84, 0x1e, 0xc1, 0xc3, 0x23, 0x81, 0x33, 0xcf, 0x5a, 0x79,
+	0x01, 0x4d, 0xe4, 0x9c, 0x0b, 0xd6, 0xd8, 0xe9, 0x7c, 0x81, 0xc6, 0x09, 0x15, 0x11, 0x17, 0x6c,
+	0x23, 0x9c, 0x0d, 0x55, 0x12, 0x63, 0x2e, 0x8e, 0xa2, 0x5c, 0xbb, 0xc2, 0x45, 0xc7, 0x50, 0x5b,
+	0x5f, 0xbf, 0x11, 0xb0, 0xee, 0xf7, 0xee, 0x3b, 0xc7, 0xb0, 0xbc, 0xba, 0xcc, 0x60, 0x03, 0x30,
+	0x3c, 0xbb, 0x5c, 0x38, 0xd6, 0xd5, 0xc2, 0xb1, 0x7e, 0x2f, 0x1c, 0xeb, 0xdb, 0xd2, 0x29, 0x5d,
+	0x2d, 0x9d, 0xd2, 0xcf, 0xa5, 0x53, 0xfa, 0x70, 0xb0, 0x75, 0xbc, 0x19, 0x7c, 0xff, 0x18, 0x87,
+	0xca, 0xcb, 0xf0, 0xbd, 0x73, 0xef, 0x3f, 0x4f, 0xd3, 0x9c, 0x75, 0x58, 0x31, 0xef, 0xe8, 0xd5,
+	0x9f, 0x01, 0x00, 0x0a, 0xe6, 0xa9, 0xe6, 0xc1, 0x03, 0x00, 0x00,
+}
+
+func (m *ValidatorExchangeRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorExchangeRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorExchangeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.InternalTokensToSharesRate.Size()
+		i -= size
+		if _, err := m.InternalTokensToSharesRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintValidator(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Validator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InternalExchangeRate != nil {
+		{
+			size, err := m.InternalExchangeRate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintValidator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Weight != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DelegationAmt != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.DelegationAmt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CommissionRate != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.CommissionRate))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Status != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintValidator(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintValidator(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Validator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintValidator(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintValidator(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintValidator(dAtA []byte, offset int, v uint64) int {
+	offset -= sovValidator(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorExchangeRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InternalTokensToSharesRate.Size()
+	n += 1 + l + sovValidator(uint64(l))
+	if m.EpochNumber != 0 {
+		n += 1 + sovValidator(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *Validator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovValidator(uint64(m.Status))
+	}
+	if m.CommissionRate != 0 {
+		n += 1 + sovValidator(uint64(m.CommissionRate))
+	}
+	if m.DelegationAmt != 0 {
+		n += 1 + sovValidator(uint64(m.DelegationAmt))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovValidator(uint64(m.Weight))
+	}
+	if m.InternalExchangeRate != nil {
+		l = m.InternalExchangeRate.Size()
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	l = m.Validator.Size()
+	n += 1 + l + sovValidator(uint64(l))
+	return n
+}
+
+func sovValidator(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozValidator(x uint64) (n int) {
+	return sovValidator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorExchangeRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorExchangeRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorExchangeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalTokensToSharesRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InternalTokensToSharesRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Validator_ValidatorStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			m.CommissionRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommissionRate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAmt", wireType)
+			}
+			m.DelegationAmt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationAmt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalExchangeRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InternalExchangeRate == nil {
+				m.InternalExchangeRate = &ValidatorExchangeRate{}
+			}
+			if err := m.InternalExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipValidator(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowValidator
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthValidator
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupValidator
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthValidator
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthValidator        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowValidator          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupValidator = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,207 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	oldstakeibctypes "github.com/Stride-Labs/stride/x/stakeibc/migrations/v2/types"
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+// The ids of the stakeibc callbacks whose args contain amounts, as of v2
+const (
+	DelegateCallbackId   = "delegate"
+	UndelegateCallbackId = "undelegate"
+	RebalanceCallbackId  = "rebalance"
+	BatchCallbackId      = "batch"
+)
+
+func convertValidator(oldValidator oldstakeibctypes.Validator) types.Validator {
+	newValidator := types.Validator{
+		Name:           oldValidator.Name,
+		Address:        oldValidator.Address,
+		Status:         types.Validator_ValidatorStatus(oldValidator.Status),
+		CommissionRate: oldValidator.CommissionRate,
+		DelegationAmt:  sdk.NewIntFromUint64(oldValidator.DelegationAmt),
+		Weight:         oldValidator.Weight,
+	}
+	if oldValidator.InternalExchangeRate != nil {
+		newValidator.InternalExchangeRate = &types.ValidatorExchangeRate{
+			InternalTokensToSharesRate: oldValidator.InternalExchangeRate.InternalTokensToSharesRate,
+			EpochNumber:                oldValidator.InternalExchangeRate.EpochNumber,
+		}
+	}
+	return newValidator
+}
+
+func convertValidators(oldValidators []*oldstakeibctypes.Validator) []*types.Validator {
+	var newValidators []*types.Validator
+	for _, oldValidator := range oldValidators {
+		newValidator := convertValidator(*oldValidator)
+		newValidators = append(newValidators, &newValidator)
+	}
+	return newValidators
+}
+
+func convertDelegation(oldDelegation oldstakeibctypes.Delegation) types.Delegation {
+	newDelegation := types.Delegation{
+		DelegateAcctAddress: oldDelegation.DelegateAcctAddress,
+		Amt:                 oldDelegation.Amt,
+	}
+	if oldDelegation.Validator != nil {
+		newValidator := convertValidator(*oldDelegation.Validator)
+		newDelegation.Validator = &newValidator
+	}
+	return newDelegation
+}
+
+func convertICAAccount(oldAccount oldstakeibctypes.ICAAccount) types.ICAAccount {
+	newAccount := types.ICAAccount{
+		Address: oldAccount.Address,
+		Target:  types.ICAAccountType(oldAccount.Target),
+	}
+	for _, oldDelegation := range oldAccount.Delegations {
+		newDelegation := convertDelegation(*oldDelegation)
+		newAccount.Delegations = append(newAccount.Delegations, &newDelegation)
+	}
+	return newAccount
+}
+
+func convertOptionalICAAccount(oldAccount *oldstakeibctypes.ICAAccount) *types.ICAAccount {
+	if oldAccount == nil {
+		return nil
+	}
+	newAccount := convertICAAccount(*oldAccount)
+	return &newAccount
+}
+
+func convertPipelineSchedule(oldSchedule *oldstakeibctypes.PipelineSchedule) *types.PipelineSchedule {
+	if oldSchedule == nil {
+		return nil
+	}
+	return &types.PipelineSchedule{
+		Interval: oldSchedule.Interval,
+		Offset:   oldSchedule.Offset,
+	}
+}
+
+func convertHostZone(oldHostZone oldstakeibctypes.HostZone) types.HostZone {
+	return types.HostZone{
+		ChainId:                oldHostZone.ChainId,
+		ConnectionId:           oldHostZone.ConnectionId,
+		Bech32Prefix:           oldHostZone.Bech32Prefix,
+		TransferChannelId:      oldHostZone.TransferChannelId,
+		Validators:             convertValidators(oldHostZone.Validators),
+		BlacklistedValidators:  convertValidators(oldHostZone.BlacklistedValidators),
+		WithdrawalAccount:      convertOptionalICAAccount(oldHostZone.WithdrawalAccount),
+		FeeAccount:             convertOptionalICAAccount(oldHostZone.FeeAccount),
+		DelegationAccount:      convertOptionalICAAccount(oldHostZone.DelegationAccount),
+		RedemptionAccount:      convertOptionalICAAccount(oldHostZone.RedemptionAccount),
+		IBCDenom:               oldHostZone.IBCDenom,
+		HostDenom:              oldHostZone.HostDenom,
+		LastRedemptionRate:     oldHostZone.LastRedemptionRate,
+		RedemptionRate:         oldHostZone.RedemptionRate,
+		UnbondingFrequency:     oldHostZone.UnbondingFrequency,
+		StakedBal:              sdk.NewIntFromUint64(oldHostZone.StakedBal),
+		Address:                oldHostZone.Address,
+		DepositSchedule:        convertPipelineSchedule(oldHostZone.DepositSchedule),
+		DelegateSchedule:       convertPipelineSchedule(oldHostZone.DelegateSchedule),
+		ReinvestSchedule:       convertPipelineSchedule(oldHostZone.ReinvestSchedule),
+		RedemptionRateSchedule: convertPipelineSchedule(oldHostZone.RedemptionRateSchedule),
+		RebalanceSchedule:      convertPipelineSchedule(oldHostZone.RebalanceSchedule),
+		// all host zones registered before the exponent was tracked use micro-denoms
+		DenomExponent: types.DefaultDenomExponent,
+	}
+}
+
+func convertRedelegation(oldRedelegation oldstakeibctypes.Redelegation) types.Redelegation {
+	newRedelegation := types.Redelegation{
+		HostZoneId:   oldRedelegation.HostZoneId,
+		SrcValidator: oldRedelegation.SrcValidator,
+		DstValidator: oldRedelegation.DstValidator,
+	}
+	for _, oldEntry := range oldRedelegation.Entries {
+		newRedelegation.Entries = append(newRedelegation.Entries, &types.RedelegationEntry{
+			Amount:         sdk.NewIntFromUint64(oldEntry.Amount),
+			CompletionTime: oldEntry.CompletionTime,
+		})
+	}
+	return newRedelegation
+}
+
+func convertSplitDelegations(oldSplitDelegations []*oldstakeibctypes.SplitDelegation) []*types.SplitDelegation {
+	var newSplitDelegations []*types.SplitDelegation
+	for _, oldSplitDelegation := range oldSplitDelegations {
+		newSplitDelegations = append(newSplitDelegations, &types.SplitDelegation{
+			Validator: oldSplitDelegation.Validator,
+			Amount:    sdk.NewIntFromUint64(oldSplitDelegation.Amount),
+		})
+	}
+	return newSplitDelegations
+}
+
+// convertCallbackArgs re-serializes the args of a delegate, undelegate, or rebalance callback
+// (including those nested in a batch callback) with the new types
+// The args of any other callback are returned unchanged
+func convertCallbackArgs(cdc codec.BinaryCodec, callbackId string, args []byte) ([]byte, error) {
+	switch callbackId {
+	case DelegateCallbackId:
+		var oldCallback oldstakeibctypes.DelegateCallback
+		if err := cdc.Unmarshal(args, &oldCallback); err != nil {
+			return nil, err
+		}
+		newCallback := types.DelegateCallback{
+			HostZoneId:       oldCallback.HostZoneId,
+			DepositRecordId:  oldCallback.DepositRecordId,
+			SplitDelegations: convertSplitDelegations(oldCallback.SplitDelegations),
+		}
+		return cdc.Marshal(&newCallback)
+
+	case UndelegateCallbackId:
+		var oldCallback oldstakeibctypes.UndelegateCallback
+		if err := cdc.Unmarshal(args, &oldCallback); err != nil {
+			return nil, err
+		}
+		newCallback := types.UndelegateCallback{
+			HostZoneId:              oldCallback.HostZoneId,
+			SplitDelegations:        convertSplitDelegations(oldCallback.SplitDelegations),
+			EpochUnbondingRecordIds: oldCallback.EpochUnbondingRecordIds,
+		}
+		return cdc.Marshal(&newCallback)
+
+	case RebalanceCallbackId:
+		var oldCallback oldstakeibctypes.RebalanceCallback
+		if err := cdc.Unmarshal(args, &oldCallback); err != nil {
+			return nil, err
+		}
+		newCallback := types.RebalanceCallback{
+			HostZoneId: oldCallback.HostZoneId,
+		}
+		for _, oldRebalancing := range oldCallback.Rebalancings {
+			newCallback.Rebalancings = append(newCallback.Rebalancings, &types.Rebalancing{
+				SrcValidator: oldRebalancing.SrcValidator,
+				DstValidator: oldRebalancing.DstValidator,
+				Amt:          sdk.NewIntFromUint64(oldRebalancing.Amt),
+			})
+		}
+		return cdc.Marshal(&newCallback)
+
+	case BatchCallbackId:
+		// The batch callback itself is unchanged, but the callbacks it wraps may need to be converted
+		var batchCallback types.BatchCallback
+		if err := cdc.Unmarshal(args, &batchCallback); err != nil {
+			return nil, err
+		}
+		for _, batchedCallback := range batchCallback.Callbacks {
+			callbackArgs, err := convertCallbackArgs(cdc, batchedCallback.CallbackId, batchedCallback.CallbackArgs)
+			if err != nil {
+				return nil, err
+			}
+			batchedCallback.CallbackArgs = callbackArgs
+		}
+		return cdc.Marshal(&batchCallback)
+
+	default:
+		return args, nil
+	}
+}
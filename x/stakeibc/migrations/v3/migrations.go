@@ -0,0 +1,148 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icacallbackskeeper "github.com/Stride-Labs/stride/x/icacallbacks/keeper"
+	oldstakeibctypes "github.com/Stride-Labs/stride/x/stakeibc/migrations/v2/types"
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+// MigrateStore converts the host zone staked balances, validator delegation amounts, and redelegation amounts
+// from fixed-width integers to sdk.Int. The args of any stakeibc callbacks that are still queued or in flight
+// (which are stored in the icacallbacks module) are converted as well
+// Existing host zones are assigned the default (micro-denom) exponent
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec, icaCallbacksKeeper icacallbackskeeper.Keeper) error {
+	hostZoneStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.HostZoneKey))
+	err := migrateStoreValues(hostZoneStore, func(bz []byte) ([]byte, error) {
+		var oldHostZone oldstakeibctypes.HostZone
+		if err := cdc.Unmarshal(bz, &oldHostZone); err != nil {
+			return nil, err
+		}
+		newHostZone := convertHostZone(oldHostZone)
+		return cdc.Marshal(&newHostZone)
+	})
+	if err != nil {
+		return err
+	}
+
+	validatorStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.ValidatorKey))
+	err = migrateStoreValues(validatorStore, func(bz []byte) ([]byte, error) {
+		var oldValidator oldstakeibctypes.Validator
+		if err := cdc.Unmarshal(bz, &oldValidator); err != nil {
+			return nil, err
+		}
+		newValidator := convertValidator(oldValidator)
+		return cdc.Marshal(&newValidator)
+	})
+	if err != nil {
+		return err
+	}
+
+	delegationStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.DelegationKey))
+	err = migrateStoreValues(delegationStore, func(bz []byte) ([]byte, error) {
+		var oldDelegation oldstakeibctypes.Delegation
+		if err := cdc.Unmarshal(bz, &oldDelegation); err != nil {
+			return nil, err
+		}
+		newDelegation := convertDelegation(oldDelegation)
+		return cdc.Marshal(&newDelegation)
+	})
+	if err != nil {
+		return err
+	}
+
+	icaAccountStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.ICAAccountKey))
+	err = migrateStoreValues(icaAccountStore, func(bz []byte) ([]byte, error) {
+		var oldICAAccount oldstakeibctypes.ICAAccount
+		if err := cdc.Unmarshal(bz, &oldICAAccount); err != nil {
+			return nil, err
+		}
+		newICAAccount := convertICAAccount(oldICAAccount)
+		return cdc.Marshal(&newICAAccount)
+	})
+	if err != nil {
+		return err
+	}
+
+	pendingValidatorStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.PendingValidatorKeyPrefix))
+	err = migrateStoreValues(pendingValidatorStore, func(bz []byte) ([]byte, error) {
+		var oldPendingValidator oldstakeibctypes.PendingValidator
+		if err := cdc.Unmarshal(bz, &oldPendingValidator); err != nil {
+			return nil, err
+		}
+		newPendingValidator := types.PendingValidator{
+			ChainId:   oldPendingValidator.ChainId,
+			Validator: convertValidator(oldPendingValidator.Validator),
+		}
+		return cdc.Marshal(&newPendingValidator)
+	})
+	if err != nil {
+		return err
+	}
+
+	redelegationStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.RedelegationKeyPrefix))
+	err = migrateStoreValues(redelegationStore, func(bz []byte) ([]byte, error) {
+		var oldRedelegation oldstakeibctypes.Redelegation
+		if err := cdc.Unmarshal(bz, &oldRedelegation); err != nil {
+			return nil, err
+		}
+		newRedelegation := convertRedelegation(oldRedelegation)
+		return cdc.Marshal(&newRedelegation)
+	})
+	if err != nil {
+		return err
+	}
+
+	queuedICATxStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.QueuedICATxKeyPrefix))
+	err = migrateStoreValues(queuedICATxStore, func(bz []byte) ([]byte, error) {
+		var queuedTx types.QueuedICATx
+		if err := cdc.Unmarshal(bz, &queuedTx); err != nil {
+			return nil, err
+		}
+		callbackArgs, err := convertCallbackArgs(cdc, queuedTx.CallbackId, queuedTx.CallbackArgs)
+		if err != nil {
+			return nil, err
+		}
+		queuedTx.CallbackArgs = callbackArgs
+		return cdc.Marshal(&queuedTx)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, callbackData := range icaCallbacksKeeper.GetAllCallbackData(ctx) {
+		callbackArgs, err := convertCallbackArgs(cdc, callbackData.CallbackId, callbackData.CallbackArgs)
+		if err != nil {
+			return err
+		}
+		callbackData.CallbackArgs = callbackArgs
+		icaCallbacksKeeper.SetCallbackData(ctx, callbackData)
+	}
+
+	return nil
+}
+
+// migrateStoreValues rewrites each value in the store with the result of migrateValue
+// The new values are only written once iteration is complete
+func migrateStoreValues(store prefix.Store, migrateValue func(bz []byte) ([]byte, error)) error {
+	iterator := store.Iterator(nil, nil)
+	var keys, values [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		newValue, err := migrateValue(iterator.Value())
+		if err != nil {
+			iterator.Close()
+			return err
+		}
+		keys = append(keys, iterator.Key())
+		values = append(values, newValue)
+	}
+	iterator.Close()
+
+	for i, key := range keys {
+		store.Set(key, values[i])
+	}
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
 
+	"github.com/Stride-Labs/stride/utils"
 	icacallbacktypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
 
 	"github.com/Stride-Labs/stride/x/stakeibc/keeper"
@@ -122,6 +123,26 @@ func (im IBCModule) OnChanOpenAck(
 	}
 
 	im.keeper.SetHostZone(ctx, zoneInfo)
+
+	// If the channel replaces one that closed, the account is usable again
+	restored := false
+	for _, accountType := range types.HostZoneICAAccountTypes {
+		accountPortID, err := icatypes.NewControllerPortID(types.FormatICAAccountOwner(hostChainId, accountType))
+		if err == nil && portID == accountPortID {
+			restored = im.keeper.CompleteICARecovery(ctx, hostChainId, accountType, channelID)
+		}
+	}
+
+	// Once both the delegation and withdrawal accounts exist, point the host's rewards at the withdrawal account
+	// A failure here shouldn't fail the handshake, the address can be re-set with MsgSetWithdrawalAddress
+	if portID == withdrawalAddress || portID == delegationAddress {
+		err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return im.keeper.SetWithdrawalAddressOnChannelOpen(ctx, zoneInfo, restored)
+		})
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("Unable to set withdrawal address for %s: %s", hostChainId, err.Error()))
+		}
+	}
 	return nil
 }
 
@@ -145,14 +166,13 @@ func (im IBCModule) OnAcknowledgementPacket(
 		return sdkerrors.Wrapf(types.ErrMarshalFailure, errMsg)
 	}
 	im.keeper.Logger(ctx).Info(fmt.Sprintf("Acknowledgement was successfully unmarshalled: ackInfo: %s", ackInfo))
-	eventType := "ack"
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			eventType,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeKeyAck, fmt.Sprintf("%v", ackInfo)),
-		),
-	)
+	im.keeper.EmitTypedEvent(ctx, &types.EventAcknowledgement{
+		Sequence:           modulePacket.Sequence,
+		SourcePort:         modulePacket.SourcePort,
+		SourceChannel:      modulePacket.SourceChannel,
+		DestinationPort:    modulePacket.DestinationPort,
+		DestinationChannel: modulePacket.DestinationChannel,
+	})
 
 	err = im.keeper.ICACallbacksKeeper.CallRegisteredICACallback(ctx, modulePacket, &ack)
 	if err != nil {
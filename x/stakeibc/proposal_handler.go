@@ -4,6 +4,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 
 	"github.com/Stride-Labs/stride/x/stakeibc/keeper"
 
@@ -15,6 +18,8 @@ func NewStakeibcProposalHandler(k keeper.Keeper) govtypes.Handler {
 		switch c := content.(type) {
 		case *types.AddValidatorProposal:
 			return handleAddValidatorProposal(ctx, k, c)
+		case *types.DeleteValidatorProposal:
+			return handleDeleteValidatorProposal(ctx, k, c)
 
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized stakeibc proposal content type: %T", c)
@@ -22,6 +27,29 @@ func NewStakeibcProposalHandler(k keeper.Keeper) govtypes.Handler {
 	}
 }
 
+// NewParamChangeProposalHandler wraps the params module's proposal handler so that changes to stakeibc params
+// are also checked against the module's state, which the params' own validation can't access
+func NewParamChangeProposalHandler(k keeper.Keeper, paramsKeeper paramskeeper.Keeper) govtypes.Handler {
+	paramsHandler := params.NewParamChangeProposalHandler(paramsKeeper)
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if c, ok := content.(*paramproposal.ParameterChangeProposal); ok {
+			for _, change := range c.Changes {
+				if change.Subspace != types.ModuleName {
+					continue
+				}
+				if err := k.ValidateParamChange(ctx, change.Key, change.Value); err != nil {
+					return err
+				}
+			}
+		}
+		return paramsHandler(ctx, content)
+	}
+}
+
 func handleAddValidatorProposal(ctx sdk.Context, k keeper.Keeper, proposal *types.AddValidatorProposal) error {
 	return k.AddValidatorProposal(ctx, proposal)
 }
+
+func handleDeleteValidatorProposal(ctx sdk.Context, k keeper.Keeper, proposal *types.DeleteValidatorProposal) error {
+	return k.DeleteValidatorProposal(ctx, proposal)
+}
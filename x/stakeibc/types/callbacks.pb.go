@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -26,8 +27,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // ---------------------- Delegation Callbacks ---------------------- //
 type SplitDelegation struct {
-	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
-	Amount    uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Validator string                                 `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
 func (m *SplitDelegation) Reset()         { *m = SplitDelegation{} }
@@ -70,13 +71,6 @@ func (m *SplitDelegation) GetValidator() string {
 	return ""
 }
 
-func (m *SplitDelegation) GetAmount() uint64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
-}
-
 type DelegateCallback struct {
 	HostZoneId       string             `protobuf:"bytes,1,opt,name=hostZoneId,proto3" json:"hostZoneId,omitempty"`
 	DepositRecordId  uint64             `protobuf:"varint,2,opt,name=depositRecordId,proto3" json:"depositRecordId,omitempty"`
@@ -365,9 +359,9 @@ func (m *RedemptionCallback) GetEpochUnbondingRecordIds() []uint64 {
 }
 
 type Rebalancing struct {
-	SrcValidator string `protobuf:"bytes,1,opt,name=srcValidator,proto3" json:"srcValidator,omitempty"`
-	DstValidator string `protobuf:"bytes,2,opt,name=dstValidator,proto3" json:"dstValidator,omitempty"`
-	Amt          uint64 `protobuf:"varint,3,opt,name=amt,proto3" json:"amt,omitempty"`
+	SrcValidator string                                 `protobuf:"bytes,1,opt,name=srcValidator,proto3" json:"srcValidator,omitempty"`
+	DstValidator string                                 `protobuf:"bytes,2,opt,name=dstValidator,proto3" json:"dstValidator,omitempty"`
+	Amt          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amt"`
 }
 
 func (m *Rebalancing) Reset()         { *m = Rebalancing{} }
@@ -417,13 +411,6 @@ func (m *Rebalancing) GetDstValidator() string {
 	return ""
 }
 
-func (m *Rebalancing) GetAmt() uint64 {
-	if m != nil {
-		return m.Amt
-	}
-	return 0
-}
-
 type RebalanceCallback struct {
 	HostZoneId   string         `protobuf:"bytes,1,opt,name=hostZoneId,proto3" json:"hostZoneId,omitempty"`
 	Rebalancings []*Rebalancing `protobuf:"bytes,2,rep,name=rebalancings,proto3" json:"rebalancings,omitempty"`
@@ -476,6 +463,181 @@ func (m *RebalanceCallback) GetRebalancings() []*Rebalancing {
 	return nil
 }
 
+// BatchedCallback is the callback of one of the queued txs coalesced into a
+// batch, along with the number of messages it contributed
+type BatchedCallback struct {
+	CallbackId   string `protobuf:"bytes,1,opt,name=callbackId,proto3" json:"callbackId,omitempty"`
+	CallbackArgs []byte `protobuf:"bytes,2,opt,name=callbackArgs,proto3" json:"callbackArgs,omitempty"`
+	NumMsgs      uint64 `protobuf:"varint,3,opt,name=numMsgs,proto3" json:"numMsgs,omitempty"`
+}
+
+func (m *BatchedCallback) Reset()         { *m = BatchedCallback{} }
+func (m *BatchedCallback) String() string { return proto.CompactTextString(m) }
+func (*BatchedCallback) ProtoMessage()    {}
+func (*BatchedCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73c938d1f08de4bf, []int{8}
+}
+func (m *BatchedCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchedCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchedCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchedCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchedCallback.Merge(m, src)
+}
+func (m *BatchedCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchedCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchedCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchedCallback proto.InternalMessageInfo
+
+func (m *BatchedCallback) GetCallbackId() string {
+	if m != nil {
+		return m.CallbackId
+	}
+	return ""
+}
+
+func (m *BatchedCallback) GetCallbackArgs() []byte {
+	if m != nil {
+		return m.CallbackArgs
+	}
+	return nil
+}
+
+func (m *BatchedCallback) GetNumMsgs() uint64 {
+	if m != nil {
+		return m.NumMsgs
+	}
+	return 0
+}
+
+type BatchCallback struct {
+	HostZoneId string             `protobuf:"bytes,1,opt,name=hostZoneId,proto3" json:"hostZoneId,omitempty"`
+	Callbacks  []*BatchedCallback `protobuf:"bytes,2,rep,name=callbacks,proto3" json:"callbacks,omitempty"`
+}
+
+func (m *BatchCallback) Reset()         { *m = BatchCallback{} }
+func (m *BatchCallback) String() string { return proto.CompactTextString(m) }
+func (*BatchCallback) ProtoMessage()    {}
+func (*BatchCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73c938d1f08de4bf, []int{9}
+}
+func (m *BatchCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchCallback.Merge(m, src)
+}
+func (m *BatchCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchCallback proto.InternalMessageInfo
+
+func (m *BatchCallback) GetHostZoneId() string {
+	if m != nil {
+		return m.HostZoneId
+	}
+	return ""
+}
+
+func (m *BatchCallback) GetCallbacks() []*BatchedCallback {
+	if m != nil {
+		return m.Callbacks
+	}
+	return nil
+}
+
+// ---------------------- Wind Down Callbacks ---------------------- //
+type WindDownSweepCallback struct {
+	HostZoneId  string         `protobuf:"bytes,1,opt,name=hostZoneId,proto3" json:"hostZoneId,omitempty"`
+	AccountType ICAAccountType `protobuf:"varint,2,opt,name=accountType,proto3,enum=Stridelabs.stride.stakeibc.ICAAccountType" json:"accountType,omitempty"`
+	SweepAmount types.Coin     `protobuf:"bytes,3,opt,name=sweepAmount,proto3" json:"sweepAmount"`
+}
+
+func (m *WindDownSweepCallback) Reset()         { *m = WindDownSweepCallback{} }
+func (m *WindDownSweepCallback) String() string { return proto.CompactTextString(m) }
+func (*WindDownSweepCallback) ProtoMessage()    {}
+func (*WindDownSweepCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73c938d1f08de4bf, []int{10}
+}
+func (m *WindDownSweepCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WindDownSweepCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WindDownSweepCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WindDownSweepCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WindDownSweepCallback.Merge(m, src)
+}
+func (m *WindDownSweepCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *WindDownSweepCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_WindDownSweepCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WindDownSweepCallback proto.InternalMessageInfo
+
+func (m *WindDownSweepCallback) GetHostZoneId() string {
+	if m != nil {
+		return m.HostZoneId
+	}
+	return ""
+}
+
+func (m *WindDownSweepCallback) GetAccountType() ICAAccountType {
+	if m != nil {
+		return m.AccountType
+	}
+	return ICAAccountType_DELEGATION
+}
+
+func (m *WindDownSweepCallback) GetSweepAmount() types.Coin {
+	if m != nil {
+		return m.SweepAmount
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*SplitDelegation)(nil), "Stridelabs.stride.stakeibc.SplitDelegation")
 	proto.RegisterType((*DelegateCallback)(nil), "Stridelabs.stride.stakeibc.DelegateCallback")
@@ -485,48 +647,60 @@ func init() {
 	proto.RegisterType((*RedemptionCallback)(nil), "Stridelabs.stride.stakeibc.RedemptionCallback")
 	proto.RegisterType((*Rebalancing)(nil), "Stridelabs.stride.stakeibc.Rebalancing")
 	proto.RegisterType((*RebalanceCallback)(nil), "Stridelabs.stride.stakeibc.RebalanceCallback")
+	proto.RegisterType((*BatchedCallback)(nil), "Stridelabs.stride.stakeibc.BatchedCallback")
+	proto.RegisterType((*BatchCallback)(nil), "Stridelabs.stride.stakeibc.BatchCallback")
+	proto.RegisterType((*WindDownSweepCallback)(nil), "Stridelabs.stride.stakeibc.WindDownSweepCallback")
 }
 
 func init() { proto.RegisterFile("stakeibc/callbacks.proto", fileDescriptor_73c938d1f08de4bf) }
 
 var fileDescriptor_73c938d1f08de4bf = []byte{
-	// 562 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4d, 0x6b, 0x13, 0x41,
-	0x18, 0xc7, 0xb3, 0x49, 0xa9, 0xe4, 0x49, 0xb5, 0x71, 0x90, 0xba, 0x06, 0xd9, 0x86, 0xbd, 0x34,
-	0x20, 0xdd, 0xa5, 0x15, 0xc4, 0xab, 0x8d, 0xa0, 0x45, 0xf1, 0xb0, 0xa5, 0x0a, 0xbd, 0xcd, 0x1b,
-	0x9b, 0x21, 0xbb, 0x33, 0xcb, 0xce, 0x24, 0xe8, 0x4d, 0xf0, 0x0b, 0xf8, 0x29, 0x3c, 0x78, 0xf7,
-	0x33, 0xd8, 0x63, 0x8f, 0x9e, 0x54, 0x92, 0x2f, 0x22, 0xd9, 0x97, 0xbc, 0xac, 0x26, 0x54, 0xe8,
-	0x69, 0x67, 0x9e, 0x79, 0x5e, 0xfe, 0xcf, 0xfc, 0x9e, 0x1d, 0xb0, 0xb5, 0xc1, 0x43, 0x2e, 0x08,
-	0xf5, 0x29, 0x8e, 0x22, 0x82, 0xe9, 0x50, 0x7b, 0x49, 0xaa, 0x8c, 0x42, 0x9d, 0x33, 0x93, 0x0a,
-	0xc6, 0x23, 0x4c, 0xb4, 0xa7, 0xb3, 0xa5, 0x57, 0xfa, 0x76, 0xee, 0x85, 0x2a, 0x54, 0x99, 0x9b,
-	0x3f, 0x5b, 0xe5, 0x11, 0x1d, 0x87, 0x2a, 0x1d, 0x2b, 0xed, 0x13, 0xac, 0xb9, 0x3f, 0x3e, 0x22,
-	0xdc, 0xe0, 0x23, 0x9f, 0x2a, 0x21, 0xf3, 0x73, 0xf7, 0x05, 0xec, 0x9e, 0x25, 0x91, 0x30, 0xcf,
-	0x79, 0xc4, 0x43, 0x6c, 0x84, 0x92, 0xe8, 0x21, 0x34, 0xc7, 0x38, 0x12, 0x0c, 0x1b, 0x95, 0xda,
-	0x56, 0xd7, 0xea, 0x35, 0x83, 0x85, 0x01, 0xed, 0xc1, 0x36, 0x8e, 0xd5, 0x48, 0x1a, 0xbb, 0xde,
-	0xb5, 0x7a, 0x5b, 0x41, 0xb1, 0x73, 0xbf, 0x59, 0xd0, 0x2e, 0x92, 0xf0, 0x7e, 0x21, 0x1b, 0x39,
-	0x00, 0x03, 0xa5, 0xcd, 0x85, 0x92, 0xfc, 0x94, 0x15, 0xb9, 0x96, 0x2c, 0xa8, 0x07, 0xbb, 0x8c,
-	0x27, 0x4a, 0x0b, 0x13, 0x70, 0xaa, 0x52, 0x76, 0xca, 0x8a, 0xac, 0x55, 0x33, 0x7a, 0x07, 0x6d,
-	0xbd, 0xaa, 0x53, 0xdb, 0x8d, 0x6e, 0xa3, 0xd7, 0x3a, 0x7e, 0xe4, 0xad, 0xbf, 0x14, 0xaf, 0xd2,
-	0x5b, 0xf0, 0x57, 0x12, 0xf7, 0x93, 0x05, 0xb7, 0xfb, 0x11, 0x16, 0xf1, 0x5c, 0xf4, 0x13, 0xd8,
-	0x1b, 0x69, 0x9e, 0x06, 0x9c, 0xf1, 0x38, 0xc9, 0xa2, 0x4a, 0x6d, 0x79, 0x03, 0x6b, 0x4e, 0x91,
-	0x0d, 0xb7, 0xe8, 0x00, 0x0b, 0x59, 0x34, 0xd1, 0x0c, 0xca, 0x2d, 0xea, 0x42, 0x8b, 0x27, 0x8a,
-	0x0e, 0xde, 0x8c, 0x62, 0xc2, 0x53, 0xbb, 0x91, 0xb5, 0xb8, 0x6c, 0x72, 0xbf, 0x58, 0xd0, 0x0e,
-	0xb8, 0x90, 0x63, 0xae, 0xcd, 0x5c, 0x48, 0x0a, 0x77, 0xd2, 0xc2, 0xf6, 0x2c, 0xbf, 0xf2, 0x99,
-	0x80, 0xd6, 0xf1, 0x03, 0x2f, 0x87, 0xea, 0xcd, 0xa0, 0x7a, 0x05, 0x54, 0xaf, 0xaf, 0x84, 0x3c,
-	0xf1, 0x2f, 0x7f, 0xee, 0xd7, 0xbe, 0xfe, 0xda, 0x3f, 0x08, 0x85, 0x19, 0x8c, 0x88, 0x47, 0x55,
-	0xec, 0x17, 0x13, 0x90, 0x7f, 0x0e, 0x35, 0x1b, 0xfa, 0xe6, 0x43, 0xc2, 0x75, 0x16, 0x10, 0x54,
-	0x2a, 0x54, 0x88, 0x35, 0xaa, 0xc4, 0xdc, 0xef, 0x16, 0xa0, 0x73, 0xc9, 0xfe, 0x17, 0xf4, 0xbf,
-	0xf0, 0xd5, 0x6f, 0x00, 0x1f, 0x7a, 0x0a, 0xf7, 0xb3, 0x7b, 0x3c, 0x97, 0x44, 0x49, 0x26, 0x64,
-	0x58, 0xe2, 0xc8, 0xc7, 0x63, 0x2b, 0x58, 0x77, 0xec, 0x4a, 0x40, 0x0b, 0x88, 0xd7, 0x6e, 0x64,
-	0x43, 0xbd, 0xfa, 0xe6, 0x7a, 0x21, 0xb4, 0x02, 0x4e, 0x70, 0x84, 0x25, 0x15, 0x32, 0x44, 0x2e,
-	0xec, 0xe8, 0x94, 0xbe, 0xad, 0xfc, 0x68, 0x2b, 0xb6, 0x99, 0x0f, 0xd3, 0x66, 0xe1, 0x93, 0x8f,
-	0xd5, 0x8a, 0x0d, 0xb5, 0xa1, 0x81, 0x63, 0x53, 0xcc, 0xd4, 0x6c, 0xe9, 0x7e, 0xb4, 0xe0, 0x6e,
-	0x59, 0xe9, 0xfa, 0x84, 0x5e, 0xc1, 0x4e, 0xba, 0x90, 0x57, 0xd2, 0x39, 0xd8, 0x44, 0x67, 0xa9,
-	0x9d, 0x60, 0x25, 0xf8, 0xe4, 0xe5, 0xe5, 0xc4, 0xb1, 0xae, 0x26, 0x8e, 0xf5, 0x7b, 0xe2, 0x58,
-	0x9f, 0xa7, 0x4e, 0xed, 0x6a, 0xea, 0xd4, 0x7e, 0x4c, 0x9d, 0xda, 0x85, 0xb7, 0x34, 0x98, 0x79,
-	0xea, 0xc3, 0xd7, 0x98, 0x68, 0x3f, 0xcf, 0xed, 0xbf, 0xf7, 0xe7, 0x6f, 0x5f, 0x36, 0xa4, 0x64,
-	0x3b, 0x7b, 0xa6, 0x1e, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0xfc, 0x01, 0x37, 0x3e, 0x14, 0x05,
-	0x00, 0x00,
+	// 713 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcb, 0x6e, 0x13, 0x31,
+	0x14, 0xcd, 0x34, 0x55, 0x51, 0x6e, 0xfa, 0xc2, 0xe2, 0x11, 0x22, 0x34, 0xad, 0x66, 0x41, 0x23,
+	0x50, 0x67, 0xd4, 0x22, 0x21, 0x96, 0x24, 0xa9, 0x10, 0x11, 0x85, 0x85, 0x4b, 0xa9, 0xd4, 0x0d,
+	0xf2, 0x78, 0xac, 0xc4, 0xea, 0x8c, 0x3d, 0x1a, 0x3b, 0x2d, 0x65, 0x85, 0xc4, 0x0f, 0xb0, 0xe3,
+	0x0f, 0x58, 0xb0, 0xe7, 0x1b, 0xe8, 0xb2, 0x4b, 0xc4, 0xa2, 0xa0, 0xf6, 0x47, 0xd0, 0xbc, 0x32,
+	0x49, 0xa0, 0x21, 0x20, 0x56, 0x89, 0xaf, 0x7d, 0x7c, 0xce, 0xf1, 0xb9, 0xf6, 0x40, 0x4d, 0x69,
+	0x72, 0xc0, 0xb8, 0x4b, 0x1d, 0x4a, 0x7c, 0xdf, 0x25, 0xf4, 0x40, 0xd9, 0x61, 0x24, 0xb5, 0x44,
+	0xf5, 0x1d, 0x1d, 0x71, 0x8f, 0xf9, 0xc4, 0x55, 0xb6, 0x4a, 0xfe, 0xda, 0xf9, 0xda, 0xfa, 0xb5,
+	0xae, 0xec, 0xca, 0x64, 0x99, 0x13, 0xff, 0x4b, 0x11, 0x75, 0x93, 0x4a, 0x15, 0x48, 0xe5, 0xb8,
+	0x44, 0x31, 0xe7, 0x70, 0xc3, 0x65, 0x9a, 0x6c, 0x38, 0x54, 0x72, 0x91, 0xcd, 0xd7, 0x07, 0x5c,
+	0x9c, 0x92, 0x57, 0x84, 0x52, 0xd9, 0x17, 0x3a, 0x9d, 0xb3, 0x8e, 0x60, 0x69, 0x27, 0xf4, 0xb9,
+	0xde, 0x62, 0x3e, 0xeb, 0x12, 0xcd, 0xa5, 0x40, 0xb7, 0xa1, 0x72, 0x48, 0x7c, 0xee, 0x11, 0x2d,
+	0xa3, 0x9a, 0xb1, 0x6a, 0x34, 0x2a, 0xb8, 0x28, 0xa0, 0xc7, 0x30, 0x47, 0x82, 0x78, 0x83, 0xda,
+	0x4c, 0x3c, 0xd5, 0xb2, 0x4f, 0xce, 0x56, 0x4a, 0xdf, 0xce, 0x56, 0xee, 0x74, 0xb9, 0xee, 0xf5,
+	0x5d, 0x9b, 0xca, 0xc0, 0xc9, 0xf4, 0xa4, 0x3f, 0xeb, 0xca, 0x3b, 0x70, 0xf4, 0x71, 0xc8, 0x94,
+	0xdd, 0x11, 0x1a, 0x67, 0x68, 0xeb, 0xb3, 0x01, 0xcb, 0x19, 0x29, 0x6b, 0x67, 0x47, 0x80, 0x4c,
+	0x80, 0x9e, 0x54, 0x7a, 0x5f, 0x0a, 0xd6, 0xf1, 0x32, 0xee, 0xa1, 0x0a, 0x6a, 0xc0, 0x92, 0xc7,
+	0x42, 0xa9, 0xb8, 0xc6, 0x8c, 0xca, 0xc8, 0xeb, 0x78, 0x89, 0x8a, 0x59, 0x3c, 0x5e, 0x46, 0x7b,
+	0xb0, 0xac, 0x46, 0x7d, 0xa9, 0x5a, 0x79, 0xb5, 0xdc, 0xa8, 0x6e, 0xde, 0xb3, 0x2f, 0x3f, 0x60,
+	0x7b, 0xec, 0x2c, 0xf0, 0x2f, 0x9b, 0x58, 0xef, 0x0c, 0x58, 0x68, 0xfb, 0x84, 0x07, 0x03, 0xd1,
+	0x0f, 0xe0, 0x46, 0x5f, 0xb1, 0x08, 0x33, 0x8f, 0x05, 0x61, 0x82, 0xca, 0xb5, 0xa5, 0x06, 0x2e,
+	0x99, 0x45, 0x35, 0xb8, 0x42, 0x7b, 0x84, 0x8b, 0xcc, 0x44, 0x05, 0xe7, 0x43, 0xb4, 0x0a, 0x55,
+	0x16, 0x4a, 0xda, 0x7b, 0xde, 0x0f, 0x5c, 0x16, 0xd5, 0xca, 0x89, 0xc5, 0xe1, 0x92, 0xf5, 0xd1,
+	0x80, 0x65, 0xcc, 0xb8, 0x38, 0x64, 0x4a, 0x0f, 0x84, 0x44, 0xb0, 0x18, 0x65, 0xb5, 0x66, 0x1a,
+	0x51, 0x2c, 0xa0, 0xba, 0x79, 0xcb, 0x4e, 0x93, 0xb0, 0xe3, 0x06, 0xb1, 0xb3, 0x06, 0xb1, 0xdb,
+	0x92, 0x8b, 0x96, 0x13, 0xa7, 0xf7, 0xe9, 0xfb, 0xca, 0xda, 0x14, 0xe9, 0xc5, 0x00, 0x3c, 0xc6,
+	0x30, 0x96, 0x58, 0x79, 0x3c, 0x31, 0xeb, 0x8b, 0x01, 0x68, 0x57, 0x78, 0x7f, 0x1b, 0xf4, 0xef,
+	0xe2, 0x9b, 0xf9, 0x0f, 0xf1, 0xa1, 0x87, 0x70, 0x33, 0x39, 0xc7, 0x5d, 0xe1, 0x4a, 0xe1, 0x71,
+	0xd1, 0xcd, 0xe3, 0x48, 0xdb, 0x63, 0x16, 0x5f, 0x36, 0x6d, 0x09, 0x40, 0x45, 0x88, 0x53, 0x1b,
+	0x99, 0xc0, 0x37, 0x33, 0x99, 0xef, 0x83, 0x01, 0x55, 0xcc, 0x5c, 0xe2, 0x13, 0x41, 0xb9, 0xe8,
+	0x22, 0x0b, 0xe6, 0x55, 0x44, 0x5f, 0x8e, 0xdd, 0xcc, 0x91, 0x5a, 0xbc, 0xc6, 0x53, 0xba, 0x58,
+	0x93, 0xf6, 0xd5, 0x48, 0x0d, 0x3d, 0x82, 0x32, 0x09, 0x74, 0xad, 0xfc, 0x4f, 0xb7, 0x37, 0x86,
+	0x5a, 0x6f, 0x0d, 0xb8, 0x9a, 0x2b, 0x9b, 0x3e, 0xd2, 0xa7, 0x30, 0x1f, 0x15, 0x76, 0xf2, 0x38,
+	0xd7, 0x26, 0xc5, 0x39, 0x64, 0x1f, 0x8f, 0x80, 0x2d, 0x09, 0x4b, 0x2d, 0xa2, 0x69, 0x8f, 0x79,
+	0xc3, 0xfc, 0xf9, 0x53, 0x5a, 0xf0, 0x17, 0x95, 0xf8, 0x6c, 0xf2, 0x51, 0x33, 0x4a, 0xf8, 0x8d,
+	0xc6, 0x3c, 0x1e, 0xa9, 0xc5, 0x57, 0x52, 0xf4, 0x83, 0x67, 0xaa, 0xab, 0xb2, 0x4b, 0x97, 0x0f,
+	0xad, 0x37, 0xb0, 0x90, 0x10, 0x4e, 0x6d, 0xb7, 0x03, 0x95, 0x7c, 0xeb, 0xa9, 0x5a, 0x77, 0xcc,
+	0x0e, 0x2e, 0xd0, 0xd6, 0x89, 0x01, 0xd7, 0xf7, 0xb8, 0xf0, 0xb6, 0xe4, 0x91, 0xd8, 0x39, 0x62,
+	0x2c, 0x9c, 0x5a, 0xc4, 0x36, 0x54, 0xb3, 0xe7, 0xfe, 0xc5, 0x71, 0xc8, 0x12, 0xcb, 0x8b, 0x9b,
+	0x77, 0x27, 0xc9, 0xe8, 0xb4, 0x9b, 0xcd, 0x02, 0x81, 0x87, 0xe1, 0xa8, 0x09, 0x55, 0x15, 0xd3,
+	0x67, 0x8f, 0x4b, 0xf9, 0x4f, 0x8f, 0xcb, 0x6c, 0xdc, 0x5c, 0x78, 0x18, 0xd3, 0x7a, 0x72, 0x72,
+	0x6e, 0x1a, 0xa7, 0xe7, 0xa6, 0xf1, 0xe3, 0xdc, 0x34, 0xde, 0x5f, 0x98, 0xa5, 0xd3, 0x0b, 0xb3,
+	0xf4, 0xf5, 0xc2, 0x2c, 0xed, 0xdb, 0x43, 0x1d, 0x98, 0xea, 0x5b, 0xdf, 0x26, 0xae, 0x72, 0x52,
+	0x81, 0xce, 0x6b, 0x67, 0xf0, 0x11, 0x4b, 0xba, 0xd1, 0x9d, 0x4b, 0xbe, 0x5f, 0xf7, 0x7f, 0x0e,
+	0x00, 0x50, 0x95, 0x7b, 0xfe, 0x49, 0x07, 0x00, 0x00,
 }
 
 func (m *SplitDelegation) Marshal() (dAtA []byte, err error) {
@@ -549,11 +723,16 @@ func (m *SplitDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintCallbacks(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x10
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCallbacks(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Validator) > 0 {
 		i -= len(m.Validator)
 		copy(dAtA[i:], m.Validator)
@@ -825,11 +1004,16 @@ func (m *Rebalancing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Amt != 0 {
-		i = encodeVarintCallbacks(dAtA, i, uint64(m.Amt))
-		i--
-		dAtA[i] = 0x18
+	{
+		size := m.Amt.Size()
+		i -= size
+		if _, err := m.Amt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCallbacks(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.DstValidator) > 0 {
 		i -= len(m.DstValidator)
 		copy(dAtA[i:], m.DstValidator)
@@ -891,6 +1075,137 @@ func (m *RebalanceCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BatchedCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchedCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchedCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumMsgs != 0 {
+		i = encodeVarintCallbacks(dAtA, i, uint64(m.NumMsgs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CallbackArgs) > 0 {
+		i -= len(m.CallbackArgs)
+		copy(dAtA[i:], m.CallbackArgs)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.CallbackArgs)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CallbackId) > 0 {
+		i -= len(m.CallbackId)
+		copy(dAtA[i:], m.CallbackId)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.CallbackId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Callbacks) > 0 {
+		for iNdEx := len(m.Callbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Callbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCallbacks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.HostZoneId) > 0 {
+		i -= len(m.HostZoneId)
+		copy(dAtA[i:], m.HostZoneId)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.HostZoneId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WindDownSweepCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WindDownSweepCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WindDownSweepCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SweepAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCallbacks(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.AccountType != 0 {
+		i = encodeVarintCallbacks(dAtA, i, uint64(m.AccountType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.HostZoneId) > 0 {
+		i -= len(m.HostZoneId)
+		copy(dAtA[i:], m.HostZoneId)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.HostZoneId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCallbacks(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCallbacks(v)
 	base := offset
@@ -912,9 +1227,8 @@ func (m *SplitDelegation) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCallbacks(uint64(l))
 	}
-	if m.Amount != 0 {
-		n += 1 + sovCallbacks(uint64(m.Amount))
-	}
+	l = m.Amount.Size()
+	n += 1 + l + sovCallbacks(uint64(l))
 	return n
 }
 
@@ -1035,9 +1349,8 @@ func (m *Rebalancing) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCallbacks(uint64(l))
 	}
-	if m.Amt != 0 {
-		n += 1 + sovCallbacks(uint64(m.Amt))
-	}
+	l = m.Amt.Size()
+	n += 1 + l + sovCallbacks(uint64(l))
 	return n
 }
 
@@ -1060,11 +1373,68 @@ func (m *RebalanceCallback) Size() (n int) {
 	return n
 }
 
-func sovCallbacks(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *BatchedCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CallbackId)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	l = len(m.CallbackArgs)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	if m.NumMsgs != 0 {
+		n += 1 + sovCallbacks(uint64(m.NumMsgs))
+	}
+	return n
 }
-func sozCallbacks(x uint64) (n int) {
-	return sovCallbacks(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *BatchCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HostZoneId)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	if len(m.Callbacks) > 0 {
+		for _, e := range m.Callbacks {
+			l = e.Size()
+			n += 1 + l + sovCallbacks(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WindDownSweepCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HostZoneId)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	if m.AccountType != 0 {
+		n += 1 + sovCallbacks(uint64(m.AccountType))
+	}
+	l = m.SweepAmount.Size()
+	n += 1 + l + sovCallbacks(uint64(l))
+	return n
+}
+
+func sovCallbacks(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCallbacks(x uint64) (n int) {
+	return sovCallbacks(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *SplitDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -1128,10 +1498,10 @@ func (m *SplitDelegation) Unmarshal(dAtA []byte) error {
 			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCallbacks
@@ -1141,11 +1511,26 @@ func (m *SplitDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCallbacks(dAtA[iNdEx:])
@@ -1994,10 +2379,10 @@ func (m *Rebalancing) Unmarshal(dAtA []byte) error {
 			m.DstValidator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amt", wireType)
 			}
-			m.Amt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCallbacks
@@ -2007,11 +2392,26 @@ func (m *Rebalancing) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amt |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCallbacks(dAtA[iNdEx:])
@@ -2149,6 +2549,391 @@ func (m *RebalanceCallback) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BatchedCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCallbacks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchedCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchedCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackArgs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackArgs = append(m.CallbackArgs[:0], dAtA[iNdEx:postIndex]...)
+			if m.CallbackArgs == nil {
+				m.CallbackArgs = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumMsgs", wireType)
+			}
+			m.NumMsgs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumMsgs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCallbacks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCallbacks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callbacks = append(m.Callbacks, &BatchedCallback{})
+			if err := m.Callbacks[len(m.Callbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCallbacks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WindDownSweepCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCallbacks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WindDownSweepCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WindDownSweepCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+			}
+			m.AccountType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountType |= ICAAccountType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SweepAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SweepAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCallbacks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCallbacks(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
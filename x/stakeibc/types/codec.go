@@ -19,8 +19,13 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgChangeValidatorWeight{}, "stakeibc/ChangeValidatorWeight", nil)
 	cdc.RegisterConcrete(&MsgDeleteValidator{}, "stakeibc/DeleteValidator", nil)
 	cdc.RegisterConcrete(&AddValidatorProposal{}, "stakeibc/AddValidatorProposal", nil)
+	cdc.RegisterConcrete(&DeleteValidatorProposal{}, "stakeibc/DeleteValidatorProposal", nil)
 	cdc.RegisterConcrete(&MsgRestoreInterchainAccount{}, "stakeibc/RestoreInterchainAccount", nil)
 	cdc.RegisterConcrete(&MsgUpdateValidatorSharesExchRate{}, "stakeibc/UpdateValidatorSharesExchRate", nil)
+	cdc.RegisterConcrete(&MsgUpdateHostZoneSchedule{}, "stakeibc/UpdateHostZoneSchedule", nil)
+	cdc.RegisterConcrete(&MsgSetWithdrawalAddress{}, "stakeibc/SetWithdrawalAddress", nil)
+	cdc.RegisterConcrete(&MsgWindDownHostZone{}, "stakeibc/WindDownHostZone", nil)
+	cdc.RegisterConcrete(&MsgUpdateMaxLightClientAge{}, "stakeibc/UpdateMaxLightClientAge", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -37,10 +42,15 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgDeleteValidator{},
 		&MsgRestoreInterchainAccount{},
 		&MsgUpdateValidatorSharesExchRate{},
+		&MsgUpdateHostZoneSchedule{},
+		&MsgSetWithdrawalAddress{},
+		&MsgWindDownHostZone{},
+		&MsgUpdateMaxLightClientAge{},
 	)
 
 	registry.RegisterImplementations((*govtypes.Content)(nil),
 		&AddValidatorProposal{},
+		&DeleteValidatorProposal{},
 	)
 
 	// this line is used by starport scaffolding # 3
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+// The largest denom exponent supported for a host zone (e.g. 18 for aevmos)
+// Amounts are converted through sdk.Dec, which carries 18 decimals of precision
+const MaxDenomExponent = 18
+
+// The exponent assumed for host zones registered before the exponent was tracked
+const DefaultDenomExponent = 6
+
+// SI prefixes conventionally prepended to a base denom, keyed by the exponent they represent
+var siDenomPrefixes = map[uint32]string{
+	3:  "m",
+	6:  "u",
+	9:  "n",
+	12: "p",
+	15: "f",
+	18: "a",
+}
+
+// DefaultDisplayDenom infers a display denom by stripping the SI prefix matching the exponent
+// from the host denom (e.g. uatom with exponent 6 -> atom, aevmos with exponent 18 -> evmos)
+// If the host denom does not carry the expected prefix, it is returned unchanged
+func DefaultDisplayDenom(hostDenom string, exponent uint32) string {
+	prefix, ok := siDenomPrefixes[exponent]
+	if !ok || !strings.HasPrefix(hostDenom, prefix) || len(hostDenom) == len(prefix) {
+		return hostDenom
+	}
+	return strings.TrimPrefix(hostDenom, prefix)
+}
+
+// StTokenMetadata builds the bank denom metadata for a host zone's stToken
+// The stToken's base unit mirrors the host denom and its display unit sits at the host's exponent
+// e.g. stuatom (exponent 0) and statom (exponent 6)
+func StTokenMetadata(hostDenom string, displayDenom string, exponent uint32) banktypes.Metadata {
+	base := StAssetDenomFromHostZoneDenom(hostDenom)
+	display := base
+	denomUnits := []*banktypes.DenomUnit{{Denom: base, Exponent: 0}}
+	if exponent > 0 {
+		display = StAssetDenomFromHostZoneDenom(displayDenom)
+		denomUnits = append(denomUnits, &banktypes.DenomUnit{Denom: display, Exponent: exponent})
+	}
+	return banktypes.Metadata{
+		Description: fmt.Sprintf("Stride liquid staked %s", displayDenom),
+		DenomUnits:  denomUnits,
+		Base:        base,
+		Display:     display,
+		Name:        display,
+		Symbol:      strings.ToUpper(display),
+	}
+}
+
+// NewCoinFromAmount builds a coin directly from an integer amount and denom
+// Unlike formatting the amount into a string and parsing it back, this preserves arbitrarily
+// large amounts (e.g. 18 decimal tokens) and does not depend on the denom's character set
+func NewCoinFromAmount(denom string, amount sdk.Int) (sdk.Coin, error) {
+	coin := sdk.Coin{Denom: denom, Amount: amount}
+	if err := coin.Validate(); err != nil {
+		return sdk.Coin{}, err
+	}
+	return coin, nil
+}
+
+// IBCDenomFromHostDenom returns the denom of a host zone's native token after it has been transferred
+// to Stride over the given channel, i.e. the hash of transfer/{channelId}/{hostDenom}
+func IBCDenomFromHostDenom(transferChannelId string, hostDenom string) string {
+	prefixedDenom := ibctransfertypes.GetPrefixedDenom(ibctransfertypes.PortID, transferChannelId, hostDenom)
+	return ibctransfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
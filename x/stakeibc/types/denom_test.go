@@ -0,0 +1,51 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultDisplayDenom(t *testing.T) {
+	require.Equal(t, "atom", DefaultDisplayDenom("uatom", 6))
+	require.Equal(t, "evmos", DefaultDisplayDenom("aevmos", 18))
+	require.Equal(t, "uatom", DefaultDisplayDenom("uatom", 18), "prefix does not match exponent")
+	require.Equal(t, "uatom", DefaultDisplayDenom("uatom", 7), "no prefix for exponent")
+	require.Equal(t, "u", DefaultDisplayDenom("u", 6), "denom is only the prefix")
+}
+
+func TestStTokenMetadata(t *testing.T) {
+	metadata := StTokenMetadata("aevmos", "evmos", 18)
+	require.NoError(t, metadata.Validate())
+	require.Equal(t, "staevmos", metadata.Base)
+	require.Equal(t, "stevmos", metadata.Display)
+	require.Equal(t, uint32(18), metadata.DenomUnits[1].Exponent)
+
+	// With no exponent, the base unit is the only unit
+	metadata = StTokenMetadata("utoken", "utoken", 0)
+	require.NoError(t, metadata.Validate())
+	require.Len(t, metadata.DenomUnits, 1)
+	require.Equal(t, "stutoken", metadata.Display)
+}
+
+func TestNewCoinFromAmount(t *testing.T) {
+	amount, ok := sdk.NewIntFromString("3000000123456789012345679")
+	require.True(t, ok)
+
+	coin, err := NewCoinFromAmount("staevmos", amount)
+	require.NoError(t, err)
+	require.Equal(t, amount, coin.Amount)
+	require.Equal(t, "staevmos", coin.Denom)
+
+	_, err = NewCoinFromAmount("st:", amount)
+	require.EqualError(t, err, "invalid denom: st:")
+
+	_, err = NewCoinFromAmount("staevmos", sdk.NewInt(-1))
+	require.EqualError(t, err, "negative coin amount: -1")
+}
+
+func TestIBCDenomFromHostDenom(t *testing.T) {
+	require.Equal(t, "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", IBCDenomFromHostDenom("channel-0", "uatom"))
+	require.NotEqual(t, IBCDenomFromHostDenom("channel-0", "uatom"), IBCDenomFromHostDenom("channel-1", "uatom"))
+}
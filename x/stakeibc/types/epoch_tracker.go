@@ -0,0 +1,36 @@
+package types
+
+import (
+	"github.com/spf13/cast"
+
+	epochstypes "github.com/Stride-Labs/stride/x/epochs/types"
+)
+
+// NewEpochTracker derives the epoch tracker for an epoch from its info in x/epochs
+// Before the epoch starts counting, the next epoch start time is the time the first epoch starts
+func NewEpochTracker(epochInfo epochstypes.EpochInfo) (EpochTracker, error) {
+	epochNumber, err := cast.ToUint64E(epochInfo.CurrentEpoch)
+	if err != nil {
+		return EpochTracker{}, err
+	}
+	duration, err := cast.ToUint64E(epochInfo.Duration.Nanoseconds())
+	if err != nil {
+		return EpochTracker{}, err
+	}
+
+	nextEpochStartTime := epochInfo.StartTime
+	if epochInfo.EpochCountingStarted {
+		nextEpochStartTime = epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
+	}
+	nextEpochStartTimeNanos, err := cast.ToUint64E(nextEpochStartTime.UnixNano())
+	if err != nil {
+		return EpochTracker{}, err
+	}
+
+	return EpochTracker{
+		EpochIdentifier:    epochInfo.Identifier,
+		EpochNumber:        epochNumber,
+		NextEpochStartTime: nextEpochStartTimeNanos,
+		Duration:           duration,
+	}, nil
+}
@@ -47,4 +47,10 @@ var (
 	ErrHostZoneICAAccountNotFound        = sdkerrors.Register(ModuleName, 1537, "host zone's ICA account not found")
 	ErrNoValidatorAmts                   = sdkerrors.Register(ModuleName, 1538, "could not fetch validator amts")
 	ErrMaxNumValidators                  = sdkerrors.Register(ModuleName, 1539, "max number of validators reached")
+	ErrNoRedelegationsPossible           = sdkerrors.Register(ModuleName, 1540, "no redelegations possible within host limits")
+	ErrICAChannelClosed                  = sdkerrors.Register(ModuleName, 1541, "ICA channel is not open")
+	ErrHostZoneWindingDown               = sdkerrors.Register(ModuleName, 1542, "host zone is winding down")
+	ErrLightClientStale                  = sdkerrors.Register(ModuleName, 1543, "light client is stale")
+	ErrValidatorPending                  = sdkerrors.Register(ModuleName, 1544, "validator is pending")
+	ErrInvalidParamChange                = sdkerrors.Register(ModuleName, 1545, "invalid param change")
 )
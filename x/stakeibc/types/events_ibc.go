@@ -22,5 +22,9 @@ const (
 	AttributeKeyRedeemAmount     = "redeem_amount"
 	AttributeKeySourceAddress    = "source"
 
+	// triggers of the EventRebalance typed event
+	AttributeValueRebalanceManual    = "manual"
+	AttributeValueRebalanceAutomatic = "automatic"
+
 	AttributeValueCategory = ModuleName
 )
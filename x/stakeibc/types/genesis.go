@@ -12,8 +12,8 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		ICAAccount:       nil,
-		EpochTrackerList: []EpochTracker{},
+		ICAAccount:   nil,
+		HostZoneList: []HostZone{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 		PortId: PortID,
@@ -36,15 +36,49 @@ func (gs GenesisState) Validate() error {
 		hostZoneList[hostZone.ChainId] = hostZone
 	}
 
-	// Check for duplicated index in epochTracker
-	epochTrackerIndexMap := make(map[string]struct{})
+	// Check for duplicated index in pendingValidator
+	pendingValidatorIndexMap := make(map[string]struct{})
 
-	for _, elem := range gs.EpochTrackerList {
-		index := string(EpochTrackerKey(elem.EpochIdentifier))
-		if _, ok := epochTrackerIndexMap[index]; ok {
-			return fmt.Errorf("duplicated index for epochTracker")
+	for _, elem := range gs.PendingValidatorList {
+		index := string(PendingValidatorKey(elem.ChainId, elem.Validator.Address))
+		if _, ok := pendingValidatorIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for pendingValidator")
 		}
-		epochTrackerIndexMap[index] = struct{}{}
+		pendingValidatorIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated index in redelegation
+	redelegationIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.RedelegationList {
+		index := string(RedelegationKey(elem.HostZoneId, elem.SrcValidator, elem.DstValidator))
+		if _, ok := redelegationIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for redelegation")
+		}
+		redelegationIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated index in icaRecovery
+	icaRecoveryIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.IcaRecoveryList {
+		index := string(ICARecoveryKey(elem.ChainId, elem.AccountType))
+		if _, ok := icaRecoveryIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for icaRecovery")
+		}
+		icaRecoveryIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated ID in queuedICATx
+	queuedICATxIdMap := make(map[uint64]struct{})
+	for _, elem := range gs.QueuedICATxList {
+		if _, ok := queuedICATxIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for queuedICATx")
+		}
+		if elem.Id >= gs.QueuedICATxCount {
+			return fmt.Errorf("queuedICATx id should be lower or equal than the last id")
+		}
+		queuedICATxIdMap[elem.Id] = struct{}{}
 	}
 
 	// this line is used by starport scaffolding # genesis/types/validate
@@ -32,8 +32,20 @@ type GenesisState struct {
 	HostZoneList  []HostZone  `protobuf:"bytes,5,rep,name=hostZoneList,proto3" json:"hostZoneList"`
 	HostZoneCount uint64      `protobuf:"varint,6,opt,name=hostZoneCount,proto3" json:"hostZoneCount,omitempty"`
 	// stores a map from hostZone base denom to hostZone
-	DenomToHostZone  map[string]string `protobuf:"bytes,9,rep,name=denomToHostZone,proto3" json:"denomToHostZone,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	EpochTrackerList []EpochTracker    `protobuf:"bytes,10,rep,name=epochTrackerList,proto3" json:"epochTrackerList"`
+	DenomToHostZone map[string]string `protobuf:"bytes,9,rep,name=denomToHostZone,proto3" json:"denomToHostZone,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// deprecated: epoch trackers are derived from x/epochs, so this is ignored on
+	// import and left empty on export
+	EpochTrackerList         []EpochTracker            `protobuf:"bytes,10,rep,name=epochTrackerList,proto3" json:"epochTrackerList"`
+	MinValidatorRequirements *MinValidatorRequirements `protobuf:"bytes,12,opt,name=minValidatorRequirements,proto3" json:"minValidatorRequirements,omitempty"`
+	// validators awaiting the ICQ of their staking record before being added
+	PendingValidatorList []PendingValidator `protobuf:"bytes,13,rep,name=pendingValidatorList,proto3" json:"pendingValidatorList"`
+	// redelegations that have not yet completed on the host
+	RedelegationList []Redelegation `protobuf:"bytes,14,rep,name=redelegationList,proto3" json:"redelegationList"`
+	// closed ICA channels that are being re-opened
+	IcaRecoveryList []ICARecovery `protobuf:"bytes,15,rep,name=icaRecoveryList,proto3" json:"icaRecoveryList"`
+	// ICA txs waiting to be sent at the end of the block
+	QueuedICATxList  []QueuedICATx `protobuf:"bytes,16,rep,name=queuedICATxList,proto3" json:"queuedICATxList"`
+	QueuedICATxCount uint64        `protobuf:"varint,17,opt,name=queuedICATxCount,proto3" json:"queuedICATxCount,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -118,6 +130,48 @@ func (m *GenesisState) GetEpochTrackerList() []EpochTracker {
 	return nil
 }
 
+func (m *GenesisState) GetMinValidatorRequirements() *MinValidatorRequirements {
+	if m != nil {
+		return m.MinValidatorRequirements
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingValidatorList() []PendingValidator {
+	if m != nil {
+		return m.PendingValidatorList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRedelegationList() []Redelegation {
+	if m != nil {
+		return m.RedelegationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIcaRecoveryList() []ICARecovery {
+	if m != nil {
+		return m.IcaRecoveryList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQueuedICATxList() []QueuedICATx {
+	if m != nil {
+		return m.QueuedICATxList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQueuedICATxCount() uint64 {
+	if m != nil {
+		return m.QueuedICATxCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "Stridelabs.stride.stakeibc.GenesisState")
 	proto.RegisterMapType((map[string]string)(nil), "Stridelabs.stride.stakeibc.GenesisState.DenomToHostZoneEntry")
@@ -126,35 +180,47 @@ func init() {
 func init() { proto.RegisterFile("stakeibc/genesis.proto", fileDescriptor_b132bbaf7441a735) }
 
 var fileDescriptor_b132bbaf7441a735 = []byte{
-	// 444 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x4f, 0x6f, 0xd3, 0x30,
-	0x14, 0xaf, 0xd7, 0xac, 0xac, 0x6e, 0x11, 0x95, 0x55, 0x20, 0x8a, 0x50, 0x88, 0xa6, 0x09, 0xe5,
-	0x82, 0x23, 0x8d, 0x0b, 0x42, 0x42, 0x62, 0x1d, 0x83, 0x6d, 0x9a, 0x10, 0xca, 0x76, 0xea, 0x25,
-	0x72, 0x12, 0x2b, 0xb5, 0xba, 0xc6, 0x51, 0xec, 0x22, 0xca, 0xa7, 0xe0, 0x03, 0xf1, 0x01, 0x76,
-	0xec, 0x91, 0x13, 0x42, 0xed, 0x17, 0x41, 0xb1, 0xdd, 0xa8, 0x05, 0x96, 0xdb, 0x7b, 0x79, 0xbf,
-	0x7f, 0x2f, 0xcf, 0xf0, 0x89, 0x90, 0x64, 0x4a, 0x59, 0x9c, 0x04, 0x19, 0xcd, 0xa9, 0x60, 0x02,
-	0x17, 0x25, 0x97, 0x1c, 0x39, 0xd7, 0xb2, 0x64, 0x29, 0xbd, 0x25, 0xb1, 0xc0, 0x42, 0x95, 0x78,
-	0x83, 0x74, 0x86, 0x19, 0xcf, 0xb8, 0x82, 0x05, 0x55, 0xa5, 0x19, 0xce, 0xe3, 0x5a, 0xa9, 0x20,
-	0x25, 0x99, 0x19, 0x21, 0xc7, 0xa9, 0x3f, 0xb3, 0x84, 0x44, 0x24, 0x49, 0xf8, 0x3c, 0x97, 0x66,
-	0x66, 0xd7, 0xb3, 0x09, 0x17, 0x32, 0xfa, 0xc6, 0x73, 0x6a, 0x26, 0xcf, 0xea, 0x09, 0x2d, 0x78,
-	0x32, 0x89, 0x64, 0x49, 0x92, 0x29, 0x2d, 0xf5, 0xf4, 0xf0, 0x87, 0x05, 0xfb, 0x1f, 0x75, 0xdc,
-	0x6b, 0x49, 0x24, 0x45, 0xef, 0x60, 0x47, 0x9b, 0xda, 0xc0, 0x03, 0x7e, 0xef, 0xf8, 0x10, 0xdf,
-	0x1f, 0x1f, 0x7f, 0x56, 0xc8, 0x91, 0x75, 0xf7, 0xeb, 0x79, 0x2b, 0x34, 0x3c, 0xf4, 0x14, 0x3e,
-	0x28, 0x78, 0x29, 0x23, 0x96, 0xda, 0x7b, 0x1e, 0xf0, 0xbb, 0x61, 0xa7, 0x6a, 0x2f, 0x52, 0xf4,
-	0x01, 0x42, 0x76, 0x7a, 0x72, 0xa2, 0x73, 0xdb, 0x96, 0x92, 0x7f, 0xd1, 0x24, 0x7f, 0x51, 0xa3,
-	0xc3, 0x2d, 0x26, 0xfa, 0x04, 0xfb, 0xd5, 0x92, 0x63, 0x9e, 0xd3, 0x2b, 0x26, 0xa4, 0xbd, 0xef,
-	0xb5, 0xfd, 0xde, 0xf1, 0x51, 0x93, 0xd2, 0xb9, 0xc1, 0x9b, 0xa8, 0x3b, 0x7c, 0x74, 0x04, 0x1f,
-	0x6e, 0xfa, 0x53, 0x15, 0xad, 0xe3, 0x01, 0xdf, 0x0a, 0x77, 0x3f, 0xa2, 0x0c, 0x3e, 0x4a, 0x69,
-	0xce, 0x67, 0x37, 0x7c, 0x23, 0x66, 0x77, 0x95, 0xf1, 0xdb, 0x26, 0xe3, 0xed, 0x7f, 0x8b, 0xdf,
-	0xef, 0xf2, 0xcf, 0x72, 0x59, 0x2e, 0xc2, 0xbf, 0x55, 0xd1, 0x18, 0x0e, 0xd4, 0xa5, 0x6e, 0xf4,
-	0xa1, 0xd4, 0x8a, 0x50, 0x39, 0xf9, 0x4d, 0x4e, 0x67, 0x5b, 0x1c, 0xb3, 0xe6, 0x3f, 0x3a, 0xce,
-	0x08, 0x0e, 0xff, 0x17, 0x02, 0x0d, 0x60, 0x7b, 0x4a, 0x17, 0xea, 0xe4, 0xdd, 0xb0, 0x2a, 0xd1,
-	0x10, 0xee, 0x7f, 0x21, 0xb7, 0x73, 0x6a, 0x6e, 0xa8, 0x9b, 0x37, 0x7b, 0xaf, 0xc1, 0xa5, 0x75,
-	0xd0, 0x1e, 0x58, 0x97, 0xd6, 0x41, 0x6f, 0xd0, 0x1f, 0x9d, 0xdf, 0xad, 0x5c, 0xb0, 0x5c, 0xb9,
-	0xe0, 0xf7, 0xca, 0x05, 0xdf, 0xd7, 0x6e, 0x6b, 0xb9, 0x76, 0x5b, 0x3f, 0xd7, 0x6e, 0x6b, 0x8c,
-	0x33, 0x26, 0x27, 0xf3, 0x18, 0x27, 0x7c, 0x16, 0xe8, 0xd4, 0x2f, 0xaf, 0x48, 0x2c, 0x02, 0x1d,
-	0x3b, 0xf8, 0x1a, 0xd4, 0xcf, 0x52, 0x2e, 0x0a, 0x2a, 0xe2, 0x8e, 0x7a, 0x8f, 0xaf, 0xfe, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x4c, 0xec, 0x8f, 0x2a, 0x46, 0x03, 0x00, 0x00,
+	// 626 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x41, 0x4f, 0xd4, 0x40,
+	0x14, 0xc7, 0xb7, 0x50, 0x56, 0x18, 0x16, 0xa9, 0x93, 0x55, 0x9b, 0x6a, 0xea, 0x86, 0x10, 0x5d,
+	0x8d, 0xb6, 0x09, 0x7a, 0x30, 0x26, 0x26, 0x02, 0xa2, 0x40, 0xd0, 0x68, 0x21, 0x9a, 0x70, 0x69,
+	0x66, 0xdb, 0xb1, 0x4c, 0xd8, 0xce, 0x94, 0xe9, 0x94, 0xb0, 0x7e, 0x0a, 0x3f, 0x16, 0x47, 0x8e,
+	0x9e, 0x8c, 0x81, 0x2f, 0x62, 0x3a, 0x9d, 0xd6, 0xb2, 0x0b, 0xe5, 0xd6, 0x99, 0xff, 0xfb, 0xff,
+	0xde, 0xbc, 0xd7, 0x37, 0x03, 0xee, 0xa5, 0x02, 0x1d, 0x62, 0x32, 0x08, 0xdc, 0x08, 0x53, 0x9c,
+	0x92, 0xd4, 0x49, 0x38, 0x13, 0x0c, 0x5a, 0xbb, 0x82, 0x93, 0x10, 0x0f, 0xd1, 0x20, 0x75, 0x52,
+	0xf9, 0xe9, 0x94, 0x91, 0x56, 0x37, 0x62, 0x11, 0x93, 0x61, 0x6e, 0xfe, 0x55, 0x38, 0xac, 0xbb,
+	0x15, 0x29, 0x41, 0x1c, 0xc5, 0x0a, 0x64, 0x59, 0xd5, 0x36, 0x09, 0x90, 0x8f, 0x82, 0x80, 0x65,
+	0x54, 0x28, 0xcd, 0xac, 0xb4, 0x03, 0x96, 0x0a, 0xff, 0x27, 0xa3, 0x58, 0x29, 0x0f, 0x2b, 0x05,
+	0x27, 0x2c, 0x38, 0xf0, 0x05, 0x47, 0xc1, 0x21, 0xe6, 0x4a, 0x7d, 0x5a, 0xa9, 0x31, 0xa1, 0xfe,
+	0x31, 0x1a, 0x92, 0x10, 0x09, 0xc6, 0x7d, 0x8e, 0x8f, 0x32, 0xc2, 0x71, 0x8c, 0xa9, 0x48, 0x27,
+	0x52, 0x54, 0x61, 0x4a, 0x79, 0x50, 0x29, 0x1c, 0x87, 0x78, 0x88, 0x23, 0x24, 0x08, 0xa3, 0x13,
+	0x62, 0x7e, 0x6a, 0x8e, 0x03, 0x76, 0x8c, 0xf9, 0xe8, 0x4a, 0x51, 0x9c, 0xf8, 0x47, 0x19, 0xce,
+	0xd4, 0xc9, 0x97, 0xce, 0x66, 0x41, 0xe7, 0x63, 0xd1, 0xca, 0x5d, 0x81, 0x04, 0x86, 0xef, 0x40,
+	0xbb, 0x68, 0x88, 0xa9, 0xf5, 0xb4, 0xfe, 0xfc, 0xca, 0x92, 0x73, 0x7d, 0x6b, 0x9d, 0x2f, 0x32,
+	0x72, 0x4d, 0x3f, 0xfd, 0xf3, 0xa8, 0xe5, 0x29, 0x1f, 0xbc, 0x0f, 0x6e, 0x25, 0x8c, 0x0b, 0x9f,
+	0x84, 0xe6, 0x54, 0x4f, 0xeb, 0xcf, 0x79, 0xed, 0x7c, 0xb9, 0x15, 0xc2, 0x0f, 0x00, 0x90, 0xf5,
+	0xd5, 0xd5, 0xa2, 0xa7, 0xa6, 0x2e, 0xf1, 0x8f, 0x9b, 0xf0, 0x5b, 0x55, 0xb4, 0x57, 0x73, 0xc2,
+	0xcf, 0xa0, 0x93, 0xff, 0x80, 0x7d, 0x46, 0xf1, 0x0e, 0x49, 0x85, 0x39, 0xd3, 0x9b, 0xee, 0xcf,
+	0xaf, 0x2c, 0x37, 0x91, 0x36, 0x55, 0xbc, 0x3a, 0xea, 0x25, 0x3f, 0x5c, 0x06, 0x0b, 0xe5, 0x7a,
+	0x5d, 0x1e, 0xad, 0xdd, 0xd3, 0xfa, 0xba, 0x77, 0x79, 0x13, 0x46, 0x60, 0x31, 0xc4, 0x94, 0xc5,
+	0x7b, 0xac, 0x84, 0x99, 0x73, 0x32, 0xf1, 0xdb, 0xa6, 0xc4, 0xf5, 0xde, 0x3a, 0xef, 0x2f, 0xfb,
+	0x37, 0xa8, 0xe0, 0x23, 0x6f, 0x9c, 0x0a, 0xf7, 0x81, 0x21, 0xa7, 0x68, 0xaf, 0x18, 0x22, 0x59,
+	0x22, 0x90, 0x99, 0xfa, 0x4d, 0x99, 0x36, 0x6a, 0x1e, 0x55, 0xe6, 0x04, 0x07, 0x26, 0xc0, 0x8c,
+	0x09, 0xfd, 0x56, 0xce, 0x96, 0x57, 0x9b, 0x40, 0xb3, 0x23, 0x7f, 0xc8, 0xab, 0xa6, 0x1c, 0x9f,
+	0xae, 0xf1, 0x7a, 0xd7, 0x52, 0xe1, 0x0f, 0xd0, 0x4d, 0x30, 0x0d, 0x09, 0x8d, 0x2a, 0x5d, 0x56,
+	0xb4, 0x20, 0x2b, 0x7a, 0xde, 0x38, 0x5d, 0x63, 0x3e, 0x55, 0xd5, 0x95, 0xbc, 0xbc, 0x6b, 0xf5,
+	0x8b, 0x21, 0x73, 0xdc, 0xbe, 0xb9, 0x6b, 0x5e, 0xcd, 0x53, 0x76, 0x6d, 0x9c, 0x03, 0xbf, 0x83,
+	0x45, 0x12, 0x20, 0x4f, 0x5d, 0x2b, 0x89, 0x5e, 0x94, 0xe8, 0x27, 0x37, 0x4c, 0x6f, 0x69, 0x51,
+	0xe4, 0x71, 0x4a, 0x0e, 0x96, 0x97, 0x31, 0xdc, 0x5a, 0x5f, 0xdd, 0x3b, 0x91, 0x60, 0xe3, 0x66,
+	0xf0, 0xd7, 0xff, 0x96, 0x12, 0x3c, 0x46, 0x81, 0xcf, 0x80, 0x51, 0xdb, 0x2a, 0xa6, 0xfa, 0x8e,
+	0x9c, 0xea, 0x89, 0x7d, 0x6b, 0x0d, 0x74, 0xaf, 0x1a, 0x4c, 0x68, 0x80, 0xe9, 0x43, 0x3c, 0x92,
+	0xcf, 0xc0, 0x9c, 0x97, 0x7f, 0xc2, 0x2e, 0x98, 0x39, 0x46, 0xc3, 0x0c, 0xab, 0x7b, 0x5d, 0x2c,
+	0xde, 0x4c, 0xbd, 0xd6, 0xb6, 0xf5, 0xd9, 0x69, 0x43, 0xdf, 0xd6, 0x67, 0xe7, 0x8d, 0xce, 0xda,
+	0xe6, 0xe9, 0xb9, 0xad, 0x9d, 0x9d, 0xdb, 0xda, 0xdf, 0x73, 0x5b, 0xfb, 0x75, 0x61, 0xb7, 0xce,
+	0x2e, 0xec, 0xd6, 0xef, 0x0b, 0xbb, 0xb5, 0xef, 0x44, 0x44, 0x1c, 0x64, 0x03, 0x27, 0x60, 0xb1,
+	0x5b, 0xd4, 0xf7, 0x62, 0x07, 0x0d, 0x52, 0xb7, 0x28, 0xd0, 0x3d, 0x71, 0xab, 0x97, 0x4a, 0x8c,
+	0x12, 0x9c, 0x0e, 0xda, 0xf2, 0x8d, 0x7a, 0xf9, 0x6f, 0x00, 0x2c, 0xc4, 0xfa, 0x25, 0xf6, 0x05,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -177,6 +243,83 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.QueuedICATxCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.QueuedICATxCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.QueuedICATxList) > 0 {
+		for iNdEx := len(m.QueuedICATxList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedICATxList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.IcaRecoveryList) > 0 {
+		for iNdEx := len(m.IcaRecoveryList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IcaRecoveryList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.RedelegationList) > 0 {
+		for iNdEx := len(m.RedelegationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedelegationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.PendingValidatorList) > 0 {
+		for iNdEx := len(m.PendingValidatorList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingValidatorList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.MinValidatorRequirements != nil {
+		{
+			size, err := m.MinValidatorRequirements.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if len(m.EpochTrackerList) > 0 {
 		for iNdEx := len(m.EpochTrackerList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -311,6 +454,37 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.MinValidatorRequirements != nil {
+		l = m.MinValidatorRequirements.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.PendingValidatorList) > 0 {
+		for _, e := range m.PendingValidatorList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RedelegationList) > 0 {
+		for _, e := range m.RedelegationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IcaRecoveryList) > 0 {
+		for _, e := range m.IcaRecoveryList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QueuedICATxList) > 0 {
+		for _, e := range m.QueuedICATxList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.QueuedICATxCount != 0 {
+		n += 2 + sovGenesis(uint64(m.QueuedICATxCount))
+	}
 	return n
 }
 
@@ -664,6 +838,197 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidatorRequirements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinValidatorRequirements == nil {
+				m.MinValidatorRequirements = &MinValidatorRequirements{}
+			}
+			if err := m.MinValidatorRequirements.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingValidatorList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingValidatorList = append(m.PendingValidatorList, PendingValidator{})
+			if err := m.PendingValidatorList[len(m.PendingValidatorList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedelegationList = append(m.RedelegationList, Redelegation{})
+			if err := m.RedelegationList[len(m.RedelegationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaRecoveryList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IcaRecoveryList = append(m.IcaRecoveryList, ICARecovery{})
+			if err := m.IcaRecoveryList[len(m.IcaRecoveryList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedICATxList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedICATxList = append(m.QueuedICATxList, QueuedICATx{})
+			if err := m.QueuedICATxList[len(m.QueuedICATxList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedICATxCount", wireType)
+			}
+			m.QueuedICATxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedICATxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -22,6 +22,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				PortId: types.PortID,
 				ICAAccount: &types.ICAAccount{
 					Address: "79",
@@ -35,9 +36,81 @@ func TestGenesisState_Validate(t *testing.T) {
 					},
 				},
 				HostZoneCount: 2,
+				MinValidatorRequirements: &types.MinValidatorRequirements{
+					CommissionRate: 5,
+				},
+				PendingValidatorList: []types.PendingValidator{
+					{ChainId: "0", Validator: types.Validator{Address: "val1"}},
+					{ChainId: "1", Validator: types.Validator{Address: "val1"}},
+				},
+				RedelegationList: []types.Redelegation{
+					{HostZoneId: "0", SrcValidator: "val1", DstValidator: "val2"},
+					{HostZoneId: "0", SrcValidator: "val2", DstValidator: "val1"},
+				},
+				QueuedICATxList: []types.QueuedICATx{
+					{ChainId: "0", Id: 0},
+					{ChainId: "0", Id: 1},
+				},
+				QueuedICATxCount: 2,
 			},
 			valid: true,
 		},
+		{
+			desc: "duplicated hostZone",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				HostZoneList: []types.HostZone{
+					{ChainId: "0"},
+					{ChainId: "0"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated pendingValidator",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				PendingValidatorList: []types.PendingValidator{
+					{ChainId: "0", Validator: types.Validator{Address: "val1"}},
+					{ChainId: "0", Validator: types.Validator{Address: "val1"}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated redelegation",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				RedelegationList: []types.Redelegation{
+					{HostZoneId: "0", SrcValidator: "val1", DstValidator: "val2"},
+					{HostZoneId: "0", SrcValidator: "val1", DstValidator: "val2"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated queuedICATx",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				QueuedICATxList: []types.QueuedICATx{
+					{ChainId: "0", Id: 0},
+					{ChainId: "1", Id: 0},
+				},
+				QueuedICATxCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid queuedICATx count",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				QueuedICATxList: []types.QueuedICATx{
+					{ChainId: "0", Id: 1},
+				},
+				QueuedICATxCount: 1,
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
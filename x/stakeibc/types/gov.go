@@ -7,16 +7,20 @@ import (
 )
 
 const (
-	ProposalTypeAddValidator = "AddValidator"
+	ProposalTypeAddValidator    = "AddValidator"
+	ProposalTypeDeleteValidator = "DeleteValidator"
 )
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeAddValidator)
 	govtypes.RegisterProposalTypeCodec(&AddValidatorProposal{}, "stakeibc/AddValidatorProposal")
+	govtypes.RegisterProposalType(ProposalTypeDeleteValidator)
+	govtypes.RegisterProposalTypeCodec(&DeleteValidatorProposal{}, "stakeibc/DeleteValidatorProposal")
 }
 
 var (
 	_ govtypes.Content = &AddValidatorProposal{}
+	_ govtypes.Content = &DeleteValidatorProposal{}
 )
 
 func NewAddValidatorProposal(title, description, hostZone, name, address string) govtypes.Content {
@@ -64,3 +68,47 @@ func (p AddValidatorProposal) String() string {
 	ValidatorAddress: %s
   `, p.Title, p.Description, p.HostZone, p.ValidatorName, p.ValidatorAddress)
 }
+
+func NewDeleteValidatorProposal(title, description, hostZone, address string) govtypes.Content {
+	return &DeleteValidatorProposal{
+		Title:            title,
+		Description:      description,
+		HostZone:         hostZone,
+		ValidatorAddress: address,
+	}
+}
+
+func (p *DeleteValidatorProposal) GetTitle() string { return p.Title }
+
+func (p *DeleteValidatorProposal) GetDescription() string { return p.Description }
+
+func (p *DeleteValidatorProposal) ProposalRoute() string { return RouterKey }
+
+func (p *DeleteValidatorProposal) ProposalType() string {
+	return ProposalTypeDeleteValidator
+}
+
+func (p *DeleteValidatorProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if len(p.HostZone) == 0 {
+		return ErrRequiredFieldEmpty
+	}
+	if len(p.ValidatorAddress) == 0 {
+		return ErrRequiredFieldEmpty
+	}
+
+	return nil
+}
+
+func (p DeleteValidatorProposal) String() string {
+	return fmt.Sprintf(`Delete Validator Proposal:
+	Title:            %s
+	Description:      %s
+	HostZone:         %s
+	ValidatorAddress: %s
+  `, p.Title, p.Description, p.HostZone, p.ValidatorAddress)
+}
@@ -65,36 +65,79 @@ func (m *AddValidatorProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AddValidatorProposal proto.InternalMessageInfo
 
+type DeleteValidatorProposal struct {
+	Title            string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description      string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	HostZone         string `protobuf:"bytes,3,opt,name=host_zone,json=hostZone,proto3" json:"host_zone,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,4,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Deposit          string `protobuf:"bytes,5,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
+}
+
+func (m *DeleteValidatorProposal) Reset()      { *m = DeleteValidatorProposal{} }
+func (*DeleteValidatorProposal) ProtoMessage() {}
+func (*DeleteValidatorProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a196ca60a38004b, []int{1}
+}
+func (m *DeleteValidatorProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteValidatorProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteValidatorProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteValidatorProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteValidatorProposal.Merge(m, src)
+}
+func (m *DeleteValidatorProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteValidatorProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteValidatorProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteValidatorProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*AddValidatorProposal)(nil), "Stridelabs.stride.stakeibc.AddValidatorProposal")
+	proto.RegisterType((*DeleteValidatorProposal)(nil), "Stridelabs.stride.stakeibc.DeleteValidatorProposal")
 }
 
 func init() { proto.RegisterFile("stakeibc/gov.proto", fileDescriptor_9a196ca60a38004b) }
 
 var fileDescriptor_9a196ca60a38004b = []byte{
-	// 351 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xb1, 0x4a, 0x2b, 0x41,
-	0x14, 0x86, 0x77, 0x73, 0x6f, 0x72, 0x6f, 0xe6, 0x5e, 0x83, 0x0e, 0x5b, 0x8c, 0x11, 0x76, 0x43,
-	0x40, 0xb0, 0x30, 0xbb, 0x85, 0x5d, 0xba, 0x04, 0x04, 0x0b, 0x11, 0x89, 0x60, 0x91, 0x26, 0xcc,
-	0xee, 0x0c, 0x9b, 0xc1, 0xdd, 0x3d, 0xcb, 0xce, 0x18, 0x8c, 0x4f, 0x60, 0x69, 0x23, 0x58, 0xe6,
-	0x21, 0x7c, 0x08, 0xcb, 0x60, 0x65, 0x25, 0x92, 0x34, 0xd6, 0x3e, 0x81, 0xec, 0x4c, 0x12, 0xed,
-	0xce, 0xf9, 0xfe, 0xef, 0x30, 0xcc, 0x39, 0x08, 0x4b, 0x45, 0xaf, 0xb8, 0x08, 0xa3, 0x20, 0x86,
-	0x89, 0x9f, 0x17, 0xa0, 0x00, 0x37, 0x2f, 0x54, 0x21, 0x18, 0x4f, 0x68, 0x28, 0x7d, 0xa9, 0x4b,
-	0x7f, 0x6d, 0x35, 0x77, 0x23, 0x90, 0x29, 0xc8, 0x91, 0x36, 0x03, 0xd3, 0x98, 0xb1, 0xa6, 0x13,
-	0x43, 0x0c, 0x86, 0x97, 0x95, 0xa1, 0xed, 0x87, 0x0a, 0x72, 0x7a, 0x8c, 0x5d, 0xd2, 0x44, 0x30,
-	0xaa, 0xa0, 0x38, 0x2f, 0x20, 0x07, 0x49, 0x13, 0xec, 0xa0, 0xaa, 0x12, 0x2a, 0xe1, 0xc4, 0x6e,
-	0xd9, 0x07, 0xf5, 0x81, 0x69, 0x70, 0x0b, 0xfd, 0x63, 0x5c, 0x46, 0x85, 0xc8, 0x95, 0x80, 0x8c,
-	0x54, 0x74, 0xf6, 0x13, 0xe1, 0x3d, 0x54, 0x1f, 0x83, 0x54, 0xa3, 0x5b, 0xc8, 0x38, 0xf9, 0xa5,
-	0xf3, 0xbf, 0x25, 0x18, 0x42, 0xc6, 0xf1, 0x3e, 0x6a, 0x4c, 0xd6, 0x2f, 0x8d, 0x32, 0x9a, 0x72,
-	0xf2, 0x5b, 0x1b, 0x5b, 0x1b, 0x7a, 0x46, 0x53, 0x8e, 0x8f, 0xd1, 0xce, 0xb7, 0x46, 0x19, 0x2b,
-	0xb8, 0x94, 0xa4, 0x5a, 0x9a, 0x7d, 0xf2, 0xf2, 0xd4, 0x71, 0x56, 0xff, 0xea, 0x99, 0xa4, 0x5c,
-	0x47, 0x16, 0x0f, 0xb6, 0x37, 0x23, 0x2b, 0x8e, 0x0f, 0xd1, 0x1f, 0xc6, 0x73, 0x90, 0x42, 0x91,
-	0x9a, 0x1e, 0xc6, 0x9f, 0x6f, 0x5e, 0x63, 0x4a, 0xd3, 0xa4, 0xdb, 0x5e, 0x05, 0xed, 0xc1, 0x5a,
-	0xe9, 0xfe, 0xbf, 0x9b, 0x79, 0xd6, 0xe3, 0xcc, 0xb3, 0x3e, 0x66, 0x9e, 0xdd, 0x3f, 0x79, 0x5e,
-	0xb8, 0xf6, 0x7c, 0xe1, 0xda, 0xef, 0x0b, 0xd7, 0xbe, 0x5f, 0xba, 0xd6, 0x7c, 0xe9, 0x5a, 0xaf,
-	0x4b, 0xd7, 0x1a, 0xfa, 0xb1, 0x50, 0xe3, 0xeb, 0xd0, 0x8f, 0x20, 0x0d, 0xcc, 0x25, 0x3a, 0xa7,
-	0x34, 0x94, 0x81, 0x39, 0x45, 0x70, 0x13, 0x6c, 0x4e, 0xa6, 0xa6, 0x39, 0x97, 0x61, 0x4d, 0x2f,
-	0xfa, 0xe8, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x3a, 0x78, 0x29, 0xee, 0xcb, 0x01, 0x00, 0x00,
+	// 377 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0x41, 0x4b, 0xe3, 0x40,
+	0x18, 0x86, 0x93, 0x6e, 0xdb, 0xdd, 0xce, 0xee, 0x96, 0xdd, 0x21, 0xb0, 0xd9, 0x0a, 0x49, 0x09,
+	0x08, 0x1e, 0x6c, 0x72, 0xf0, 0xd6, 0x5b, 0x8b, 0x82, 0x07, 0x11, 0xa9, 0xe0, 0xa1, 0x97, 0x32,
+	0xc9, 0x0c, 0xe9, 0x60, 0x92, 0x2f, 0x64, 0xc6, 0x62, 0xfd, 0x05, 0x1e, 0xbd, 0x08, 0x1e, 0xfb,
+	0x23, 0xfc, 0x11, 0x1e, 0x8b, 0x27, 0x4f, 0x22, 0xed, 0xc5, 0xab, 0xfe, 0x02, 0x49, 0xa6, 0xad,
+	0x1e, 0xbc, 0x88, 0xe0, 0x6d, 0xbe, 0xf7, 0x7d, 0x3e, 0x86, 0xf7, 0xe3, 0x45, 0x58, 0x48, 0x72,
+	0xcc, 0xb8, 0x1f, 0x78, 0x21, 0x8c, 0xdc, 0x34, 0x03, 0x09, 0xb8, 0x71, 0x28, 0x33, 0x4e, 0x59,
+	0x44, 0x7c, 0xe1, 0x8a, 0xe2, 0xe9, 0x2e, 0xa9, 0xc6, 0xff, 0x00, 0x44, 0x0c, 0x62, 0x50, 0x90,
+	0x9e, 0x1a, 0xd4, 0x5a, 0xc3, 0x08, 0x21, 0x04, 0xa5, 0xe7, 0x2f, 0xa5, 0x3a, 0x97, 0x25, 0x64,
+	0x74, 0x28, 0x3d, 0x22, 0x11, 0xa7, 0x44, 0x42, 0x76, 0x90, 0x41, 0x0a, 0x82, 0x44, 0xd8, 0x40,
+	0x15, 0xc9, 0x65, 0xc4, 0x4c, 0xbd, 0xa9, 0x6f, 0xd4, 0x7a, 0x6a, 0xc0, 0x4d, 0xf4, 0x93, 0x32,
+	0x11, 0x64, 0x3c, 0x95, 0x1c, 0x12, 0xb3, 0x54, 0x78, 0x6f, 0x25, 0xbc, 0x86, 0x6a, 0x43, 0x10,
+	0x72, 0x70, 0x06, 0x09, 0x33, 0xbf, 0x15, 0xfe, 0x8f, 0x5c, 0xe8, 0x43, 0xc2, 0xf0, 0x3a, 0xaa,
+	0x8f, 0x96, 0x3f, 0x0d, 0x12, 0x12, 0x33, 0xb3, 0x5c, 0x10, 0xbf, 0x57, 0xea, 0x3e, 0x89, 0x19,
+	0xde, 0x41, 0x7f, 0x5f, 0x31, 0x42, 0x69, 0xc6, 0x84, 0x30, 0x2b, 0x39, 0xd9, 0x35, 0x6f, 0xaf,
+	0x5b, 0xc6, 0x22, 0x57, 0x47, 0x39, 0xf9, 0x39, 0x92, 0xb0, 0xf7, 0x67, 0xb5, 0xb2, 0xd0, 0xf1,
+	0x26, 0xfa, 0x4e, 0x59, 0x0a, 0x82, 0x4b, 0xb3, 0x5a, 0x2c, 0xe3, 0xe7, 0x7b, 0xbb, 0x3e, 0x26,
+	0x71, 0xd4, 0x76, 0x16, 0x86, 0xd3, 0x5b, 0x22, 0xed, 0x5f, 0xe7, 0x13, 0x5b, 0xbb, 0x9a, 0xd8,
+	0xda, 0xe3, 0xc4, 0xd6, 0x9d, 0x27, 0x1d, 0xfd, 0xdb, 0x66, 0x11, 0x93, 0xec, 0x8b, 0x4e, 0xf3,
+	0x6e, 0xe6, 0xf2, 0x67, 0x32, 0x57, 0x3e, 0x98, 0xb9, 0xbb, 0x7b, 0x33, 0xb3, 0xf4, 0xe9, 0xcc,
+	0xd2, 0x1f, 0x66, 0x96, 0x7e, 0x31, 0xb7, 0xb4, 0xe9, 0xdc, 0xd2, 0xee, 0xe6, 0x96, 0xd6, 0x77,
+	0x43, 0x2e, 0x87, 0x27, 0xbe, 0x1b, 0x40, 0xec, 0xa9, 0xf6, 0xb5, 0xf6, 0x88, 0x2f, 0x3c, 0x55,
+	0x3f, 0xef, 0xd4, 0x5b, 0xd5, 0x54, 0x8e, 0x53, 0x26, 0xfc, 0x6a, 0x51, 0xae, 0xad, 0x97, 0x01,
+	0x00, 0x78, 0x36, 0x83, 0xfe, 0xbf, 0x02, 0x00, 0x00,
 }
 
 func (this *AddValidatorProposal) Equal(that interface{}) bool {
@@ -136,6 +179,42 @@ func (this *AddValidatorProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DeleteValidatorProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeleteValidatorProposal)
+	if !ok {
+		that2, ok := that.(DeleteValidatorProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.HostZone != that1.HostZone {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.Deposit != that1.Deposit {
+		return false
+	}
+	return true
+}
 func (m *AddValidatorProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -201,6 +280,64 @@ func (m *AddValidatorProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DeleteValidatorProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteValidatorProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteValidatorProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.HostZone) > 0 {
+		i -= len(m.HostZone)
+		copy(dAtA[i:], m.HostZone)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.HostZone)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -245,6 +382,35 @@ func (m *AddValidatorProposal) Size() (n int) {
 	return n
 }
 
+func (m *DeleteValidatorProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.HostZone)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -493,6 +659,216 @@ func (m *AddValidatorProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DeleteValidatorProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteValidatorProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteValidatorProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
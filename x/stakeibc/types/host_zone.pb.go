@@ -26,7 +26,44 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// next id: 19
+// the stages of sunsetting a host zone
+type HostZone_WindDownStatus int32
+
+const (
+	HostZone_Active HostZone_WindDownStatus = 0
+	// liquid stakes are rejected, while pending deposits are still delegated
+	HostZone_WindingDown HostZone_WindDownStatus = 1
+	// the remaining stake is being undelegated and the redemption rate is frozen,
+	// redemptions are paid out of the wind-down undelegation
+	HostZone_UnbondingAll HostZone_WindDownStatus = 2
+	// every stToken has been redeemed and claimed, and the residual balances of the
+	// ICA accounts are being swept to stride before the host zone is removed
+	HostZone_Sweeping HostZone_WindDownStatus = 3
+)
+
+var HostZone_WindDownStatus_name = map[int32]string{
+	0: "Active",
+	1: "WindingDown",
+	2: "UnbondingAll",
+	3: "Sweeping",
+}
+
+var HostZone_WindDownStatus_value = map[string]int32{
+	"Active":       0,
+	"WindingDown":  1,
+	"UnbondingAll": 2,
+	"Sweeping":     3,
+}
+
+func (x HostZone_WindDownStatus) String() string {
+	return proto.EnumName(HostZone_WindDownStatus_name, int32(x))
+}
+
+func (HostZone_WindDownStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a1d300c62c2b2d54, []int{0, 0}
+}
+
+// next id: 29
 type HostZone struct {
 	ChainId               string       `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
 	ConnectionId          string       `protobuf:"bytes,2,opt,name=connectionId,proto3" json:"connectionId,omitempty"`
@@ -47,10 +84,26 @@ type HostZone struct {
 	LastRedemptionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=LastRedemptionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"LastRedemptionRate"`
 	RedemptionRate     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=RedemptionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"RedemptionRate"`
 	// stores how many days we should wait before issuing unbondings
-	UnbondingFrequency uint64 `protobuf:"varint,14,opt,name=unbondingFrequency,proto3" json:"unbondingFrequency,omitempty"`
-	//TODO(TEST-101) int to dec
-	StakedBal uint64 `protobuf:"varint,13,opt,name=stakedBal,proto3" json:"stakedBal,omitempty"`
-	Address   string `protobuf:"bytes,18,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	UnbondingFrequency uint64                                 `protobuf:"varint,14,opt,name=unbondingFrequency,proto3" json:"unbondingFrequency,omitempty"`
+	StakedBal          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,13,opt,name=stakedBal,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"stakedBal"`
+	Address            string                                 `protobuf:"bytes,18,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// per host zone overrides of how often each pipeline stage runs
+	DepositSchedule        *PipelineSchedule `protobuf:"bytes,19,opt,name=depositSchedule,proto3" json:"depositSchedule,omitempty"`
+	DelegateSchedule       *PipelineSchedule `protobuf:"bytes,20,opt,name=delegateSchedule,proto3" json:"delegateSchedule,omitempty"`
+	ReinvestSchedule       *PipelineSchedule `protobuf:"bytes,21,opt,name=reinvestSchedule,proto3" json:"reinvestSchedule,omitempty"`
+	RedemptionRateSchedule *PipelineSchedule `protobuf:"bytes,22,opt,name=redemptionRateSchedule,proto3" json:"redemptionRateSchedule,omitempty"`
+	RebalanceSchedule      *PipelineSchedule `protobuf:"bytes,23,opt,name=rebalanceSchedule,proto3" json:"rebalanceSchedule,omitempty"`
+	// number of decimals between the host denom and its display unit
+	// (e.g. 6 for uatom, 18 for aevmos)
+	DenomExponent  uint32                  `protobuf:"varint,24,opt,name=denomExponent,proto3" json:"denomExponent,omitempty"`
+	WindDownStatus HostZone_WindDownStatus `protobuf:"varint,25,opt,name=windDownStatus,proto3,enum=Stridelabs.stride.stakeibc.HostZone_WindDownStatus" json:"windDownStatus,omitempty"`
+	// the epoch unbonding record holding the undelegation of the remaining stake,
+	// set once the wind-down reaches UnbondingAll
+	WindDownEpochNumber uint64 `protobuf:"varint,26,opt,name=windDownEpochNumber,proto3" json:"windDownEpochNumber,omitempty"`
+	// overrides the max_light_client_age_nanos param for the host zone, if set
+	MaxLightClientAgeNanos uint64 `protobuf:"varint,27,opt,name=maxLightClientAgeNanos,proto3" json:"maxLightClientAgeNanos,omitempty"`
+	// the ICA accounts confirmed to have no balance left while the host zone is sweeping
+	WindDownSweptAccounts []ICAAccountType `protobuf:"varint,28,rep,packed,name=windDownSweptAccounts,proto3,enum=Stridelabs.stride.stakeibc.ICAAccountType" json:"windDownSweptAccounts,omitempty"`
 }
 
 func (m *HostZone) Reset()         { *m = HostZone{} }
@@ -177,65 +230,208 @@ func (m *HostZone) GetUnbondingFrequency() uint64 {
 	return 0
 }
 
-func (m *HostZone) GetStakedBal() uint64 {
+func (m *HostZone) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *HostZone) GetDepositSchedule() *PipelineSchedule {
+	if m != nil {
+		return m.DepositSchedule
+	}
+	return nil
+}
+
+func (m *HostZone) GetDelegateSchedule() *PipelineSchedule {
+	if m != nil {
+		return m.DelegateSchedule
+	}
+	return nil
+}
+
+func (m *HostZone) GetReinvestSchedule() *PipelineSchedule {
+	if m != nil {
+		return m.ReinvestSchedule
+	}
+	return nil
+}
+
+func (m *HostZone) GetRedemptionRateSchedule() *PipelineSchedule {
+	if m != nil {
+		return m.RedemptionRateSchedule
+	}
+	return nil
+}
+
+func (m *HostZone) GetRebalanceSchedule() *PipelineSchedule {
+	if m != nil {
+		return m.RebalanceSchedule
+	}
+	return nil
+}
+
+func (m *HostZone) GetDenomExponent() uint32 {
+	if m != nil {
+		return m.DenomExponent
+	}
+	return 0
+}
+
+func (m *HostZone) GetWindDownStatus() HostZone_WindDownStatus {
 	if m != nil {
-		return m.StakedBal
+		return m.WindDownStatus
+	}
+	return HostZone_Active
+}
+
+func (m *HostZone) GetWindDownEpochNumber() uint64 {
+	if m != nil {
+		return m.WindDownEpochNumber
 	}
 	return 0
 }
 
-func (m *HostZone) GetAddress() string {
+func (m *HostZone) GetMaxLightClientAgeNanos() uint64 {
 	if m != nil {
-		return m.Address
+		return m.MaxLightClientAgeNanos
 	}
-	return ""
+	return 0
+}
+
+func (m *HostZone) GetWindDownSweptAccounts() []ICAAccountType {
+	if m != nil {
+		return m.WindDownSweptAccounts
+	}
+	return nil
+}
+
+// PipelineSchedule determines the epochs on which a pipeline stage runs for a
+// host zone: every epoch where epochNumber % interval == offset
+type PipelineSchedule struct {
+	// number of epochs between runs, falls back to the module param if 0
+	Interval uint64 `protobuf:"varint,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	// staggers the host zone within the interval, set from the number of host
+	// zones at registration. The staggering is per epoch, not per block: the
+	// host zones due in an epoch all run in that epoch's first block
+	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (m *PipelineSchedule) Reset()         { *m = PipelineSchedule{} }
+func (m *PipelineSchedule) String() string { return proto.CompactTextString(m) }
+func (*PipelineSchedule) ProtoMessage()    {}
+func (*PipelineSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1d300c62c2b2d54, []int{1}
+}
+func (m *PipelineSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PipelineSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PipelineSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PipelineSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PipelineSchedule.Merge(m, src)
+}
+func (m *PipelineSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *PipelineSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_PipelineSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PipelineSchedule proto.InternalMessageInfo
+
+func (m *PipelineSchedule) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *PipelineSchedule) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
 }
 
 func init() {
+	proto.RegisterEnum("Stridelabs.stride.stakeibc.HostZone_WindDownStatus", HostZone_WindDownStatus_name, HostZone_WindDownStatus_value)
 	proto.RegisterType((*HostZone)(nil), "Stridelabs.stride.stakeibc.HostZone")
+	proto.RegisterType((*PipelineSchedule)(nil), "Stridelabs.stride.stakeibc.PipelineSchedule")
 }
 
 func init() { proto.RegisterFile("stakeibc/host_zone.proto", fileDescriptor_a1d300c62c2b2d54) }
 
 var fileDescriptor_a1d300c62c2b2d54 = []byte{
-	// 590 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x4e, 0x1b, 0x3b,
-	0x14, 0xc6, 0x33, 0x17, 0x2e, 0x04, 0x43, 0x29, 0x58, 0x45, 0x72, 0xa3, 0x6a, 0x88, 0x22, 0x15,
-	0x65, 0x01, 0x33, 0x12, 0xec, 0xaa, 0x6e, 0x08, 0x14, 0x91, 0x8a, 0xd5, 0xb4, 0x62, 0x41, 0x17,
-	0xc8, 0x63, 0x1f, 0x66, 0x2c, 0x26, 0x76, 0x6a, 0x3b, 0x05, 0xfa, 0x14, 0x5d, 0xf6, 0x41, 0xfa,
-	0x10, 0x2c, 0x51, 0x57, 0x55, 0x17, 0xa8, 0x4a, 0xde, 0xa0, 0x4f, 0x50, 0xcd, 0xbf, 0x24, 0x25,
-	0x69, 0xa5, 0x48, 0x5d, 0x8d, 0x7d, 0xbe, 0xef, 0xfc, 0xc6, 0x3e, 0xc7, 0x36, 0x22, 0xc6, 0xd2,
-	0x4b, 0x10, 0x21, 0xf3, 0x63, 0x65, 0xec, 0xf9, 0x47, 0x25, 0xc1, 0xeb, 0x6a, 0x65, 0x15, 0xae,
-	0xbd, 0xb1, 0x5a, 0x70, 0x48, 0x68, 0x68, 0x3c, 0x93, 0x0d, 0xbd, 0xd2, 0x5b, 0x1b, 0x65, 0x7d,
-	0xa0, 0x89, 0xe0, 0xd4, 0x2a, 0x9d, 0x67, 0xd5, 0x6a, 0x43, 0x45, 0x30, 0x7a, 0x4e, 0x19, 0x53,
-	0x3d, 0x69, 0x0b, 0xed, 0x49, 0xa4, 0x22, 0x95, 0x0d, 0xfd, 0x74, 0x54, 0x44, 0x9f, 0x32, 0x65,
-	0x3a, 0xca, 0x9c, 0xe7, 0x42, 0x3e, 0x29, 0xa4, 0x0d, 0x0d, 0x4c, 0x69, 0x6e, 0xfc, 0x08, 0x24,
-	0x18, 0x51, 0x84, 0x1b, 0x9f, 0xab, 0xa8, 0x7a, 0xac, 0x8c, 0x3d, 0x53, 0x12, 0x30, 0x41, 0x8b,
-	0x2c, 0xa6, 0x42, 0xb6, 0x39, 0x71, 0xea, 0x4e, 0x73, 0x29, 0x28, 0xa7, 0xb8, 0x81, 0x56, 0x98,
-	0x92, 0x12, 0x98, 0x15, 0x2a, 0x95, 0xff, 0xcb, 0xe4, 0xdf, 0x62, 0xa9, 0x27, 0x04, 0x16, 0xef,
-	0xed, 0x76, 0x35, 0x5c, 0x88, 0x6b, 0xb2, 0x9e, 0x7b, 0xc6, 0x63, 0x78, 0x1b, 0xad, 0x5b, 0x4d,
-	0xa5, 0xb9, 0x00, 0x7d, 0x10, 0x53, 0x29, 0x21, 0x69, 0x73, 0xb2, 0x92, 0x19, 0x27, 0x05, 0xfc,
-	0x0a, 0xa1, 0x61, 0x4d, 0x0c, 0x99, 0xab, 0xcf, 0x35, 0x97, 0x77, 0x9f, 0x7b, 0x7f, 0xae, 0xa5,
-	0x77, 0x5a, 0xba, 0x83, 0xb1, 0x44, 0xfc, 0x0e, 0x6d, 0x84, 0x09, 0x65, 0x97, 0x89, 0x30, 0x16,
-	0xf8, 0xe9, 0x88, 0x38, 0x3f, 0x0b, 0x71, 0x3a, 0x03, 0xbf, 0x45, 0xeb, 0x57, 0xc2, 0xc6, 0x5c,
-	0xd3, 0x2b, 0x9a, 0xec, 0xe7, 0x3d, 0x22, 0xff, 0xd7, 0x9d, 0xe6, 0xf2, 0xee, 0xd6, 0xdf, 0xc0,
-	0xed, 0x83, 0xfd, 0xc2, 0x1d, 0x4c, 0x02, 0xf0, 0x11, 0x42, 0x17, 0x00, 0x25, 0x6e, 0x61, 0x26,
-	0xdc, 0x58, 0x66, 0xba, 0x3a, 0x0e, 0x09, 0x44, 0x34, 0xed, 0x51, 0x89, 0x5b, 0x9c, 0x6d, 0x75,
-	0x13, 0x80, 0x94, 0xaa, 0x81, 0x43, 0xa7, 0x3b, 0x4e, 0x5d, 0x9b, 0x8d, 0x3a, 0x01, 0xc0, 0x35,
-	0x54, 0x6d, 0xb7, 0x0e, 0x0e, 0x41, 0xaa, 0x0e, 0xa9, 0x66, 0x47, 0x62, 0x38, 0xc7, 0xcf, 0xd0,
-	0x52, 0x7a, 0x4a, 0x73, 0x71, 0x29, 0x13, 0x47, 0x01, 0x9c, 0x20, 0x7c, 0x42, 0x8d, 0x0d, 0x86,
-	0xc8, 0x80, 0x5a, 0x20, 0x28, 0xb5, 0xb5, 0x5e, 0xde, 0xde, 0x6f, 0x56, 0xbe, 0xdf, 0x6f, 0x6e,
-	0x45, 0xc2, 0xc6, 0xbd, 0xd0, 0x63, 0xaa, 0x53, 0x5c, 0x8c, 0xe2, 0xb3, 0x63, 0xf8, 0xa5, 0x6f,
-	0x6f, 0xba, 0x60, 0xbc, 0x43, 0x60, 0x5f, 0xbf, 0xec, 0xa0, 0xe2, 0xde, 0x1c, 0x02, 0x0b, 0xa6,
-	0x70, 0x31, 0x47, 0xab, 0x0f, 0xfe, 0xb4, 0xfc, 0x0f, 0xfe, 0xf4, 0x80, 0x89, 0x3d, 0x84, 0x7b,
-	0x32, 0x54, 0x92, 0x0b, 0x19, 0x1d, 0x69, 0x78, 0xdf, 0x03, 0xc9, 0x6e, 0xc8, 0x6a, 0xdd, 0x69,
-	0xce, 0x07, 0x53, 0x94, 0xb4, 0x42, 0x59, 0x9d, 0x79, 0x8b, 0x26, 0xe4, 0x51, 0x66, 0x1b, 0x05,
-	0xf0, 0x36, 0x5a, 0xa4, 0x9c, 0x6b, 0x30, 0x86, 0xe0, 0x6c, 0xb1, 0xf8, 0xe7, 0xfd, 0xe6, 0xea,
-	0x0d, 0xed, 0x24, 0x2f, 0x1a, 0x85, 0xd0, 0x08, 0x4a, 0xcb, 0xeb, 0xf9, 0xea, 0xe3, 0xb5, 0xb5,
-	0xd6, 0xf1, 0x6d, 0xdf, 0x75, 0xee, 0xfa, 0xae, 0xf3, 0xa3, 0xef, 0x3a, 0x9f, 0x06, 0x6e, 0xe5,
-	0x6e, 0xe0, 0x56, 0xbe, 0x0d, 0xdc, 0xca, 0x99, 0x37, 0xb6, 0xc3, 0xbc, 0xdd, 0x3b, 0x27, 0x34,
-	0x34, 0x7e, 0xde, 0x6f, 0xff, 0xda, 0x1f, 0x3e, 0x5c, 0xd9, 0x6e, 0xc3, 0x85, 0xec, 0xad, 0xd9,
-	0xfb, 0x15, 0x00, 0x00, 0xff, 0xff, 0xc1, 0xbc, 0xfa, 0x2c, 0x21, 0x05, 0x00, 0x00,
+	// 897 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xdd, 0x4e, 0x23, 0x37,
+	0x14, 0xc7, 0x33, 0x4b, 0xca, 0x06, 0x03, 0x61, 0xf0, 0x2e, 0xd4, 0x9b, 0xae, 0x42, 0x14, 0xb5,
+	0xab, 0xa8, 0x82, 0x49, 0x15, 0xa4, 0x5e, 0x54, 0xbd, 0x09, 0x5f, 0xda, 0x54, 0x74, 0x55, 0x0d,
+	0x5b, 0x5a, 0xb1, 0x17, 0xd4, 0x33, 0x3e, 0x99, 0x58, 0x4c, 0xec, 0xe9, 0xd8, 0x21, 0xd0, 0xa7,
+	0xe8, 0xc3, 0xec, 0x43, 0xec, 0xe5, 0xaa, 0x57, 0x55, 0x2f, 0x50, 0x05, 0x6f, 0xd0, 0x27, 0xa8,
+	0x66, 0x32, 0x33, 0xf9, 0x04, 0x35, 0xa8, 0x57, 0xf1, 0x39, 0xff, 0x73, 0x7e, 0xc7, 0xb1, 0x8f,
+	0xed, 0x41, 0x44, 0x69, 0x7a, 0x01, 0xdc, 0x71, 0xeb, 0x1d, 0xa9, 0xf4, 0xf9, 0x6f, 0x52, 0x80,
+	0x15, 0x84, 0x52, 0x4b, 0x5c, 0x3a, 0xd1, 0x21, 0x67, 0xe0, 0x53, 0x47, 0x59, 0x2a, 0x1e, 0x5a,
+	0x69, 0x6c, 0x69, 0x98, 0x75, 0x49, 0x7d, 0xce, 0xa8, 0x96, 0xe1, 0x20, 0xab, 0x54, 0xca, 0x14,
+	0xee, 0xd2, 0x73, 0xea, 0xba, 0xb2, 0x27, 0x74, 0xa2, 0x3d, 0xf7, 0xa4, 0x27, 0xe3, 0x61, 0x3d,
+	0x1a, 0x25, 0xde, 0x17, 0xae, 0x54, 0x5d, 0xa9, 0xce, 0x07, 0xc2, 0xc0, 0x48, 0xa4, 0x8d, 0x10,
+	0x5c, 0x19, 0x32, 0x55, 0xf7, 0x40, 0x80, 0xe2, 0x89, 0xbb, 0xfa, 0x7e, 0x0d, 0x15, 0x5e, 0x4b,
+	0xa5, 0xcf, 0xa4, 0x00, 0x4c, 0xd0, 0x53, 0xb7, 0x43, 0xb9, 0x68, 0x31, 0x62, 0x54, 0x8c, 0xda,
+	0x92, 0x9d, 0x9a, 0xb8, 0x8a, 0x56, 0x5c, 0x29, 0x04, 0xb8, 0x9a, 0xcb, 0x48, 0x7e, 0x12, 0xcb,
+	0x63, 0xbe, 0x28, 0xc6, 0x01, 0xb7, 0xb3, 0xdb, 0x08, 0x42, 0x68, 0xf3, 0x2b, 0xb2, 0x3e, 0x88,
+	0x19, 0xf5, 0xe1, 0x6d, 0xb4, 0xae, 0x43, 0x2a, 0x54, 0x1b, 0xc2, 0xfd, 0x0e, 0x15, 0x02, 0xfc,
+	0x16, 0x23, 0x2b, 0x71, 0xe0, 0xb4, 0x80, 0x0f, 0x11, 0xca, 0xd6, 0x44, 0x91, 0x85, 0xca, 0x42,
+	0x6d, 0xb9, 0xf1, 0x85, 0x75, 0xff, 0x5a, 0x5a, 0xa7, 0x69, 0xb4, 0x3d, 0x92, 0x88, 0xdf, 0xa1,
+	0x0d, 0xc7, 0xa7, 0xee, 0x85, 0xcf, 0x95, 0x06, 0x76, 0x3a, 0x24, 0xe6, 0xe7, 0x21, 0xce, 0x66,
+	0xe0, 0xb7, 0x68, 0xbd, 0xcf, 0x75, 0x87, 0x85, 0xb4, 0x4f, 0xfd, 0xe6, 0x60, 0x8f, 0xc8, 0x27,
+	0x15, 0xa3, 0xb6, 0xdc, 0x78, 0xf5, 0x10, 0xb8, 0xb5, 0xdf, 0x4c, 0xa2, 0xed, 0x69, 0x00, 0x3e,
+	0x42, 0xa8, 0x0d, 0x90, 0xe2, 0x16, 0xe7, 0xc2, 0x8d, 0x64, 0x46, 0xb3, 0x63, 0xe0, 0x83, 0x47,
+	0xa3, 0x3d, 0x4a, 0x71, 0x4f, 0xe7, 0x9b, 0xdd, 0x14, 0x20, 0xa2, 0x86, 0xc0, 0xa0, 0x1b, 0x8c,
+	0x52, 0xcd, 0xf9, 0xa8, 0x53, 0x00, 0x5c, 0x42, 0x85, 0xd6, 0xde, 0xfe, 0x01, 0x08, 0xd9, 0x25,
+	0x85, 0xb8, 0x25, 0x32, 0x1b, 0xbf, 0x44, 0x4b, 0x51, 0x97, 0x0e, 0xc4, 0xa5, 0x58, 0x1c, 0x3a,
+	0xb0, 0x8f, 0xf0, 0x31, 0x55, 0xda, 0xce, 0x90, 0x36, 0xd5, 0x40, 0x50, 0x14, 0xb6, 0xf7, 0xed,
+	0x87, 0x9b, 0xad, 0xdc, 0x5f, 0x37, 0x5b, 0xaf, 0x3c, 0xae, 0x3b, 0x3d, 0xc7, 0x72, 0x65, 0x37,
+	0x39, 0x18, 0xc9, 0xcf, 0x8e, 0x62, 0x17, 0x75, 0x7d, 0x1d, 0x80, 0xb2, 0x0e, 0xc0, 0xfd, 0xe3,
+	0xfd, 0x0e, 0x1a, 0xf8, 0x23, 0xcb, 0x9e, 0xc1, 0xc5, 0x0c, 0x15, 0x27, 0x2a, 0x2d, 0xff, 0x0f,
+	0x95, 0x26, 0x98, 0xd8, 0x42, 0xb8, 0x27, 0x1c, 0x29, 0x18, 0x17, 0xde, 0x51, 0x08, 0xbf, 0xf6,
+	0x40, 0xb8, 0xd7, 0xa4, 0x58, 0x31, 0x6a, 0x79, 0x7b, 0x86, 0x82, 0x8f, 0xd1, 0x52, 0xbc, 0xce,
+	0x6c, 0x8f, 0xfa, 0x64, 0x35, 0x9e, 0x90, 0x35, 0xc7, 0x84, 0x5a, 0x42, 0xdb, 0x43, 0x00, 0xde,
+	0x46, 0x4f, 0x29, 0x63, 0x21, 0x28, 0x45, 0x70, 0xcc, 0xc2, 0xff, 0xdc, 0x6c, 0x15, 0xaf, 0x69,
+	0xd7, 0xff, 0xa6, 0x9a, 0x08, 0x55, 0x3b, 0x0d, 0xc1, 0xa7, 0x68, 0x8d, 0x41, 0x20, 0x15, 0xd7,
+	0x27, 0x6e, 0x07, 0x58, 0xcf, 0x07, 0xf2, 0x2c, 0xee, 0x86, 0xed, 0x87, 0xba, 0xe1, 0x07, 0x1e,
+	0x80, 0xcf, 0x05, 0xa4, 0x39, 0xf6, 0x24, 0x04, 0xff, 0x8c, 0xcc, 0xa4, 0xf9, 0xb2, 0x20, 0xf2,
+	0xfc, 0x11, 0xe0, 0x29, 0x4a, 0x44, 0x0e, 0x81, 0x8b, 0x4b, 0x50, 0xc3, 0x29, 0x6f, 0x3c, 0x86,
+	0x3c, 0x49, 0xc1, 0x0c, 0x6d, 0x86, 0x63, 0x3b, 0x99, 0xf1, 0x37, 0x1f, 0xc1, 0xbf, 0x87, 0x85,
+	0xcf, 0xa2, 0x13, 0xe8, 0x50, 0x9f, 0x0a, 0x77, 0x58, 0xe0, 0xd3, 0x47, 0x14, 0x98, 0xc6, 0xe0,
+	0xcf, 0xd1, 0x2a, 0x8b, 0x8e, 0xd5, 0xe1, 0x55, 0x20, 0x05, 0x08, 0x4d, 0x48, 0xc5, 0xa8, 0xad,
+	0xda, 0xe3, 0x4e, 0xfc, 0x0e, 0x15, 0xfb, 0x5c, 0xb0, 0x03, 0xd9, 0x17, 0x27, 0x9a, 0xea, 0x9e,
+	0x22, 0x2f, 0x2a, 0x46, 0xad, 0xd8, 0xd8, 0x7d, 0xa8, 0x7c, 0xfa, 0xd2, 0x58, 0x3f, 0x8d, 0xa5,
+	0xda, 0x13, 0x28, 0xfc, 0x15, 0x7a, 0x96, 0x7a, 0x0e, 0x03, 0xe9, 0x76, 0xde, 0xf4, 0xba, 0x0e,
+	0x84, 0xa4, 0x14, 0x77, 0xff, 0x2c, 0x09, 0x7f, 0x8d, 0x36, 0xbb, 0xf4, 0xea, 0x98, 0x7b, 0x1d,
+	0xbd, 0xef, 0x73, 0x10, 0xba, 0xe9, 0xc1, 0x1b, 0x2a, 0xa4, 0x22, 0x9f, 0xc5, 0x49, 0xf7, 0xa8,
+	0xf8, 0x17, 0xb4, 0x91, 0xd5, 0xee, 0x43, 0xa0, 0x93, 0xcb, 0x48, 0x91, 0x97, 0x95, 0x85, 0x5a,
+	0xb1, 0xf1, 0xe5, 0x7f, 0xbb, 0xce, 0xde, 0x5e, 0x07, 0x60, 0xcf, 0x06, 0x55, 0xbf, 0x47, 0xc5,
+	0xf1, 0x7f, 0x8b, 0x11, 0x5a, 0x6c, 0xba, 0x9a, 0x5f, 0x82, 0x99, 0xc3, 0x6b, 0x68, 0x39, 0x52,
+	0xb9, 0xf0, 0xa2, 0x00, 0xd3, 0xc0, 0x26, 0x5a, 0xf9, 0x31, 0x3d, 0xdd, 0x4d, 0xdf, 0x37, 0x9f,
+	0xe0, 0x15, 0x54, 0x38, 0xe9, 0x03, 0x04, 0x5c, 0x78, 0xe6, 0xc2, 0x77, 0xf9, 0xc2, 0x9a, 0x69,
+	0x56, 0x8f, 0x90, 0x39, 0xb9, 0x95, 0xd1, 0xfd, 0xc9, 0x85, 0x86, 0xf0, 0x92, 0xfa, 0xf1, 0xf3,
+	0x9d, 0xb7, 0x33, 0x1b, 0x6f, 0xa2, 0x45, 0xd9, 0x6e, 0x2b, 0xd0, 0xf1, 0xcb, 0x9d, 0xb7, 0x13,
+	0x6b, 0xef, 0xf5, 0x87, 0xdb, 0xb2, 0xf1, 0xf1, 0xb6, 0x6c, 0xfc, 0x7d, 0x5b, 0x36, 0x7e, 0xbf,
+	0x2b, 0xe7, 0x3e, 0xde, 0x95, 0x73, 0x7f, 0xde, 0x95, 0x73, 0x67, 0xd6, 0xc8, 0xa5, 0x31, 0x58,
+	0x83, 0x9d, 0x63, 0xea, 0xa8, 0xfa, 0x60, 0x11, 0xea, 0x57, 0xf5, 0xec, 0xe3, 0x24, 0xbe, 0x40,
+	0x9c, 0xc5, 0xf8, 0x7b, 0x62, 0xf7, 0xdf, 0x01, 0x00, 0xec, 0x9d, 0x13, 0x7c, 0x05, 0x09, 0x00,
+	0x00,
 }
 
 func (m *HostZone) Marshal() (dAtA []byte, err error) {
@@ -258,6 +454,124 @@ func (m *HostZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WindDownSweptAccounts) > 0 {
+		dAtA2 := make([]byte, len(m.WindDownSweptAccounts)*10)
+		var j1 int
+		for _, num := range m.WindDownSweptAccounts {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintHostZone(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if m.MaxLightClientAgeNanos != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.MaxLightClientAgeNanos))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.WindDownEpochNumber != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.WindDownEpochNumber))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.WindDownStatus != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.WindDownStatus))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.DenomExponent != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.DenomExponent))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.RebalanceSchedule != nil {
+		{
+			size, err := m.RebalanceSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.RedemptionRateSchedule != nil {
+		{
+			size, err := m.RedemptionRateSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.ReinvestSchedule != nil {
+		{
+			size, err := m.ReinvestSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.DelegateSchedule != nil {
+		{
+			size, err := m.DelegateSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.DepositSchedule != nil {
+		{
+			size, err := m.DepositSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -295,11 +609,16 @@ func (m *HostZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x70
 	}
-	if m.StakedBal != 0 {
-		i = encodeVarintHostZone(dAtA, i, uint64(m.StakedBal))
-		i--
-		dAtA[i] = 0x68
+	{
+		size := m.StakedBal.Size()
+		i -= size
+		if _, err := m.StakedBal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x6a
 	if len(m.TransferChannelId) > 0 {
 		i -= len(m.TransferChannelId)
 		copy(dAtA[i:], m.TransferChannelId)
@@ -422,6 +741,39 @@ func (m *HostZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PipelineSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PipelineSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PipelineSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Interval != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintHostZone(dAtA []byte, offset int, v uint64) int {
 	offset -= sovHostZone(v)
 	base := offset
@@ -487,9 +839,8 @@ func (m *HostZone) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovHostZone(uint64(l))
 	}
-	if m.StakedBal != 0 {
-		n += 1 + sovHostZone(uint64(m.StakedBal))
-	}
+	l = m.StakedBal.Size()
+	n += 1 + l + sovHostZone(uint64(l))
 	if m.UnbondingFrequency != 0 {
 		n += 1 + sovHostZone(uint64(m.UnbondingFrequency))
 	}
@@ -505,6 +856,60 @@ func (m *HostZone) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovHostZone(uint64(l))
 	}
+	if m.DepositSchedule != nil {
+		l = m.DepositSchedule.Size()
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	if m.DelegateSchedule != nil {
+		l = m.DelegateSchedule.Size()
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	if m.ReinvestSchedule != nil {
+		l = m.ReinvestSchedule.Size()
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	if m.RedemptionRateSchedule != nil {
+		l = m.RedemptionRateSchedule.Size()
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	if m.RebalanceSchedule != nil {
+		l = m.RebalanceSchedule.Size()
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	if m.DenomExponent != 0 {
+		n += 2 + sovHostZone(uint64(m.DenomExponent))
+	}
+	if m.WindDownStatus != 0 {
+		n += 2 + sovHostZone(uint64(m.WindDownStatus))
+	}
+	if m.WindDownEpochNumber != 0 {
+		n += 2 + sovHostZone(uint64(m.WindDownEpochNumber))
+	}
+	if m.MaxLightClientAgeNanos != 0 {
+		n += 2 + sovHostZone(uint64(m.MaxLightClientAgeNanos))
+	}
+	if len(m.WindDownSweptAccounts) > 0 {
+		l = 0
+		for _, e := range m.WindDownSweptAccounts {
+			l += sovHostZone(uint64(e))
+		}
+		n += 2 + sovHostZone(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *PipelineSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Interval != 0 {
+		n += 1 + sovHostZone(uint64(m.Interval))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovHostZone(uint64(m.Offset))
+	}
 	return n
 }
 
@@ -948,10 +1353,10 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			m.TransferChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 13:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StakedBal", wireType)
 			}
-			m.StakedBal = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHostZone
@@ -961,11 +1366,26 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StakedBal |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakedBal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 14:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingFrequency", wireType)
@@ -1085,6 +1505,419 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DepositSchedule == nil {
+				m.DepositSchedule = &PipelineSchedule{}
+			}
+			if err := m.DepositSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DelegateSchedule == nil {
+				m.DelegateSchedule = &PipelineSchedule{}
+			}
+			if err := m.DelegateSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReinvestSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReinvestSchedule == nil {
+				m.ReinvestSchedule = &PipelineSchedule{}
+			}
+			if err := m.ReinvestSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRateSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RedemptionRateSchedule == nil {
+				m.RedemptionRateSchedule = &PipelineSchedule{}
+			}
+			if err := m.RedemptionRateSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalanceSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RebalanceSchedule == nil {
+				m.RebalanceSchedule = &PipelineSchedule{}
+			}
+			if err := m.RebalanceSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomExponent", wireType)
+			}
+			m.DenomExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DenomExponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindDownStatus", wireType)
+			}
+			m.WindDownStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindDownStatus |= HostZone_WindDownStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindDownEpochNumber", wireType)
+			}
+			m.WindDownEpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindDownEpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLightClientAgeNanos", wireType)
+			}
+			m.MaxLightClientAgeNanos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLightClientAgeNanos |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType == 0 {
+				var v ICAAccountType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHostZone
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ICAAccountType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.WindDownSweptAccounts = append(m.WindDownSweptAccounts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHostZone
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthHostZone
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthHostZone
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.WindDownSweptAccounts) == 0 {
+					m.WindDownSweptAccounts = make([]ICAAccountType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ICAAccountType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHostZone
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ICAAccountType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.WindDownSweptAccounts = append(m.WindDownSweptAccounts, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindDownSweptAccounts", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHostZone(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PipelineSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHostZone
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PipelineSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PipelineSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHostZone(dAtA[iNdEx:])
@@ -3,3 +3,26 @@ package types
 func FormatICAAccountOwner(chainId string, accountType ICAAccountType) (result string) {
 	return chainId + "." + accountType.String()
 }
+
+// The ICA accounts registered for each host zone
+var HostZoneICAAccountTypes = []ICAAccountType{
+	ICAAccountType_DELEGATION,
+	ICAAccountType_FEE,
+	ICAAccountType_WITHDRAWAL,
+	ICAAccountType_REDEMPTION,
+}
+
+// GetICAAccount returns the host zone's ICA account of the given type, or nil if it hasn't been registered
+func (h HostZone) GetICAAccount(accountType ICAAccountType) *ICAAccount {
+	switch accountType {
+	case ICAAccountType_DELEGATION:
+		return h.DelegationAccount
+	case ICAAccountType_FEE:
+		return h.FeeAccount
+	case ICAAccountType_WITHDRAWAL:
+		return h.WithdrawalAccount
+	case ICAAccountType_REDEMPTION:
+		return h.RedemptionAccount
+	}
+	return nil
+}
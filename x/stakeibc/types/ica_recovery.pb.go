@@ -0,0 +1,1008 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stakeibc/ica_recovery.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ICARecovery tracks the re-opening of a host zone's ICA channel after it closed
+// (ordered ICA channels close whenever a packet times out). It's removed once
+// the new channel finishes its handshake
+type ICARecovery struct {
+	ChainId     string         `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	AccountType ICAAccountType `protobuf:"varint,2,opt,name=account_type,json=accountType,proto3,enum=Stridelabs.stride.stakeibc.ICAAccountType" json:"account_type,omitempty"`
+	// the closed channel being replaced
+	ClosedChannelId string `protobuf:"bytes,3,opt,name=closed_channel_id,json=closedChannelId,proto3" json:"closed_channel_id,omitempty"`
+	// the channel opened by the last recovery attempt, which is waiting on a
+	// relayer to complete the handshake
+	PendingChannelId string `protobuf:"bytes,4,opt,name=pending_channel_id,json=pendingChannelId,proto3" json:"pending_channel_id,omitempty"`
+	Attempts         uint64 `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// block height of the last recovery attempt
+	LastAttemptHeight int64 `protobuf:"varint,6,opt,name=last_attempt_height,json=lastAttemptHeight,proto3" json:"last_attempt_height,omitempty"`
+}
+
+func (m *ICARecovery) Reset()         { *m = ICARecovery{} }
+func (m *ICARecovery) String() string { return proto.CompactTextString(m) }
+func (*ICARecovery) ProtoMessage()    {}
+func (*ICARecovery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecb5791615c47a38, []int{0}
+}
+func (m *ICARecovery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ICARecovery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ICARecovery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ICARecovery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ICARecovery.Merge(m, src)
+}
+func (m *ICARecovery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ICARecovery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ICARecovery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ICARecovery proto.InternalMessageInfo
+
+func (m *ICARecovery) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ICARecovery) GetAccountType() ICAAccountType {
+	if m != nil {
+		return m.AccountType
+	}
+	return ICAAccountType_DELEGATION
+}
+
+func (m *ICARecovery) GetClosedChannelId() string {
+	if m != nil {
+		return m.ClosedChannelId
+	}
+	return ""
+}
+
+func (m *ICARecovery) GetPendingChannelId() string {
+	if m != nil {
+		return m.PendingChannelId
+	}
+	return ""
+}
+
+func (m *ICARecovery) GetAttempts() uint64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *ICARecovery) GetLastAttemptHeight() int64 {
+	if m != nil {
+		return m.LastAttemptHeight
+	}
+	return 0
+}
+
+// ICAChannelHealth is the state of the channel behind one of a host zone's ICA
+// accounts
+type ICAChannelHealth struct {
+	AccountType ICAAccountType `protobuf:"varint,1,opt,name=account_type,json=accountType,proto3,enum=Stridelabs.stride.stakeibc.ICAAccountType" json:"account_type,omitempty"`
+	Address     string         `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	PortId      string         `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId   string         `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the channel's state, e.g. STATE_OPEN or STATE_CLOSED
+	ChannelState string `protobuf:"bytes,5,opt,name=channel_state,json=channelState,proto3" json:"channel_state,omitempty"`
+	Open         bool   `protobuf:"varint,6,opt,name=open,proto3" json:"open,omitempty"`
+	// set while a closed channel is being re-opened
+	Recovery *ICARecovery `protobuf:"bytes,7,opt,name=recovery,proto3" json:"recovery,omitempty"`
+}
+
+func (m *ICAChannelHealth) Reset()         { *m = ICAChannelHealth{} }
+func (m *ICAChannelHealth) String() string { return proto.CompactTextString(m) }
+func (*ICAChannelHealth) ProtoMessage()    {}
+func (*ICAChannelHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecb5791615c47a38, []int{1}
+}
+func (m *ICAChannelHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ICAChannelHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ICAChannelHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ICAChannelHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ICAChannelHealth.Merge(m, src)
+}
+func (m *ICAChannelHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *ICAChannelHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_ICAChannelHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ICAChannelHealth proto.InternalMessageInfo
+
+func (m *ICAChannelHealth) GetAccountType() ICAAccountType {
+	if m != nil {
+		return m.AccountType
+	}
+	return ICAAccountType_DELEGATION
+}
+
+func (m *ICAChannelHealth) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ICAChannelHealth) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ICAChannelHealth) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ICAChannelHealth) GetChannelState() string {
+	if m != nil {
+		return m.ChannelState
+	}
+	return ""
+}
+
+func (m *ICAChannelHealth) GetOpen() bool {
+	if m != nil {
+		return m.Open
+	}
+	return false
+}
+
+func (m *ICAChannelHealth) GetRecovery() *ICARecovery {
+	if m != nil {
+		return m.Recovery
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ICARecovery)(nil), "Stridelabs.stride.stakeibc.ICARecovery")
+	proto.RegisterType((*ICAChannelHealth)(nil), "Stridelabs.stride.stakeibc.ICAChannelHealth")
+}
+
+func init() { proto.RegisterFile("stakeibc/ica_recovery.proto", fileDescriptor_ecb5791615c47a38) }
+
+var fileDescriptor_ecb5791615c47a38 = []byte{
+	// 426 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0x4f, 0x8e, 0xd3, 0x30,
+	0x14, 0xc6, 0xeb, 0x4e, 0xe9, 0x9f, 0xd7, 0x01, 0x66, 0xcc, 0x82, 0x50, 0x44, 0x14, 0x0d, 0x0b,
+	0xa2, 0x11, 0x24, 0xd2, 0x70, 0x82, 0x90, 0x4d, 0x23, 0xc1, 0xc6, 0xc3, 0x8a, 0x4d, 0xe4, 0xd8,
+	0x56, 0x63, 0x91, 0x89, 0xa3, 0xd8, 0x83, 0xe8, 0x2d, 0xb8, 0x00, 0x87, 0xe0, 0x16, 0x2c, 0x67,
+	0xc9, 0x12, 0xb5, 0x17, 0x41, 0x71, 0xfe, 0x14, 0x54, 0x21, 0x16, 0xec, 0xfc, 0xbd, 0xef, 0x7d,
+	0xef, 0xc9, 0x3f, 0x3d, 0x78, 0xaa, 0x0d, 0xfd, 0x28, 0x64, 0xc6, 0x42, 0xc9, 0x68, 0x5a, 0x0b,
+	0xa6, 0x3e, 0x89, 0x7a, 0x1b, 0x54, 0xb5, 0x32, 0x0a, 0xaf, 0xae, 0x4d, 0x2d, 0xb9, 0x28, 0x68,
+	0xa6, 0x03, 0x6d, 0x9f, 0x41, 0xdf, 0xbe, 0x5a, 0xfd, 0x11, 0xa4, 0x8c, 0xa9, 0xdb, 0xd2, 0xb4,
+	0xb9, 0x8b, 0xaf, 0x63, 0x58, 0x26, 0x71, 0x44, 0xba, 0x69, 0xf8, 0x09, 0xcc, 0x59, 0x4e, 0x65,
+	0x99, 0x4a, 0xee, 0x20, 0x0f, 0xf9, 0x0b, 0x32, 0xb3, 0x3a, 0xe1, 0xf8, 0x1d, 0x9c, 0x76, 0xd9,
+	0xd4, 0x6c, 0x2b, 0xe1, 0x8c, 0x3d, 0xe4, 0x3f, 0xb8, 0xba, 0x0c, 0xfe, 0xbe, 0x39, 0x48, 0xe2,
+	0x28, 0x6a, 0x23, 0xef, 0xb7, 0x95, 0x20, 0x4b, 0x7a, 0x10, 0xf8, 0x12, 0xce, 0x59, 0xa1, 0xb4,
+	0xe0, 0x29, 0xcb, 0x69, 0x59, 0x8a, 0xa2, 0x59, 0x79, 0x62, 0x57, 0x3e, 0x6c, 0x8d, 0xb8, 0xad,
+	0x27, 0x1c, 0xbf, 0x04, 0x5c, 0x89, 0x92, 0xcb, 0x72, 0xf3, 0x7b, 0xf3, 0xc4, 0x36, 0x9f, 0x75,
+	0xce, 0xa1, 0x7b, 0x05, 0x73, 0x6a, 0x8c, 0xb8, 0xa9, 0x8c, 0x76, 0xee, 0x79, 0xc8, 0x9f, 0x90,
+	0x41, 0xe3, 0x00, 0x1e, 0x15, 0x54, 0x9b, 0xb4, 0x2b, 0xa4, 0xb9, 0x90, 0x9b, 0xdc, 0x38, 0x53,
+	0x0f, 0xf9, 0x27, 0xe4, 0xbc, 0xb1, 0xa2, 0xd6, 0x59, 0x5b, 0xe3, 0xe2, 0xdb, 0x18, 0xce, 0x92,
+	0x38, 0xea, 0x86, 0xaf, 0x05, 0x2d, 0x4c, 0x7e, 0x44, 0x02, 0xfd, 0x1f, 0x09, 0x07, 0x66, 0x94,
+	0xf3, 0x5a, 0x68, 0x6d, 0x99, 0x2e, 0x48, 0x2f, 0xf1, 0x63, 0x98, 0x55, 0xaa, 0x36, 0x07, 0x32,
+	0xd3, 0x46, 0x26, 0x1c, 0x3f, 0x03, 0x38, 0x02, 0xb1, 0x60, 0x03, 0x81, 0xe7, 0x70, 0xbf, 0xb7,
+	0xb5, 0xa1, 0x46, 0x58, 0x0c, 0x0b, 0x72, 0xda, 0x15, 0xaf, 0x9b, 0x1a, 0xc6, 0x30, 0x51, 0x95,
+	0x28, 0xed, 0xdf, 0xe7, 0xc4, 0xbe, 0x71, 0x0c, 0xf3, 0xfe, 0xb0, 0x9c, 0x99, 0x87, 0xfc, 0xe5,
+	0xd5, 0x8b, 0x7f, 0xfc, 0xaa, 0xbf, 0x1c, 0x32, 0x04, 0xdf, 0xac, 0xbf, 0xef, 0x5c, 0x74, 0xb7,
+	0x73, 0xd1, 0xcf, 0x9d, 0x8b, 0xbe, 0xec, 0xdd, 0xd1, 0xdd, 0xde, 0x1d, 0xfd, 0xd8, 0xbb, 0xa3,
+	0x0f, 0xc1, 0x46, 0x9a, 0xfc, 0x36, 0x0b, 0x98, 0xba, 0x09, 0xdb, 0xb1, 0xaf, 0xde, 0xd2, 0x4c,
+	0x87, 0xed, 0xdc, 0xf0, 0x73, 0x38, 0x5c, 0x6a, 0xc3, 0x55, 0x67, 0x53, 0x7b, 0xa4, 0xaf, 0x7f,
+	0x0d, 0x00, 0xbf, 0x26, 0x8c, 0x02, 0xfb, 0x02, 0x00, 0x00,
+}
+
+func (m *ICARecovery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ICARecovery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ICARecovery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastAttemptHeight != 0 {
+		i = encodeVarintIcaRecovery(dAtA, i, uint64(m.LastAttemptHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintIcaRecovery(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PendingChannelId) > 0 {
+		i -= len(m.PendingChannelId)
+		copy(dAtA[i:], m.PendingChannelId)
+		i = encodeVarintIcaRecovery(dAtA, i, uint64(len(m.PendingChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ClosedChannelId) > 0 {
+		i -= len(m.ClosedChannelId)
+		copy(dAtA[i:], m.ClosedChannelId)
+		i = encodeVarintIcaRecovery(dAtA, i, uint64(len(m.ClosedChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AccountType != 0 {
+		i = encodeVarintIcaRecovery(dAtA, i, uint64(m.AccountType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintIcaRecovery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ICAChannelHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ICAChannelHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ICAChannelHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Recovery != nil {
+		{
+			size, err := m.Recovery.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIcaRecovery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Open {
+		i--
+		if m.Open {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ChannelState) > 0 {
+		i -= len(m.ChannelState)
+		copy(dAtA[i:], m.ChannelState)
+		i = encodeVarintIcaRecovery(dAtA, i, uint64(len(m.ChannelState)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintIcaRecovery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintIcaRecovery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintIcaRecovery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AccountType != 0 {
+		i = encodeVarintIcaRecovery(dAtA, i, uint64(m.AccountType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIcaRecovery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIcaRecovery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ICARecovery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovIcaRecovery(uint64(l))
+	}
+	if m.AccountType != 0 {
+		n += 1 + sovIcaRecovery(uint64(m.AccountType))
+	}
+	l = len(m.ClosedChannelId)
+	if l > 0 {
+		n += 1 + l + sovIcaRecovery(uint64(l))
+	}
+	l = len(m.PendingChannelId)
+	if l > 0 {
+		n += 1 + l + sovIcaRecovery(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovIcaRecovery(uint64(m.Attempts))
+	}
+	if m.LastAttemptHeight != 0 {
+		n += 1 + sovIcaRecovery(uint64(m.LastAttemptHeight))
+	}
+	return n
+}
+
+func (m *ICAChannelHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AccountType != 0 {
+		n += 1 + sovIcaRecovery(uint64(m.AccountType))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovIcaRecovery(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovIcaRecovery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovIcaRecovery(uint64(l))
+	}
+	l = len(m.ChannelState)
+	if l > 0 {
+		n += 1 + l + sovIcaRecovery(uint64(l))
+	}
+	if m.Open {
+		n += 2
+	}
+	if m.Recovery != nil {
+		l = m.Recovery.Size()
+		n += 1 + l + sovIcaRecovery(uint64(l))
+	}
+	return n
+}
+
+func sovIcaRecovery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIcaRecovery(x uint64) (n int) {
+	return sovIcaRecovery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ICARecovery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcaRecovery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ICARecovery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ICARecovery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+			}
+			m.AccountType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountType |= ICAAccountType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClosedChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAttemptHeight", wireType)
+			}
+			m.LastAttemptHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastAttemptHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcaRecovery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ICAChannelHealth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcaRecovery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ICAChannelHealth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ICAChannelHealth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+			}
+			m.AccountType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountType |= ICAAccountType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Open = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recovery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Recovery == nil {
+				m.Recovery = &ICARecovery{}
+			}
+			if err := m.Recovery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcaRecovery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIcaRecovery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIcaRecovery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIcaRecovery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIcaRecovery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIcaRecovery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIcaRecovery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIcaRecovery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIcaRecovery = fmt.Errorf("proto: unexpected end of group")
+)
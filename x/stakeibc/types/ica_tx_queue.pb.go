@@ -0,0 +1,689 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stakeibc/ica_tx_queue.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types1 "github.com/Stride-Labs/stride/x/records/types"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueuedICATx is a set of messages waiting to be sent from one of a host zone's
+// ICA accounts. The queued txs for each account are coalesced into a single
+// packet at the end of the block
+type QueuedICATx struct {
+	ChainId     string         `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	AccountType ICAAccountType `protobuf:"varint,2,opt,name=account_type,json=accountType,proto3,enum=Stridelabs.stride.stakeibc.ICAAccountType" json:"account_type,omitempty"`
+	Id          uint64         `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	Msgs        []*types.Any   `protobuf:"bytes,4,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// the epoch the packet's timeout is derived from
+	EpochIdentifier string `protobuf:"bytes,5,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	CallbackId      string `protobuf:"bytes,6,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	CallbackArgs    []byte `protobuf:"bytes,7,opt,name=callback_args,json=callbackArgs,proto3" json:"callback_args,omitempty"`
+	// the record status transitions made when the tx was queued, which are written to
+	// the record history (along with the packet's sequence) once it's sent
+	RecordStatusTransitions []types1.RecordStatusTransition `protobuf:"bytes,8,rep,name=record_status_transitions,json=recordStatusTransitions,proto3" json:"record_status_transitions"`
+}
+
+func (m *QueuedICATx) Reset()         { *m = QueuedICATx{} }
+func (m *QueuedICATx) String() string { return proto.CompactTextString(m) }
+func (*QueuedICATx) ProtoMessage()    {}
+func (*QueuedICATx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79999d2af9172f8a, []int{0}
+}
+func (m *QueuedICATx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedICATx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedICATx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedICATx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedICATx.Merge(m, src)
+}
+func (m *QueuedICATx) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedICATx) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedICATx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedICATx proto.InternalMessageInfo
+
+func (m *QueuedICATx) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *QueuedICATx) GetAccountType() ICAAccountType {
+	if m != nil {
+		return m.AccountType
+	}
+	return ICAAccountType_DELEGATION
+}
+
+func (m *QueuedICATx) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QueuedICATx) GetMsgs() []*types.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+func (m *QueuedICATx) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *QueuedICATx) GetCallbackId() string {
+	if m != nil {
+		return m.CallbackId
+	}
+	return ""
+}
+
+func (m *QueuedICATx) GetCallbackArgs() []byte {
+	if m != nil {
+		return m.CallbackArgs
+	}
+	return nil
+}
+
+func (m *QueuedICATx) GetRecordStatusTransitions() []types1.RecordStatusTransition {
+	if m != nil {
+		return m.RecordStatusTransitions
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueuedICATx)(nil), "Stridelabs.stride.stakeibc.QueuedICATx")
+}
+
+func init() { proto.RegisterFile("stakeibc/ica_tx_queue.proto", fileDescriptor_79999d2af9172f8a) }
+
+var fileDescriptor_79999d2af9172f8a = []byte{
+	// 425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x51, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0x6e, 0xda, 0xb2, 0x0d, 0xb7, 0x0c, 0x64, 0x4d, 0x22, 0x2d, 0x28, 0x8b, 0xe0, 0x12, 0x90,
+	0xb0, 0xc5, 0xf8, 0x05, 0xd9, 0x2e, 0x44, 0x82, 0x03, 0x59, 0x4f, 0x5c, 0x22, 0xc7, 0xf6, 0x5c,
+	0x6b, 0x5d, 0x5c, 0xfc, 0x1c, 0xa9, 0xfd, 0x17, 0xdc, 0xf8, 0x4b, 0x3b, 0xee, 0xc8, 0x09, 0xa1,
+	0xf6, 0x8f, 0xa0, 0x3a, 0x4d, 0x10, 0x52, 0x39, 0xd9, 0xef, 0x7d, 0xdf, 0xa7, 0xf7, 0x7d, 0xef,
+	0xa1, 0x17, 0xe0, 0xd8, 0xad, 0xd4, 0x25, 0xa7, 0x9a, 0xb3, 0xc2, 0xad, 0x8a, 0x6f, 0xb5, 0xac,
+	0x25, 0x59, 0x5a, 0xe3, 0x0c, 0x9e, 0x5e, 0x3b, 0xab, 0x85, 0x5c, 0xb0, 0x12, 0x08, 0xf8, 0x2f,
+	0x69, 0xe9, 0xd3, 0x33, 0x65, 0x94, 0xf1, 0x34, 0xba, 0xfb, 0x35, 0x8a, 0xe9, 0x44, 0x19, 0xa3,
+	0x16, 0x92, 0xfa, 0xaa, 0xac, 0x6f, 0x28, 0xab, 0xd6, 0x7b, 0xe8, 0xa5, 0x95, 0xdc, 0x58, 0x01,
+	0xb4, 0x79, 0x8b, 0xb9, 0x06, 0x67, 0x6c, 0x8b, 0x4e, 0xff, 0xf1, 0xc1, 0x38, 0x37, 0x75, 0xe5,
+	0x1a, 0xec, 0xd5, 0x8f, 0x01, 0x1a, 0x7d, 0xd9, 0xd9, 0x12, 0xd9, 0x55, 0x3a, 0x5b, 0xe1, 0x09,
+	0x3a, 0xe1, 0x73, 0xa6, 0xab, 0x42, 0x8b, 0x30, 0x88, 0x83, 0xe4, 0x71, 0x7e, 0xec, 0xeb, 0x4c,
+	0xe0, 0xcf, 0x68, 0xbc, 0xd7, 0x16, 0x6e, 0xbd, 0x94, 0x61, 0x3f, 0x0e, 0x92, 0xd3, 0x8b, 0xb7,
+	0xe4, 0xff, 0x41, 0x48, 0x76, 0x95, 0xa6, 0x8d, 0x64, 0xb6, 0x5e, 0xca, 0x7c, 0xc4, 0xfe, 0x16,
+	0xf8, 0x14, 0xf5, 0xb5, 0x08, 0x07, 0x71, 0x90, 0x0c, 0xf3, 0xbe, 0x16, 0x38, 0x41, 0xc3, 0x3b,
+	0x50, 0x10, 0x0e, 0xe3, 0x41, 0x32, 0xba, 0x38, 0x23, 0x4d, 0x5a, 0xd2, 0xa6, 0x25, 0x69, 0xb5,
+	0xce, 0x3d, 0x03, 0xbf, 0x41, 0xcf, 0xe4, 0xd2, 0xf0, 0x79, 0xa1, 0x85, 0xac, 0x9c, 0xbe, 0xd1,
+	0xd2, 0x86, 0x8f, 0xbc, 0xd7, 0xa7, 0xbe, 0x9f, 0x75, 0x6d, 0x7c, 0x8e, 0x46, 0x9c, 0x2d, 0x16,
+	0x25, 0xe3, 0xb7, 0xbb, 0x44, 0x47, 0x9e, 0x85, 0xda, 0x56, 0x26, 0xf0, 0x6b, 0xf4, 0xa4, 0x23,
+	0x30, 0xab, 0x20, 0x3c, 0x8e, 0x83, 0x64, 0x9c, 0x8f, 0xdb, 0x66, 0x6a, 0x15, 0x60, 0x40, 0x93,
+	0xfd, 0x62, 0xc1, 0x31, 0x57, 0x43, 0xe1, 0x2c, 0xab, 0x40, 0x3b, 0x6d, 0x2a, 0x08, 0x4f, 0xbc,
+	0xdf, 0xf7, 0x07, 0xd6, 0xb0, 0x3f, 0x0a, 0xc9, 0xfd, 0x7b, 0xed, 0xa5, 0xb3, 0x4e, 0x79, 0x39,
+	0xbc, 0xff, 0x75, 0xde, 0xcb, 0x9f, 0xdb, 0x83, 0x28, 0x5c, 0x7e, 0xbc, 0xdf, 0x44, 0xc1, 0xc3,
+	0x26, 0x0a, 0x7e, 0x6f, 0xa2, 0xe0, 0xfb, 0x36, 0xea, 0x3d, 0x6c, 0xa3, 0xde, 0xcf, 0x6d, 0xd4,
+	0xfb, 0x4a, 0x94, 0x76, 0xf3, 0xba, 0x24, 0xdc, 0xdc, 0xd1, 0x66, 0xea, 0xbb, 0x4f, 0xac, 0x04,
+	0xda, 0x8c, 0xa5, 0x2b, 0xda, 0xdd, 0x7b, 0x77, 0x27, 0x28, 0x8f, 0xfc, 0x0e, 0x3f, 0xfc, 0x19,
+	0x00, 0x71, 0xdb, 0xed, 0xde, 0x90, 0x02, 0x00, 0x00,
+}
+
+func (m *QueuedICATx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedICATx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedICATx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecordStatusTransitions) > 0 {
+		for iNdEx := len(m.RecordStatusTransitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordStatusTransitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIcaTxQueue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.CallbackArgs) > 0 {
+		i -= len(m.CallbackArgs)
+		copy(dAtA[i:], m.CallbackArgs)
+		i = encodeVarintIcaTxQueue(dAtA, i, uint64(len(m.CallbackArgs)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CallbackId) > 0 {
+		i -= len(m.CallbackId)
+		copy(dAtA[i:], m.CallbackId)
+		i = encodeVarintIcaTxQueue(dAtA, i, uint64(len(m.CallbackId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintIcaTxQueue(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIcaTxQueue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintIcaTxQueue(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AccountType != 0 {
+		i = encodeVarintIcaTxQueue(dAtA, i, uint64(m.AccountType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintIcaTxQueue(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIcaTxQueue(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIcaTxQueue(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueuedICATx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovIcaTxQueue(uint64(l))
+	}
+	if m.AccountType != 0 {
+		n += 1 + sovIcaTxQueue(uint64(m.AccountType))
+	}
+	if m.Id != 0 {
+		n += 1 + sovIcaTxQueue(uint64(m.Id))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovIcaTxQueue(uint64(l))
+		}
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovIcaTxQueue(uint64(l))
+	}
+	l = len(m.CallbackId)
+	if l > 0 {
+		n += 1 + l + sovIcaTxQueue(uint64(l))
+	}
+	l = len(m.CallbackArgs)
+	if l > 0 {
+		n += 1 + l + sovIcaTxQueue(uint64(l))
+	}
+	if len(m.RecordStatusTransitions) > 0 {
+		for _, e := range m.RecordStatusTransitions {
+			l = e.Size()
+			n += 1 + l + sovIcaTxQueue(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovIcaTxQueue(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIcaTxQueue(x uint64) (n int) {
+	return sovIcaTxQueue(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueuedICATx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcaTxQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedICATx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedICATx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaTxQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaTxQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaTxQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+			}
+			m.AccountType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaTxQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountType |= ICAAccountType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaTxQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaTxQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIcaTxQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaTxQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaTxQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaTxQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaTxQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaTxQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaTxQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaTxQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackArgs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaTxQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIcaTxQueue
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaTxQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackArgs = append(m.CallbackArgs[:0], dAtA[iNdEx:postIndex]...)
+			if m.CallbackArgs == nil {
+				m.CallbackArgs = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordStatusTransitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaTxQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIcaTxQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaTxQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordStatusTransitions = append(m.RecordStatusTransitions, types1.RecordStatusTransition{})
+			if err := m.RecordStatusTransitions[len(m.RecordStatusTransitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcaTxQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcaTxQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIcaTxQueue(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIcaTxQueue
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIcaTxQueue
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIcaTxQueue
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIcaTxQueue
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIcaTxQueue
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIcaTxQueue
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIcaTxQueue        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIcaTxQueue          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIcaTxQueue = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,24 @@
+package types
+
+const (
+	// ICARecoveryKeyPrefix is the prefix to retrieve all ICA channel recoveries
+	ICARecoveryKeyPrefix = "ICARecovery/value/"
+)
+
+// ICARecoveryKey returns the store key to retrieve the recovery of a host zone's ICA channel from the index fields
+func ICARecoveryKey(
+	chainId string,
+	accountType ICAAccountType,
+) []byte {
+	var key []byte
+
+	chainIdBytes := []byte(chainId)
+	key = append(key, chainIdBytes...)
+	key = append(key, []byte("/")...)
+
+	accountTypeBytes := []byte(accountType.String())
+	key = append(key, accountTypeBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
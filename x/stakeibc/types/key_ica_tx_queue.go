@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// QueuedICATxKeyPrefix is the prefix to retrieve all queued ICA txs
+	QueuedICATxKeyPrefix = "QueuedICATx/value/"
+	// QueuedICATxCountKey is the key of the counter used to assign queued ICA tx ids
+	QueuedICATxCountKey = "QueuedICATx/count/"
+)
+
+// ICATxQueueKey returns the store key prefix of the txs queued for one of a host zone's ICA accounts
+func ICATxQueueKey(
+	chainId string,
+	accountType ICAAccountType,
+) []byte {
+	var key []byte
+
+	chainIdBytes := []byte(chainId)
+	key = append(key, chainIdBytes...)
+	key = append(key, []byte("/")...)
+
+	accountTypeBytes := []byte(accountType.String())
+	key = append(key, accountTypeBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// QueuedICATxKey returns the store key to retrieve a queued ICA tx from the index fields
+// The id is big endian encoded so the txs of each queue are iterated in the order they were queued
+func QueuedICATxKey(
+	chainId string,
+	accountType ICAAccountType,
+	id uint64,
+) []byte {
+	return append(ICATxQueueKey(chainId, accountType), sdk.Uint64ToBigEndian(id)...)
+}
@@ -0,0 +1,24 @@
+package types
+
+const (
+	// PendingValidatorKeyPrefix is the prefix to retrieve all validators awaiting an ICQ of their host staking record
+	PendingValidatorKeyPrefix = "PendingValidator/value/"
+)
+
+// PendingValidatorKey returns the store key to retrieve a pending validator from the index fields
+func PendingValidatorKey(
+	chainId string,
+	validatorAddress string,
+) []byte {
+	var key []byte
+
+	chainIdBytes := []byte(chainId)
+	key = append(key, chainIdBytes...)
+	key = append(key, []byte("/")...)
+
+	validatorAddressBytes := []byte(validatorAddress)
+	key = append(key, validatorAddressBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
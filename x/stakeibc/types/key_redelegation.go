@@ -0,0 +1,36 @@
+package types
+
+const (
+	// RedelegationKeyPrefix is the prefix to retrieve all in-flight redelegations
+	RedelegationKeyPrefix = "Redelegation/value/"
+)
+
+// RedelegationHostZonePrefix returns the store prefix to retrieve all in-flight redelegations for a host zone
+func RedelegationHostZonePrefix(chainId string) []byte {
+	var key []byte
+
+	chainIdBytes := []byte(chainId)
+	key = append(key, chainIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// RedelegationKey returns the store key to retrieve an in-flight redelegation from the index fields
+func RedelegationKey(
+	chainId string,
+	srcValidator string,
+	dstValidator string,
+) []byte {
+	key := RedelegationHostZonePrefix(chainId)
+
+	srcValidatorBytes := []byte(srcValidator)
+	key = append(key, srcValidatorBytes...)
+	key = append(key, []byte("/")...)
+
+	dstValidatorBytes := []byte(dstValidator)
+	key = append(key, dstValidatorBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
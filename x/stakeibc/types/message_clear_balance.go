@@ -12,7 +12,7 @@ const TypeMsgClearBalance = "clear_balance"
 
 var _ sdk.Msg = &MsgClearBalance{}
 
-func NewMsgClearBalance(creator string, chainId string, amount uint64, channelId string) *MsgClearBalance {
+func NewMsgClearBalance(creator string, chainId string, amount sdk.Int, channelId string) *MsgClearBalance {
 	return &MsgClearBalance{
 		Creator: creator,
 		ChainId: chainId,
@@ -54,7 +54,7 @@ func (msg *MsgClearBalance) ValidateBasic() error {
 	if len(msg.ChainId) == 0 {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "chainid is required")
 	}
-	if msg.Amount <= 0 {
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "amount must be greater than 0")
 	}
 	if isValid := channeltypes.IsValidChannelID(msg.Channel); !isValid {
@@ -4,6 +4,7 @@ import (
 	"math"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
@@ -22,7 +23,7 @@ func TestMsgRedeemStake_ValidateBasic(t *testing.T) {
 				Creator:  sample.AccAddress(),
 				HostZone: "GAIA",
 				Receiver: sample.AccAddress(),
-				Amount:   uint64(1),
+				Amount:   sdk.NewInt(1),
 			},
 		},
 		{
@@ -31,7 +32,7 @@ func TestMsgRedeemStake_ValidateBasic(t *testing.T) {
 				Creator:  "invalid_address",
 				HostZone: "GAIA",
 				Receiver: sample.AccAddress(),
-				Amount:   uint64(1),
+				Amount:   sdk.NewInt(1),
 			},
 			err: sdkerrors.ErrInvalidAddress,
 		},
@@ -40,7 +41,7 @@ func TestMsgRedeemStake_ValidateBasic(t *testing.T) {
 			msg: MsgRedeemStake{
 				Creator:  sample.AccAddress(),
 				Receiver: sample.AccAddress(),
-				Amount:   uint64(1),
+				Amount:   sdk.NewInt(1),
 			},
 			err: ErrRequiredFieldEmpty,
 		},
@@ -49,19 +50,28 @@ func TestMsgRedeemStake_ValidateBasic(t *testing.T) {
 			msg: MsgRedeemStake{
 				Creator:  sample.AccAddress(),
 				HostZone: "GAIA",
-				Amount:   uint64(1),
+				Amount:   sdk.NewInt(1),
 			},
 			err: ErrRequiredFieldEmpty,
 		},
 		{
-			name: "amount max int",
+			name: "zero amount",
 			msg: MsgRedeemStake{
 				Creator:  sample.AccAddress(),
 				HostZone: "GAIA",
 				Receiver: sample.AccAddress(),
-				Amount:   math.MaxUint64,
+				Amount:   sdk.ZeroInt(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "amount greater than max uint64",
+			msg: MsgRedeemStake{
+				Creator:  sample.AccAddress(),
+				HostZone: "GAIA",
+				Receiver: sample.AccAddress(),
+				Amount:   sdk.NewIntFromUint64(math.MaxUint64).Add(sdk.OneInt()),
 			},
-			err: ErrInvalidAmount,
 		},
 	}
 	for _, tt := range tests {
@@ -15,7 +15,7 @@ const TypeMsgRegisterHostZone = "register_host_zone"
 
 var _ sdk.Msg = &MsgRegisterHostZone{}
 
-func NewMsgRegisterHostZone(creator string, connectionId string, bech32prefix string, hostDenom string, ibcDenom string, transferChannelId string, unbondingFrequency uint64) *MsgRegisterHostZone {
+func NewMsgRegisterHostZone(creator string, connectionId string, bech32prefix string, hostDenom string, ibcDenom string, transferChannelId string, unbondingFrequency uint64, denomExponent uint32, displayDenom string) *MsgRegisterHostZone {
 	return &MsgRegisterHostZone{
 		Creator:            creator,
 		ConnectionId:       connectionId,
@@ -24,6 +24,8 @@ func NewMsgRegisterHostZone(creator string, connectionId string, bech32prefix st
 		IbcDenom:           ibcDenom,
 		TransferChannelId:  transferChannelId,
 		UnbondingFrequency: unbondingFrequency,
+		DenomExponent:      denomExponent,
+		DisplayDenom:       displayDenom,
 	}
 }
 
@@ -67,6 +69,22 @@ func (msg *MsgRegisterHostZone) ValidateBasic() error {
 		return err
 	}
 
+	// the exponent must be representable with sdk.Dec precision
+	if msg.DenomExponent > MaxDenomExponent {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "denom exponent cannot exceed %d", MaxDenomExponent)
+	}
+	// display denom must be a valid asset denom, distinct from the host denom unless the exponent is 0
+	if err := sdk.ValidateDenom(msg.DisplayDenom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid display denom (%s)", err)
+	}
+	if msg.DenomExponent > 0 && msg.DisplayDenom == msg.HostDenom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "display denom must differ from host denom when denom exponent is non-zero")
+	}
+	// the stToken metadata registered in x/bank must be valid
+	if err := StTokenMetadata(msg.HostDenom, msg.DisplayDenom, msg.DenomExponent).Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid stToken metadata (%s)", err)
+	}
+
 	// ibc denom cannot be empty and must begin with "ibc"
 	if msg.IbcDenom == "" {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "ibc denom cannot be empty")
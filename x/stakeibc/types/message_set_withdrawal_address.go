@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/utils"
+)
+
+const TypeMsgSetWithdrawalAddress = "set_withdrawal_address"
+
+var _ sdk.Msg = &MsgSetWithdrawalAddress{}
+
+func NewMsgSetWithdrawalAddress(creator string, hostZone string) *MsgSetWithdrawalAddress {
+	return &MsgSetWithdrawalAddress{
+		Creator:  creator,
+		HostZone: hostZone,
+	}
+}
+
+func (msg *MsgSetWithdrawalAddress) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetWithdrawalAddress) Type() string {
+	return TypeMsgSetWithdrawalAddress
+}
+
+func (msg *MsgSetWithdrawalAddress) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetWithdrawalAddress) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetWithdrawalAddress) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := utils.ValidateAdminAddress(msg.Creator); err != nil {
+		return err
+	}
+	if msg.HostZone == "" {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "host zone must be specified")
+	}
+	return nil
+}
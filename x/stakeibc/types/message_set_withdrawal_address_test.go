@@ -0,0 +1,42 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/Stride-Labs/stride/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetWithdrawalAddress_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetWithdrawalAddress
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetWithdrawalAddress{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address but not whitelisted",
+			msg: MsgSetWithdrawalAddress{
+				Creator:  sample.AccAddress(),
+				HostZone: "GAIA",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/utils"
+)
+
+const TypeMsgUpdateHostZoneSchedule = "update_host_zone_schedule"
+
+var _ sdk.Msg = &MsgUpdateHostZoneSchedule{}
+
+func NewMsgUpdateHostZoneSchedule(creator string, hostZone string, pipeline string, interval uint64, offset uint64) *MsgUpdateHostZoneSchedule {
+	return &MsgUpdateHostZoneSchedule{
+		Creator:  creator,
+		HostZone: hostZone,
+		Pipeline: pipeline,
+		Interval: interval,
+		Offset:   offset,
+	}
+}
+
+func (msg *MsgUpdateHostZoneSchedule) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateHostZoneSchedule) Type() string {
+	return TypeMsgUpdateHostZoneSchedule
+}
+
+func (msg *MsgUpdateHostZoneSchedule) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateHostZoneSchedule) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateHostZoneSchedule) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := utils.ValidateAdminAddress(msg.Creator); err != nil {
+		return err
+	}
+	if msg.HostZone == "" {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "host zone must be specified")
+	}
+	if err := ValidatePipeline(msg.Pipeline); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// an interval of 0 falls back to the module param, so the offset can only be checked against an explicit interval
+	if msg.Interval > 0 && msg.Offset >= msg.Interval {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "offset (%d) must be less than the interval (%d)", msg.Offset, msg.Interval)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/Stride-Labs/stride/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdateHostZoneSchedule_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUpdateHostZoneSchedule
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUpdateHostZoneSchedule{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address but not whitelisted",
+			msg: MsgUpdateHostZoneSchedule{
+				Creator:  sample.AccAddress(),
+				HostZone: "GAIA",
+				Pipeline: PipelineDeposit,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestHostZonePipelineSchedule(t *testing.T) {
+	hostZone := HostZone{}
+	for pipeline := range PipelineIntervalKeys {
+		require.Nil(t, hostZone.GetPipelineSchedule(pipeline), "%s schedule should start unset", pipeline)
+
+		schedule := &PipelineSchedule{Interval: 4, Offset: 1}
+		require.NoError(t, hostZone.SetPipelineSchedule(pipeline, schedule), "set %s schedule", pipeline)
+		require.Equal(t, schedule, hostZone.GetPipelineSchedule(pipeline), "%s schedule", pipeline)
+	}
+
+	require.Error(t, hostZone.SetPipelineSchedule("unbonding", &PipelineSchedule{Interval: 1}))
+	require.Nil(t, hostZone.GetPipelineSchedule("unbonding"))
+}
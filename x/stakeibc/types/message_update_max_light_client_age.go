@@ -0,0 +1,55 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/utils"
+)
+
+const TypeMsgUpdateMaxLightClientAge = "update_max_light_client_age"
+
+var _ sdk.Msg = &MsgUpdateMaxLightClientAge{}
+
+func NewMsgUpdateMaxLightClientAge(creator string, hostZone string, maxLightClientAgeNanos uint64) *MsgUpdateMaxLightClientAge {
+	return &MsgUpdateMaxLightClientAge{
+		Creator:                creator,
+		HostZone:               hostZone,
+		MaxLightClientAgeNanos: maxLightClientAgeNanos,
+	}
+}
+
+func (msg *MsgUpdateMaxLightClientAge) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateMaxLightClientAge) Type() string {
+	return TypeMsgUpdateMaxLightClientAge
+}
+
+func (msg *MsgUpdateMaxLightClientAge) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateMaxLightClientAge) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateMaxLightClientAge) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := utils.ValidateAdminAddress(msg.Creator); err != nil {
+		return err
+	}
+	if msg.HostZone == "" {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "host zone must be specified")
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/Stride-Labs/stride/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdateMaxLightClientAge_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUpdateMaxLightClientAge
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUpdateMaxLightClientAge{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address but not whitelisted",
+			msg: MsgUpdateMaxLightClientAge{
+				Creator:                sample.AccAddress(),
+				HostZone:               "GAIA",
+				MaxLightClientAgeNanos: 1_000,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
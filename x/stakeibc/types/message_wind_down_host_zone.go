@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/utils"
+)
+
+const TypeMsgWindDownHostZone = "wind_down_host_zone"
+
+var _ sdk.Msg = &MsgWindDownHostZone{}
+
+func NewMsgWindDownHostZone(creator string, hostZone string) *MsgWindDownHostZone {
+	return &MsgWindDownHostZone{
+		Creator:  creator,
+		HostZone: hostZone,
+	}
+}
+
+func (msg *MsgWindDownHostZone) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgWindDownHostZone) Type() string {
+	return TypeMsgWindDownHostZone
+}
+
+func (msg *MsgWindDownHostZone) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgWindDownHostZone) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgWindDownHostZone) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := utils.ValidateAdminAddress(msg.Creator); err != nil {
+		return err
+	}
+	if msg.HostZone == "" {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "host zone must be specified")
+	}
+	return nil
+}
@@ -22,9 +22,16 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MinValidatorRequirements are checked against a validator's staking record on
+// the host (queried with an ICQ) before it's added to a host zone
 type MinValidatorRequirements struct {
+	// min commission rate, as a whole percentage (compared against the host's
+	// exact rate)
 	CommissionRate int32 `protobuf:"varint,1,opt,name=commissionRate,proto3" json:"commissionRate,omitempty"`
-	Uptime         int32 `protobuf:"varint,2,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	// the validator's uptime isn't measured, since that would require the host's
+	// slashing signing info and params: any value above zero only requires the
+	// validator to be bonded and not jailed on the host when it's added
+	Uptime int32 `protobuf:"varint,2,opt,name=uptime,proto3" json:"uptime,omitempty"`
 }
 
 func (m *MinValidatorRequirements) Reset()         { *m = MinValidatorRequirements{} }
@@ -83,7 +90,7 @@ func init() {
 }
 
 var fileDescriptor_e9310c10994d4a9b = []byte{
-	// 208 bytes of a gzipped FileDescriptorProto
+	// 205 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2c, 0x2e, 0x49, 0xcc,
 	0x4e, 0xcd, 0x4c, 0x4a, 0xd6, 0xcf, 0xcd, 0xcc, 0x8b, 0x2f, 0x4b, 0xcc, 0xc9, 0x4c, 0x49, 0x2c,
 	0xc9, 0x2f, 0x8a, 0x2f, 0x4a, 0x2d, 0x2c, 0xcd, 0x2c, 0x4a, 0xcd, 0x4d, 0xcd, 0x2b, 0x29, 0xd6,
@@ -96,7 +103,7 @@ var fileDescriptor_e9310c10994d4a9b = []byte{
 	0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4b, 0xcf, 0x2c,
 	0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0x38, 0x4e, 0xd7, 0x27, 0x31, 0xa9, 0x58,
 	0x1f, 0xe2, 0x3a, 0xfd, 0x0a, 0x7d, 0xb8, 0xe7, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0,
-	0x1e, 0x31, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x3b, 0x9e, 0xa9, 0xc4, 0xf5, 0x00, 0x00, 0x00,
+	0x1e, 0x31, 0x06, 0x0c, 0x00, 0x3b, 0x9e, 0xa9, 0xc4, 0xf5, 0x00, 0x00, 0x00,
 }
 
 func (m *MinValidatorRequirements) Marshal() (dAtA []byte, err error) {
@@ -5,6 +5,8 @@ import (
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
+
+	epochtypes "github.com/Stride-Labs/stride/x/epochs/types"
 )
 
 // Default init params
@@ -15,6 +17,7 @@ var (
 	DefaultReinvestInterval       uint64 = 1
 	DefaultRewardsInterval        uint64 = 1
 	DefaultRedemptionRateInterval uint64 = 1
+	DefaultRebalanceInterval      uint64 = 4
 	// you apparantly cannot safely encode floats, so we make commission / 100
 	DefaultStrideCommission                 uint64 = 10
 	DefaultValidatorRebalancingThreshold    uint64 = 100 // divide by 10,000, so 100 = 1%
@@ -27,6 +30,20 @@ var (
 	DefaultMaxStakeICACallsPerEpoch         uint64 = 100
 	DefaultIBCTransferTimeoutNanos          uint64 = 1800000000000 // 30 minutes
 	DefaultSafetyNumValidators              uint64 = 35
+	DefaultMaxRedelegationEntries           uint64 = 7 // the host staking module's default max_entries
+	DefaultMaxRebalanceRedelegations        uint64 = 10
+	DefaultICARecoveryInterval              uint64 = 1
+	DefaultMaxLightClientAgeNanos           uint64 = 86400000000000 // 1 day
+	DefaultMaxICAMsgsPerPacket              uint64 = 100
+	// epochs that drive each pipeline
+	DefaultDepositEpochIdentifier        = epochtypes.STRIDE_EPOCH
+	DefaultDelegateEpochIdentifier       = epochtypes.STRIDE_EPOCH
+	DefaultReinvestEpochIdentifier       = epochtypes.STRIDE_EPOCH
+	DefaultRedemptionRateEpochIdentifier = epochtypes.STRIDE_EPOCH
+	DefaultRebalanceEpochIdentifier      = epochtypes.STRIDE_EPOCH
+	DefaultUnbondingEpochIdentifier      = epochtypes.DAY_EPOCH
+	DefaultSweepEpochIdentifier          = epochtypes.DAY_EPOCH
+	DefaultICARecoveryEpochIdentifier    = epochtypes.STRIDE_EPOCH
 
 	// KeyDepositInterval is store's key for the DepositInterval option
 	KeyDepositInterval                  = []byte("DepositInterval")
@@ -34,6 +51,7 @@ var (
 	KeyReinvestInterval                 = []byte("ReinvestInterval")
 	KeyRewardsInterval                  = []byte("RewardsInterval")
 	KeyRedemptionRateInterval           = []byte("RedemptionRateInterval")
+	KeyRebalanceInterval                = []byte("RebalanceInterval")
 	KeyStrideCommission                 = []byte("StrideCommission")
 	KeyValidatorRebalancingThreshold    = []byte("ValidatorRebalancingThreshold")
 	KeyICATimeoutNanos                  = []byte("ICATimeoutNanos")
@@ -45,6 +63,19 @@ var (
 	KeyMaxStakeICACallsPerEpoch         = []byte("MaxStakeICACallsPerEpoch")
 	KeyIBCTransferTimeoutNanos          = []byte("IBCTransferTimeoutNanos")
 	KeySafetyNumValidators              = []byte("SafetyNumValidators")
+	KeyMaxRedelegationEntries           = []byte("MaxRedelegationEntries")
+	KeyMaxRebalanceRedelegations        = []byte("MaxRebalanceRedelegations")
+	KeyDepositEpochIdentifier           = []byte("DepositEpochIdentifier")
+	KeyDelegateEpochIdentifier          = []byte("DelegateEpochIdentifier")
+	KeyReinvestEpochIdentifier          = []byte("ReinvestEpochIdentifier")
+	KeyRedemptionRateEpochIdentifier    = []byte("RedemptionRateEpochIdentifier")
+	KeyRebalanceEpochIdentifier         = []byte("RebalanceEpochIdentifier")
+	KeyUnbondingEpochIdentifier         = []byte("UnbondingEpochIdentifier")
+	KeySweepEpochIdentifier             = []byte("SweepEpochIdentifier")
+	KeyICARecoveryInterval              = []byte("ICARecoveryInterval")
+	KeyICARecoveryEpochIdentifier       = []byte("ICARecoveryEpochIdentifier")
+	KeyMaxLightClientAgeNanos           = []byte("MaxLightClientAgeNanos")
+	KeyMaxICAMsgsPerPacket              = []byte("MaxICAMsgsPerPacket")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -72,6 +103,20 @@ func NewParams(
 	safety_max_redemption_rate_threshold uint64,
 	ibc_transfer_timeout_nanos uint64,
 	safety_num_validators uint64,
+	max_redelegation_entries uint64,
+	rebalance_interval uint64,
+	max_rebalance_redelegations uint64,
+	deposit_epoch_identifier string,
+	delegate_epoch_identifier string,
+	reinvest_epoch_identifier string,
+	redemption_rate_epoch_identifier string,
+	rebalance_epoch_identifier string,
+	unbonding_epoch_identifier string,
+	sweep_epoch_identifier string,
+	ica_recovery_interval uint64,
+	ica_recovery_epoch_identifier string,
+	max_light_client_age_nanos uint64,
+	max_ica_msgs_per_packet uint64,
 ) Params {
 	return Params{
 		DepositInterval:                  deposit_interval,
@@ -90,6 +135,20 @@ func NewParams(
 		SafetyMaxRedemptionRateThreshold: safety_max_redemption_rate_threshold,
 		IbcTransferTimeoutNanos:          ibc_transfer_timeout_nanos,
 		SafetyNumValidators:              safety_num_validators,
+		MaxRedelegationEntries:           max_redelegation_entries,
+		RebalanceInterval:                rebalance_interval,
+		MaxRebalanceRedelegations:        max_rebalance_redelegations,
+		DepositEpochIdentifier:           deposit_epoch_identifier,
+		DelegateEpochIdentifier:          delegate_epoch_identifier,
+		ReinvestEpochIdentifier:          reinvest_epoch_identifier,
+		RedemptionRateEpochIdentifier:    redemption_rate_epoch_identifier,
+		RebalanceEpochIdentifier:         rebalance_epoch_identifier,
+		UnbondingEpochIdentifier:         unbonding_epoch_identifier,
+		SweepEpochIdentifier:             sweep_epoch_identifier,
+		IcaRecoveryInterval:              ica_recovery_interval,
+		IcaRecoveryEpochIdentifier:       ica_recovery_epoch_identifier,
+		MaxLightClientAgeNanos:           max_light_client_age_nanos,
+		MaxIcaMsgsPerPacket:              max_ica_msgs_per_packet,
 	}
 }
 
@@ -112,6 +171,20 @@ func DefaultParams() Params {
 		DefaultSafetyMaxRedemptionRateThreshold,
 		DefaultIBCTransferTimeoutNanos,
 		DefaultSafetyNumValidators,
+		DefaultMaxRedelegationEntries,
+		DefaultRebalanceInterval,
+		DefaultMaxRebalanceRedelegations,
+		DefaultDepositEpochIdentifier,
+		DefaultDelegateEpochIdentifier,
+		DefaultReinvestEpochIdentifier,
+		DefaultRedemptionRateEpochIdentifier,
+		DefaultRebalanceEpochIdentifier,
+		DefaultUnbondingEpochIdentifier,
+		DefaultSweepEpochIdentifier,
+		DefaultICARecoveryInterval,
+		DefaultICARecoveryEpochIdentifier,
+		DefaultMaxLightClientAgeNanos,
+		DefaultMaxICAMsgsPerPacket,
 	)
 }
 
@@ -134,6 +207,20 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeySafetyMaxRedemptionRateThreshold, &p.SafetyMaxRedemptionRateThreshold, validMaxRedemptionRateThreshold),
 		paramtypes.NewParamSetPair(KeyIBCTransferTimeoutNanos, &p.IbcTransferTimeoutNanos, validTimeoutNanos),
 		paramtypes.NewParamSetPair(KeySafetyNumValidators, &p.SafetyNumValidators, isPositive),
+		paramtypes.NewParamSetPair(KeyMaxRedelegationEntries, &p.MaxRedelegationEntries, isPositive),
+		paramtypes.NewParamSetPair(KeyRebalanceInterval, &p.RebalanceInterval, isPositive),
+		paramtypes.NewParamSetPair(KeyMaxRebalanceRedelegations, &p.MaxRebalanceRedelegations, isPositive),
+		paramtypes.NewParamSetPair(KeyDepositEpochIdentifier, &p.DepositEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
+		paramtypes.NewParamSetPair(KeyDelegateEpochIdentifier, &p.DelegateEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
+		paramtypes.NewParamSetPair(KeyReinvestEpochIdentifier, &p.ReinvestEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
+		paramtypes.NewParamSetPair(KeyRedemptionRateEpochIdentifier, &p.RedemptionRateEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
+		paramtypes.NewParamSetPair(KeyRebalanceEpochIdentifier, &p.RebalanceEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
+		paramtypes.NewParamSetPair(KeyUnbondingEpochIdentifier, &p.UnbondingEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
+		paramtypes.NewParamSetPair(KeySweepEpochIdentifier, &p.SweepEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
+		paramtypes.NewParamSetPair(KeyICARecoveryInterval, &p.IcaRecoveryInterval, isPositive),
+		paramtypes.NewParamSetPair(KeyICARecoveryEpochIdentifier, &p.IcaRecoveryEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
+		paramtypes.NewParamSetPair(KeyMaxLightClientAgeNanos, &p.MaxLightClientAgeNanos, isPositive),
+		paramtypes.NewParamSetPair(KeyMaxICAMsgsPerPacket, &p.MaxIcaMsgsPerPacket, isPositive),
 	}
 }
 
@@ -226,9 +313,28 @@ func isCommission(i interface{}) error {
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	for _, epochIdentifier := range p.EpochIdentifiers() {
+		if err := epochtypes.ValidateEpochIdentifierString(epochIdentifier); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// EpochIdentifiers returns the identifiers of every epoch that drives a stakeibc pipeline
+func (p Params) EpochIdentifiers() []string {
+	return []string{
+		p.DepositEpochIdentifier,
+		p.DelegateEpochIdentifier,
+		p.ReinvestEpochIdentifier,
+		p.RedemptionRateEpochIdentifier,
+		p.RebalanceEpochIdentifier,
+		p.UnbondingEpochIdentifier,
+		p.SweepEpochIdentifier,
+		p.IcaRecoveryEpochIdentifier,
+	}
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
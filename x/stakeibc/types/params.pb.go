@@ -24,7 +24,7 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
-// next id: 18
+// next id: 32
 type Params struct {
 	// define epoch lengths, in stride_epochs
 	RewardsInterval        uint64 `protobuf:"varint,1,opt,name=rewards_interval,json=rewardsInterval,proto3" json:"rewards_interval,omitempty"`
@@ -36,18 +36,50 @@ type Params struct {
 	// send the Stride commission too, as well as what portion
 	// of the fee each address is entitled to
 	// TODO implement this
-	ZoneComAddress                   map[string]string `protobuf:"bytes,5,rep,name=zone_com_address,json=zoneComAddress,proto3" json:"zone_com_address,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	ReinvestInterval                 uint64            `protobuf:"varint,7,opt,name=reinvest_interval,json=reinvestInterval,proto3" json:"reinvest_interval,omitempty"`
-	ValidatorRebalancingThreshold    uint64            `protobuf:"varint,8,opt,name=validator_rebalancing_threshold,json=validatorRebalancingThreshold,proto3" json:"validator_rebalancing_threshold,omitempty"`
-	IcaTimeoutNanos                  uint64            `protobuf:"varint,9,opt,name=ica_timeout_nanos,json=icaTimeoutNanos,proto3" json:"ica_timeout_nanos,omitempty"`
-	BufferSize                       uint64            `protobuf:"varint,10,opt,name=buffer_size,json=bufferSize,proto3" json:"buffer_size,omitempty"`
-	IbcTimeoutBlocks                 uint64            `protobuf:"varint,11,opt,name=ibc_timeout_blocks,json=ibcTimeoutBlocks,proto3" json:"ibc_timeout_blocks,omitempty"`
-	FeeTransferTimeoutNanos          uint64            `protobuf:"varint,12,opt,name=fee_transfer_timeout_nanos,json=feeTransferTimeoutNanos,proto3" json:"fee_transfer_timeout_nanos,omitempty"`
-	MaxStakeIcaCallsPerEpoch         uint64            `protobuf:"varint,13,opt,name=max_stake_ica_calls_per_epoch,json=maxStakeIcaCallsPerEpoch,proto3" json:"max_stake_ica_calls_per_epoch,omitempty"`
-	SafetyMinRedemptionRateThreshold uint64            `protobuf:"varint,14,opt,name=safety_min_redemption_rate_threshold,json=safetyMinRedemptionRateThreshold,proto3" json:"safety_min_redemption_rate_threshold,omitempty"`
-	SafetyMaxRedemptionRateThreshold uint64            `protobuf:"varint,15,opt,name=safety_max_redemption_rate_threshold,json=safetyMaxRedemptionRateThreshold,proto3" json:"safety_max_redemption_rate_threshold,omitempty"`
-	IbcTransferTimeoutNanos          uint64            `protobuf:"varint,16,opt,name=ibc_transfer_timeout_nanos,json=ibcTransferTimeoutNanos,proto3" json:"ibc_transfer_timeout_nanos,omitempty"`
-	SafetyNumValidators              uint64            `protobuf:"varint,17,opt,name=safety_num_validators,json=safetyNumValidators,proto3" json:"safety_num_validators,omitempty"`
+	ZoneComAddress   map[string]string `protobuf:"bytes,5,rep,name=zone_com_address,json=zoneComAddress,proto3" json:"zone_com_address,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ReinvestInterval uint64            `protobuf:"varint,7,opt,name=reinvest_interval,json=reinvestInterval,proto3" json:"reinvest_interval,omitempty"`
+	// how often (in stride_epochs) host zones are automatically rebalanced
+	RebalanceInterval             uint64 `protobuf:"varint,19,opt,name=rebalance_interval,json=rebalanceInterval,proto3" json:"rebalance_interval,omitempty"`
+	ValidatorRebalancingThreshold uint64 `protobuf:"varint,8,opt,name=validator_rebalancing_threshold,json=validatorRebalancingThreshold,proto3" json:"validator_rebalancing_threshold,omitempty"`
+	IcaTimeoutNanos               uint64 `protobuf:"varint,9,opt,name=ica_timeout_nanos,json=icaTimeoutNanos,proto3" json:"ica_timeout_nanos,omitempty"`
+	BufferSize                    uint64 `protobuf:"varint,10,opt,name=buffer_size,json=bufferSize,proto3" json:"buffer_size,omitempty"`
+	IbcTimeoutBlocks              uint64 `protobuf:"varint,11,opt,name=ibc_timeout_blocks,json=ibcTimeoutBlocks,proto3" json:"ibc_timeout_blocks,omitempty"`
+	FeeTransferTimeoutNanos       uint64 `protobuf:"varint,12,opt,name=fee_transfer_timeout_nanos,json=feeTransferTimeoutNanos,proto3" json:"fee_transfer_timeout_nanos,omitempty"`
+	// max number of deposit records delegated each delegate epoch, each of which
+	// queues one delegation ICA tx. This bounds how many txs are queued, while
+	// max_ica_msgs_per_packet bounds how they're coalesced when the queue is flushed
+	MaxStakeIcaCallsPerEpoch         uint64 `protobuf:"varint,13,opt,name=max_stake_ica_calls_per_epoch,json=maxStakeIcaCallsPerEpoch,proto3" json:"max_stake_ica_calls_per_epoch,omitempty"`
+	SafetyMinRedemptionRateThreshold uint64 `protobuf:"varint,14,opt,name=safety_min_redemption_rate_threshold,json=safetyMinRedemptionRateThreshold,proto3" json:"safety_min_redemption_rate_threshold,omitempty"`
+	SafetyMaxRedemptionRateThreshold uint64 `protobuf:"varint,15,opt,name=safety_max_redemption_rate_threshold,json=safetyMaxRedemptionRateThreshold,proto3" json:"safety_max_redemption_rate_threshold,omitempty"`
+	IbcTransferTimeoutNanos          uint64 `protobuf:"varint,16,opt,name=ibc_transfer_timeout_nanos,json=ibcTransferTimeoutNanos,proto3" json:"ibc_transfer_timeout_nanos,omitempty"`
+	SafetyNumValidators              uint64 `protobuf:"varint,17,opt,name=safety_num_validators,json=safetyNumValidators,proto3" json:"safety_num_validators,omitempty"`
+	// max number of in-flight redelegation entries per validator pair on the host
+	// (mirrors the host staking module's max_entries)
+	MaxRedelegationEntries uint64 `protobuf:"varint,18,opt,name=max_redelegation_entries,json=maxRedelegationEntries,proto3" json:"max_redelegation_entries,omitempty"`
+	// max number of redelegations submitted per host zone in an automatic rebalance
+	MaxRebalanceRedelegations uint64 `protobuf:"varint,20,opt,name=max_rebalance_redelegations,json=maxRebalanceRedelegations,proto3" json:"max_rebalance_redelegations,omitempty"`
+	// the epochs that drive each stakeibc pipeline
+	// the pipeline intervals above are counted in epochs of these identifiers
+	DepositEpochIdentifier        string `protobuf:"bytes,21,opt,name=deposit_epoch_identifier,json=depositEpochIdentifier,proto3" json:"deposit_epoch_identifier,omitempty"`
+	DelegateEpochIdentifier       string `protobuf:"bytes,22,opt,name=delegate_epoch_identifier,json=delegateEpochIdentifier,proto3" json:"delegate_epoch_identifier,omitempty"`
+	ReinvestEpochIdentifier       string `protobuf:"bytes,23,opt,name=reinvest_epoch_identifier,json=reinvestEpochIdentifier,proto3" json:"reinvest_epoch_identifier,omitempty"`
+	RedemptionRateEpochIdentifier string `protobuf:"bytes,24,opt,name=redemption_rate_epoch_identifier,json=redemptionRateEpochIdentifier,proto3" json:"redemption_rate_epoch_identifier,omitempty"`
+	RebalanceEpochIdentifier      string `protobuf:"bytes,25,opt,name=rebalance_epoch_identifier,json=rebalanceEpochIdentifier,proto3" json:"rebalance_epoch_identifier,omitempty"`
+	UnbondingEpochIdentifier      string `protobuf:"bytes,26,opt,name=unbonding_epoch_identifier,json=unbondingEpochIdentifier,proto3" json:"unbonding_epoch_identifier,omitempty"`
+	SweepEpochIdentifier          string `protobuf:"bytes,27,opt,name=sweep_epoch_identifier,json=sweepEpochIdentifier,proto3" json:"sweep_epoch_identifier,omitempty"`
+	// how often (in epochs of ica_recovery_epoch_identifier) closed ICA channels
+	// are re-opened
+	IcaRecoveryInterval        uint64 `protobuf:"varint,28,opt,name=ica_recovery_interval,json=icaRecoveryInterval,proto3" json:"ica_recovery_interval,omitempty"`
+	IcaRecoveryEpochIdentifier string `protobuf:"bytes,29,opt,name=ica_recovery_epoch_identifier,json=icaRecoveryEpochIdentifier,proto3" json:"ica_recovery_epoch_identifier,omitempty"`
+	// how far (in nanoseconds) a host zone's light client can fall behind stride's
+	// block time before the pipelines that depend on the host's time are skipped
+	MaxLightClientAgeNanos uint64 `protobuf:"varint,30,opt,name=max_light_client_age_nanos,json=maxLightClientAgeNanos,proto3" json:"max_light_client_age_nanos,omitempty"`
+	// max number of msgs coalesced into a single ICA packet when an account's
+	// queued txs are flushed (any remaining txs are sent in the following blocks).
+	// This is separate from max_stake_ica_calls_per_epoch since it applies to the
+	// txs of every pipeline and ICA account, and limits the size of each packet
+	// (which the host's max tx size bounds) rather than the number of txs per epoch
+	MaxIcaMsgsPerPacket uint64 `protobuf:"varint,31,opt,name=max_ica_msgs_per_packet,json=maxIcaMsgsPerPacket,proto3" json:"max_ica_msgs_per_packet,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -131,6 +163,13 @@ func (m *Params) GetReinvestInterval() uint64 {
 	return 0
 }
 
+func (m *Params) GetRebalanceInterval() uint64 {
+	if m != nil {
+		return m.RebalanceInterval
+	}
+	return 0
+}
+
 func (m *Params) GetValidatorRebalancingThreshold() uint64 {
 	if m != nil {
 		return m.ValidatorRebalancingThreshold
@@ -201,6 +240,97 @@ func (m *Params) GetSafetyNumValidators() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxRedelegationEntries() uint64 {
+	if m != nil {
+		return m.MaxRedelegationEntries
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRebalanceRedelegations() uint64 {
+	if m != nil {
+		return m.MaxRebalanceRedelegations
+	}
+	return 0
+}
+
+func (m *Params) GetDepositEpochIdentifier() string {
+	if m != nil {
+		return m.DepositEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetDelegateEpochIdentifier() string {
+	if m != nil {
+		return m.DelegateEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetReinvestEpochIdentifier() string {
+	if m != nil {
+		return m.ReinvestEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetRedemptionRateEpochIdentifier() string {
+	if m != nil {
+		return m.RedemptionRateEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetRebalanceEpochIdentifier() string {
+	if m != nil {
+		return m.RebalanceEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetUnbondingEpochIdentifier() string {
+	if m != nil {
+		return m.UnbondingEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetSweepEpochIdentifier() string {
+	if m != nil {
+		return m.SweepEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetIcaRecoveryInterval() uint64 {
+	if m != nil {
+		return m.IcaRecoveryInterval
+	}
+	return 0
+}
+
+func (m *Params) GetIcaRecoveryEpochIdentifier() string {
+	if m != nil {
+		return m.IcaRecoveryEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetMaxLightClientAgeNanos() uint64 {
+	if m != nil {
+		return m.MaxLightClientAgeNanos
+	}
+	return 0
+}
+
+func (m *Params) GetMaxIcaMsgsPerPacket() uint64 {
+	if m != nil {
+		return m.MaxIcaMsgsPerPacket
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "Stridelabs.stride.stakeibc.Params")
 	proto.RegisterMapType((map[string]string)(nil), "Stridelabs.stride.stakeibc.Params.ZoneComAddressEntry")
@@ -209,47 +339,64 @@ func init() {
 func init() { proto.RegisterFile("stakeibc/params.proto", fileDescriptor_41f5fe1d2f7ac763) }
 
 var fileDescriptor_41f5fe1d2f7ac763 = []byte{
-	// 638 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcf, 0x4f, 0x13, 0x41,
-	0x14, 0xc7, 0x5b, 0x7e, 0x09, 0x83, 0x42, 0xbb, 0x80, 0x6e, 0x9a, 0x50, 0x88, 0xf1, 0x00, 0xa2,
-	0xdb, 0x04, 0x13, 0x43, 0xe0, 0x60, 0x80, 0x60, 0x24, 0x51, 0x42, 0x16, 0xe2, 0x81, 0xcb, 0xf8,
-	0x76, 0xf7, 0xb5, 0x9d, 0xb0, 0x3b, 0xb3, 0x99, 0x99, 0xd6, 0xb6, 0x7f, 0x85, 0x47, 0x0f, 0x1e,
-	0xfc, 0x73, 0x3c, 0x72, 0xf4, 0x68, 0xe0, 0x1f, 0x31, 0x3b, 0xd3, 0x6e, 0x59, 0x53, 0xbc, 0x4d,
-	0xbf, 0xef, 0xf3, 0xbe, 0x7d, 0xf3, 0xcd, 0xbc, 0x25, 0x6b, 0x4a, 0xc3, 0x35, 0xb2, 0x20, 0x6c,
-	0xa4, 0x20, 0x21, 0x51, 0x5e, 0x2a, 0x85, 0x16, 0x4e, 0xed, 0x42, 0x4b, 0x16, 0x61, 0x0c, 0x81,
-	0xf2, 0x94, 0x39, 0x7a, 0x23, 0xb0, 0xb6, 0xda, 0x12, 0x2d, 0x61, 0xb0, 0x46, 0x76, 0xb2, 0x1d,
-	0xcf, 0x7f, 0xcc, 0x93, 0xb9, 0x73, 0x63, 0xe1, 0x6c, 0x93, 0x8a, 0xc4, 0xaf, 0x20, 0x23, 0x45,
-	0x19, 0xd7, 0x28, 0xbb, 0x10, 0xbb, 0xe5, 0xcd, 0xf2, 0xd6, 0x8c, 0xbf, 0x3c, 0xd4, 0x4f, 0x87,
-	0xb2, 0xb3, 0x43, 0xaa, 0x11, 0xc6, 0xd8, 0x02, 0x8d, 0x63, 0x76, 0xce, 0xb0, 0x95, 0x51, 0x21,
-	0x87, 0xb7, 0x49, 0x25, 0xc2, 0x54, 0x28, 0xa6, 0xc7, 0xec, 0x94, 0xf5, 0x1d, 0xea, 0x39, 0xba,
-	0x47, 0x5c, 0x89, 0x11, 0x26, 0xa9, 0x66, 0x82, 0x53, 0x59, 0xb0, 0x9f, 0x36, 0x2d, 0x4f, 0xc7,
-	0x75, 0xff, 0xfe, 0x9f, 0xec, 0x90, 0xaa, 0xbd, 0x30, 0x0d, 0x45, 0x92, 0x30, 0xa5, 0x98, 0xe0,
-	0xee, 0x8c, 0x9d, 0xc8, 0x16, 0x8e, 0x73, 0xdd, 0xf9, 0x42, 0x2a, 0x03, 0xc1, 0x0d, 0x4a, 0x21,
-	0x8a, 0x24, 0x2a, 0xe5, 0xce, 0x6e, 0x4e, 0x6f, 0x2d, 0xee, 0xbe, 0xf5, 0x1e, 0x4e, 0xd0, 0xb3,
-	0x39, 0x79, 0x57, 0x82, 0x67, 0x66, 0x87, 0xb6, 0xf1, 0x84, 0x6b, 0xd9, 0xf7, 0x97, 0x06, 0x05,
-	0x31, 0x1b, 0x47, 0x22, 0xe3, 0x5d, 0x54, 0xf7, 0x2e, 0xfd, 0xc8, 0x8e, 0x33, 0x2a, 0xe4, 0xb3,
-	0xbf, 0x27, 0x1b, 0x5d, 0x88, 0x59, 0x04, 0x5a, 0x48, 0x2a, 0x31, 0x80, 0x18, 0x78, 0xc8, 0x78,
-	0x8b, 0xea, 0xb6, 0x44, 0xd5, 0x16, 0x71, 0xe4, 0xce, 0x9b, 0xd6, 0xf5, 0x1c, 0xf3, 0xc7, 0xd4,
-	0xe5, 0x08, 0x72, 0x5e, 0x92, 0x2a, 0x0b, 0x81, 0x6a, 0x96, 0xa0, 0xe8, 0x68, 0xca, 0x81, 0x0b,
-	0xe5, 0x2e, 0xd8, 0xa4, 0x59, 0x08, 0x97, 0x56, 0x3f, 0xcb, 0x64, 0x67, 0x83, 0x2c, 0x06, 0x9d,
-	0x66, 0x13, 0x25, 0x55, 0x6c, 0x80, 0x2e, 0x31, 0x14, 0xb1, 0xd2, 0x05, 0x1b, 0xa0, 0xf3, 0x8a,
-	0x38, 0x2c, 0x08, 0x73, 0xb3, 0x20, 0x16, 0xe1, 0xb5, 0x72, 0x17, 0xed, 0x15, 0x58, 0x10, 0x0e,
-	0xdd, 0x8e, 0x8c, 0xee, 0x1c, 0x90, 0x5a, 0x13, 0x91, 0x6a, 0x09, 0x5c, 0x65, 0xa6, 0xc5, 0x19,
-	0x1e, 0x9b, 0xae, 0x67, 0x4d, 0xc4, 0xcb, 0x21, 0x50, 0x98, 0xe5, 0x1d, 0x59, 0x4f, 0xa0, 0x47,
-	0x4d, 0xce, 0x34, 0xbb, 0x41, 0x08, 0x71, 0xac, 0x68, 0x8a, 0x92, 0x62, 0x2a, 0xc2, 0xb6, 0xfb,
-	0xc4, 0xf4, 0xbb, 0x09, 0xf4, 0x2e, 0x32, 0xe6, 0x34, 0x84, 0xe3, 0x8c, 0x38, 0x47, 0x79, 0x92,
-	0xd5, 0x9d, 0x33, 0xf2, 0x42, 0x41, 0x13, 0x75, 0x9f, 0x26, 0x8c, 0xd3, 0x7f, 0x5f, 0xd0, 0x38,
-	0xc5, 0x25, 0xe3, 0xb3, 0x69, 0xd9, 0x4f, 0x8c, 0xfb, 0x85, 0xb7, 0x34, 0x0e, 0xf2, 0x9e, 0x1f,
-	0xf4, 0xfe, 0xe3, 0xb7, 0x5c, 0xf0, 0x83, 0xde, 0x43, 0x7e, 0x07, 0xa4, 0x66, 0xb2, 0x9c, 0x9c,
-	0x4e, 0xc5, 0xa6, 0x93, 0x65, 0x3a, 0x29, 0x9d, 0x5d, 0xb2, 0x36, 0x1c, 0x86, 0x77, 0x12, 0x9a,
-	0xbf, 0x00, 0xe5, 0x56, 0x4d, 0xdf, 0x8a, 0x2d, 0x9e, 0x75, 0x92, 0xcf, 0x79, 0xa9, 0x76, 0x48,
-	0x56, 0x26, 0xbc, 0x52, 0xa7, 0x42, 0xa6, 0xaf, 0xb1, 0x6f, 0x96, 0x7a, 0xc1, 0xcf, 0x8e, 0xce,
-	0x2a, 0x99, 0xed, 0x42, 0xdc, 0x41, 0xb3, 0x90, 0x0b, 0xbe, 0xfd, 0xb1, 0x3f, 0xb5, 0x57, 0xde,
-	0x9f, 0xf9, 0xfe, 0x73, 0xa3, 0x74, 0xf4, 0xe1, 0xd7, 0x6d, 0xbd, 0x7c, 0x73, 0x5b, 0x2f, 0xff,
-	0xb9, 0xad, 0x97, 0xbf, 0xdd, 0xd5, 0x4b, 0x37, 0x77, 0xf5, 0xd2, 0xef, 0xbb, 0x7a, 0xe9, 0xca,
-	0x6b, 0x31, 0xdd, 0xee, 0x04, 0x5e, 0x28, 0x92, 0x86, 0xdd, 0x99, 0xd7, 0x1f, 0x21, 0x50, 0x0d,
-	0xbb, 0x34, 0x8d, 0x5e, 0x23, 0xff, 0x42, 0xe9, 0x7e, 0x8a, 0x2a, 0x98, 0x33, 0xdf, 0x9b, 0x37,
-	0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x04, 0xcc, 0xfd, 0xae, 0xba, 0x04, 0x00, 0x00,
+	// 905 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0x4f, 0x6f, 0xdb, 0x36,
+	0x14, 0x8f, 0xdb, 0x34, 0x5b, 0x98, 0x2d, 0xb5, 0x95, 0x7f, 0x8a, 0x3a, 0x3b, 0xc6, 0xb0, 0x43,
+	0xba, 0xae, 0x36, 0x90, 0x15, 0x43, 0x91, 0x0e, 0x1b, 0xd2, 0x20, 0xdb, 0x02, 0xb4, 0x41, 0xa0,
+	0x04, 0x3b, 0xf4, 0xc2, 0x51, 0xd2, 0xb3, 0x4c, 0x44, 0x22, 0x05, 0x92, 0x76, 0xed, 0x7c, 0x8a,
+	0x1d, 0x77, 0xdc, 0xc7, 0xd9, 0xb1, 0xc7, 0x01, 0xbb, 0x0c, 0xc9, 0x17, 0x19, 0x48, 0x4a, 0xf4,
+	0xdf, 0xee, 0x26, 0xbd, 0xdf, 0x1f, 0x3e, 0x3e, 0xf2, 0x3d, 0xa2, 0x1d, 0xa9, 0xc8, 0x0d, 0xd0,
+	0x28, 0xee, 0x16, 0x44, 0x90, 0x5c, 0x76, 0x0a, 0xc1, 0x15, 0xf7, 0x82, 0x2b, 0x25, 0x68, 0x02,
+	0x19, 0x89, 0x64, 0x47, 0x9a, 0xcf, 0x4e, 0x45, 0x0c, 0xb6, 0x53, 0x9e, 0x72, 0x43, 0xeb, 0xea,
+	0x2f, 0xab, 0xf8, 0xf2, 0x9f, 0x4d, 0xb4, 0x76, 0x69, 0x2c, 0xbc, 0xa7, 0xa8, 0x2e, 0xe0, 0x3d,
+	0x11, 0x89, 0xc4, 0x94, 0x29, 0x10, 0x43, 0x92, 0xf9, 0xb5, 0x76, 0xed, 0x70, 0x35, 0x7c, 0x5c,
+	0xc6, 0xcf, 0xcb, 0xb0, 0xf7, 0x0c, 0x35, 0x12, 0xc8, 0x20, 0x25, 0x0a, 0x26, 0xdc, 0x35, 0xc3,
+	0xad, 0x57, 0x80, 0x23, 0x3f, 0x45, 0xf5, 0x04, 0x0a, 0x2e, 0xa9, 0x9a, 0x70, 0x1f, 0x58, 0xdf,
+	0x32, 0xee, 0xa8, 0x2f, 0x91, 0x2f, 0x20, 0x81, 0xbc, 0x50, 0x94, 0x33, 0x2c, 0x66, 0xec, 0x1f,
+	0x1a, 0xc9, 0xee, 0x04, 0x0f, 0xa7, 0x17, 0x79, 0x86, 0x1a, 0x76, 0xc3, 0x38, 0xe6, 0x79, 0x4e,
+	0xa5, 0xa4, 0x9c, 0xf9, 0xab, 0x36, 0x23, 0x0b, 0x9c, 0xba, 0xb8, 0xf7, 0x1b, 0xaa, 0xdf, 0x72,
+	0x66, 0xa8, 0x98, 0x24, 0x89, 0x00, 0x29, 0xfd, 0x47, 0xed, 0x87, 0x87, 0x1b, 0x47, 0xdf, 0x75,
+	0x3e, 0x5e, 0xc1, 0x8e, 0xad, 0x53, 0xe7, 0x1d, 0x67, 0xda, 0xec, 0xc4, 0x0a, 0xcf, 0x98, 0x12,
+	0xe3, 0x70, 0xf3, 0x76, 0x26, 0xa8, 0xd3, 0x11, 0x40, 0xd9, 0x10, 0xe4, 0xd4, 0xa6, 0x3f, 0xb1,
+	0xe9, 0x54, 0x80, 0xcb, 0xfd, 0x39, 0xf2, 0x04, 0x44, 0x24, 0x23, 0x2c, 0x9e, 0xda, 0xef, 0x96,
+	0x61, 0x37, 0x1c, 0xe2, 0xe8, 0x3f, 0xa1, 0x83, 0x21, 0xc9, 0x68, 0x42, 0x14, 0x17, 0xb8, 0x82,
+	0x29, 0x4b, 0xb1, 0xea, 0x0b, 0x90, 0x7d, 0x9e, 0x25, 0xfe, 0xa7, 0x46, 0xdb, 0x74, 0xb4, 0x70,
+	0xc2, 0xba, 0xae, 0x48, 0xde, 0xd7, 0xa8, 0x41, 0x63, 0x82, 0x15, 0xcd, 0x81, 0x0f, 0x14, 0x66,
+	0x84, 0x71, 0xe9, 0xaf, 0xdb, 0x83, 0xa1, 0x31, 0xb9, 0xb6, 0xf1, 0x0b, 0x1d, 0xf6, 0x0e, 0xd0,
+	0x46, 0x34, 0xe8, 0xf5, 0x40, 0x60, 0x49, 0x6f, 0xc1, 0x47, 0x86, 0x85, 0x6c, 0xe8, 0x8a, 0xde,
+	0x82, 0xf7, 0x0d, 0xf2, 0x68, 0x14, 0x3b, 0xb3, 0x28, 0xe3, 0xf1, 0x8d, 0xf4, 0x37, 0xec, 0x8e,
+	0x69, 0x14, 0x97, 0x6e, 0xaf, 0x4d, 0xdc, 0x7b, 0x85, 0x82, 0x1e, 0x00, 0x56, 0x82, 0x30, 0xa9,
+	0x4d, 0x67, 0x73, 0xf8, 0xcc, 0xa8, 0xf6, 0x7a, 0x00, 0xd7, 0x25, 0x61, 0x26, 0x97, 0x1f, 0x51,
+	0x33, 0x27, 0x23, 0x6c, 0x8e, 0x05, 0xeb, 0x1d, 0xc4, 0x24, 0xcb, 0x24, 0x2e, 0x40, 0x60, 0x28,
+	0x78, 0xdc, 0xf7, 0x3f, 0x37, 0x7a, 0x3f, 0x27, 0xa3, 0x2b, 0xcd, 0x39, 0x8f, 0xc9, 0xa9, 0x66,
+	0x5c, 0x82, 0x38, 0xd3, 0xb8, 0x77, 0x81, 0xbe, 0x92, 0xa4, 0x07, 0x6a, 0x8c, 0x73, 0xca, 0xf0,
+	0xfc, 0x85, 0x9b, 0x54, 0x71, 0xd3, 0xf8, 0xb4, 0x2d, 0xf7, 0x2d, 0x65, 0xe1, 0xcc, 0xd5, 0x9b,
+	0x14, 0x72, 0xca, 0x8f, 0x8c, 0xfe, 0xc7, 0xef, 0xf1, 0x8c, 0x1f, 0x19, 0x7d, 0xcc, 0xef, 0x15,
+	0x0a, 0x4c, 0x2d, 0x97, 0x57, 0xa7, 0x6e, 0xab, 0xa3, 0x6b, 0xba, 0xac, 0x3a, 0x47, 0x68, 0xa7,
+	0x4c, 0x86, 0x0d, 0x72, 0xec, 0x6e, 0x80, 0xf4, 0x1b, 0x46, 0xb7, 0x65, 0xc1, 0x8b, 0x41, 0xfe,
+	0xab, 0x83, 0x74, 0xdb, 0x55, 0x99, 0x9b, 0xde, 0xd5, 0xb9, 0x03, 0x53, 0x82, 0x82, 0xf4, 0x3d,
+	0xdb, 0x76, 0xb9, 0x4d, 0xb7, 0x82, 0xcf, 0x2c, 0xea, 0xfd, 0x80, 0x9e, 0x58, 0x65, 0x75, 0x7d,
+	0xa7, 0x3d, 0xa4, 0xbf, 0x6d, 0xc4, 0xfb, 0x46, 0x5c, 0x32, 0xa6, 0x5d, 0xcc, 0xca, 0xd5, 0x6c,
+	0x30, 0x67, 0x87, 0x69, 0x02, 0x4c, 0xd1, 0x1e, 0x05, 0xe1, 0xef, 0xb4, 0x6b, 0x87, 0xeb, 0xe1,
+	0x6e, 0x89, 0x9b, 0xa3, 0x3b, 0x77, 0xa8, 0x77, 0x8c, 0xf6, 0xdd, 0x08, 0x5a, 0x90, 0xee, 0x1a,
+	0xe9, 0x5e, 0x45, 0x58, 0xa2, 0x75, 0xdd, 0xb9, 0xa0, 0xdd, 0xb3, 0xda, 0x8a, 0x30, 0xaf, 0xfd,
+	0x19, 0xb5, 0xe7, 0x4f, 0x78, 0xc1, 0xc2, 0x37, 0x16, 0xcd, 0xd9, 0x51, 0x35, 0x6f, 0xf4, 0x3d,
+	0x0a, 0x26, 0x65, 0x5b, 0xb0, 0xd8, 0x37, 0x16, 0xbe, 0x63, 0x2c, 0x51, 0x0f, 0x58, 0xc4, 0x59,
+	0xa2, 0x1b, 0x7f, 0x41, 0x1d, 0x58, 0xb5, 0x63, 0xcc, 0xab, 0x5f, 0xa0, 0x5d, 0xf9, 0x1e, 0xa0,
+	0x58, 0x54, 0x3e, 0x31, 0xca, 0x6d, 0x83, 0xce, 0xab, 0x8e, 0xd0, 0x8e, 0x6e, 0x37, 0x01, 0x31,
+	0x1f, 0x82, 0x18, 0x4f, 0x46, 0xd5, 0x17, 0xf6, 0x6a, 0xd1, 0x98, 0x84, 0x25, 0xe6, 0x86, 0xd5,
+	0x09, 0x6a, 0xce, 0x68, 0x16, 0x16, 0x6c, 0x9a, 0x05, 0x83, 0x29, 0xed, 0xe2, 0x69, 0x05, 0xfa,
+	0x8e, 0x65, 0x34, 0xed, 0x2b, 0x1c, 0x67, 0x14, 0x98, 0xc2, 0x24, 0x85, 0xb2, 0x1d, 0x5a, 0xee,
+	0x7e, 0xbe, 0xd1, 0x84, 0x53, 0x83, 0x9f, 0xa4, 0x60, 0xbb, 0xe1, 0x05, 0xda, 0xd3, 0x5a, 0x9d,
+	0x42, 0x2e, 0x53, 0x3b, 0x24, 0x0a, 0x12, 0xdf, 0x80, 0xf2, 0x0f, 0x6c, 0xd2, 0x39, 0x19, 0x9d,
+	0xc7, 0xe4, 0xad, 0x4c, 0xf5, 0x7c, 0xb8, 0x34, 0x50, 0x70, 0x82, 0xb6, 0x96, 0x0c, 0x79, 0xaf,
+	0x8e, 0x1e, 0xde, 0xc0, 0xd8, 0xbc, 0x89, 0xeb, 0xa1, 0xfe, 0xf4, 0xb6, 0xd1, 0xa3, 0x21, 0xc9,
+	0x06, 0x60, 0xde, 0xb3, 0xf5, 0xd0, 0xfe, 0x1c, 0x3f, 0x78, 0x59, 0x3b, 0x5e, 0xfd, 0xe3, 0xcf,
+	0x83, 0x95, 0xd7, 0xbf, 0xfc, 0x75, 0xd7, 0xaa, 0x7d, 0xb8, 0x6b, 0xd5, 0xfe, 0xbd, 0x6b, 0xd5,
+	0x7e, 0xbf, 0x6f, 0xad, 0x7c, 0xb8, 0x6f, 0xad, 0xfc, 0x7d, 0xdf, 0x5a, 0x79, 0xd7, 0x49, 0xa9,
+	0xea, 0x0f, 0xa2, 0x4e, 0xcc, 0xf3, 0xae, 0x7d, 0x72, 0x9e, 0xbf, 0x21, 0x91, 0xec, 0xda, 0x37,
+	0xa7, 0x3b, 0xea, 0xba, 0x07, 0x5e, 0x8d, 0x0b, 0x90, 0xd1, 0x9a, 0x79, 0xae, 0xbf, 0xfd, 0x6f,
+	0x00, 0x3d, 0xfc, 0x8b, 0xdf, 0xf9, 0x07, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -272,6 +419,120 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxIcaMsgsPerPacket != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxIcaMsgsPerPacket))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.MaxLightClientAgeNanos != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxLightClientAgeNanos))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if len(m.IcaRecoveryEpochIdentifier) > 0 {
+		i -= len(m.IcaRecoveryEpochIdentifier)
+		copy(dAtA[i:], m.IcaRecoveryEpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.IcaRecoveryEpochIdentifier)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.IcaRecoveryInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.IcaRecoveryInterval))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if len(m.SweepEpochIdentifier) > 0 {
+		i -= len(m.SweepEpochIdentifier)
+		copy(dAtA[i:], m.SweepEpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.SweepEpochIdentifier)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(m.UnbondingEpochIdentifier) > 0 {
+		i -= len(m.UnbondingEpochIdentifier)
+		copy(dAtA[i:], m.UnbondingEpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.UnbondingEpochIdentifier)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.RebalanceEpochIdentifier) > 0 {
+		i -= len(m.RebalanceEpochIdentifier)
+		copy(dAtA[i:], m.RebalanceEpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.RebalanceEpochIdentifier)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.RedemptionRateEpochIdentifier) > 0 {
+		i -= len(m.RedemptionRateEpochIdentifier)
+		copy(dAtA[i:], m.RedemptionRateEpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.RedemptionRateEpochIdentifier)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.ReinvestEpochIdentifier) > 0 {
+		i -= len(m.ReinvestEpochIdentifier)
+		copy(dAtA[i:], m.ReinvestEpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ReinvestEpochIdentifier)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.DelegateEpochIdentifier) > 0 {
+		i -= len(m.DelegateEpochIdentifier)
+		copy(dAtA[i:], m.DelegateEpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.DelegateEpochIdentifier)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.DepositEpochIdentifier) > 0 {
+		i -= len(m.DepositEpochIdentifier)
+		copy(dAtA[i:], m.DepositEpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.DepositEpochIdentifier)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.MaxRebalanceRedelegations != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRebalanceRedelegations))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.RebalanceInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RebalanceInterval))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.MaxRedelegationEntries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRedelegationEntries))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
 	if m.SafetyNumValidators != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.SafetyNumValidators))
 		i--
@@ -451,6 +712,56 @@ func (m *Params) Size() (n int) {
 	if m.SafetyNumValidators != 0 {
 		n += 2 + sovParams(uint64(m.SafetyNumValidators))
 	}
+	if m.MaxRedelegationEntries != 0 {
+		n += 2 + sovParams(uint64(m.MaxRedelegationEntries))
+	}
+	if m.RebalanceInterval != 0 {
+		n += 2 + sovParams(uint64(m.RebalanceInterval))
+	}
+	if m.MaxRebalanceRedelegations != 0 {
+		n += 2 + sovParams(uint64(m.MaxRebalanceRedelegations))
+	}
+	l = len(m.DepositEpochIdentifier)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	l = len(m.DelegateEpochIdentifier)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	l = len(m.ReinvestEpochIdentifier)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	l = len(m.RedemptionRateEpochIdentifier)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	l = len(m.RebalanceEpochIdentifier)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	l = len(m.UnbondingEpochIdentifier)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	l = len(m.SweepEpochIdentifier)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if m.IcaRecoveryInterval != 0 {
+		n += 2 + sovParams(uint64(m.IcaRecoveryInterval))
+	}
+	l = len(m.IcaRecoveryEpochIdentifier)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if m.MaxLightClientAgeNanos != 0 {
+		n += 2 + sovParams(uint64(m.MaxLightClientAgeNanos))
+	}
+	if m.MaxIcaMsgsPerPacket != 0 {
+		n += 2 + sovParams(uint64(m.MaxIcaMsgsPerPacket))
+	}
 	return n
 }
 
@@ -920,6 +1231,376 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedelegationEntries", wireType)
+			}
+			m.MaxRedelegationEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRedelegationEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalanceInterval", wireType)
+			}
+			m.RebalanceInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RebalanceInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRebalanceRedelegations", wireType)
+			}
+			m.MaxRebalanceRedelegations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRebalanceRedelegations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReinvestEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReinvestEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRateEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedemptionRateEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalanceEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RebalanceEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SweepEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SweepEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaRecoveryInterval", wireType)
+			}
+			m.IcaRecoveryInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IcaRecoveryInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaRecoveryEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IcaRecoveryEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLightClientAgeNanos", wireType)
+			}
+			m.MaxLightClientAgeNanos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLightClientAgeNanos |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIcaMsgsPerPacket", wireType)
+			}
+			m.MaxIcaMsgsPerPacket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxIcaMsgsPerPacket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
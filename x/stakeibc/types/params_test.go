@@ -0,0 +1,17 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+func TestParamsValidate_EpochIdentifiers(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate(), "default params")
+
+	params := types.DefaultParams()
+	params.SweepEpochIdentifier = " "
+	require.ErrorContains(t, params.Validate(), "blank epoch identifier")
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+)
+
+// The pipeline stages that can be scheduled per host zone
+const (
+	PipelineDeposit        = "deposit"
+	PipelineDelegate       = "delegate"
+	PipelineReinvest       = "reinvest"
+	PipelineRedemptionRate = "redemption_rate"
+	PipelineRebalance      = "rebalance"
+)
+
+// Sweeping unbonded tokens isn't scheduled per host zone, but is skipped alongside the
+// scheduled stages when the host's light client is stale
+const PipelineSweep = "sweep"
+
+// The param holding the default interval of each pipeline stage
+var PipelineIntervalKeys = map[string][]byte{
+	PipelineDeposit:        KeyDepositInterval,
+	PipelineDelegate:       KeyDelegateInterval,
+	PipelineReinvest:       KeyReinvestInterval,
+	PipelineRedemptionRate: KeyRedemptionRateInterval,
+	PipelineRebalance:      KeyRebalanceInterval,
+}
+
+func ValidatePipeline(pipeline string) error {
+	if _, ok := PipelineIntervalKeys[pipeline]; !ok {
+		return fmt.Errorf("invalid pipeline %s", pipeline)
+	}
+	return nil
+}
+
+// GetPipelineSchedule returns the host zone's schedule override for a pipeline stage, or nil if there is none
+func (h HostZone) GetPipelineSchedule(pipeline string) *PipelineSchedule {
+	switch pipeline {
+	case PipelineDeposit:
+		return h.DepositSchedule
+	case PipelineDelegate:
+		return h.DelegateSchedule
+	case PipelineReinvest:
+		return h.ReinvestSchedule
+	case PipelineRedemptionRate:
+		return h.RedemptionRateSchedule
+	case PipelineRebalance:
+		return h.RebalanceSchedule
+	}
+	return nil
+}
+
+// SetPipelineSchedule sets the host zone's schedule override for a pipeline stage
+func (h *HostZone) SetPipelineSchedule(pipeline string, schedule *PipelineSchedule) error {
+	switch pipeline {
+	case PipelineDeposit:
+		h.DepositSchedule = schedule
+	case PipelineDelegate:
+		h.DelegateSchedule = schedule
+	case PipelineReinvest:
+		h.ReinvestSchedule = schedule
+	case PipelineRedemptionRate:
+		h.RedemptionRateSchedule = schedule
+	case PipelineRebalance:
+		h.RebalanceSchedule = schedule
+	default:
+		return fmt.Errorf("invalid pipeline %s", pipeline)
+	}
+	return nil
+}
+
+// StaggerPipelineSchedules offsets each of the host zone's pipeline stages by the given amount, without overriding
+// their intervals, so that host zones with different offsets take turns on the epochs where a stage runs
+func (h *HostZone) StaggerPipelineSchedules(offset uint64) {
+	h.DepositSchedule = &PipelineSchedule{Offset: offset}
+	h.DelegateSchedule = &PipelineSchedule{Offset: offset}
+	h.ReinvestSchedule = &PipelineSchedule{Offset: offset}
+	h.RedemptionRateSchedule = &PipelineSchedule{Offset: offset}
+	h.RebalanceSchedule = &PipelineSchedule{Offset: offset}
+}
@@ -6,6 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/Stride-Labs/stride/x/icacallbacks/types"
+	types1 "github.com/Stride-Labs/stride/x/interchainquery/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -841,6 +844,288 @@ func (m *QueryAllEpochTrackerResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type QueryICAHealthRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryICAHealthRequest) Reset()         { *m = QueryICAHealthRequest{} }
+func (m *QueryICAHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryICAHealthRequest) ProtoMessage()    {}
+func (*QueryICAHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc8fd2cb3c1d11f2, []int{18}
+}
+func (m *QueryICAHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryICAHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryICAHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryICAHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryICAHealthRequest.Merge(m, src)
+}
+func (m *QueryICAHealthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryICAHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryICAHealthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryICAHealthRequest proto.InternalMessageInfo
+
+func (m *QueryICAHealthRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+type QueryICAHealthResponse struct {
+	Accounts []ICAChannelHealth `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
+}
+
+func (m *QueryICAHealthResponse) Reset()         { *m = QueryICAHealthResponse{} }
+func (m *QueryICAHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryICAHealthResponse) ProtoMessage()    {}
+func (*QueryICAHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc8fd2cb3c1d11f2, []int{19}
+}
+func (m *QueryICAHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryICAHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryICAHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryICAHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryICAHealthResponse.Merge(m, src)
+}
+func (m *QueryICAHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryICAHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryICAHealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryICAHealthResponse proto.InternalMessageInfo
+
+func (m *QueryICAHealthResponse) GetAccounts() []ICAChannelHealth {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+type QueryHostZoneStatusRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryHostZoneStatusRequest) Reset()         { *m = QueryHostZoneStatusRequest{} }
+func (m *QueryHostZoneStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHostZoneStatusRequest) ProtoMessage()    {}
+func (*QueryHostZoneStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc8fd2cb3c1d11f2, []int{20}
+}
+func (m *QueryHostZoneStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostZoneStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostZoneStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHostZoneStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostZoneStatusRequest.Merge(m, src)
+}
+func (m *QueryHostZoneStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHostZoneStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostZoneStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHostZoneStatusRequest proto.InternalMessageInfo
+
+func (m *QueryHostZoneStatusRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+// RecordStatusSummary is the number of a host zone's records with a given status,
+// and the sum of their native token amounts
+type RecordStatusSummary struct {
+	Status string                                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Count  uint64                                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *RecordStatusSummary) Reset()         { *m = RecordStatusSummary{} }
+func (m *RecordStatusSummary) String() string { return proto.CompactTextString(m) }
+func (*RecordStatusSummary) ProtoMessage()    {}
+func (*RecordStatusSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc8fd2cb3c1d11f2, []int{21}
+}
+func (m *RecordStatusSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordStatusSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordStatusSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordStatusSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordStatusSummary.Merge(m, src)
+}
+func (m *RecordStatusSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordStatusSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordStatusSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordStatusSummary proto.InternalMessageInfo
+
+func (m *RecordStatusSummary) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *RecordStatusSummary) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type QueryHostZoneStatusResponse struct {
+	Accounts       []ICAChannelHealth    `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
+	DepositRecords []RecordStatusSummary `protobuf:"bytes,2,rep,name=deposit_records,json=depositRecords,proto3" json:"deposit_records"`
+	// summarizes the host zone's unbonding on each epoch unbonding record
+	UnbondingRecords []RecordStatusSummary `protobuf:"bytes,3,rep,name=unbonding_records,json=unbondingRecords,proto3" json:"unbonding_records"`
+	// callbacks waiting on an ack or timeout for a packet sent over the host zone's ICA or transfer channels
+	PendingCallbacks   []types.CallbackData `protobuf:"bytes,4,rep,name=pending_callbacks,json=pendingCallbacks,proto3" json:"pending_callbacks"`
+	OutstandingQueries []types1.Query       `protobuf:"bytes,5,rep,name=outstanding_queries,json=outstandingQueries,proto3" json:"outstanding_queries"`
+	// the latest height and time (unix nanoseconds) of the host's light client, zero if it can't be read
+	LightClientHeight uint64 `protobuf:"varint,6,opt,name=light_client_height,json=lightClientHeight,proto3" json:"light_client_height,omitempty"`
+	LightClientTime   uint64 `protobuf:"varint,7,opt,name=light_client_time,json=lightClientTime,proto3" json:"light_client_time,omitempty"`
+}
+
+func (m *QueryHostZoneStatusResponse) Reset()         { *m = QueryHostZoneStatusResponse{} }
+func (m *QueryHostZoneStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHostZoneStatusResponse) ProtoMessage()    {}
+func (*QueryHostZoneStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc8fd2cb3c1d11f2, []int{22}
+}
+func (m *QueryHostZoneStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostZoneStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostZoneStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHostZoneStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostZoneStatusResponse.Merge(m, src)
+}
+func (m *QueryHostZoneStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHostZoneStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostZoneStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHostZoneStatusResponse proto.InternalMessageInfo
+
+func (m *QueryHostZoneStatusResponse) GetAccounts() []ICAChannelHealth {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *QueryHostZoneStatusResponse) GetDepositRecords() []RecordStatusSummary {
+	if m != nil {
+		return m.DepositRecords
+	}
+	return nil
+}
+
+func (m *QueryHostZoneStatusResponse) GetUnbondingRecords() []RecordStatusSummary {
+	if m != nil {
+		return m.UnbondingRecords
+	}
+	return nil
+}
+
+func (m *QueryHostZoneStatusResponse) GetPendingCallbacks() []types.CallbackData {
+	if m != nil {
+		return m.PendingCallbacks
+	}
+	return nil
+}
+
+func (m *QueryHostZoneStatusResponse) GetOutstandingQueries() []types1.Query {
+	if m != nil {
+		return m.OutstandingQueries
+	}
+	return nil
+}
+
+func (m *QueryHostZoneStatusResponse) GetLightClientHeight() uint64 {
+	if m != nil {
+		return m.LightClientHeight
+	}
+	return 0
+}
+
+func (m *QueryHostZoneStatusResponse) GetLightClientTime() uint64 {
+	if m != nil {
+		return m.LightClientTime
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryInterchainAccountFromAddressRequest)(nil), "Stridelabs.stride.stakeibc.QueryInterchainAccountFromAddressRequest")
 	proto.RegisterType((*QueryInterchainAccountFromAddressResponse)(nil), "Stridelabs.stride.stakeibc.QueryInterchainAccountFromAddressResponse")
@@ -860,79 +1145,109 @@ func init() {
 	proto.RegisterType((*QueryGetEpochTrackerResponse)(nil), "Stridelabs.stride.stakeibc.QueryGetEpochTrackerResponse")
 	proto.RegisterType((*QueryAllEpochTrackerRequest)(nil), "Stridelabs.stride.stakeibc.QueryAllEpochTrackerRequest")
 	proto.RegisterType((*QueryAllEpochTrackerResponse)(nil), "Stridelabs.stride.stakeibc.QueryAllEpochTrackerResponse")
+	proto.RegisterType((*QueryICAHealthRequest)(nil), "Stridelabs.stride.stakeibc.QueryICAHealthRequest")
+	proto.RegisterType((*QueryICAHealthResponse)(nil), "Stridelabs.stride.stakeibc.QueryICAHealthResponse")
+	proto.RegisterType((*QueryHostZoneStatusRequest)(nil), "Stridelabs.stride.stakeibc.QueryHostZoneStatusRequest")
+	proto.RegisterType((*RecordStatusSummary)(nil), "Stridelabs.stride.stakeibc.RecordStatusSummary")
+	proto.RegisterType((*QueryHostZoneStatusResponse)(nil), "Stridelabs.stride.stakeibc.QueryHostZoneStatusResponse")
 }
 
 func init() { proto.RegisterFile("stakeibc/query.proto", fileDescriptor_cc8fd2cb3c1d11f2) }
 
 var fileDescriptor_cc8fd2cb3c1d11f2 = []byte{
-	// 1066 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xdd, 0x6e, 0xdc, 0x44,
-	0x14, 0xc7, 0xe3, 0x24, 0x0d, 0xe5, 0x24, 0x55, 0xa5, 0x21, 0xc0, 0xc6, 0x8d, 0x36, 0x30, 0x6a,
-	0xcb, 0x36, 0x02, 0xbb, 0xd9, 0x84, 0x16, 0x2a, 0x40, 0x6c, 0x68, 0x92, 0xae, 0x54, 0xa4, 0xb2,
-	0x20, 0x90, 0x7a, 0xb3, 0x9a, 0xb5, 0x87, 0x8d, 0xa9, 0x77, 0x66, 0x63, 0xcf, 0x16, 0x42, 0x14,
-	0x21, 0xf1, 0x04, 0x95, 0x10, 0xef, 0x80, 0x54, 0x21, 0x71, 0x07, 0x82, 0x07, 0xa0, 0x97, 0x95,
-	0xb8, 0x80, 0xab, 0x08, 0x25, 0x3c, 0x41, 0x9f, 0x00, 0x79, 0x3c, 0x63, 0x7b, 0x77, 0x1d, 0xc7,
-	0x69, 0x7b, 0x67, 0xcf, 0x9c, 0x8f, 0xdf, 0x39, 0x3e, 0xfb, 0x9f, 0x59, 0x98, 0x0f, 0x05, 0xb9,
-	0x47, 0xbd, 0x8e, 0x63, 0xef, 0x0c, 0x68, 0xb0, 0x6b, 0xf5, 0x03, 0x2e, 0x38, 0x32, 0x3f, 0x15,
-	0x81, 0xe7, 0x52, 0x9f, 0x74, 0x42, 0x2b, 0x94, 0x8f, 0x96, 0xb6, 0x33, 0xe7, 0xbb, 0xbc, 0xcb,
-	0xa5, 0x99, 0x1d, 0x3d, 0xc5, 0x1e, 0xe6, 0x62, 0x97, 0xf3, 0xae, 0x4f, 0x6d, 0xd2, 0xf7, 0x6c,
-	0xc2, 0x18, 0x17, 0x44, 0x78, 0x9c, 0x85, 0x6a, 0x77, 0xd9, 0xe1, 0x61, 0x8f, 0x87, 0x76, 0x87,
-	0x84, 0x34, 0x4e, 0x64, 0xdf, 0x5f, 0xe9, 0x50, 0x41, 0x56, 0xec, 0x3e, 0xe9, 0x7a, 0x4c, 0x1a,
-	0x2b, 0xdb, 0x97, 0x13, 0xa2, 0x3e, 0x09, 0x48, 0x4f, 0x87, 0xa8, 0x24, 0xcb, 0xf7, 0x89, 0xef,
-	0xb9, 0x44, 0xf0, 0x40, 0xed, 0x2c, 0x24, 0x3b, 0x2e, 0xf5, 0x69, 0x37, 0x1b, 0xeb, 0x4a, 0xb2,
-	0xd5, 0xf3, 0x58, 0x3b, 0x71, 0x6c, 0x07, 0x74, 0x67, 0xe0, 0x05, 0xb4, 0x47, 0x99, 0xd0, 0xf1,
-	0xcd, 0xc4, 0xd4, 0x73, 0x48, 0x9b, 0x38, 0x0e, 0x1f, 0x30, 0x31, 0x96, 0x7b, 0x9b, 0x87, 0xa2,
-	0xfd, 0x2d, 0x67, 0x54, 0x97, 0x9d, 0xec, 0xd0, 0x3e, 0x77, 0xb6, 0xdb, 0x22, 0x20, 0xce, 0x3d,
-	0xaa, 0xc9, 0x5e, 0x49, 0x76, 0xbb, 0x94, 0xd1, 0xd0, 0x53, 0xb9, 0xf0, 0x77, 0x50, 0xfb, 0x24,
-	0x6a, 0x42, 0x93, 0x09, 0x1a, 0x38, 0xdb, 0xc4, 0x63, 0x8d, 0x38, 0xdf, 0x66, 0xc0, 0x7b, 0x0d,
-	0xd7, 0x0d, 0x68, 0x18, 0xb6, 0xe8, 0xce, 0x80, 0x86, 0x02, 0xcd, 0xc3, 0x19, 0xfe, 0x35, 0xa3,
-	0x41, 0xc5, 0x78, 0xcd, 0xa8, 0xbd, 0xd8, 0x8a, 0x5f, 0xd0, 0xfb, 0x70, 0xce, 0xe1, 0x8c, 0x51,
-	0x27, 0x2a, 0xb6, 0xed, 0xb9, 0x95, 0xc9, 0x68, 0x77, 0xbd, 0xf2, 0xe4, 0x60, 0x69, 0x7e, 0x97,
-	0xf4, 0xfc, 0x1b, 0x78, 0x68, 0x1b, 0xb7, 0xe6, 0xd2, 0xf7, 0xa6, 0x8b, 0x1f, 0x18, 0x70, 0xa5,
-	0x04, 0x41, 0xd8, 0xe7, 0x2c, 0xa4, 0xc8, 0x01, 0xd3, 0x4b, 0xec, 0x74, 0x6b, 0xda, 0x24, 0xb6,
-	0x8a, 0xb9, 0xd6, 0x2f, 0x3d, 0x39, 0x58, 0x7a, 0x3d, 0xce, 0x7c, 0xbc, 0x2d, 0x6e, 0x55, 0xbc,
-	0xd1, 0x84, 0x2a, 0x19, 0x9e, 0x07, 0x24, 0x89, 0xee, 0xc8, 0x8f, 0xae, 0xaa, 0xc7, 0x5f, 0xc0,
-	0x4b, 0x43, 0xab, 0x8a, 0xe8, 0x43, 0x98, 0x89, 0x87, 0x43, 0x66, 0x9f, 0xad, 0x63, 0xeb, 0xf8,
-	0x81, 0xb5, 0x62, 0xdf, 0xf5, 0xe9, 0x47, 0x07, 0x4b, 0x13, 0x2d, 0xe5, 0x87, 0xaf, 0xc1, 0x82,
-	0x0c, 0xbc, 0x45, 0xc5, 0xe7, 0x7a, 0x2c, 0x92, 0x9e, 0x2f, 0xc0, 0xd9, 0x98, 0xdf, 0x73, 0x55,
-	0xdb, 0x5f, 0x90, 0xef, 0x4d, 0x17, 0x3b, 0x60, 0xe6, 0xf9, 0x29, 0xae, 0x0d, 0x80, 0x64, 0xc8,
-	0x22, 0xb6, 0xa9, 0xda, 0x6c, 0xfd, 0x52, 0x11, 0x5b, 0x12, 0xa3, 0x95, 0x71, 0xc4, 0x17, 0x52,
-	0xb8, 0xe6, 0x47, 0x0d, 0xd5, 0x28, 0xdd, 0x92, 0xaf, 0x52, 0x82, 0xec, 0xa6, 0x22, 0xb8, 0x0d,
-	0x90, 0xae, 0xaa, 0xee, 0x5c, 0x2e, 0x22, 0x48, 0xad, 0x55, 0x87, 0x32, 0xfe, 0x78, 0x0d, 0x5e,
-	0xd5, 0xb9, 0x6e, 0xf1, 0x50, 0xdc, 0xe5, 0x8c, 0x96, 0xe8, 0x51, 0x07, 0x2a, 0xe3, 0x5e, 0x8a,
-	0x6f, 0x13, 0xce, 0xea, 0x35, 0x45, 0x77, 0xb1, 0x88, 0x4e, 0xdb, 0x2a, 0xb6, 0xc4, 0x17, 0x13,
-	0x45, 0xd6, 0xf0, 0xfd, 0x51, 0xb2, 0x4d, 0x80, 0x54, 0x54, 0x92, 0x16, 0xc4, 0x0a, 0x64, 0x45,
-	0x0a, 0x64, 0xc5, 0x52, 0xa7, 0x14, 0xc8, 0xba, 0x43, 0xba, 0xda, 0xb7, 0x95, 0xf1, 0xc4, 0x0f,
-	0x0d, 0x55, 0xc7, 0x50, 0x8e, 0xdc, 0x3a, 0xa6, 0x9e, 0xb6, 0x0e, 0xb4, 0x35, 0x04, 0x3b, 0x29,
-	0x61, 0xdf, 0x38, 0x11, 0x36, 0x86, 0x18, 0xa2, 0xb5, 0xd5, 0xcc, 0x7c, 0xcc, 0xdd, 0x81, 0x4f,
-	0x47, 0x44, 0x04, 0xc1, 0x34, 0x23, 0x3d, 0xaa, 0x3e, 0x94, 0x7c, 0xc6, 0x57, 0xd5, 0x1c, 0x8d,
-	0x38, 0xa8, 0xfa, 0x10, 0x4c, 0x47, 0x3f, 0x5a, 0xed, 0x11, 0x3d, 0xe3, 0x2d, 0xb8, 0xa0, 0xbf,
-	0xeb, 0x46, 0xa4, 0x76, 0x9f, 0xc5, 0x62, 0xa7, 0x93, 0xd4, 0xe0, 0xbc, 0x14, 0xc1, 0xa6, 0x4b,
-	0x99, 0xf0, 0xbe, 0xf4, 0x12, 0xcd, 0x1a, 0x5d, 0xc6, 0x01, 0x2c, 0xe6, 0x07, 0x52, 0xc9, 0x5b,
-	0x30, 0x47, 0x33, 0xeb, 0xea, 0x1b, 0xd6, 0x8a, 0x1a, 0x9c, 0x8d, 0xa3, 0x9a, 0x3c, 0x14, 0x03,
-	0x53, 0x05, 0xdf, 0xf0, 0xfd, 0x3c, 0xf8, 0xe7, 0x35, 0x34, 0x7f, 0x18, 0xaa, 0xb6, 0xb1, 0x3c,
-	0xc7, 0xd6, 0x36, 0xf5, 0xac, 0xb5, 0x3d, 0xb7, 0x21, 0xaa, 0xff, 0x3d, 0x07, 0x67, 0x24, 0x3d,
-	0xfa, 0xd1, 0x80, 0x99, 0x58, 0x38, 0x91, 0x55, 0xc4, 0x36, 0xae, 0xd9, 0xa6, 0x5d, 0xda, 0x3e,
-	0x26, 0xc0, 0xcb, 0xdf, 0xff, 0xf5, 0xdf, 0x0f, 0x93, 0x17, 0x11, 0xb6, 0x53, 0x47, 0x3b, 0x76,
-	0xb4, 0x47, 0x2e, 0x03, 0xe8, 0x57, 0x03, 0x20, 0x15, 0x5e, 0xf4, 0xf6, 0x89, 0xb9, 0xf2, 0x04,
-	0xde, 0xbc, 0x76, 0x5a, 0x37, 0x45, 0x7a, 0x43, 0x92, 0xae, 0xa1, 0xba, 0x22, 0x7d, 0xeb, 0x76,
-	0x1e, 0x6a, 0xaa, 0xe4, 0xf6, 0x9e, 0xd6, 0xc8, 0x7d, 0xf4, 0xb3, 0x91, 0x95, 0xe6, 0x72, 0xe4,
-	0x63, 0xea, 0x5f, 0x8e, 0x7c, 0xfc, 0x5c, 0xc0, 0x57, 0x25, 0xf9, 0x32, 0xaa, 0x15, 0x92, 0x67,
-	0xae, 0x3e, 0xe8, 0x17, 0x23, 0x95, 0x38, 0xb4, 0x5a, 0x26, 0xed, 0x88, 0x10, 0x9b, 0x6b, 0xa7,
-	0x73, 0x52, 0xa4, 0xef, 0x4a, 0xd2, 0x55, 0xb4, 0x52, 0x48, 0x9a, 0x5c, 0xc4, 0xb2, 0x2d, 0xfe,
-	0xc9, 0x80, 0x59, 0x1d, 0xaf, 0xe1, 0xfb, 0x25, 0xa8, 0xc7, 0x8f, 0x8f, 0x12, 0xd4, 0x39, 0xe7,
-	0x01, 0xb6, 0x24, 0x75, 0x0d, 0x5d, 0x2e, 0x47, 0x8d, 0x7e, 0x37, 0xe0, 0xdc, 0x90, 0xf2, 0x96,
-	0x18, 0x88, 0x3c, 0x69, 0x2f, 0x31, 0x10, 0xb9, 0x02, 0x5f, 0x72, 0x94, 0x7b, 0xd2, 0x57, 0xdf,
-	0xdf, 0xec, 0xbd, 0xe8, 0xe4, 0xd8, 0x47, 0x0f, 0x0d, 0x58, 0x2c, 0xba, 0x39, 0xa2, 0x9b, 0x27,
-	0x42, 0x95, 0xb8, 0xfa, 0x9a, 0x1b, 0xcf, 0x18, 0x45, 0x29, 0xee, 0x9f, 0x06, 0xcc, 0x65, 0x25,
-	0x14, 0x5d, 0x2f, 0x33, 0x97, 0x39, 0x87, 0x84, 0xf9, 0xce, 0xe9, 0x1d, 0x55, 0xb7, 0x6f, 0xca,
-	0x6e, 0x7f, 0x80, 0xde, 0x2b, 0xec, 0xf6, 0xd0, 0x7f, 0x08, 0x7b, 0x6f, 0xe4, 0xd8, 0xdc, 0x47,
-	0xbf, 0x19, 0x70, 0x3e, 0x1b, 0x3e, 0x9a, 0xf1, 0xeb, 0x65, 0xc6, 0xf5, 0xe9, 0x8a, 0x39, 0xe6,
-	0x08, 0xc3, 0x75, 0x59, 0xcc, 0x9b, 0x68, 0xb9, 0x7c, 0x31, 0xeb, 0xb7, 0x1e, 0x1d, 0x56, 0x8d,
-	0xc7, 0x87, 0x55, 0xe3, 0xdf, 0xc3, 0xaa, 0xf1, 0xe0, 0xa8, 0x3a, 0xf1, 0xf8, 0xa8, 0x3a, 0xf1,
-	0xcf, 0x51, 0x75, 0xe2, 0xae, 0xd5, 0xf5, 0xc4, 0xf6, 0xa0, 0x63, 0x39, 0xbc, 0x97, 0x17, 0xef,
-	0x9b, 0x34, 0xa2, 0xd8, 0xed, 0xd3, 0xb0, 0x33, 0x23, 0xff, 0x43, 0xad, 0xfe, 0x1f, 0x00, 0x00,
-	0xff, 0xff, 0xcf, 0x36, 0x74, 0x0e, 0xba, 0x0e, 0x00, 0x00,
+	// 1462 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x5f, 0x6f, 0x14, 0x55,
+	0x14, 0xef, 0xb4, 0xa5, 0xc0, 0xe1, 0x4f, 0xed, 0x6d, 0xc1, 0x65, 0x5a, 0x5b, 0x9c, 0x00, 0x2e,
+	0x0d, 0xcc, 0xd0, 0xa5, 0xb6, 0x48, 0xd4, 0xb0, 0x2d, 0x94, 0x36, 0x41, 0x03, 0x0b, 0xd1, 0x84,
+	0x07, 0x37, 0x77, 0x67, 0xae, 0xbb, 0x63, 0x67, 0xe6, 0x2e, 0x33, 0x77, 0xd1, 0x4a, 0x88, 0x89,
+	0xaf, 0xbe, 0x90, 0x18, 0xbf, 0x83, 0x09, 0xd1, 0xf8, 0xa6, 0xd1, 0x47, 0x4d, 0xe4, 0xc9, 0x90,
+	0xf8, 0x62, 0x7c, 0x68, 0x0c, 0xf8, 0x09, 0xf8, 0x04, 0x66, 0xee, 0x9f, 0xd9, 0xd9, 0xdd, 0xe9,
+	0x76, 0x16, 0xf0, 0x69, 0x67, 0xee, 0x3d, 0xbf, 0x73, 0x7e, 0xe7, 0xcc, 0xb9, 0x67, 0x7e, 0xb3,
+	0x30, 0x15, 0x31, 0xbc, 0x49, 0xdc, 0x9a, 0x6d, 0xdd, 0x69, 0x91, 0x70, 0xcb, 0x6c, 0x86, 0x94,
+	0x51, 0xa4, 0xdf, 0x64, 0xa1, 0xeb, 0x10, 0x0f, 0xd7, 0x22, 0x33, 0xe2, 0x97, 0xa6, 0xb2, 0xd3,
+	0xa7, 0xea, 0xb4, 0x4e, 0xb9, 0x99, 0x15, 0x5f, 0x09, 0x84, 0x3e, 0x53, 0xa7, 0xb4, 0xee, 0x11,
+	0x0b, 0x37, 0x5d, 0x0b, 0x07, 0x01, 0x65, 0x98, 0xb9, 0x34, 0x88, 0xe4, 0xee, 0xbc, 0x4d, 0x23,
+	0x9f, 0x46, 0x56, 0x0d, 0x47, 0x44, 0x04, 0xb2, 0xee, 0x2e, 0xd4, 0x08, 0xc3, 0x0b, 0x56, 0x13,
+	0xd7, 0xdd, 0x80, 0x1b, 0x4b, 0xdb, 0x23, 0x09, 0xa3, 0x26, 0x0e, 0xb1, 0xaf, 0x5c, 0x14, 0x92,
+	0xe5, 0xbb, 0xd8, 0x73, 0x1d, 0xcc, 0x68, 0x28, 0x77, 0x8e, 0x25, 0x3b, 0x0e, 0xf1, 0x48, 0x3d,
+	0xed, 0xeb, 0x74, 0xb2, 0xe5, 0xbb, 0x41, 0x35, 0x01, 0x56, 0x43, 0x72, 0xa7, 0xe5, 0x86, 0xc4,
+	0x27, 0x01, 0x53, 0xfe, 0xf5, 0xc4, 0xd4, 0xb5, 0x71, 0x15, 0xdb, 0x36, 0x6d, 0x05, 0xac, 0x27,
+	0x76, 0x83, 0x46, 0xac, 0xfa, 0x39, 0x0d, 0x88, 0x4a, 0x3b, 0xd9, 0x21, 0x4d, 0x6a, 0x37, 0xaa,
+	0x2c, 0xc4, 0xf6, 0x26, 0x51, 0xcc, 0x8e, 0x26, 0xbb, 0x75, 0x12, 0x90, 0xc8, 0x55, 0xb1, 0xa6,
+	0x3b, 0x62, 0x85, 0xc4, 0xa6, 0x77, 0x93, 0xda, 0xeb, 0xc7, 0x5d, 0x1b, 0xdb, 0xd8, 0xf3, 0x6a,
+	0xd8, 0xde, 0x8c, 0x2c, 0x75, 0x55, 0x75, 0x30, 0xc3, 0x89, 0x45, 0xc0, 0x48, 0x68, 0x37, 0xb0,
+	0x1b, 0xa8, 0x5a, 0x76, 0x06, 0x30, 0xbe, 0x80, 0xe2, 0x8d, 0x78, 0x67, 0x23, 0x31, 0x2c, 0x8b,
+	0x84, 0xd6, 0x42, 0xea, 0x97, 0x1d, 0x27, 0x24, 0x51, 0x54, 0x21, 0x77, 0x5a, 0x24, 0x62, 0x68,
+	0x0a, 0xf6, 0xd0, 0x4f, 0x03, 0x12, 0x16, 0xb4, 0xe3, 0x5a, 0x71, 0x7f, 0x45, 0xdc, 0xa0, 0x77,
+	0xe0, 0x90, 0x4d, 0x83, 0x80, 0xd8, 0x71, 0x35, 0xab, 0xae, 0x53, 0x18, 0x8e, 0x77, 0x57, 0x0a,
+	0xcf, 0xb6, 0xe7, 0xa6, 0xb6, 0xb0, 0xef, 0x5d, 0x34, 0x3a, 0xb6, 0x8d, 0xca, 0xc1, 0xf6, 0xfd,
+	0x86, 0x63, 0x3c, 0xd0, 0xe0, 0x74, 0x0e, 0x06, 0x51, 0x93, 0x06, 0x11, 0x41, 0x36, 0xe8, 0xed,
+	0x94, 0x54, 0xed, 0xab, 0x58, 0x58, 0x09, 0x5e, 0x2b, 0x27, 0x9f, 0x6d, 0xcf, 0xbd, 0x2e, 0x22,
+	0xef, 0x6c, 0x6b, 0x54, 0x0a, 0x6e, 0x77, 0x40, 0x19, 0xcc, 0x98, 0x02, 0xc4, 0x19, 0x5d, 0xe7,
+	0x5d, 0x25, 0xb3, 0x37, 0x3e, 0x84, 0xc9, 0x8e, 0x55, 0xc9, 0xe8, 0x12, 0x8c, 0x89, 0xee, 0xe3,
+	0xd1, 0x0f, 0x94, 0x0c, 0x73, 0xe7, 0x13, 0x61, 0x0a, 0xec, 0xca, 0xe8, 0xa3, 0xed, 0xb9, 0xa1,
+	0x8a, 0xc4, 0x19, 0x4b, 0x70, 0x8c, 0x3b, 0xbe, 0x4a, 0xd8, 0x07, 0xaa, 0xef, 0x92, 0x9a, 0x1f,
+	0x83, 0x7d, 0x82, 0xbf, 0xeb, 0xc8, 0xb2, 0xef, 0xe5, 0xf7, 0x1b, 0x8e, 0x61, 0x83, 0x9e, 0x85,
+	0x93, 0xbc, 0xae, 0x00, 0x24, 0x5d, 0x1c, 0x73, 0x1b, 0x29, 0x1e, 0x28, 0x9d, 0xec, 0xc7, 0x2d,
+	0xf1, 0x51, 0x49, 0x01, 0x8d, 0xe9, 0x36, 0xb9, 0x8d, 0xd5, 0xb2, 0x2c, 0x94, 0x2a, 0xc9, 0x27,
+	0xa0, 0x67, 0x6d, 0x4a, 0x06, 0xd7, 0x00, 0xda, 0xab, 0xb2, 0x3a, 0xa7, 0xfa, 0x31, 0x68, 0x5b,
+	0xcb, 0x0a, 0xa5, 0xf0, 0xc6, 0x22, 0xbc, 0xaa, 0x62, 0xad, 0xd3, 0x88, 0xdd, 0xa6, 0x01, 0xc9,
+	0x51, 0xa3, 0x1a, 0x14, 0x7a, 0x51, 0x92, 0xdf, 0x1a, 0xec, 0x53, 0x6b, 0x92, 0xdd, 0x89, 0x7e,
+	0xec, 0x94, 0xad, 0xe4, 0x96, 0x60, 0x0d, 0x2c, 0x99, 0x95, 0x3d, 0xaf, 0x9b, 0xd9, 0x1a, 0x40,
+	0x7b, 0x6a, 0x25, 0x25, 0x10, 0x23, 0xce, 0x8c, 0x47, 0x9c, 0x29, 0x66, 0xa9, 0x1c, 0x71, 0xe6,
+	0x75, 0x5c, 0x57, 0xd8, 0x4a, 0x0a, 0x69, 0x3c, 0xd4, 0xa0, 0xd0, 0x1b, 0x23, 0x33, 0x8f, 0x91,
+	0xe7, 0xcd, 0x03, 0x5d, 0xed, 0x20, 0x3b, 0xcc, 0xc9, 0xbe, 0xb1, 0x2b, 0x59, 0x41, 0xa2, 0x83,
+	0xad, 0x25, 0x7b, 0xe6, 0x3d, 0xea, 0xb4, 0x3c, 0xd2, 0x35, 0x44, 0x10, 0x8c, 0x06, 0xd8, 0x27,
+	0xf2, 0x41, 0xf1, 0x6b, 0xe3, 0x1c, 0xe8, 0x59, 0x00, 0x99, 0x1f, 0x82, 0xd1, 0xf8, 0xd0, 0x2a,
+	0x44, 0x7c, 0x6d, 0x5c, 0x85, 0x69, 0xf5, 0x5c, 0xaf, 0xc4, 0xe3, 0xf4, 0x96, 0x98, 0xa6, 0x2a,
+	0x48, 0x11, 0xc6, 0xf9, 0x94, 0xdd, 0x70, 0x48, 0xc0, 0xdc, 0x8f, 0xdd, 0x64, 0x66, 0x75, 0x2f,
+	0x1b, 0x21, 0xcc, 0x64, 0x3b, 0x92, 0xc1, 0x2b, 0x70, 0x90, 0xa4, 0xd6, 0xe5, 0x33, 0x2c, 0xf6,
+	0x2b, 0x70, 0xda, 0x8f, 0x2c, 0x72, 0x87, 0x0f, 0x83, 0x48, 0xf2, 0x65, 0xcf, 0xcb, 0x22, 0xff,
+	0xb2, 0x9a, 0xe6, 0x17, 0x0d, 0x66, 0xb2, 0xe3, 0xec, 0x98, 0xdb, 0xc8, 0x8b, 0xe6, 0xf6, 0xf2,
+	0x9a, 0xa8, 0x04, 0x47, 0xc4, 0x6b, 0x61, 0xb5, 0xbc, 0x4e, 0xb0, 0xc7, 0x1a, 0x39, 0x4e, 0x7b,
+	0x03, 0x8e, 0x76, 0x63, 0x64, 0xaa, 0xef, 0xc3, 0x3e, 0xf9, 0x02, 0x50, 0xb3, 0xf0, 0xcc, 0x2e,
+	0x93, 0x68, 0xb5, 0x81, 0x83, 0x80, 0x78, 0xc2, 0x8f, 0x3a, 0x2b, 0xca, 0x87, 0xb1, 0x2c, 0x3b,
+	0x56, 0x1d, 0x9e, 0x9b, 0x0c, 0xb3, 0x56, 0x9e, 0xa1, 0xfd, 0x95, 0x06, 0x93, 0x15, 0x62, 0xd3,
+	0xd0, 0x11, 0x90, 0x9b, 0x2d, 0xdf, 0xc7, 0xe1, 0x16, 0x3a, 0x0a, 0x63, 0x11, 0x5f, 0x90, 0x00,
+	0x79, 0x17, 0xbf, 0x73, 0xc5, 0xfc, 0x8c, 0x4b, 0x39, 0x5a, 0x11, 0x37, 0x68, 0x0d, 0xc6, 0xb0,
+	0xcf, 0x97, 0x47, 0xf8, 0x2b, 0xcf, 0x8c, 0xe9, 0xfd, 0xbd, 0x3d, 0x77, 0xaa, 0xee, 0xb2, 0x46,
+	0xab, 0x66, 0xda, 0xd4, 0xb7, 0xa4, 0x90, 0x12, 0x3f, 0x67, 0x23, 0x67, 0xd3, 0x62, 0x5b, 0x4d,
+	0x12, 0x99, 0x1b, 0x01, 0xab, 0x48, 0xb4, 0xf1, 0xdb, 0x28, 0x4c, 0x67, 0xe6, 0xf1, 0xff, 0x94,
+	0x0d, 0x7d, 0x04, 0xe3, 0x0e, 0x69, 0xd2, 0xc8, 0x65, 0x5c, 0xcb, 0x84, 0x4e, 0x54, 0x18, 0xe6,
+	0x6e, 0xad, 0x7e, 0x6e, 0x33, 0xea, 0x25, 0x3d, 0x1f, 0x96, 0xde, 0x84, 0x45, 0x84, 0x6a, 0x30,
+	0xd1, 0x0a, 0x6a, 0x34, 0x70, 0xdc, 0xa0, 0x9e, 0x44, 0x18, 0x79, 0x91, 0x08, 0xaf, 0x24, 0xfe,
+	0x54, 0x8c, 0x2a, 0x4c, 0x34, 0x89, 0x88, 0x90, 0x88, 0xaf, 0xc2, 0xa8, 0x2c, 0x4e, 0xd4, 0x13,
+	0x23, 0xad, 0xd1, 0xcc, 0x55, 0x79, 0x75, 0x19, 0x33, 0xac, 0x02, 0x48, 0x67, 0x6a, 0x2b, 0x42,
+	0xb7, 0x60, 0x92, 0xb6, 0x58, 0xc4, 0xb0, 0x08, 0x12, 0x9f, 0x17, 0x97, 0x44, 0x85, 0x3d, 0x3c,
+	0xc4, 0x6b, 0x89, 0xdf, 0x4e, 0x65, 0x67, 0xf2, 0xc7, 0x28, 0x7d, 0xa2, 0x14, 0xfe, 0x86, 0x80,
+	0x23, 0x13, 0x26, 0x3d, 0xb7, 0xde, 0x60, 0x55, 0xdb, 0x73, 0x49, 0xc0, 0xaa, 0x0d, 0x12, 0xdf,
+	0x15, 0xc6, 0x78, 0x5b, 0x4d, 0xf0, 0xad, 0x55, 0xbe, 0xb3, 0xce, 0x37, 0xd0, 0x3c, 0x4c, 0x74,
+	0xd8, 0x33, 0xd7, 0x27, 0x85, 0xbd, 0xdc, 0x7a, 0x3c, 0x65, 0x7d, 0xcb, 0xf5, 0x49, 0xe9, 0x8f,
+	0x71, 0xd8, 0xc3, 0xe3, 0xa3, 0x6f, 0x34, 0x18, 0x13, 0x22, 0x07, 0x99, 0xfd, 0x0a, 0xde, 0xab,
+	0xaf, 0x74, 0x2b, 0xb7, 0xbd, 0x68, 0x4e, 0x63, 0xfe, 0xcb, 0x3f, 0xff, 0xfd, 0x7a, 0xf8, 0x04,
+	0x32, 0xac, 0x36, 0xd0, 0x12, 0x40, 0xab, 0xeb, 0xcb, 0x00, 0xfd, 0xa8, 0x01, 0xb4, 0x45, 0x12,
+	0x7a, 0x73, 0xd7, 0x58, 0x59, 0x62, 0x4c, 0x5f, 0x1a, 0x14, 0x26, 0x99, 0x5e, 0xe4, 0x4c, 0x17,
+	0x51, 0x49, 0x32, 0x3d, 0x7b, 0x2d, 0x8b, 0x6a, 0x5b, 0x75, 0x59, 0xf7, 0xd4, 0xf8, 0xb8, 0x8f,
+	0xbe, 0xd3, 0xd2, 0x32, 0x2a, 0x1f, 0xf3, 0x1e, 0xa5, 0xa6, 0x2f, 0x0d, 0x0a, 0x93, 0xcc, 0xcf,
+	0x71, 0xe6, 0xf3, 0xa8, 0xd8, 0x97, 0x79, 0xea, 0x3b, 0x08, 0xfd, 0xa0, 0xb5, 0xe5, 0x08, 0x3a,
+	0x9f, 0x27, 0x6c, 0x97, 0x68, 0xd2, 0x17, 0x07, 0x03, 0x49, 0xa6, 0x6f, 0x71, 0xa6, 0xe7, 0xd1,
+	0x42, 0x5f, 0xa6, 0xc9, 0x57, 0x59, 0xba, 0xc4, 0xdf, 0x6a, 0x70, 0x40, 0xf9, 0x2b, 0x7b, 0x5e,
+	0x0e, 0xd6, 0xbd, 0x52, 0x4f, 0x5f, 0x1c, 0x0c, 0x24, 0x59, 0x9b, 0x9c, 0x75, 0x11, 0x9d, 0xca,
+	0xc7, 0x1a, 0xfd, 0xac, 0xc1, 0xa1, 0x0e, 0x95, 0x94, 0xa3, 0x21, 0xb2, 0x64, 0x98, 0xbe, 0x34,
+	0x28, 0x6c, 0xa0, 0x56, 0xf6, 0x39, 0x56, 0x7d, 0x6b, 0x59, 0xf7, 0x62, 0x95, 0x77, 0x1f, 0x3d,
+	0xd4, 0x60, 0xa6, 0xdf, 0x57, 0x1e, 0xba, 0xbc, 0x2b, 0xa9, 0x1c, 0x9f, 0xa9, 0xfa, 0x95, 0x17,
+	0xf4, 0x22, 0xdf, 0x7d, 0xbf, 0x6b, 0x70, 0x30, 0x2d, 0x77, 0xd0, 0x72, 0x9e, 0xbe, 0xcc, 0x10,
+	0x74, 0xfa, 0x85, 0xc1, 0x81, 0xb2, 0xda, 0x97, 0x79, 0xb5, 0xdf, 0x45, 0x6f, 0xf7, 0xad, 0x76,
+	0xc7, 0x1f, 0x0a, 0xd6, 0xbd, 0x2e, 0x89, 0x7b, 0x1f, 0xfd, 0xa4, 0xc1, 0x78, 0xda, 0x7d, 0xdc,
+	0xe3, 0xcb, 0x79, 0xda, 0xf5, 0xf9, 0x92, 0xd9, 0x41, 0x6e, 0x1a, 0x25, 0x9e, 0xcc, 0x19, 0x34,
+	0x9f, 0x3f, 0x19, 0xf4, 0xbd, 0x06, 0xfb, 0x13, 0x35, 0x87, 0x16, 0x76, 0x7f, 0xb2, 0x5d, 0x6a,
+	0x51, 0x2f, 0x0d, 0x02, 0x19, 0xa8, 0xc7, 0xe3, 0xa1, 0xd7, 0xe0, 0xc0, 0xf4, 0x2c, 0xf9, 0x55,
+	0x83, 0xc3, 0x9d, 0x62, 0x0a, 0xed, 0x7e, 0xd4, 0x32, 0x55, 0xa4, 0xbe, 0x3c, 0x30, 0x4e, 0xf2,
+	0xbf, 0xc4, 0xf9, 0x5f, 0x44, 0x17, 0xf2, 0x0d, 0x95, 0xaa, 0x90, 0x9a, 0xa9, 0x2c, 0x56, 0xd6,
+	0x1f, 0x3d, 0x99, 0xd5, 0x1e, 0x3f, 0x99, 0xd5, 0xfe, 0x79, 0x32, 0xab, 0x3d, 0x78, 0x3a, 0x3b,
+	0xf4, 0xf8, 0xe9, 0xec, 0xd0, 0x5f, 0x4f, 0x67, 0x87, 0x6e, 0x9b, 0x29, 0x85, 0x99, 0xe1, 0xfd,
+	0xb3, 0xb6, 0x7f, 0xae, 0x36, 0x6b, 0x63, 0xfc, 0x6f, 0xa6, 0xf3, 0xff, 0x0d, 0x00, 0xc0, 0x2e,
+	0x91, 0x20, 0x3e, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -965,6 +1280,11 @@ type QueryClient interface {
 	EpochTracker(ctx context.Context, in *QueryGetEpochTrackerRequest, opts ...grpc.CallOption) (*QueryGetEpochTrackerResponse, error)
 	// Queries a list of EpochTracker items.
 	EpochTrackerAll(ctx context.Context, in *QueryAllEpochTrackerRequest, opts ...grpc.CallOption) (*QueryAllEpochTrackerResponse, error)
+	// Queries the state of the channels behind a host zone's ICA accounts
+	ICAHealth(ctx context.Context, in *QueryICAHealthRequest, opts ...grpc.CallOption) (*QueryICAHealthResponse, error)
+	// Queries the state of a host zone's ICA channels, records in flight, pending callbacks,
+	// outstanding queries and light client
+	HostZoneStatus(ctx context.Context, in *QueryHostZoneStatusRequest, opts ...grpc.CallOption) (*QueryHostZoneStatusResponse, error)
 }
 
 type queryClient struct {
@@ -1056,6 +1376,24 @@ func (c *queryClient) EpochTrackerAll(ctx context.Context, in *QueryAllEpochTrac
 	return out, nil
 }
 
+func (c *queryClient) ICAHealth(ctx context.Context, in *QueryICAHealthRequest, opts ...grpc.CallOption) (*QueryICAHealthResponse, error) {
+	out := new(QueryICAHealthResponse)
+	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Query/ICAHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HostZoneStatus(ctx context.Context, in *QueryHostZoneStatusRequest, opts ...grpc.CallOption) (*QueryHostZoneStatusResponse, error) {
+	out := new(QueryHostZoneStatusResponse)
+	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Query/HostZoneStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -1076,6 +1414,11 @@ type QueryServer interface {
 	EpochTracker(context.Context, *QueryGetEpochTrackerRequest) (*QueryGetEpochTrackerResponse, error)
 	// Queries a list of EpochTracker items.
 	EpochTrackerAll(context.Context, *QueryAllEpochTrackerRequest) (*QueryAllEpochTrackerResponse, error)
+	// Queries the state of the channels behind a host zone's ICA accounts
+	ICAHealth(context.Context, *QueryICAHealthRequest) (*QueryICAHealthResponse, error)
+	// Queries the state of a host zone's ICA channels, records in flight, pending callbacks,
+	// outstanding queries and light client
+	HostZoneStatus(context.Context, *QueryHostZoneStatusRequest) (*QueryHostZoneStatusResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1109,6 +1452,12 @@ func (*UnimplementedQueryServer) EpochTracker(ctx context.Context, req *QueryGet
 func (*UnimplementedQueryServer) EpochTrackerAll(ctx context.Context, req *QueryAllEpochTrackerRequest) (*QueryAllEpochTrackerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EpochTrackerAll not implemented")
 }
+func (*UnimplementedQueryServer) ICAHealth(ctx context.Context, req *QueryICAHealthRequest) (*QueryICAHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ICAHealth not implemented")
+}
+func (*UnimplementedQueryServer) HostZoneStatus(ctx context.Context, req *QueryHostZoneStatusRequest) (*QueryHostZoneStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostZoneStatus not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1276,6 +1625,42 @@ func _Query_EpochTrackerAll_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ICAHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryICAHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ICAHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Stridelabs.stride.stakeibc.Query/ICAHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ICAHealth(ctx, req.(*QueryICAHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostZoneStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHostZoneStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostZoneStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Stridelabs.stride.stakeibc.Query/HostZoneStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostZoneStatus(ctx, req.(*QueryHostZoneStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "Stridelabs.stride.stakeibc.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1316,6 +1701,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EpochTrackerAll",
 			Handler:    _Query_EpochTrackerAll_Handler,
 		},
+		{
+			MethodName: "ICAHealth",
+			Handler:    _Query_ICAHealth_Handler,
+		},
+		{
+			MethodName: "HostZoneStatus",
+			Handler:    _Query_HostZoneStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stakeibc/query.proto",
@@ -1921,39 +2314,284 @@ func (m *QueryAllEpochTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryICAHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryInterchainAccountFromAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryICAHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryICAHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryInterchainAccountFromAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *QueryICAHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryICAHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryICAHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostZoneStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostZoneStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostZoneStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordStatusSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordStatusSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordStatusSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostZoneStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostZoneStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostZoneStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LightClientTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LightClientTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LightClientHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LightClientHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.OutstandingQueries) > 0 {
+		for iNdEx := len(m.OutstandingQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutstandingQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PendingCallbacks) > 0 {
+		for iNdEx := len(m.PendingCallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingCallbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.UnbondingRecords) > 0 {
+		for iNdEx := len(m.UnbondingRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DepositRecords) > 0 {
+		for iNdEx := len(m.DepositRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryInterchainAccountFromAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountFromAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = len(m.InterchainAccountAddress)
 	if l > 0 {
@@ -2168,48 +2806,152 @@ func (m *QueryAllEpochTrackerResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryICAHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryInterchainAccountFromAddressRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInterchainAccountFromAddressRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInterchainAccountFromAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+
+func (m *QueryICAHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
+	}
+	return n
+}
+
+func (m *QueryHostZoneStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordStatusSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHostZoneStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DepositRecords) > 0 {
+		for _, e := range m.DepositRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UnbondingRecords) > 0 {
+		for _, e := range m.UnbondingRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PendingCallbacks) > 0 {
+		for _, e := range m.PendingCallbacks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.OutstandingQueries) > 0 {
+		for _, e := range m.OutstandingQueries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.LightClientHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LightClientHeight))
+	}
+	if m.LightClientTime != 0 {
+		n += 1 + sovQuery(uint64(m.LightClientTime))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryInterchainAccountFromAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountFromAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountFromAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
 					return ErrIntOverflowQuery
 				}
 				if iNdEx >= l {
@@ -2262,11 +3004,442 @@ func (m *QueryInterchainAccountFromAddressRequest) Unmarshal(dAtA []byte) error
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountFromAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountFromAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountFromAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterchainAccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterchainAccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetICAAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetICAAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetICAAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2288,7 +3461,7 @@ func (m *QueryInterchainAccountFromAddressRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryInterchainAccountFromAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetICAAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2311,17 +3484,17 @@ func (m *QueryInterchainAccountFromAddressResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInterchainAccountFromAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetICAAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInterchainAccountFromAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetICAAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterchainAccountAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ICAAccount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2331,23 +3504,24 @@ func (m *QueryInterchainAccountFromAddressResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InterchainAccountAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.ICAAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2370,7 +3544,7 @@ func (m *QueryInterchainAccountFromAddressResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetHostZoneRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2393,12 +3567,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetHostZoneRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetHostZoneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2420,7 +3626,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetHostZoneResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2443,15 +3649,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetHostZoneResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetHostZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2478,7 +3684,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HostZone.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2503,7 +3709,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllHostZoneRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2526,17 +3732,17 @@ func (m *QueryGetValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllHostZoneRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllHostZoneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2546,23 +3752,27 @@ func (m *QueryGetValidatorsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2585,7 +3795,7 @@ func (m *QueryGetValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllHostZoneResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2608,15 +3818,15 @@ func (m *QueryGetValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllHostZoneResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllHostZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2643,8 +3853,44 @@ func (m *QueryGetValidatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, &Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HostZone = append(m.HostZone, HostZone{})
+			if err := m.HostZone[len(m.HostZone)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2669,7 +3915,7 @@ func (m *QueryGetValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetICAAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2692,12 +3938,44 @@ func (m *QueryGetICAAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetICAAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetICAAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2719,7 +3997,7 @@ func (m *QueryGetICAAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetICAAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2742,17 +4020,17 @@ func (m *QueryGetICAAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetICAAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetICAAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ICAAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2762,24 +4040,23 @@ func (m *QueryGetICAAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ICAAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2802,7 +4079,7 @@ func (m *QueryGetICAAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetHostZoneRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetEpochTrackerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2825,15 +4102,15 @@ func (m *QueryGetHostZoneRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetHostZoneRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetEpochTrackerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetHostZoneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetEpochTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2861,7 +4138,7 @@ func (m *QueryGetHostZoneRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2884,7 +4161,7 @@ func (m *QueryGetHostZoneRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetHostZoneResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetEpochTrackerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2907,15 +4184,15 @@ func (m *QueryGetHostZoneResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetHostZoneResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetEpochTrackerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetHostZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetEpochTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochTracker", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2942,7 +4219,7 @@ func (m *QueryGetHostZoneResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HostZone.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EpochTracker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2967,7 +4244,7 @@ func (m *QueryGetHostZoneResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllHostZoneRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllEpochTrackerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2990,10 +4267,10 @@ func (m *QueryAllHostZoneRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllHostZoneRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllEpochTrackerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllHostZoneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllEpochTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3053,7 +4330,7 @@ func (m *QueryAllHostZoneRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllHostZoneResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllEpochTrackerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3076,15 +4353,15 @@ func (m *QueryAllHostZoneResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllHostZoneResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllEpochTrackerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllHostZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllEpochTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochTracker", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3111,8 +4388,8 @@ func (m *QueryAllHostZoneResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostZone = append(m.HostZone, HostZone{})
-			if err := m.HostZone[len(m.HostZone)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EpochTracker = append(m.EpochTracker, EpochTracker{})
+			if err := m.EpochTracker[len(m.EpochTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3173,7 +4450,7 @@ func (m *QueryAllHostZoneResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryICAHealthRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3196,15 +4473,15 @@ func (m *QueryModuleAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryICAHealthRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryICAHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3232,7 +4509,7 @@ func (m *QueryModuleAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3255,7 +4532,7 @@ func (m *QueryModuleAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryICAHealthResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3278,17 +4555,17 @@ func (m *QueryModuleAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryICAHealthResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryICAHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3298,23 +4575,25 @@ func (m *QueryModuleAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.Accounts = append(m.Accounts, ICAChannelHealth{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3337,7 +4616,7 @@ func (m *QueryModuleAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetEpochTrackerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHostZoneStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3360,15 +4639,15 @@ func (m *QueryGetEpochTrackerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEpochTrackerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHostZoneStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEpochTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHostZoneStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3396,7 +4675,7 @@ func (m *QueryGetEpochTrackerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3419,7 +4698,7 @@ func (m *QueryGetEpochTrackerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetEpochTrackerResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordStatusSummary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3442,17 +4721,17 @@ func (m *QueryGetEpochTrackerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEpochTrackerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordStatusSummary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEpochTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordStatusSummary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochTracker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3462,22 +4741,74 @@ func (m *QueryGetEpochTrackerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EpochTracker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3502,7 +4833,7 @@ func (m *QueryGetEpochTrackerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllEpochTrackerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHostZoneStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3525,15 +4856,15 @@ func (m *QueryAllEpochTrackerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEpochTrackerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHostZoneStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEpochTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHostZoneStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3560,66 +4891,82 @@ func (m *QueryAllEpochTrackerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Accounts = append(m.Accounts, ICAChannelHealth{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositRecords", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllEpochTrackerResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.DepositRecords = append(m.DepositRecords, RecordStatusSummary{})
+			if err := m.DepositRecords[len(m.DepositRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.UnbondingRecords = append(m.UnbondingRecords, RecordStatusSummary{})
+			if err := m.UnbondingRecords[len(m.UnbondingRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEpochTrackerResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEpochTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochTracker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCallbacks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3646,14 +4993,14 @@ func (m *QueryAllEpochTrackerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EpochTracker = append(m.EpochTracker, EpochTracker{})
-			if err := m.EpochTracker[len(m.EpochTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingCallbacks = append(m.PendingCallbacks, types.CallbackData{})
+			if err := m.PendingCallbacks[len(m.PendingCallbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingQueries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3680,13 +5027,49 @@ func (m *QueryAllEpochTrackerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OutstandingQueries = append(m.OutstandingQueries, types1.Query{})
+			if err := m.OutstandingQueries[len(m.OutstandingQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LightClientHeight", wireType)
+			}
+			m.LightClientHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LightClientHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LightClientTime", wireType)
+			}
+			m.LightClientTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LightClientTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -31,8 +32,8 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type MsgLiquidStake struct {
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Amount  uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Creator string                                 `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 	// TODO(TEST-86): Update Denom -> HostDenom
 	HostDenom string `protobuf:"bytes,3,opt,name=host_denom,json=hostDenom,proto3" json:"host_denom,omitempty"`
 }
@@ -77,13 +78,6 @@ func (m *MsgLiquidStake) GetCreator() string {
 	return ""
 }
 
-func (m *MsgLiquidStake) GetAmount() uint64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
-}
-
 func (m *MsgLiquidStake) GetHostDenom() string {
 	if m != nil {
 		return m.HostDenom
@@ -128,10 +122,10 @@ func (m *MsgLiquidStakeResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgLiquidStakeResponse proto.InternalMessageInfo
 
 type MsgClearBalance struct {
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Amount  uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Channel string `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	Creator string                                 `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId string                                 `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	Channel string                                 `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
 }
 
 func (m *MsgClearBalance) Reset()         { *m = MsgClearBalance{} }
@@ -181,13 +175,6 @@ func (m *MsgClearBalance) GetChainId() string {
 	return ""
 }
 
-func (m *MsgClearBalance) GetAmount() uint64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
-}
-
 func (m *MsgClearBalance) GetChannel() string {
 	if m != nil {
 		return m.Channel
@@ -232,10 +219,10 @@ func (m *MsgClearBalanceResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgClearBalanceResponse proto.InternalMessageInfo
 
 type MsgRedeemStake struct {
-	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Amount   uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	HostZone string `protobuf:"bytes,3,opt,name=hostZone,proto3" json:"hostZone,omitempty"`
-	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Creator  string                                 `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Amount   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	HostZone string                                 `protobuf:"bytes,3,opt,name=hostZone,proto3" json:"hostZone,omitempty"`
+	Receiver string                                 `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
 }
 
 func (m *MsgRedeemStake) Reset()         { *m = MsgRedeemStake{} }
@@ -278,13 +265,6 @@ func (m *MsgRedeemStake) GetCreator() string {
 	return ""
 }
 
-func (m *MsgRedeemStake) GetAmount() uint64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
-}
-
 func (m *MsgRedeemStake) GetHostZone() string {
 	if m != nil {
 		return m.HostZone
@@ -344,6 +324,9 @@ type MsgRegisterHostZone struct {
 	Creator            string `protobuf:"bytes,6,opt,name=creator,proto3" json:"creator,omitempty"`
 	TransferChannelId  string `protobuf:"bytes,10,opt,name=transfer_channel_id,json=transferChannelId,proto3" json:"transfer_channel_id,omitempty" yaml:"transfer_channel_id"`
 	UnbondingFrequency uint64 `protobuf:"varint,11,opt,name=unbonding_frequency,json=unbondingFrequency,proto3" json:"unbonding_frequency,omitempty" yaml:"unbonding_frequency"`
+	// number of decimals between the host denom and display denom (e.g. 6 for uatom -> atom)
+	DenomExponent uint32 `protobuf:"varint,13,opt,name=denom_exponent,json=denomExponent,proto3" json:"denom_exponent,omitempty" yaml:"denom_exponent"`
+	DisplayDenom  string `protobuf:"bytes,14,opt,name=display_denom,json=displayDenom,proto3" json:"display_denom,omitempty" yaml:"display_denom"`
 }
 
 func (m *MsgRegisterHostZone) Reset()         { *m = MsgRegisterHostZone{} }
@@ -1131,1041 +1114,1150 @@ func (m *MsgUpdateValidatorSharesExchRateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateValidatorSharesExchRateResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgLiquidStake)(nil), "Stridelabs.stride.stakeibc.MsgLiquidStake")
-	proto.RegisterType((*MsgLiquidStakeResponse)(nil), "Stridelabs.stride.stakeibc.MsgLiquidStakeResponse")
-	proto.RegisterType((*MsgClearBalance)(nil), "Stridelabs.stride.stakeibc.MsgClearBalance")
-	proto.RegisterType((*MsgClearBalanceResponse)(nil), "Stridelabs.stride.stakeibc.MsgClearBalanceResponse")
-	proto.RegisterType((*MsgRedeemStake)(nil), "Stridelabs.stride.stakeibc.MsgRedeemStake")
-	proto.RegisterType((*MsgRedeemStakeResponse)(nil), "Stridelabs.stride.stakeibc.MsgRedeemStakeResponse")
-	proto.RegisterType((*MsgRegisterHostZone)(nil), "Stridelabs.stride.stakeibc.MsgRegisterHostZone")
-	proto.RegisterType((*MsgRegisterHostZoneResponse)(nil), "Stridelabs.stride.stakeibc.MsgRegisterHostZoneResponse")
-	proto.RegisterType((*MsgClaimUndelegatedTokens)(nil), "Stridelabs.stride.stakeibc.MsgClaimUndelegatedTokens")
-	proto.RegisterType((*MsgClaimUndelegatedTokensResponse)(nil), "Stridelabs.stride.stakeibc.MsgClaimUndelegatedTokensResponse")
-	proto.RegisterType((*MsgRebalanceValidators)(nil), "Stridelabs.stride.stakeibc.MsgRebalanceValidators")
-	proto.RegisterType((*MsgRebalanceValidatorsResponse)(nil), "Stridelabs.stride.stakeibc.MsgRebalanceValidatorsResponse")
-	proto.RegisterType((*MsgAddValidator)(nil), "Stridelabs.stride.stakeibc.MsgAddValidator")
-	proto.RegisterType((*MsgAddValidatorResponse)(nil), "Stridelabs.stride.stakeibc.MsgAddValidatorResponse")
-	proto.RegisterType((*MsgChangeValidatorWeight)(nil), "Stridelabs.stride.stakeibc.MsgChangeValidatorWeight")
-	proto.RegisterType((*MsgChangeValidatorWeightResponse)(nil), "Stridelabs.stride.stakeibc.MsgChangeValidatorWeightResponse")
-	proto.RegisterType((*MsgDeleteValidator)(nil), "Stridelabs.stride.stakeibc.MsgDeleteValidator")
-	proto.RegisterType((*MsgDeleteValidatorResponse)(nil), "Stridelabs.stride.stakeibc.MsgDeleteValidatorResponse")
-	proto.RegisterType((*MsgRestoreInterchainAccount)(nil), "Stridelabs.stride.stakeibc.MsgRestoreInterchainAccount")
-	proto.RegisterType((*MsgRestoreInterchainAccountResponse)(nil), "Stridelabs.stride.stakeibc.MsgRestoreInterchainAccountResponse")
-	proto.RegisterType((*MsgUpdateValidatorSharesExchRate)(nil), "Stridelabs.stride.stakeibc.MsgUpdateValidatorSharesExchRate")
-	proto.RegisterType((*MsgUpdateValidatorSharesExchRateResponse)(nil), "Stridelabs.stride.stakeibc.MsgUpdateValidatorSharesExchRateResponse")
+type MsgUpdateHostZoneSchedule struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	HostZone string `protobuf:"bytes,2,opt,name=hostZone,proto3" json:"hostZone,omitempty"`
+	// deposit, delegate, reinvest, redemption_rate or rebalance
+	Pipeline string `protobuf:"bytes,3,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	Interval uint64 `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	Offset   uint64 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
 }
 
-func init() { proto.RegisterFile("stakeibc/tx.proto", fileDescriptor_e80cdc2de072d1f1) }
-
-var fileDescriptor_e80cdc2de072d1f1 = []byte{
-	// 1149 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4f, 0x6f, 0xdc, 0x44,
-	0x14, 0x8f, 0x9b, 0x6d, 0x93, 0xbc, 0x84, 0xb6, 0x71, 0xd2, 0xe0, 0x98, 0x66, 0x37, 0xb8, 0x42,
-	0x8a, 0x5a, 0x75, 0x57, 0x6c, 0x0a, 0x48, 0x11, 0x15, 0xca, 0x1f, 0x50, 0x57, 0x4a, 0x40, 0x72,
-	0x5a, 0x90, 0x7a, 0x59, 0x8d, 0xed, 0x89, 0xd7, 0xca, 0x7a, 0xc6, 0xf5, 0x78, 0x4b, 0x16, 0x21,
-	0x2e, 0x08, 0x09, 0x09, 0x09, 0x71, 0xe0, 0x88, 0x44, 0x25, 0x10, 0x9f, 0x80, 0xef, 0x00, 0xc7,
-	0x8a, 0x13, 0xa7, 0x08, 0x25, 0x17, 0xce, 0xf9, 0x04, 0x68, 0xc6, 0xf6, 0xac, 0x9d, 0x78, 0xd7,
-	0x64, 0x7b, 0xf3, 0x9b, 0xf7, 0x7e, 0xef, 0xfd, 0xde, 0x1f, 0xcf, 0xb3, 0x61, 0x9e, 0x45, 0xe8,
-	0x10, 0x7b, 0x96, 0xdd, 0x88, 0x8e, 0xea, 0x41, 0x48, 0x23, 0xaa, 0xea, 0xfb, 0x51, 0xe8, 0x39,
-	0xb8, 0x8b, 0x2c, 0x56, 0x67, 0xe2, 0xb1, 0x9e, 0x1a, 0xe9, 0xb7, 0xa5, 0x39, 0x0e, 0xa8, 0xdd,
-	0x69, 0x47, 0x21, 0xb2, 0x0f, 0x71, 0x18, 0x23, 0x75, 0x5d, 0x6a, 0x3d, 0x1b, 0xb5, 0x91, 0x6d,
-	0xd3, 0x1e, 0x89, 0x12, 0xdd, 0xa2, 0x4b, 0x5d, 0x2a, 0x1e, 0x1b, 0xfc, 0x29, 0x39, 0x5d, 0x76,
-	0x29, 0x75, 0xbb, 0xb8, 0x21, 0x24, 0xab, 0x77, 0xd0, 0x40, 0xa4, 0x9f, 0xaa, 0x6c, 0xca, 0x7c,
-	0xca, 0xda, 0x31, 0x26, 0x16, 0x62, 0x95, 0x81, 0xe0, 0xfa, 0x1e, 0x73, 0x77, 0xbd, 0x67, 0x3d,
-	0xcf, 0xd9, 0xe7, 0x21, 0x55, 0x0d, 0xa6, 0xec, 0x10, 0xa3, 0x88, 0x86, 0x9a, 0xb2, 0xaa, 0xac,
-	0xcd, 0x98, 0xa9, 0xa8, 0x2e, 0xc1, 0x35, 0xe4, 0x73, 0x1e, 0xda, 0x95, 0x55, 0x65, 0xad, 0x62,
-	0x26, 0x92, 0xba, 0x02, 0xd0, 0xa1, 0x2c, 0x6a, 0x3b, 0x98, 0x50, 0x5f, 0x9b, 0x14, 0xa0, 0x19,
-	0x7e, 0xb2, 0xc3, 0x0f, 0x0c, 0x0d, 0x96, 0xf2, 0x21, 0x4c, 0xcc, 0x02, 0x4a, 0x18, 0x36, 0x8e,
-	0xe0, 0xc6, 0x1e, 0x73, 0xb7, 0xbb, 0x18, 0x85, 0x5b, 0xa8, 0x8b, 0x88, 0x3d, 0x2a, 0xfa, 0x32,
-	0x4c, 0xdb, 0x1d, 0xe4, 0x91, 0xb6, 0xe7, 0x88, 0xf8, 0x5c, 0xc5, 0xe5, 0x96, 0x93, 0x21, 0x36,
-	0x99, 0x23, 0xc6, 0x9d, 0x75, 0x10, 0x21, 0xb8, 0xab, 0x55, 0x24, 0x82, 0x8b, 0xc6, 0x32, 0xbc,
-	0x7e, 0x2e, 0xb2, 0x24, 0xf5, 0x85, 0xa8, 0x88, 0x89, 0x1d, 0x8c, 0xfd, 0x71, 0x2b, 0xa2, 0xc3,
-	0x34, 0xcf, 0xff, 0x29, 0x25, 0x38, 0xa9, 0x87, 0x94, 0xb9, 0x2e, 0xc4, 0x36, 0xf6, 0x9e, 0xe3,
-	0x30, 0x61, 0x25, 0xe5, 0xa4, 0x54, 0x99, 0xd8, 0x92, 0xd5, 0x6f, 0x93, 0xb0, 0x20, 0x54, 0xae,
-	0xc7, 0x22, 0x1c, 0x3e, 0x4a, 0xbd, 0x3d, 0x84, 0xd7, 0x6c, 0x4a, 0x08, 0xb6, 0x23, 0x8f, 0x0e,
-	0x4a, 0xb3, 0xa5, 0x9d, 0x1d, 0xd7, 0x16, 0xfb, 0xc8, 0xef, 0x6e, 0x18, 0x39, 0xb5, 0x61, 0xce,
-	0x0d, 0xe4, 0x96, 0xa3, 0x1a, 0x30, 0x67, 0x61, 0xbb, 0xb3, 0xde, 0x0c, 0x42, 0x7c, 0xe0, 0x1d,
-	0x69, 0x73, 0x82, 0x50, 0xee, 0x4c, 0x7d, 0x90, 0x6b, 0xaf, 0xa0, 0xbc, 0x75, 0xeb, 0xec, 0xb8,
-	0x36, 0x1f, 0xfb, 0x1f, 0xe8, 0x8c, 0x4c, 0xd7, 0xd5, 0xb7, 0x61, 0xc6, 0xb3, 0xec, 0x04, 0x74,
-	0x55, 0x80, 0x16, 0xcf, 0x8e, 0x6b, 0x37, 0x63, 0x90, 0x54, 0x19, 0xe6, 0xb4, 0x67, 0xd9, 0x31,
-	0x24, 0x53, 0xe7, 0x6b, 0xf9, 0x3a, 0x7f, 0x0c, 0x0b, 0x51, 0x88, 0x08, 0x3b, 0xc0, 0x61, 0x3b,
-	0x69, 0x21, 0xcf, 0x15, 0x84, 0xdb, 0xea, 0xd9, 0x71, 0x4d, 0x8f, 0xdd, 0x16, 0x18, 0x19, 0xe6,
-	0x7c, 0x7a, 0xba, 0x1d, 0x1f, 0xb6, 0x1c, 0xf5, 0x13, 0x58, 0xe8, 0x11, 0x8b, 0x12, 0xc7, 0x23,
-	0x6e, 0xfb, 0x20, 0xc4, 0xcf, 0x7a, 0x98, 0xd8, 0x7d, 0x6d, 0x96, 0x37, 0x31, 0xeb, 0xaf, 0xc0,
-	0xc8, 0x30, 0x55, 0x79, 0xfa, 0x51, 0x7a, 0xb8, 0x31, 0xfd, 0xed, 0x8b, 0xda, 0xc4, 0xbf, 0x2f,
-	0x6a, 0x13, 0xc6, 0x0a, 0xbc, 0x51, 0xd0, 0x27, 0xd9, 0xc7, 0xaf, 0x15, 0x58, 0x16, 0x93, 0x87,
-	0x3c, 0xff, 0x09, 0x71, 0x70, 0x17, 0xbb, 0x28, 0xc2, 0xce, 0x63, 0x7a, 0x88, 0x09, 0x1b, 0x31,
-	0x69, 0xd5, 0xb8, 0x09, 0xdc, 0x57, 0x2b, 0x9d, 0xff, 0xcc, 0x89, 0xba, 0x08, 0x57, 0xc5, 0x35,
-	0x92, 0xbc, 0x01, 0xb1, 0xc0, 0xe7, 0x93, 0x61, 0xe2, 0xc8, 0x49, 0x4b, 0x24, 0xe3, 0x0e, 0xbc,
-	0x39, 0x94, 0x84, 0xa4, 0x1a, 0x26, 0xc3, 0x68, 0xc5, 0x2f, 0xc8, 0xa7, 0xa8, 0xeb, 0x39, 0x9c,
-	0xcb, 0x28, 0x9a, 0xd9, 0xc1, 0xbf, 0x72, 0x6e, 0xf0, 0x0d, 0x98, 0x23, 0x3d, 0x5f, 0xfa, 0x4b,
-	0x98, 0xe6, 0xce, 0x8c, 0x55, 0xa8, 0x16, 0xc7, 0x94, 0xac, 0xfe, 0x50, 0xc4, 0xa5, 0xb1, 0xe9,
-	0x38, 0x52, 0x39, 0x26, 0x1f, 0x15, 0x2a, 0x04, 0xf9, 0xe9, 0x0b, 0x2a, 0x9e, 0xd5, 0x26, 0x4c,
-	0x21, 0xc7, 0x09, 0x31, 0x63, 0xc9, 0xa0, 0x6b, 0x7f, 0xfd, 0x7e, 0x7f, 0x31, 0xb9, 0x31, 0x37,
-	0x63, 0x0d, 0xbf, 0xd3, 0x89, 0x6b, 0xa6, 0x86, 0xbc, 0x35, 0x36, 0xf5, 0x7d, 0x8f, 0x31, 0x8f,
-	0x12, 0x31, 0xea, 0x15, 0x33, 0x73, 0xc2, 0x9b, 0xf0, 0x39, 0xf6, 0xdc, 0x4e, 0x24, 0xa6, 0xba,
-	0x62, 0x26, 0x52, 0x72, 0x07, 0x65, 0x13, 0x91, 0x49, 0xfe, 0xa4, 0x80, 0xc6, 0x1b, 0xd4, 0x41,
-	0xc4, 0x1d, 0x14, 0xe1, 0x33, 0x81, 0x1b, 0x33, 0xdb, 0x26, 0x4c, 0x3d, 0x47, 0x5d, 0x9e, 0x42,
-	0x9c, 0xf0, 0xa8, 0xcc, 0x12, 0xc3, 0x0c, 0xf3, 0x4a, 0x8e, 0xb9, 0x01, 0xab, 0xc3, 0xd8, 0xc9,
-	0x14, 0xbe, 0x02, 0x75, 0x8f, 0xb9, 0x3b, 0xb8, 0x8b, 0x23, 0xfc, 0xaa, 0x9d, 0x1a, 0x83, 0xbb,
-	0x71, 0x1b, 0xf4, 0x8b, 0xf1, 0x25, 0xbb, 0x9f, 0x95, 0xe4, 0x35, 0x65, 0x11, 0x0d, 0x71, 0x8b,
-	0x44, 0x38, 0x14, 0xcb, 0x64, 0x33, 0x5e, 0xb4, 0x23, 0x78, 0xc6, 0x3b, 0x85, 0xaf, 0x9d, 0xf3,
-	0x5b, 0x68, 0x17, 0x66, 0x93, 0x3d, 0xfd, 0xb8, 0x1f, 0xc4, 0x63, 0x75, 0xbd, 0x79, 0xb7, 0x3e,
-	0xfc, 0x13, 0xa0, 0xde, 0xda, 0xde, 0xdc, 0x1c, 0x20, 0xcc, 0x2c, 0xdc, 0x78, 0x0b, 0xee, 0x8c,
-	0x20, 0x28, 0x13, 0x09, 0x44, 0x2b, 0x9e, 0x04, 0x0e, 0xca, 0xa4, 0xb9, 0xdf, 0x41, 0x21, 0x66,
-	0x1f, 0x1e, 0xd9, 0x1d, 0x13, 0x45, 0x78, 0xac, 0x64, 0x34, 0x51, 0x72, 0x1a, 0xe0, 0xa4, 0xe4,
-	0x66, 0x2a, 0x1a, 0x77, 0x61, 0xad, 0x2c, 0x62, 0xca, 0xae, 0xf9, 0xeb, 0x2c, 0x4c, 0xee, 0x31,
-	0x57, 0xf5, 0x61, 0x36, 0xfb, 0x89, 0x31, 0xb2, 0x28, 0xf9, 0x6f, 0x05, 0xbd, 0xf9, 0xff, 0x6d,
-	0xd3, 0xb0, 0x3c, 0x5c, 0x76, 0x7f, 0x97, 0x85, 0xcb, 0xd8, 0x96, 0x86, 0x2b, 0xd8, 0xcd, 0xea,
-	0x97, 0x70, 0xf3, 0xc2, 0x5e, 0x6e, 0x94, 0xfa, 0xc9, 0x03, 0xf4, 0xf7, 0x2e, 0x09, 0x90, 0xd1,
-	0xbf, 0x57, 0x60, 0x69, 0xc8, 0x3a, 0x79, 0xa7, 0xc4, 0x67, 0x31, 0x4c, 0x7f, 0x38, 0x16, 0x4c,
-	0x12, 0xfa, 0x46, 0x81, 0x85, 0xa2, 0xad, 0x51, 0x5e, 0xda, 0x0b, 0x18, 0x7d, 0xe3, 0xf2, 0x18,
-	0xc9, 0x23, 0x80, 0xb9, 0xdc, 0x96, 0xb8, 0x57, 0xe2, 0x2b, 0x6b, 0xac, 0xaf, 0x5f, 0xc2, 0x58,
-	0x46, 0xfc, 0x4e, 0x81, 0x5b, 0xc5, 0x77, 0xf6, 0x83, 0xb2, 0x92, 0x16, 0xa1, 0xf4, 0xf7, 0xc7,
-	0x41, 0x49, 0x36, 0x7d, 0xb8, 0x71, 0xfe, 0xfa, 0xad, 0x97, 0x38, 0x3c, 0x67, 0xaf, 0xbf, 0x7b,
-	0x39, 0x7b, 0x19, 0xfa, 0x47, 0x05, 0xb4, 0xa1, 0x77, 0x6b, 0xf9, 0xa4, 0x17, 0x03, 0xf5, 0x0f,
-	0xc6, 0x04, 0x4a, 0x5a, 0xbf, 0x28, 0xb0, 0x32, 0xfa, 0xaa, 0x2c, 0xab, 0xf8, 0x48, 0xb4, 0xbe,
-	0xf3, 0x2a, 0xe8, 0xec, 0xdc, 0xe6, 0x7e, 0x89, 0xee, 0x95, 0xbe, 0x8e, 0x03, 0xe3, 0xd2, 0xb9,
-	0x2d, 0xfa, 0xe5, 0xd9, 0x7a, 0xf4, 0xe7, 0x49, 0x55, 0x79, 0x79, 0x52, 0x55, 0xfe, 0x39, 0xa9,
-	0x2a, 0x3f, 0x9c, 0x56, 0x27, 0x5e, 0x9e, 0x56, 0x27, 0xfe, 0x3e, 0xad, 0x4e, 0x3c, 0xad, 0xbb,
-	0x5e, 0xd4, 0xe9, 0x59, 0x75, 0x9b, 0xfa, 0x8d, 0xd8, 0xf1, 0xfd, 0x5d, 0x64, 0xb1, 0x46, 0xec,
-	0xb9, 0x71, 0xd4, 0x18, 0xfc, 0xf3, 0xf6, 0x03, 0xcc, 0xac, 0x6b, 0xe2, 0xaf, 0x72, 0xfd, 0xbf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xe7, 0x70, 0x71, 0x7f, 0x0c, 0x0f, 0x00, 0x00,
+func (m *MsgUpdateHostZoneSchedule) Reset()         { *m = MsgUpdateHostZoneSchedule{} }
+func (m *MsgUpdateHostZoneSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateHostZoneSchedule) ProtoMessage()    {}
+func (*MsgUpdateHostZoneSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e80cdc2de072d1f1, []int{22}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	LiquidStake(ctx context.Context, in *MsgLiquidStake, opts ...grpc.CallOption) (*MsgLiquidStakeResponse, error)
-	RedeemStake(ctx context.Context, in *MsgRedeemStake, opts ...grpc.CallOption) (*MsgRedeemStakeResponse, error)
-	// TODO(TEST-53): Remove this pre-launch (no need for clients to create / interact with ICAs)
-	RegisterHostZone(ctx context.Context, in *MsgRegisterHostZone, opts ...grpc.CallOption) (*MsgRegisterHostZoneResponse, error)
-	ClaimUndelegatedTokens(ctx context.Context, in *MsgClaimUndelegatedTokens, opts ...grpc.CallOption) (*MsgClaimUndelegatedTokensResponse, error)
-	RebalanceValidators(ctx context.Context, in *MsgRebalanceValidators, opts ...grpc.CallOption) (*MsgRebalanceValidatorsResponse, error)
-	AddValidator(ctx context.Context, in *MsgAddValidator, opts ...grpc.CallOption) (*MsgAddValidatorResponse, error)
-	ChangeValidatorWeight(ctx context.Context, in *MsgChangeValidatorWeight, opts ...grpc.CallOption) (*MsgChangeValidatorWeightResponse, error)
-	DeleteValidator(ctx context.Context, in *MsgDeleteValidator, opts ...grpc.CallOption) (*MsgDeleteValidatorResponse, error)
-	RestoreInterchainAccount(ctx context.Context, in *MsgRestoreInterchainAccount, opts ...grpc.CallOption) (*MsgRestoreInterchainAccountResponse, error)
-	UpdateValidatorSharesExchRate(ctx context.Context, in *MsgUpdateValidatorSharesExchRate, opts ...grpc.CallOption) (*MsgUpdateValidatorSharesExchRateResponse, error)
-	ClearBalance(ctx context.Context, in *MsgClearBalance, opts ...grpc.CallOption) (*MsgClearBalanceResponse, error)
+func (m *MsgUpdateHostZoneSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUpdateHostZoneSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateHostZoneSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgUpdateHostZoneSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateHostZoneSchedule.Merge(m, src)
+}
+func (m *MsgUpdateHostZoneSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateHostZoneSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateHostZoneSchedule.DiscardUnknown(m)
 }
 
-func (c *msgClient) LiquidStake(ctx context.Context, in *MsgLiquidStake, opts ...grpc.CallOption) (*MsgLiquidStakeResponse, error) {
-	out := new(MsgLiquidStakeResponse)
-	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Msg/LiquidStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateHostZoneSchedule proto.InternalMessageInfo
+
+func (m *MsgUpdateHostZoneSchedule) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RedeemStake(ctx context.Context, in *MsgRedeemStake, opts ...grpc.CallOption) (*MsgRedeemStakeResponse, error) {
-	out := new(MsgRedeemStakeResponse)
-	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Msg/RedeemStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateHostZoneSchedule) GetHostZone() string {
+	if m != nil {
+		return m.HostZone
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RegisterHostZone(ctx context.Context, in *MsgRegisterHostZone, opts ...grpc.CallOption) (*MsgRegisterHostZoneResponse, error) {
-	out := new(MsgRegisterHostZoneResponse)
-	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Msg/RegisterHostZone", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateHostZoneSchedule) GetPipeline() string {
+	if m != nil {
+		return m.Pipeline
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ClaimUndelegatedTokens(ctx context.Context, in *MsgClaimUndelegatedTokens, opts ...grpc.CallOption) (*MsgClaimUndelegatedTokensResponse, error) {
-	out := new(MsgClaimUndelegatedTokensResponse)
-	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Msg/ClaimUndelegatedTokens", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateHostZoneSchedule) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) RebalanceValidators(ctx context.Context, in *MsgRebalanceValidators, opts ...grpc.CallOption) (*MsgRebalanceValidatorsResponse, error) {
-	out := new(MsgRebalanceValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Msg/RebalanceValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateHostZoneSchedule) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) AddValidator(ctx context.Context, in *MsgAddValidator, opts ...grpc.CallOption) (*MsgAddValidatorResponse, error) {
-	out := new(MsgAddValidatorResponse)
-	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Msg/AddValidator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgUpdateHostZoneScheduleResponse struct {
 }
 
-func (c *msgClient) ChangeValidatorWeight(ctx context.Context, in *MsgChangeValidatorWeight, opts ...grpc.CallOption) (*MsgChangeValidatorWeightResponse, error) {
-	out := new(MsgChangeValidatorWeightResponse)
-	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Msg/ChangeValidatorWeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateHostZoneScheduleResponse) Reset()         { *m = MsgUpdateHostZoneScheduleResponse{} }
+func (m *MsgUpdateHostZoneScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateHostZoneScheduleResponse) ProtoMessage()    {}
+func (*MsgUpdateHostZoneScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e80cdc2de072d1f1, []int{23}
+}
+func (m *MsgUpdateHostZoneScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateHostZoneScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateHostZoneScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateHostZoneScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateHostZoneScheduleResponse.Merge(m, src)
+}
+func (m *MsgUpdateHostZoneScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateHostZoneScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateHostZoneScheduleResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) DeleteValidator(ctx context.Context, in *MsgDeleteValidator, opts ...grpc.CallOption) (*MsgDeleteValidatorResponse, error) {
-	out := new(MsgDeleteValidatorResponse)
-	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Msg/DeleteValidator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgUpdateHostZoneScheduleResponse proto.InternalMessageInfo
+
+// Queries the withdrawal address of the host zone's delegation ICA, and re-sets it to the
+// withdrawal ICA if it differs
+type MsgSetWithdrawalAddress struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	HostZone string `protobuf:"bytes,2,opt,name=hostZone,proto3" json:"hostZone,omitempty"`
 }
 
-func (c *msgClient) RestoreInterchainAccount(ctx context.Context, in *MsgRestoreInterchainAccount, opts ...grpc.CallOption) (*MsgRestoreInterchainAccountResponse, error) {
-	out := new(MsgRestoreInterchainAccountResponse)
-	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Msg/RestoreInterchainAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetWithdrawalAddress) Reset()         { *m = MsgSetWithdrawalAddress{} }
+func (m *MsgSetWithdrawalAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgSetWithdrawalAddress) ProtoMessage()    {}
+func (*MsgSetWithdrawalAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e80cdc2de072d1f1, []int{24}
+}
+func (m *MsgSetWithdrawalAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetWithdrawalAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetWithdrawalAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetWithdrawalAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetWithdrawalAddress.Merge(m, src)
+}
+func (m *MsgSetWithdrawalAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetWithdrawalAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetWithdrawalAddress.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateValidatorSharesExchRate(ctx context.Context, in *MsgUpdateValidatorSharesExchRate, opts ...grpc.CallOption) (*MsgUpdateValidatorSharesExchRateResponse, error) {
-	out := new(MsgUpdateValidatorSharesExchRateResponse)
-	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Msg/UpdateValidatorSharesExchRate", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetWithdrawalAddress proto.InternalMessageInfo
+
+func (m *MsgSetWithdrawalAddress) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ClearBalance(ctx context.Context, in *MsgClearBalance, opts ...grpc.CallOption) (*MsgClearBalanceResponse, error) {
-	out := new(MsgClearBalanceResponse)
-	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Msg/ClearBalance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetWithdrawalAddress) GetHostZone() string {
+	if m != nil {
+		return m.HostZone
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	LiquidStake(context.Context, *MsgLiquidStake) (*MsgLiquidStakeResponse, error)
-	RedeemStake(context.Context, *MsgRedeemStake) (*MsgRedeemStakeResponse, error)
-	// TODO(TEST-53): Remove this pre-launch (no need for clients to create / interact with ICAs)
-	RegisterHostZone(context.Context, *MsgRegisterHostZone) (*MsgRegisterHostZoneResponse, error)
-	ClaimUndelegatedTokens(context.Context, *MsgClaimUndelegatedTokens) (*MsgClaimUndelegatedTokensResponse, error)
-	RebalanceValidators(context.Context, *MsgRebalanceValidators) (*MsgRebalanceValidatorsResponse, error)
-	AddValidator(context.Context, *MsgAddValidator) (*MsgAddValidatorResponse, error)
-	ChangeValidatorWeight(context.Context, *MsgChangeValidatorWeight) (*MsgChangeValidatorWeightResponse, error)
-	DeleteValidator(context.Context, *MsgDeleteValidator) (*MsgDeleteValidatorResponse, error)
-	RestoreInterchainAccount(context.Context, *MsgRestoreInterchainAccount) (*MsgRestoreInterchainAccountResponse, error)
-	UpdateValidatorSharesExchRate(context.Context, *MsgUpdateValidatorSharesExchRate) (*MsgUpdateValidatorSharesExchRateResponse, error)
-	ClearBalance(context.Context, *MsgClearBalance) (*MsgClearBalanceResponse, error)
+type MsgSetWithdrawalAddressResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgSetWithdrawalAddressResponse) Reset()         { *m = MsgSetWithdrawalAddressResponse{} }
+func (m *MsgSetWithdrawalAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetWithdrawalAddressResponse) ProtoMessage()    {}
+func (*MsgSetWithdrawalAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e80cdc2de072d1f1, []int{25}
 }
-
-func (*UnimplementedMsgServer) LiquidStake(ctx context.Context, req *MsgLiquidStake) (*MsgLiquidStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidStake not implemented")
+func (m *MsgSetWithdrawalAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) RedeemStake(ctx context.Context, req *MsgRedeemStake) (*MsgRedeemStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RedeemStake not implemented")
+func (m *MsgSetWithdrawalAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetWithdrawalAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RegisterHostZone(ctx context.Context, req *MsgRegisterHostZone) (*MsgRegisterHostZoneResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterHostZone not implemented")
+func (m *MsgSetWithdrawalAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetWithdrawalAddressResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) ClaimUndelegatedTokens(ctx context.Context, req *MsgClaimUndelegatedTokens) (*MsgClaimUndelegatedTokensResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimUndelegatedTokens not implemented")
+func (m *MsgSetWithdrawalAddressResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) RebalanceValidators(ctx context.Context, req *MsgRebalanceValidators) (*MsgRebalanceValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RebalanceValidators not implemented")
+func (m *MsgSetWithdrawalAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetWithdrawalAddressResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) AddValidator(ctx context.Context, req *MsgAddValidator) (*MsgAddValidatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddValidator not implemented")
+
+var xxx_messageInfo_MsgSetWithdrawalAddressResponse proto.InternalMessageInfo
+
+// Starts sunsetting a host zone: liquid stakes are rejected, the remaining stake is undelegated,
+// and the zone is removed once every stToken has been redeemed and claimed
+type MsgWindDownHostZone struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	HostZone string `protobuf:"bytes,2,opt,name=hostZone,proto3" json:"hostZone,omitempty"`
 }
-func (*UnimplementedMsgServer) ChangeValidatorWeight(ctx context.Context, req *MsgChangeValidatorWeight) (*MsgChangeValidatorWeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeValidatorWeight not implemented")
+
+func (m *MsgWindDownHostZone) Reset()         { *m = MsgWindDownHostZone{} }
+func (m *MsgWindDownHostZone) String() string { return proto.CompactTextString(m) }
+func (*MsgWindDownHostZone) ProtoMessage()    {}
+func (*MsgWindDownHostZone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e80cdc2de072d1f1, []int{26}
 }
-func (*UnimplementedMsgServer) DeleteValidator(ctx context.Context, req *MsgDeleteValidator) (*MsgDeleteValidatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteValidator not implemented")
+func (m *MsgWindDownHostZone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) RestoreInterchainAccount(ctx context.Context, req *MsgRestoreInterchainAccount) (*MsgRestoreInterchainAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RestoreInterchainAccount not implemented")
+func (m *MsgWindDownHostZone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWindDownHostZone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateValidatorSharesExchRate(ctx context.Context, req *MsgUpdateValidatorSharesExchRate) (*MsgUpdateValidatorSharesExchRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateValidatorSharesExchRate not implemented")
+func (m *MsgWindDownHostZone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWindDownHostZone.Merge(m, src)
 }
-func (*UnimplementedMsgServer) ClearBalance(ctx context.Context, req *MsgClearBalance) (*MsgClearBalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClearBalance not implemented")
+func (m *MsgWindDownHostZone) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgWindDownHostZone) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWindDownHostZone.DiscardUnknown(m)
 }
 
-func _Msg_LiquidStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLiquidStake)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).LiquidStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/Stridelabs.stride.stakeibc.Msg/LiquidStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).LiquidStake(ctx, req.(*MsgLiquidStake))
+var xxx_messageInfo_MsgWindDownHostZone proto.InternalMessageInfo
+
+func (m *MsgWindDownHostZone) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RedeemStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRedeemStake)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RedeemStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/Stridelabs.stride.stakeibc.Msg/RedeemStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RedeemStake(ctx, req.(*MsgRedeemStake))
+func (m *MsgWindDownHostZone) GetHostZone() string {
+	if m != nil {
+		return m.HostZone
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RegisterHostZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterHostZone)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterHostZone(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/Stridelabs.stride.stakeibc.Msg/RegisterHostZone",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterHostZone(ctx, req.(*MsgRegisterHostZone))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgWindDownHostZoneResponse struct {
 }
 
-func _Msg_ClaimUndelegatedTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaimUndelegatedTokens)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClaimUndelegatedTokens(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/Stridelabs.stride.stakeibc.Msg/ClaimUndelegatedTokens",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClaimUndelegatedTokens(ctx, req.(*MsgClaimUndelegatedTokens))
+func (m *MsgWindDownHostZoneResponse) Reset()         { *m = MsgWindDownHostZoneResponse{} }
+func (m *MsgWindDownHostZoneResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWindDownHostZoneResponse) ProtoMessage()    {}
+func (*MsgWindDownHostZoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e80cdc2de072d1f1, []int{27}
+}
+func (m *MsgWindDownHostZoneResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWindDownHostZoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWindDownHostZoneResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgWindDownHostZoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWindDownHostZoneResponse.Merge(m, src)
+}
+func (m *MsgWindDownHostZoneResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWindDownHostZoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWindDownHostZoneResponse.DiscardUnknown(m)
 }
 
-func _Msg_RebalanceValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRebalanceValidators)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RebalanceValidators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/Stridelabs.stride.stakeibc.Msg/RebalanceValidators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RebalanceValidators(ctx, req.(*MsgRebalanceValidators))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgWindDownHostZoneResponse proto.InternalMessageInfo
+
+// Overrides how far a host zone's light client can fall behind before the
+// pipelines that depend on the host's time are skipped (0 uses the module param)
+type MsgUpdateMaxLightClientAge struct {
+	Creator                string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	HostZone               string `protobuf:"bytes,2,opt,name=hostZone,proto3" json:"hostZone,omitempty"`
+	MaxLightClientAgeNanos uint64 `protobuf:"varint,3,opt,name=maxLightClientAgeNanos,proto3" json:"maxLightClientAgeNanos,omitempty"`
 }
 
-func _Msg_AddValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddValidator)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddValidator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/Stridelabs.stride.stakeibc.Msg/AddValidator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddValidator(ctx, req.(*MsgAddValidator))
+func (m *MsgUpdateMaxLightClientAge) Reset()         { *m = MsgUpdateMaxLightClientAge{} }
+func (m *MsgUpdateMaxLightClientAge) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMaxLightClientAge) ProtoMessage()    {}
+func (*MsgUpdateMaxLightClientAge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e80cdc2de072d1f1, []int{28}
+}
+func (m *MsgUpdateMaxLightClientAge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMaxLightClientAge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMaxLightClientAge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateMaxLightClientAge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMaxLightClientAge.Merge(m, src)
+}
+func (m *MsgUpdateMaxLightClientAge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMaxLightClientAge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMaxLightClientAge.DiscardUnknown(m)
 }
 
-func _Msg_ChangeValidatorWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgChangeValidatorWeight)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ChangeValidatorWeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/Stridelabs.stride.stakeibc.Msg/ChangeValidatorWeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ChangeValidatorWeight(ctx, req.(*MsgChangeValidatorWeight))
+var xxx_messageInfo_MsgUpdateMaxLightClientAge proto.InternalMessageInfo
+
+func (m *MsgUpdateMaxLightClientAge) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_DeleteValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteValidator)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteValidator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/Stridelabs.stride.stakeibc.Msg/DeleteValidator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteValidator(ctx, req.(*MsgDeleteValidator))
+func (m *MsgUpdateMaxLightClientAge) GetHostZone() string {
+	if m != nil {
+		return m.HostZone
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RestoreInterchainAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRestoreInterchainAccount)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RestoreInterchainAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/Stridelabs.stride.stakeibc.Msg/RestoreInterchainAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RestoreInterchainAccount(ctx, req.(*MsgRestoreInterchainAccount))
+func (m *MsgUpdateMaxLightClientAge) GetMaxLightClientAgeNanos() uint64 {
+	if m != nil {
+		return m.MaxLightClientAgeNanos
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpdateValidatorSharesExchRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateValidatorSharesExchRate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateValidatorSharesExchRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/Stridelabs.stride.stakeibc.Msg/UpdateValidatorSharesExchRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateValidatorSharesExchRate(ctx, req.(*MsgUpdateValidatorSharesExchRate))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgUpdateMaxLightClientAgeResponse struct {
 }
 
-func _Msg_ClearBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClearBalance)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClearBalance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/Stridelabs.stride.stakeibc.Msg/ClearBalance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClearBalance(ctx, req.(*MsgClearBalance))
+func (m *MsgUpdateMaxLightClientAgeResponse) Reset()         { *m = MsgUpdateMaxLightClientAgeResponse{} }
+func (m *MsgUpdateMaxLightClientAgeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMaxLightClientAgeResponse) ProtoMessage()    {}
+func (*MsgUpdateMaxLightClientAgeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e80cdc2de072d1f1, []int{29}
+}
+func (m *MsgUpdateMaxLightClientAgeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMaxLightClientAgeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMaxLightClientAgeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateMaxLightClientAgeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMaxLightClientAgeResponse.Merge(m, src)
+}
+func (m *MsgUpdateMaxLightClientAgeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMaxLightClientAgeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMaxLightClientAgeResponse.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "Stridelabs.stride.stakeibc.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "LiquidStake",
-			Handler:    _Msg_LiquidStake_Handler,
-		},
-		{
-			MethodName: "RedeemStake",
-			Handler:    _Msg_RedeemStake_Handler,
-		},
-		{
-			MethodName: "RegisterHostZone",
-			Handler:    _Msg_RegisterHostZone_Handler,
-		},
-		{
-			MethodName: "ClaimUndelegatedTokens",
-			Handler:    _Msg_ClaimUndelegatedTokens_Handler,
-		},
-		{
-			MethodName: "RebalanceValidators",
-			Handler:    _Msg_RebalanceValidators_Handler,
-		},
-		{
-			MethodName: "AddValidator",
-			Handler:    _Msg_AddValidator_Handler,
-		},
-		{
-			MethodName: "ChangeValidatorWeight",
-			Handler:    _Msg_ChangeValidatorWeight_Handler,
-		},
-		{
-			MethodName: "DeleteValidator",
-			Handler:    _Msg_DeleteValidator_Handler,
-		},
-		{
-			MethodName: "RestoreInterchainAccount",
-			Handler:    _Msg_RestoreInterchainAccount_Handler,
-		},
-		{
-			MethodName: "UpdateValidatorSharesExchRate",
-			Handler:    _Msg_UpdateValidatorSharesExchRate_Handler,
-		},
-		{
-			MethodName: "ClearBalance",
-			Handler:    _Msg_ClearBalance_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "stakeibc/tx.proto",
-}
+var xxx_messageInfo_MsgUpdateMaxLightClientAgeResponse proto.InternalMessageInfo
 
-func (m *MsgLiquidStake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterType((*MsgLiquidStake)(nil), "Stridelabs.stride.stakeibc.MsgLiquidStake")
+	proto.RegisterType((*MsgLiquidStakeResponse)(nil), "Stridelabs.stride.stakeibc.MsgLiquidStakeResponse")
+	proto.RegisterType((*MsgClearBalance)(nil), "Stridelabs.stride.stakeibc.MsgClearBalance")
+	proto.RegisterType((*MsgClearBalanceResponse)(nil), "Stridelabs.stride.stakeibc.MsgClearBalanceResponse")
+	proto.RegisterType((*MsgRedeemStake)(nil), "Stridelabs.stride.stakeibc.MsgRedeemStake")
+	proto.RegisterType((*MsgRedeemStakeResponse)(nil), "Stridelabs.stride.stakeibc.MsgRedeemStakeResponse")
+	proto.RegisterType((*MsgRegisterHostZone)(nil), "Stridelabs.stride.stakeibc.MsgRegisterHostZone")
+	proto.RegisterType((*MsgRegisterHostZoneResponse)(nil), "Stridelabs.stride.stakeibc.MsgRegisterHostZoneResponse")
+	proto.RegisterType((*MsgClaimUndelegatedTokens)(nil), "Stridelabs.stride.stakeibc.MsgClaimUndelegatedTokens")
+	proto.RegisterType((*MsgClaimUndelegatedTokensResponse)(nil), "Stridelabs.stride.stakeibc.MsgClaimUndelegatedTokensResponse")
+	proto.RegisterType((*MsgRebalanceValidators)(nil), "Stridelabs.stride.stakeibc.MsgRebalanceValidators")
+	proto.RegisterType((*MsgRebalanceValidatorsResponse)(nil), "Stridelabs.stride.stakeibc.MsgRebalanceValidatorsResponse")
+	proto.RegisterType((*MsgAddValidator)(nil), "Stridelabs.stride.stakeibc.MsgAddValidator")
+	proto.RegisterType((*MsgAddValidatorResponse)(nil), "Stridelabs.stride.stakeibc.MsgAddValidatorResponse")
+	proto.RegisterType((*MsgChangeValidatorWeight)(nil), "Stridelabs.stride.stakeibc.MsgChangeValidatorWeight")
+	proto.RegisterType((*MsgChangeValidatorWeightResponse)(nil), "Stridelabs.stride.stakeibc.MsgChangeValidatorWeightResponse")
+	proto.RegisterType((*MsgDeleteValidator)(nil), "Stridelabs.stride.stakeibc.MsgDeleteValidator")
+	proto.RegisterType((*MsgDeleteValidatorResponse)(nil), "Stridelabs.stride.stakeibc.MsgDeleteValidatorResponse")
+	proto.RegisterType((*MsgRestoreInterchainAccount)(nil), "Stridelabs.stride.stakeibc.MsgRestoreInterchainAccount")
+	proto.RegisterType((*MsgRestoreInterchainAccountResponse)(nil), "Stridelabs.stride.stakeibc.MsgRestoreInterchainAccountResponse")
+	proto.RegisterType((*MsgUpdateValidatorSharesExchRate)(nil), "Stridelabs.stride.stakeibc.MsgUpdateValidatorSharesExchRate")
+	proto.RegisterType((*MsgUpdateValidatorSharesExchRateResponse)(nil), "Stridelabs.stride.stakeibc.MsgUpdateValidatorSharesExchRateResponse")
+	proto.RegisterType((*MsgUpdateHostZoneSchedule)(nil), "Stridelabs.stride.stakeibc.MsgUpdateHostZoneSchedule")
+	proto.RegisterType((*MsgUpdateHostZoneScheduleResponse)(nil), "Stridelabs.stride.stakeibc.MsgUpdateHostZoneScheduleResponse")
+	proto.RegisterType((*MsgSetWithdrawalAddress)(nil), "Stridelabs.stride.stakeibc.MsgSetWithdrawalAddress")
+	proto.RegisterType((*MsgSetWithdrawalAddressResponse)(nil), "Stridelabs.stride.stakeibc.MsgSetWithdrawalAddressResponse")
+	proto.RegisterType((*MsgWindDownHostZone)(nil), "Stridelabs.stride.stakeibc.MsgWindDownHostZone")
+	proto.RegisterType((*MsgWindDownHostZoneResponse)(nil), "Stridelabs.stride.stakeibc.MsgWindDownHostZoneResponse")
+	proto.RegisterType((*MsgUpdateMaxLightClientAge)(nil), "Stridelabs.stride.stakeibc.MsgUpdateMaxLightClientAge")
+	proto.RegisterType((*MsgUpdateMaxLightClientAgeResponse)(nil), "Stridelabs.stride.stakeibc.MsgUpdateMaxLightClientAgeResponse")
 }
 
-func (m *MsgLiquidStake) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+func init() { proto.RegisterFile("stakeibc/tx.proto", fileDescriptor_e80cdc2de072d1f1) }
 
-func (m *MsgLiquidStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.HostDenom) > 0 {
-		i -= len(m.HostDenom)
-		copy(dAtA[i:], m.HostDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HostDenom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var fileDescriptor_e80cdc2de072d1f1 = []byte{
+	// 1456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x6f, 0xd4, 0xc6,
+	0x17, 0x8f, 0xc9, 0x92, 0x84, 0x47, 0x12, 0xc0, 0x09, 0xc1, 0xf1, 0x17, 0x76, 0x83, 0xf9, 0x7e,
+	0xbf, 0x8a, 0x40, 0xec, 0xaa, 0x09, 0x3f, 0x24, 0x5a, 0xda, 0xe6, 0x07, 0x88, 0x55, 0x13, 0x90,
+	0x1c, 0x28, 0x12, 0x97, 0xd5, 0xac, 0x3d, 0xf1, 0x5a, 0xf1, 0xce, 0x18, 0x8f, 0x17, 0xb2, 0x52,
+	0xd5, 0x4b, 0x55, 0x09, 0xb5, 0x52, 0x55, 0xa4, 0x1e, 0x2b, 0x95, 0xaa, 0x87, 0x56, 0x3d, 0xf7,
+	0x7f, 0x28, 0x47, 0xc4, 0xa9, 0xea, 0x61, 0x55, 0xc1, 0xa5, 0xe7, 0xfc, 0x05, 0x95, 0xed, 0xf1,
+	0xac, 0xbd, 0xd9, 0x5d, 0x27, 0x46, 0xea, 0x69, 0xf7, 0xcd, 0x7b, 0x9f, 0xf7, 0x3e, 0xef, 0xcd,
+	0xbc, 0x79, 0x23, 0xc3, 0x29, 0xe6, 0xa3, 0x1d, 0x6c, 0xd7, 0x8d, 0x8a, 0xbf, 0x5b, 0x76, 0x3d,
+	0xea, 0x53, 0x59, 0xdd, 0xf2, 0x3d, 0xdb, 0xc4, 0x0e, 0xaa, 0xb3, 0x32, 0x0b, 0xff, 0x96, 0x63,
+	0x23, 0xf5, 0xac, 0x30, 0xc7, 0x2e, 0x35, 0x1a, 0x35, 0xdf, 0x43, 0xc6, 0x0e, 0xf6, 0x22, 0xa4,
+	0xaa, 0x0a, 0xad, 0x6d, 0xa0, 0x1a, 0x32, 0x0c, 0xda, 0x22, 0x3e, 0xd7, 0xcd, 0x5a, 0xd4, 0xa2,
+	0xe1, 0xdf, 0x4a, 0xf0, 0x8f, 0xaf, 0xce, 0x5b, 0x94, 0x5a, 0x0e, 0xae, 0x84, 0x52, 0xbd, 0xb5,
+	0x5d, 0x41, 0xa4, 0x1d, 0xab, 0x0c, 0xca, 0x9a, 0x94, 0xd5, 0x22, 0x4c, 0x24, 0x44, 0x2a, 0xed,
+	0xb9, 0x04, 0xd3, 0x9b, 0xcc, 0xda, 0xb0, 0x1f, 0xb7, 0x6c, 0x73, 0x2b, 0x88, 0x29, 0x2b, 0x30,
+	0x6e, 0x78, 0x18, 0xf9, 0xd4, 0x53, 0xa4, 0x05, 0x69, 0xf1, 0x98, 0x1e, 0x8b, 0xf2, 0x6d, 0x18,
+	0x43, 0xcd, 0x80, 0x88, 0x72, 0x24, 0x50, 0xac, 0x96, 0x5f, 0x76, 0x4a, 0x23, 0x7f, 0x76, 0x4a,
+	0xff, 0xb7, 0x6c, 0xbf, 0xd1, 0xaa, 0x97, 0x0d, 0xda, 0xe4, 0xde, 0xf9, 0xcf, 0x65, 0x66, 0xee,
+	0x54, 0xfc, 0xb6, 0x8b, 0x59, 0xb9, 0x4a, 0x7c, 0x9d, 0xa3, 0xe5, 0x73, 0x00, 0x0d, 0xca, 0xfc,
+	0x9a, 0x89, 0x09, 0x6d, 0x2a, 0xa3, 0x61, 0x90, 0x63, 0xc1, 0xca, 0x7a, 0xb0, 0xa0, 0x29, 0x30,
+	0x97, 0xa6, 0xa4, 0x63, 0xe6, 0x52, 0xc2, 0xb0, 0xf6, 0x8b, 0x04, 0x27, 0x36, 0x99, 0xb5, 0xe6,
+	0x60, 0xe4, 0xad, 0x22, 0x07, 0x11, 0x63, 0x18, 0xdd, 0x79, 0x98, 0x30, 0x1a, 0xc8, 0x26, 0x35,
+	0xdb, 0x8c, 0x08, 0xeb, 0xe3, 0xa1, 0x5c, 0x35, 0x13, 0x99, 0x8c, 0xbe, 0x53, 0x26, 0x41, 0xf0,
+	0x06, 0x22, 0x04, 0x3b, 0x4a, 0x41, 0x44, 0x08, 0x44, 0x6d, 0x1e, 0xce, 0xf4, 0x30, 0x15, 0x59,
+	0xfc, 0x1a, 0xd5, 0x5c, 0xc7, 0x26, 0xc6, 0xcd, 0x7f, 0xab, 0xe6, 0x2a, 0x4c, 0x04, 0x15, 0x7e,
+	0x44, 0x09, 0xe6, 0x15, 0x17, 0x72, 0xa0, 0xf3, 0xb0, 0x81, 0xed, 0x27, 0xd8, 0xe3, 0x69, 0x08,
+	0x99, 0x6f, 0x46, 0x82, 0xab, 0x48, 0xe3, 0xe7, 0x02, 0xcc, 0x84, 0x2a, 0xcb, 0x66, 0x3e, 0xf6,
+	0xee, 0xc4, 0xde, 0x6e, 0xc2, 0x94, 0x41, 0x09, 0xc1, 0x86, 0x6f, 0xd3, 0x6e, 0xed, 0x57, 0x95,
+	0xbd, 0x4e, 0x69, 0xb6, 0x8d, 0x9a, 0xce, 0x0d, 0x2d, 0xa5, 0xd6, 0xf4, 0xc9, 0xae, 0x5c, 0x35,
+	0x65, 0x0d, 0x26, 0xeb, 0xd8, 0x68, 0x2c, 0x2f, 0xb9, 0x1e, 0xde, 0xb6, 0x77, 0x95, 0xc9, 0x90,
+	0x50, 0x6a, 0x4d, 0xbe, 0x92, 0x3a, 0x40, 0x21, 0xe5, 0xd5, 0xd3, 0x7b, 0x9d, 0xd2, 0xa9, 0xc8,
+	0x7f, 0x57, 0xa7, 0x25, 0xce, 0x95, 0xfc, 0x1e, 0x1c, 0xb3, 0xeb, 0x06, 0x07, 0x1d, 0x0d, 0x41,
+	0xb3, 0x7b, 0x9d, 0xd2, 0xc9, 0x08, 0x24, 0x54, 0x9a, 0x3e, 0x61, 0xd7, 0x8d, 0x08, 0x92, 0xd8,
+	0x97, 0xb1, 0xf4, 0xbe, 0xdc, 0x85, 0x19, 0xdf, 0x43, 0x84, 0x6d, 0x63, 0xaf, 0xc6, 0xf7, 0x3c,
+	0xc8, 0x15, 0x42, 0xb7, 0xc5, 0xbd, 0x4e, 0x49, 0x8d, 0xdc, 0xf6, 0x31, 0xd2, 0xf4, 0x53, 0xf1,
+	0xea, 0x5a, 0xb4, 0x58, 0x35, 0xe5, 0x7b, 0x30, 0xd3, 0x22, 0x75, 0x4a, 0x4c, 0x9b, 0x58, 0xb5,
+	0x6d, 0x0f, 0x3f, 0x6e, 0x61, 0x62, 0xb4, 0x95, 0xe3, 0x0b, 0xd2, 0x62, 0x21, 0xe9, 0xaf, 0x8f,
+	0x91, 0xa6, 0xcb, 0x62, 0xf5, 0x76, 0xbc, 0x28, 0x7f, 0x0c, 0xd3, 0x61, 0x3a, 0x35, 0xbc, 0xeb,
+	0x52, 0x82, 0x89, 0xaf, 0x4c, 0x2d, 0x48, 0x8b, 0x53, 0xab, 0xf3, 0x7b, 0x9d, 0xd2, 0xe9, 0xc8,
+	0x57, 0x5a, 0xaf, 0xe9, 0x53, 0xe1, 0xc2, 0x2d, 0x2e, 0x07, 0x1b, 0x69, 0xda, 0xcc, 0x75, 0x50,
+	0x9b, 0xd7, 0x6c, 0xba, 0x77, 0x23, 0x53, 0x6a, 0x4d, 0x9f, 0xe4, 0x72, 0x58, 0xbb, 0x1b, 0x13,
+	0xcf, 0x5e, 0x94, 0x46, 0xfe, 0x7e, 0x51, 0x1a, 0xd1, 0xce, 0xc1, 0x7f, 0xfa, 0x1c, 0x14, 0x71,
+	0x90, 0xbe, 0x90, 0x60, 0x3e, 0xec, 0x15, 0x64, 0x37, 0x1f, 0x10, 0x13, 0x3b, 0xd8, 0x42, 0x3e,
+	0x36, 0xef, 0xd3, 0x1d, 0x4c, 0xd8, 0x90, 0xd6, 0x28, 0x46, 0xa7, 0x20, 0xf0, 0x55, 0x8d, 0x3b,
+	0x3c, 0xb1, 0x22, 0xcf, 0xc2, 0xd1, 0xf0, 0x6a, 0x0d, 0xcf, 0x7b, 0x41, 0x8f, 0x04, 0x79, 0x0e,
+	0xc6, 0x18, 0x26, 0xa6, 0x38, 0xea, 0x5c, 0xd2, 0x2e, 0xc0, 0xf9, 0x81, 0x24, 0x04, 0x55, 0x8f,
+	0x77, 0x43, 0x3d, 0x6a, 0xe9, 0x4f, 0x91, 0x63, 0x9b, 0x01, 0x97, 0x61, 0x34, 0x93, 0x9d, 0x77,
+	0xa4, 0xa7, 0xf3, 0x34, 0x98, 0x24, 0xad, 0xa6, 0xf0, 0xc7, 0x99, 0xa6, 0xd6, 0xb4, 0x05, 0x28,
+	0xf6, 0x8f, 0x29, 0x58, 0xfd, 0x1e, 0x5d, 0x8b, 0x2b, 0xa6, 0x29, 0x94, 0x39, 0xf9, 0xc8, 0x50,
+	0x20, 0xa8, 0x19, 0xdf, 0x10, 0xe1, 0x7f, 0x79, 0x09, 0xc6, 0x91, 0x69, 0x7a, 0x98, 0x31, 0xde,
+	0x69, 0xca, 0xeb, 0xdf, 0x2e, 0xcf, 0xf2, 0x29, 0xb2, 0x12, 0x69, 0x82, 0x39, 0x47, 0x2c, 0x3d,
+	0x36, 0x0c, 0xb6, 0xc6, 0xa0, 0xcd, 0xa6, 0xcd, 0x98, 0x4d, 0x49, 0xd8, 0x6b, 0x05, 0x3d, 0xb1,
+	0x12, 0x6c, 0xc2, 0x53, 0x6c, 0x5b, 0x0d, 0x3f, 0x6c, 0xab, 0x82, 0xce, 0x25, 0x7e, 0x6b, 0x26,
+	0x13, 0x11, 0x49, 0x7e, 0x2f, 0x81, 0x12, 0x6c, 0x50, 0x03, 0x11, 0xab, 0x5b, 0x84, 0x87, 0x21,
+	0x2e, 0x67, 0xb6, 0x4b, 0x30, 0xfe, 0x04, 0x39, 0x41, 0x0a, 0xca, 0x68, 0x56, 0x66, 0xdc, 0x30,
+	0xc1, 0xbc, 0x90, 0x62, 0xae, 0xc1, 0xc2, 0x20, 0x76, 0x22, 0x85, 0xcf, 0x41, 0xde, 0x64, 0xd6,
+	0x3a, 0x76, 0xb0, 0x8f, 0xdf, 0x75, 0xa7, 0x72, 0x70, 0xd7, 0xce, 0x82, 0xba, 0x3f, 0xbe, 0x60,
+	0xf7, 0x83, 0xc4, 0xdb, 0x94, 0xf9, 0xd4, 0xc3, 0x55, 0xe2, 0x63, 0x2f, 0x1c, 0x97, 0x2b, 0xd1,
+	0xe3, 0x63, 0x08, 0x4f, 0x05, 0xe2, 0xc1, 0xda, 0x3b, 0x67, 0x37, 0xe0, 0x38, 0x7f, 0xbb, 0xdc,
+	0x6f, 0xbb, 0xd1, 0xb1, 0x9a, 0x5e, 0xba, 0x58, 0x1e, 0xfc, 0x2c, 0x2a, 0x57, 0xd7, 0x56, 0x56,
+	0xba, 0x08, 0x3d, 0x09, 0xd7, 0xfe, 0x07, 0x17, 0x86, 0x10, 0x14, 0x89, 0xb8, 0xe1, 0x56, 0x3c,
+	0x70, 0x4d, 0x94, 0x48, 0x73, 0xab, 0x81, 0x3c, 0xcc, 0x6e, 0xed, 0x1a, 0x0d, 0x1d, 0xf9, 0x38,
+	0x57, 0x32, 0x4a, 0x58, 0x72, 0xea, 0x62, 0x5e, 0x72, 0x3d, 0x16, 0xb5, 0x8b, 0xb0, 0x98, 0x15,
+	0x51, 0xb0, 0xfb, 0x31, 0xba, 0xed, 0x22, 0xe3, 0xf8, 0x2e, 0xdc, 0x32, 0x1a, 0xd8, 0x6c, 0x39,
+	0x38, 0xe7, 0x61, 0x50, 0x61, 0xc2, 0xb5, 0x5d, 0xec, 0xd8, 0xdd, 0xe1, 0x1e, 0xcb, 0x81, 0xce,
+	0x0e, 0x4a, 0xf5, 0x04, 0x39, 0xfc, 0xc8, 0x0a, 0x39, 0x38, 0xcc, 0x74, 0x7b, 0x9b, 0x61, 0x9f,
+	0xb7, 0x28, 0x97, 0xf8, 0x5d, 0xd8, 0x9f, 0xa2, 0x48, 0xe4, 0x5e, 0xd8, 0xab, 0x5b, 0xd8, 0x7f,
+	0x68, 0xfb, 0x0d, 0xd3, 0x43, 0x4f, 0xa3, 0x53, 0x16, 0xb4, 0x7f, 0xae, 0x2c, 0xb4, 0xf3, 0x50,
+	0x1a, 0xe0, 0x50, 0xc4, 0xfc, 0x24, 0x7c, 0x72, 0x3c, 0xb4, 0x89, 0xb9, 0x4e, 0x9f, 0x12, 0xf1,
+	0xe4, 0xc8, 0x17, 0x2f, 0x1a, 0x4b, 0xbd, 0xce, 0x44, 0xac, 0xaf, 0x24, 0x50, 0x45, 0x15, 0x36,
+	0xd1, 0xee, 0x46, 0xd0, 0xcb, 0x6b, 0x8e, 0x8d, 0x89, 0xbf, 0x62, 0xe5, 0xdd, 0xa9, 0x6b, 0x30,
+	0xd7, 0xec, 0x75, 0x75, 0x17, 0x11, 0xca, 0xf8, 0xd5, 0x3f, 0x40, 0xab, 0xfd, 0x17, 0xb4, 0xc1,
+	0x5c, 0x62, 0xca, 0x4b, 0xaf, 0x4f, 0xc0, 0xe8, 0x26, 0xb3, 0xe4, 0x26, 0x1c, 0x4f, 0xbe, 0xe8,
+	0x87, 0x36, 0x5c, 0xfa, 0xa9, 0xad, 0x2e, 0x1d, 0xdc, 0x36, 0x0e, 0x1b, 0x84, 0x4b, 0x3e, 0x66,
+	0xb3, 0xc2, 0x25, 0x6c, 0xd5, 0xa5, 0x83, 0xdb, 0x8a, 0x70, 0x9f, 0xc1, 0xc9, 0x7d, 0x8f, 0xce,
+	0x4a, 0xa6, 0x9f, 0x34, 0x40, 0xbd, 0x7e, 0x48, 0x80, 0x88, 0xfe, 0x8d, 0x04, 0x73, 0x03, 0x9e,
+	0x2a, 0x57, 0x33, 0x7c, 0xf6, 0x87, 0xa9, 0x37, 0x73, 0xc1, 0x04, 0xa1, 0x2f, 0x25, 0x98, 0xe9,
+	0xf7, 0x22, 0xc9, 0x2e, 0xed, 0x3e, 0x8c, 0x7a, 0xe3, 0xf0, 0x18, 0xc1, 0xc3, 0x85, 0xc9, 0xd4,
+	0x0b, 0xe4, 0x52, 0x86, 0xaf, 0xa4, 0xb1, 0xba, 0x7c, 0x08, 0x63, 0x11, 0xf1, 0x6b, 0x09, 0x4e,
+	0xf7, 0x7f, 0x0f, 0x5c, 0xc9, 0x2a, 0x69, 0x3f, 0x94, 0xfa, 0x41, 0x1e, 0x94, 0x60, 0xd3, 0x86,
+	0x13, 0xbd, 0xa3, 0xbd, 0x9c, 0xe1, 0xb0, 0xc7, 0x5e, 0xbd, 0x76, 0x38, 0x7b, 0x11, 0xfa, 0x3b,
+	0x09, 0x94, 0x81, 0x73, 0x3b, 0xfb, 0xa4, 0xf7, 0x07, 0xaa, 0x1f, 0xe5, 0x04, 0x0a, 0x5a, 0x3f,
+	0x49, 0x70, 0x6e, 0xf8, 0x18, 0xce, 0xaa, 0xf8, 0x50, 0xb4, 0xba, 0xfe, 0x2e, 0xe8, 0xe4, 0xb9,
+	0x4d, 0x7d, 0x50, 0xb8, 0x94, 0xd9, 0x8e, 0x5d, 0x63, 0x75, 0xf9, 0x10, 0xc6, 0xa9, 0x2b, 0x64,
+	0xc0, 0xfc, 0xbf, 0x7a, 0xa0, 0x94, 0x7a, 0x61, 0xea, 0xcd, 0x5c, 0x30, 0x41, 0xe8, 0x99, 0x04,
+	0xb3, 0x7d, 0x07, 0x79, 0x56, 0x7a, 0xfd, 0x40, 0xea, 0xfb, 0x39, 0x40, 0xc9, 0xcb, 0x7d, 0xdf,
+	0x78, 0xcf, 0xba, 0xdc, 0x7b, 0x01, 0xea, 0xf5, 0x43, 0x02, 0x44, 0xf4, 0xe7, 0x12, 0x9c, 0x19,
+	0x34, 0xf0, 0xaf, 0x1d, 0xa8, 0xc6, 0xfb, 0x70, 0xea, 0x87, 0xf9, 0x70, 0x31, 0xa7, 0xd5, 0x3b,
+	0x2f, 0xdf, 0x14, 0xa5, 0x57, 0x6f, 0x8a, 0xd2, 0x5f, 0x6f, 0x8a, 0xd2, 0xb7, 0x6f, 0x8b, 0x23,
+	0xaf, 0xde, 0x16, 0x47, 0xfe, 0x78, 0x5b, 0x1c, 0x79, 0x54, 0x4e, 0x7c, 0x03, 0x8a, 0x62, 0x5c,
+	0xde, 0x40, 0x75, 0x56, 0x89, 0x82, 0x54, 0x76, 0x2b, 0xdd, 0x2f, 0x92, 0xc1, 0xf7, 0xa0, 0xfa,
+	0x58, 0xf8, 0xcd, 0x6f, 0xf9, 0x9f, 0x01, 0x00, 0x57, 0xd5, 0x94, 0x9d, 0xaa, 0x14, 0x00, 0x00,
 }
 
-func (m *MsgLiquidStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	LiquidStake(ctx context.Context, in *MsgLiquidStake, opts ...grpc.CallOption) (*MsgLiquidStakeResponse, error)
+	RedeemStake(ctx context.Context, in *MsgRedeemStake, opts ...grpc.CallOption) (*MsgRedeemStakeResponse, error)
+	// TODO(TEST-53): Remove this pre-launch (no need for clients to create / interact with ICAs)
+	RegisterHostZone(ctx context.Context, in *MsgRegisterHostZone, opts ...grpc.CallOption) (*MsgRegisterHostZoneResponse, error)
+	ClaimUndelegatedTokens(ctx context.Context, in *MsgClaimUndelegatedTokens, opts ...grpc.CallOption) (*MsgClaimUndelegatedTokensResponse, error)
+	RebalanceValidators(ctx context.Context, in *MsgRebalanceValidators, opts ...grpc.CallOption) (*MsgRebalanceValidatorsResponse, error)
+	AddValidator(ctx context.Context, in *MsgAddValidator, opts ...grpc.CallOption) (*MsgAddValidatorResponse, error)
+	ChangeValidatorWeight(ctx context.Context, in *MsgChangeValidatorWeight, opts ...grpc.CallOption) (*MsgChangeValidatorWeightResponse, error)
+	DeleteValidator(ctx context.Context, in *MsgDeleteValidator, opts ...grpc.CallOption) (*MsgDeleteValidatorResponse, error)
+	RestoreInterchainAccount(ctx context.Context, in *MsgRestoreInterchainAccount, opts ...grpc.CallOption) (*MsgRestoreInterchainAccountResponse, error)
+	UpdateValidatorSharesExchRate(ctx context.Context, in *MsgUpdateValidatorSharesExchRate, opts ...grpc.CallOption) (*MsgUpdateValidatorSharesExchRateResponse, error)
+	ClearBalance(ctx context.Context, in *MsgClearBalance, opts ...grpc.CallOption) (*MsgClearBalanceResponse, error)
+	UpdateHostZoneSchedule(ctx context.Context, in *MsgUpdateHostZoneSchedule, opts ...grpc.CallOption) (*MsgUpdateHostZoneScheduleResponse, error)
+	SetWithdrawalAddress(ctx context.Context, in *MsgSetWithdrawalAddress, opts ...grpc.CallOption) (*MsgSetWithdrawalAddressResponse, error)
+	WindDownHostZone(ctx context.Context, in *MsgWindDownHostZone, opts ...grpc.CallOption) (*MsgWindDownHostZoneResponse, error)
+	UpdateMaxLightClientAge(ctx context.Context, in *MsgUpdateMaxLightClientAge, opts ...grpc.CallOption) (*MsgUpdateMaxLightClientAgeResponse, error)
 }
 
-func (m *MsgLiquidStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgLiquidStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *MsgClearBalance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) LiquidStake(ctx context.Context, in *MsgLiquidStake, opts ...grpc.CallOption) (*MsgLiquidStakeResponse, error) {
+	out := new(MsgLiquidStakeResponse)
+	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Msg/LiquidStake", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgClearBalance) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RedeemStake(ctx context.Context, in *MsgRedeemStake, opts ...grpc.CallOption) (*MsgRedeemStakeResponse, error) {
+	out := new(MsgRedeemStakeResponse)
+	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Msg/RedeemStake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgClearBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Channel) > 0 {
-		i -= len(m.Channel)
-		copy(dAtA[i:], m.Channel)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Channel)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
+func (c *msgClient) RegisterHostZone(ctx context.Context, in *MsgRegisterHostZone, opts ...grpc.CallOption) (*MsgRegisterHostZoneResponse, error) {
+	out := new(MsgRegisterHostZoneResponse)
+	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Msg/RegisterHostZone", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) ClaimUndelegatedTokens(ctx context.Context, in *MsgClaimUndelegatedTokens, opts ...grpc.CallOption) (*MsgClaimUndelegatedTokensResponse, error) {
+	out := new(MsgClaimUndelegatedTokensResponse)
+	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Msg/ClaimUndelegatedTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgClearBalanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RebalanceValidators(ctx context.Context, in *MsgRebalanceValidators, opts ...grpc.CallOption) (*MsgRebalanceValidatorsResponse, error) {
+	out := new(MsgRebalanceValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Msg/RebalanceValidators", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgClearBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddValidator(ctx context.Context, in *MsgAddValidator, opts ...grpc.CallOption) (*MsgAddValidatorResponse, error) {
+	out := new(MsgAddValidatorResponse)
+	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Msg/AddValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgClearBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) ChangeValidatorWeight(ctx context.Context, in *MsgChangeValidatorWeight, opts ...grpc.CallOption) (*MsgChangeValidatorWeightResponse, error) {
+	out := new(MsgChangeValidatorWeightResponse)
+	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Msg/ChangeValidatorWeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRedeemStake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeleteValidator(ctx context.Context, in *MsgDeleteValidator, opts ...grpc.CallOption) (*MsgDeleteValidatorResponse, error) {
+	out := new(MsgDeleteValidatorResponse)
+	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Msg/DeleteValidator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRedeemStake) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRedeemStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.HostZone) > 0 {
-		i -= len(m.HostZone)
-		copy(dAtA[i:], m.HostZone)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZone)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) RestoreInterchainAccount(ctx context.Context, in *MsgRestoreInterchainAccount, opts ...grpc.CallOption) (*MsgRestoreInterchainAccountResponse, error) {
+	out := new(MsgRestoreInterchainAccountResponse)
+	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Msg/RestoreInterchainAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRedeemStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateValidatorSharesExchRate(ctx context.Context, in *MsgUpdateValidatorSharesExchRate, opts ...grpc.CallOption) (*MsgUpdateValidatorSharesExchRateResponse, error) {
+	out := new(MsgUpdateValidatorSharesExchRateResponse)
+	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Msg/UpdateValidatorSharesExchRate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRedeemStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgRedeemStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) ClearBalance(ctx context.Context, in *MsgClearBalance, opts ...grpc.CallOption) (*MsgClearBalanceResponse, error) {
+	out := new(MsgClearBalanceResponse)
+	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Msg/ClearBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRegisterHostZone) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateHostZoneSchedule(ctx context.Context, in *MsgUpdateHostZoneSchedule, opts ...grpc.CallOption) (*MsgUpdateHostZoneScheduleResponse, error) {
+	out := new(MsgUpdateHostZoneScheduleResponse)
+	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Msg/UpdateHostZoneSchedule", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRegisterHostZone) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetWithdrawalAddress(ctx context.Context, in *MsgSetWithdrawalAddress, opts ...grpc.CallOption) (*MsgSetWithdrawalAddressResponse, error) {
+	out := new(MsgSetWithdrawalAddressResponse)
+	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Msg/SetWithdrawalAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRegisterHostZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Bech32Prefix) > 0 {
-		i -= len(m.Bech32Prefix)
-		copy(dAtA[i:], m.Bech32Prefix)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Bech32Prefix)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.UnbondingFrequency != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingFrequency))
-		i--
-		dAtA[i] = 0x58
-	}
-	if len(m.TransferChannelId) > 0 {
-		i -= len(m.TransferChannelId)
-		copy(dAtA[i:], m.TransferChannelId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferChannelId)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.IbcDenom) > 0 {
-		i -= len(m.IbcDenom)
-		copy(dAtA[i:], m.IbcDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.IbcDenom)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.HostDenom) > 0 {
-		i -= len(m.HostDenom)
-		copy(dAtA[i:], m.HostDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HostDenom)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0x12
+func (c *msgClient) WindDownHostZone(ctx context.Context, in *MsgWindDownHostZone, opts ...grpc.CallOption) (*MsgWindDownHostZoneResponse, error) {
+	out := new(MsgWindDownHostZoneResponse)
+	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Msg/WindDownHostZone", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRegisterHostZoneResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateMaxLightClientAge(ctx context.Context, in *MsgUpdateMaxLightClientAge, opts ...grpc.CallOption) (*MsgUpdateMaxLightClientAgeResponse, error) {
+	out := new(MsgUpdateMaxLightClientAgeResponse)
+	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Msg/UpdateMaxLightClientAge", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRegisterHostZoneResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	LiquidStake(context.Context, *MsgLiquidStake) (*MsgLiquidStakeResponse, error)
+	RedeemStake(context.Context, *MsgRedeemStake) (*MsgRedeemStakeResponse, error)
+	// TODO(TEST-53): Remove this pre-launch (no need for clients to create / interact with ICAs)
+	RegisterHostZone(context.Context, *MsgRegisterHostZone) (*MsgRegisterHostZoneResponse, error)
+	ClaimUndelegatedTokens(context.Context, *MsgClaimUndelegatedTokens) (*MsgClaimUndelegatedTokensResponse, error)
+	RebalanceValidators(context.Context, *MsgRebalanceValidators) (*MsgRebalanceValidatorsResponse, error)
+	AddValidator(context.Context, *MsgAddValidator) (*MsgAddValidatorResponse, error)
+	ChangeValidatorWeight(context.Context, *MsgChangeValidatorWeight) (*MsgChangeValidatorWeightResponse, error)
+	DeleteValidator(context.Context, *MsgDeleteValidator) (*MsgDeleteValidatorResponse, error)
+	RestoreInterchainAccount(context.Context, *MsgRestoreInterchainAccount) (*MsgRestoreInterchainAccountResponse, error)
+	UpdateValidatorSharesExchRate(context.Context, *MsgUpdateValidatorSharesExchRate) (*MsgUpdateValidatorSharesExchRateResponse, error)
+	ClearBalance(context.Context, *MsgClearBalance) (*MsgClearBalanceResponse, error)
+	UpdateHostZoneSchedule(context.Context, *MsgUpdateHostZoneSchedule) (*MsgUpdateHostZoneScheduleResponse, error)
+	SetWithdrawalAddress(context.Context, *MsgSetWithdrawalAddress) (*MsgSetWithdrawalAddressResponse, error)
+	WindDownHostZone(context.Context, *MsgWindDownHostZone) (*MsgWindDownHostZoneResponse, error)
+	UpdateMaxLightClientAge(context.Context, *MsgUpdateMaxLightClientAge) (*MsgUpdateMaxLightClientAgeResponse, error)
 }
 
-func (m *MsgRegisterHostZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgClaimUndelegatedTokens) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) LiquidStake(ctx context.Context, req *MsgLiquidStake) (*MsgLiquidStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidStake not implemented")
+}
+func (*UnimplementedMsgServer) RedeemStake(ctx context.Context, req *MsgRedeemStake) (*MsgRedeemStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemStake not implemented")
+}
+func (*UnimplementedMsgServer) RegisterHostZone(ctx context.Context, req *MsgRegisterHostZone) (*MsgRegisterHostZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterHostZone not implemented")
+}
+func (*UnimplementedMsgServer) ClaimUndelegatedTokens(ctx context.Context, req *MsgClaimUndelegatedTokens) (*MsgClaimUndelegatedTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimUndelegatedTokens not implemented")
+}
+func (*UnimplementedMsgServer) RebalanceValidators(ctx context.Context, req *MsgRebalanceValidators) (*MsgRebalanceValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebalanceValidators not implemented")
+}
+func (*UnimplementedMsgServer) AddValidator(ctx context.Context, req *MsgAddValidator) (*MsgAddValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddValidator not implemented")
+}
+func (*UnimplementedMsgServer) ChangeValidatorWeight(ctx context.Context, req *MsgChangeValidatorWeight) (*MsgChangeValidatorWeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeValidatorWeight not implemented")
+}
+func (*UnimplementedMsgServer) DeleteValidator(ctx context.Context, req *MsgDeleteValidator) (*MsgDeleteValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteValidator not implemented")
+}
+func (*UnimplementedMsgServer) RestoreInterchainAccount(ctx context.Context, req *MsgRestoreInterchainAccount) (*MsgRestoreInterchainAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreInterchainAccount not implemented")
+}
+func (*UnimplementedMsgServer) UpdateValidatorSharesExchRate(ctx context.Context, req *MsgUpdateValidatorSharesExchRate) (*MsgUpdateValidatorSharesExchRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateValidatorSharesExchRate not implemented")
+}
+func (*UnimplementedMsgServer) ClearBalance(ctx context.Context, req *MsgClearBalance) (*MsgClearBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearBalance not implemented")
+}
+func (*UnimplementedMsgServer) UpdateHostZoneSchedule(ctx context.Context, req *MsgUpdateHostZoneSchedule) (*MsgUpdateHostZoneScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHostZoneSchedule not implemented")
+}
+func (*UnimplementedMsgServer) SetWithdrawalAddress(ctx context.Context, req *MsgSetWithdrawalAddress) (*MsgSetWithdrawalAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWithdrawalAddress not implemented")
+}
+func (*UnimplementedMsgServer) WindDownHostZone(ctx context.Context, req *MsgWindDownHostZone) (*MsgWindDownHostZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WindDownHostZone not implemented")
+}
+func (*UnimplementedMsgServer) UpdateMaxLightClientAge(ctx context.Context, req *MsgUpdateMaxLightClientAge) (*MsgUpdateMaxLightClientAgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMaxLightClientAge not implemented")
 }
 
-func (m *MsgClaimUndelegatedTokens) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgClaimUndelegatedTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_LiquidStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiquidStake)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Epoch != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(MsgServer).LiquidStake(ctx, in)
 	}
-	if len(m.HostZoneId) > 0 {
-		i -= len(m.HostZoneId)
-		copy(dAtA[i:], m.HostZoneId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZoneId)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Stridelabs.stride.stakeibc.Msg/LiquidStake",
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiquidStake(ctx, req.(*MsgLiquidStake))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimUndelegatedTokensResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RedeemStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeemStake)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RedeemStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Stridelabs.stride.stakeibc.Msg/RedeemStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedeemStake(ctx, req.(*MsgRedeemStake))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimUndelegatedTokensResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_RegisterHostZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterHostZone)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterHostZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Stridelabs.stride.stakeibc.Msg/RegisterHostZone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterHostZone(ctx, req.(*MsgRegisterHostZone))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimUndelegatedTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_ClaimUndelegatedTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimUndelegatedTokens)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimUndelegatedTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Stridelabs.stride.stakeibc.Msg/ClaimUndelegatedTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimUndelegatedTokens(ctx, req.(*MsgClaimUndelegatedTokens))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRebalanceValidators) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RebalanceValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRebalanceValidators)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RebalanceValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Stridelabs.stride.stakeibc.Msg/RebalanceValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RebalanceValidators(ctx, req.(*MsgRebalanceValidators))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRebalanceValidators) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_AddValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddValidator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Stridelabs.stride.stakeibc.Msg/AddValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddValidator(ctx, req.(*MsgAddValidator))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRebalanceValidators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NumRebalance != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.NumRebalance))
-		i--
-		dAtA[i] = 0x18
+func _Msg_ChangeValidatorWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeValidatorWeight)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.HostZone) > 0 {
-		i -= len(m.HostZone)
-		copy(dAtA[i:], m.HostZone)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZone)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeValidatorWeight(ctx, in)
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Stridelabs.stride.stakeibc.Msg/ChangeValidatorWeight",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeValidatorWeight(ctx, req.(*MsgChangeValidatorWeight))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRebalanceValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeleteValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteValidator)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Stridelabs.stride.stakeibc.Msg/DeleteValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteValidator(ctx, req.(*MsgDeleteValidator))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRebalanceValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_RestoreInterchainAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRestoreInterchainAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RestoreInterchainAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Stridelabs.stride.stakeibc.Msg/RestoreInterchainAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RestoreInterchainAccount(ctx, req.(*MsgRestoreInterchainAccount))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRebalanceValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_UpdateValidatorSharesExchRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateValidatorSharesExchRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateValidatorSharesExchRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Stridelabs.stride.stakeibc.Msg/UpdateValidatorSharesExchRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateValidatorSharesExchRate(ctx, req.(*MsgUpdateValidatorSharesExchRate))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddValidator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ClearBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClearBalance)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).ClearBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Stridelabs.stride.stakeibc.Msg/ClearBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClearBalance(ctx, req.(*MsgClearBalance))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddValidator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_UpdateHostZoneSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateHostZoneSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateHostZoneSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Stridelabs.stride.stakeibc.Msg/UpdateHostZoneSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateHostZoneSchedule(ctx, req.(*MsgUpdateHostZoneSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Weight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Weight))
-		i--
-		dAtA[i] = 0x30
+func _Msg_SetWithdrawalAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetWithdrawalAddress)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Commission != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Commission))
-		i--
-		dAtA[i] = 0x28
+	if interceptor == nil {
+		return srv.(MsgServer).SetWithdrawalAddress(ctx, in)
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x22
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Stridelabs.stride.stakeibc.Msg/SetWithdrawalAddress",
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetWithdrawalAddress(ctx, req.(*MsgSetWithdrawalAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WindDownHostZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWindDownHostZone)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WindDownHostZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Stridelabs.stride.stakeibc.Msg/WindDownHostZone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WindDownHostZone(ctx, req.(*MsgWindDownHostZone))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateMaxLightClientAge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMaxLightClientAge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMaxLightClientAge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Stridelabs.stride.stakeibc.Msg/UpdateMaxLightClientAge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMaxLightClientAge(ctx, req.(*MsgUpdateMaxLightClientAge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "Stridelabs.stride.stakeibc.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LiquidStake",
+			Handler:    _Msg_LiquidStake_Handler,
+		},
+		{
+			MethodName: "RedeemStake",
+			Handler:    _Msg_RedeemStake_Handler,
+		},
+		{
+			MethodName: "RegisterHostZone",
+			Handler:    _Msg_RegisterHostZone_Handler,
+		},
+		{
+			MethodName: "ClaimUndelegatedTokens",
+			Handler:    _Msg_ClaimUndelegatedTokens_Handler,
+		},
+		{
+			MethodName: "RebalanceValidators",
+			Handler:    _Msg_RebalanceValidators_Handler,
+		},
+		{
+			MethodName: "AddValidator",
+			Handler:    _Msg_AddValidator_Handler,
+		},
+		{
+			MethodName: "ChangeValidatorWeight",
+			Handler:    _Msg_ChangeValidatorWeight_Handler,
+		},
+		{
+			MethodName: "DeleteValidator",
+			Handler:    _Msg_DeleteValidator_Handler,
+		},
+		{
+			MethodName: "RestoreInterchainAccount",
+			Handler:    _Msg_RestoreInterchainAccount_Handler,
+		},
+		{
+			MethodName: "UpdateValidatorSharesExchRate",
+			Handler:    _Msg_UpdateValidatorSharesExchRate_Handler,
+		},
+		{
+			MethodName: "ClearBalance",
+			Handler:    _Msg_ClearBalance_Handler,
+		},
+		{
+			MethodName: "UpdateHostZoneSchedule",
+			Handler:    _Msg_UpdateHostZoneSchedule_Handler,
+		},
+		{
+			MethodName: "SetWithdrawalAddress",
+			Handler:    _Msg_SetWithdrawalAddress_Handler,
+		},
+		{
+			MethodName: "WindDownHostZone",
+			Handler:    _Msg_WindDownHostZone_Handler,
+		},
+		{
+			MethodName: "UpdateMaxLightClientAge",
+			Handler:    _Msg_UpdateMaxLightClientAge_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "stakeibc/tx.proto",
+}
+
+func (m *MsgLiquidStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HostDenom) > 0 {
+		i -= len(m.HostDenom)
+		copy(dAtA[i:], m.HostDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostDenom)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.HostZone) > 0 {
-		i -= len(m.HostZone)
-		copy(dAtA[i:], m.HostZone)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZone)))
-		i--
-		dAtA[i] = 0x12
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -2176,7 +2268,7 @@ func (m *MsgAddValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddValidatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgLiquidStakeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2186,12 +2278,12 @@ func (m *MsgAddValidatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgLiquidStakeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgLiquidStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2199,7 +2291,7 @@ func (m *MsgAddValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgChangeValidatorWeight) Marshal() (dAtA []byte, err error) {
+func (m *MsgClearBalance) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2209,32 +2301,37 @@ func (m *MsgChangeValidatorWeight) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgChangeValidatorWeight) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgClearBalance) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgChangeValidatorWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgClearBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Weight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Weight))
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Channel)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x22
 	}
-	if len(m.ValAddr) > 0 {
-		i -= len(m.ValAddr)
-		copy(dAtA[i:], m.ValAddr)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValAddr)))
-		i--
-		dAtA[i] = 0x1a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.HostZone) > 0 {
-		i -= len(m.HostZone)
-		copy(dAtA[i:], m.HostZone)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZone)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2248,7 +2345,7 @@ func (m *MsgChangeValidatorWeight) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgChangeValidatorWeightResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgClearBalanceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2258,12 +2355,12 @@ func (m *MsgChangeValidatorWeightResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgChangeValidatorWeightResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgClearBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgChangeValidatorWeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgClearBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2271,7 +2368,7 @@ func (m *MsgChangeValidatorWeightResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteValidator) Marshal() (dAtA []byte, err error) {
+func (m *MsgRedeemStake) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2281,30 +2378,40 @@ func (m *MsgDeleteValidator) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteValidator) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRedeemStake) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRedeemStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ValAddr) > 0 {
-		i -= len(m.ValAddr)
-		copy(dAtA[i:], m.ValAddr)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValAddr)))
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
 	if len(m.HostZone) > 0 {
 		i -= len(m.HostZone)
 		copy(dAtA[i:], m.HostZone)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZone)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -2315,7 +2422,7 @@ func (m *MsgDeleteValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteValidatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRedeemStakeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2325,12 +2432,12 @@ func (m *MsgDeleteValidatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRedeemStakeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRedeemStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2338,7 +2445,7 @@ func (m *MsgDeleteValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRestoreInterchainAccount) Marshal() (dAtA []byte, err error) {
+func (m *MsgRegisterHostZone) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2348,39 +2455,79 @@ func (m *MsgRestoreInterchainAccount) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRestoreInterchainAccount) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRegisterHostZone) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRestoreInterchainAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRegisterHostZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.AccountType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AccountType))
+	if len(m.DisplayDenom) > 0 {
+		i -= len(m.DisplayDenom)
+		copy(dAtA[i:], m.DisplayDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DisplayDenom)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x72
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+	if m.DenomExponent != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DenomExponent))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x68
+	}
+	if len(m.Bech32Prefix) > 0 {
+		i -= len(m.Bech32Prefix)
+		copy(dAtA[i:], m.Bech32Prefix)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bech32Prefix)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.UnbondingFrequency != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingFrequency))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.TransferChannelId) > 0 {
+		i -= len(m.TransferChannelId)
+		copy(dAtA[i:], m.TransferChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferChannelId)))
+		i--
+		dAtA[i] = 0x52
 	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x32
+	}
+	if len(m.IbcDenom) > 0 {
+		i -= len(m.IbcDenom)
+		copy(dAtA[i:], m.IbcDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IbcDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.HostDenom) > 0 {
+		i -= len(m.HostDenom)
+		copy(dAtA[i:], m.HostDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRestoreInterchainAccountResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRegisterHostZoneResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2390,12 +2537,12 @@ func (m *MsgRestoreInterchainAccountResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgRestoreInterchainAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRegisterHostZoneResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRestoreInterchainAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRegisterHostZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2403,7 +2550,7 @@ func (m *MsgRestoreInterchainAccountResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateValidatorSharesExchRate) Marshal() (dAtA []byte, err error) {
+func (m *MsgClaimUndelegatedTokens) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2413,27 +2560,32 @@ func (m *MsgUpdateValidatorSharesExchRate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateValidatorSharesExchRate) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgClaimUndelegatedTokens) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateValidatorSharesExchRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgClaimUndelegatedTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Valoper) > 0 {
-		i -= len(m.Valoper)
-		copy(dAtA[i:], m.Valoper)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Valoper)))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+	if m.Epoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.HostZoneId) > 0 {
+		i -= len(m.HostZoneId)
+		copy(dAtA[i:], m.HostZoneId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZoneId)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2447,7 +2599,7 @@ func (m *MsgUpdateValidatorSharesExchRate) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateValidatorSharesExchRateResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgClaimUndelegatedTokensResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2457,12 +2609,12 @@ func (m *MsgUpdateValidatorSharesExchRateResponse) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateValidatorSharesExchRateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgClaimUndelegatedTokensResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateValidatorSharesExchRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgClaimUndelegatedTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2470,388 +2622,2211 @@ func (m *MsgUpdateValidatorSharesExchRateResponse) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRebalanceValidators) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgLiquidStake) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgRebalanceValidators) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRebalanceValidators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.NumRebalance != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NumRebalance))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
+	if len(m.HostZone) > 0 {
+		i -= len(m.HostZone)
+		copy(dAtA[i:], m.HostZone)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZone)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.HostDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgLiquidStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRebalanceValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgClearBalance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
-	}
-	l = len(m.Channel)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgRebalanceValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgClearBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRebalanceValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRedeemStake) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
-	}
-	l = len(m.HostZone)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgAddValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRedeemStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgAddValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterHostZone) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAddValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.HostDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Weight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.IbcDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Commission != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Commission))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.TransferChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.UnbondingFrequency != 0 {
-		n += 1 + sovTx(uint64(m.UnbondingFrequency))
+	if len(m.HostZone) > 0 {
+		i -= len(m.HostZone)
+		copy(dAtA[i:], m.HostZone)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZone)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Bech32Prefix)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterHostZoneResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgClaimUndelegatedTokens) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAddValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.HostZoneId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Epoch != 0 {
-		n += 1 + sovTx(uint64(m.Epoch))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgClaimUndelegatedTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgChangeValidatorWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRebalanceValidators) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgChangeValidatorWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeValidatorWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Weight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.HostZone)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ValAddr) > 0 {
+		i -= len(m.ValAddr)
+		copy(dAtA[i:], m.ValAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValAddr)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.NumRebalance != 0 {
-		n += 1 + sovTx(uint64(m.NumRebalance))
+	if len(m.HostZone) > 0 {
+		i -= len(m.HostZone)
+		copy(dAtA[i:], m.HostZone)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZone)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRebalanceValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgChangeValidatorWeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeValidatorWeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeValidatorWeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddValidator) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.HostZone)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ValAddr) > 0 {
+		i -= len(m.ValAddr)
+		copy(dAtA[i:], m.ValAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValAddr)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Commission != 0 {
-		n += 1 + sovTx(uint64(m.Commission))
+	if len(m.HostZone) > 0 {
+		i -= len(m.HostZone)
+		copy(dAtA[i:], m.HostZone)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZone)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Weight != 0 {
-		n += 1 + sovTx(uint64(m.Weight))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChangeValidatorWeight) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRestoreInterchainAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRestoreInterchainAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRestoreInterchainAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.HostZone)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.AccountType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AccountType))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.ValAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Weight != 0 {
-		n += 1 + sovTx(uint64(m.Weight))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChangeValidatorWeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRestoreInterchainAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRestoreInterchainAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRestoreInterchainAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteValidator) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateValidatorSharesExchRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateValidatorSharesExchRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateValidatorSharesExchRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Valoper) > 0 {
+		i -= len(m.Valoper)
+		copy(dAtA[i:], m.Valoper)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Valoper)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.HostZone)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ValAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateValidatorSharesExchRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateValidatorSharesExchRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateValidatorSharesExchRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRestoreInterchainAccount) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateHostZoneSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateHostZoneSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateHostZoneSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Offset != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Interval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.AccountType != 0 {
-		n += 1 + sovTx(uint64(m.AccountType))
+	if len(m.Pipeline) > 0 {
+		i -= len(m.Pipeline)
+		copy(dAtA[i:], m.Pipeline)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Pipeline)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.HostZone) > 0 {
+		i -= len(m.HostZone)
+		copy(dAtA[i:], m.HostZone)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZone)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateHostZoneScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateHostZoneScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateHostZoneScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetWithdrawalAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetWithdrawalAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetWithdrawalAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HostZone) > 0 {
+		i -= len(m.HostZone)
+		copy(dAtA[i:], m.HostZone)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZone)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetWithdrawalAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetWithdrawalAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetWithdrawalAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWindDownHostZone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWindDownHostZone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWindDownHostZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HostZone) > 0 {
+		i -= len(m.HostZone)
+		copy(dAtA[i:], m.HostZone)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZone)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWindDownHostZoneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWindDownHostZoneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWindDownHostZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMaxLightClientAge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMaxLightClientAge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMaxLightClientAge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxLightClientAgeNanos != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxLightClientAgeNanos))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.HostZone) > 0 {
+		i -= len(m.HostZone)
+		copy(dAtA[i:], m.HostZone)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostZone)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMaxLightClientAgeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMaxLightClientAgeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMaxLightClientAgeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgLiquidStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.HostDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLiquidStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClearBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClearBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRedeemStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.HostZone)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRedeemStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterHostZone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IbcDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TransferChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnbondingFrequency != 0 {
+		n += 1 + sovTx(uint64(m.UnbondingFrequency))
+	}
+	l = len(m.Bech32Prefix)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DenomExponent != 0 {
+		n += 1 + sovTx(uint64(m.DenomExponent))
+	}
+	l = len(m.DisplayDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterHostZoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimUndelegatedTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostZoneId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovTx(uint64(m.Epoch))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimUndelegatedTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRebalanceValidators) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostZone)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NumRebalance != 0 {
+		n += 1 + sovTx(uint64(m.NumRebalance))
+	}
+	return n
+}
+
+func (m *MsgRebalanceValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostZone)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Commission != 0 {
+		n += 1 + sovTx(uint64(m.Commission))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovTx(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *MsgAddValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeValidatorWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostZone)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovTx(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *MsgChangeValidatorWeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostZone)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRestoreInterchainAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AccountType != 0 {
+		n += 1 + sovTx(uint64(m.AccountType))
+	}
+	return n
+}
+
+func (m *MsgRestoreInterchainAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateValidatorSharesExchRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Valoper)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateValidatorSharesExchRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateHostZoneSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostZone)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Pipeline)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTx(uint64(m.Interval))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovTx(uint64(m.Offset))
+	}
+	return n
+}
+
+func (m *MsgUpdateHostZoneScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetWithdrawalAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostZone)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetWithdrawalAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWindDownHostZone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostZone)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWindDownHostZoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateMaxLightClientAge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostZone)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxLightClientAgeNanos != 0 {
+		n += 1 + sovTx(uint64(m.MaxLightClientAgeNanos))
+	}
+	return n
+}
+
+func (m *MsgUpdateMaxLightClientAgeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClearBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClearBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClearBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClearBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClearBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClearBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeemStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeemStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterHostZone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterHostZone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterHostZone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingFrequency", wireType)
+			}
+			m.UnbondingFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingFrequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bech32Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bech32Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomExponent", wireType)
+			}
+			m.DenomExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DenomExponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgRestoreInterchainAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateValidatorSharesExchRate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Valoper)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRegisterHostZoneResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterHostZoneResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterHostZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgUpdateValidatorSharesExchRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimUndelegatedTokens) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2874,10 +4849,10 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidStake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimUndelegatedTokens: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimUndelegatedTokens: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2913,10 +4888,10 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneId", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2926,14 +4901,46 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2961,7 +4968,7 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostDenom = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2984,7 +4991,7 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimUndelegatedTokensResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3007,10 +5014,10 @@ func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimUndelegatedTokensResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimUndelegatedTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3034,7 +5041,7 @@ func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClearBalance) Unmarshal(dAtA []byte) error {
+func (m *MsgRebalanceValidators) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3057,10 +5064,10 @@ func (m *MsgClearBalance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClearBalance: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRebalanceValidators: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClearBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRebalanceValidators: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3097,7 +5104,7 @@ func (m *MsgClearBalance) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3125,32 +5132,13 @@ func (m *MsgClearBalance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.HostZone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumRebalance", wireType)
 			}
-			var stringLen uint64
+			m.NumRebalance = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3160,24 +5148,11 @@ func (m *MsgClearBalance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NumRebalance |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3199,7 +5174,7 @@ func (m *MsgClearBalance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClearBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRebalanceValidatorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3222,10 +5197,10 @@ func (m *MsgClearBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClearBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRebalanceValidatorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClearBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRebalanceValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3249,7 +5224,7 @@ func (m *MsgClearBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedeemStake) Unmarshal(dAtA []byte) error {
+func (m *MsgAddValidator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3272,10 +5247,10 @@ func (m *MsgRedeemStake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedeemStake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddValidator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedeemStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddValidator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3311,10 +5286,10 @@ func (m *MsgRedeemStake) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3324,14 +5299,27 @@ func (m *MsgRedeemStake) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3359,11 +5347,11 @@ func (m *MsgRedeemStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostZone = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3391,8 +5379,46 @@ func (m *MsgRedeemStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			m.Commission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Commission |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3414,7 +5440,7 @@ func (m *MsgRedeemStake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedeemStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddValidatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3437,10 +5463,10 @@ func (m *MsgRedeemStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedeemStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddValidatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedeemStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3464,7 +5490,7 @@ func (m *MsgRedeemStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterHostZone) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeValidatorWeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3487,79 +5513,15 @@ func (m *MsgRegisterHostZone) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterHostZone: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeValidatorWeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterHostZone: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeValidatorWeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HostDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3587,11 +5549,11 @@ func (m *MsgRegisterHostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3619,11 +5581,11 @@ func (m *MsgRegisterHostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.HostZone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3651,32 +5613,13 @@ func (m *MsgRegisterHostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransferChannelId = string(dAtA[iNdEx:postIndex])
+			m.ValAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingFrequency", wireType)
-			}
-			m.UnbondingFrequency = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingFrequency |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bech32Prefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var stringLen uint64
+			m.Weight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3686,24 +5629,11 @@ func (m *MsgRegisterHostZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Weight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Bech32Prefix = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3725,7 +5655,7 @@ func (m *MsgRegisterHostZone) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterHostZoneResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeValidatorWeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3748,10 +5678,10 @@ func (m *MsgRegisterHostZoneResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterHostZoneResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeValidatorWeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterHostZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeValidatorWeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3775,7 +5705,7 @@ func (m *MsgRegisterHostZoneResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimUndelegatedTokens) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteValidator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3798,10 +5728,10 @@ func (m *MsgClaimUndelegatedTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimUndelegatedTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteValidator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimUndelegatedTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteValidator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3838,7 +5768,7 @@ func (m *MsgClaimUndelegatedTokens) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3857,39 +5787,20 @@ func (m *MsgClaimUndelegatedTokens) Unmarshal(dAtA []byte) error {
 			}
 			intStringLen := int(stringLen)
 			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HostZoneId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
-			}
-			m.Epoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return ErrInvalidLengthTx
 			}
-		case 4:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3917,7 +5828,7 @@ func (m *MsgClaimUndelegatedTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.ValAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3940,7 +5851,7 @@ func (m *MsgClaimUndelegatedTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimUndelegatedTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteValidatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3963,10 +5874,10 @@ func (m *MsgClaimUndelegatedTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimUndelegatedTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteValidatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimUndelegatedTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3990,7 +5901,7 @@ func (m *MsgClaimUndelegatedTokensResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRebalanceValidators) Unmarshal(dAtA []byte) error {
+func (m *MsgRestoreInterchainAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4013,10 +5924,10 @@ func (m *MsgRebalanceValidators) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRebalanceValidators: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRestoreInterchainAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRebalanceValidators: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRestoreInterchainAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4053,7 +5964,7 @@ func (m *MsgRebalanceValidators) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4081,13 +5992,13 @@ func (m *MsgRebalanceValidators) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostZone = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumRebalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
 			}
-			m.NumRebalance = 0
+			m.AccountType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4097,7 +6008,7 @@ func (m *MsgRebalanceValidators) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumRebalance |= uint64(b&0x7F) << shift
+				m.AccountType |= ICAAccountType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4123,7 +6034,7 @@ func (m *MsgRebalanceValidators) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRebalanceValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRestoreInterchainAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4146,10 +6057,10 @@ func (m *MsgRebalanceValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRebalanceValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRestoreInterchainAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRebalanceValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRestoreInterchainAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4173,7 +6084,7 @@ func (m *MsgRebalanceValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddValidator) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateValidatorSharesExchRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4196,10 +6107,10 @@ func (m *MsgAddValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateValidatorSharesExchRate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateValidatorSharesExchRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4236,7 +6147,7 @@ func (m *MsgAddValidator) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4264,43 +6175,11 @@ func (m *MsgAddValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostZone = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Valoper", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4328,46 +6207,8 @@ func (m *MsgAddValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Valoper = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
-			}
-			m.Commission = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Commission |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
-			}
-			m.Weight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Weight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4389,7 +6230,7 @@ func (m *MsgAddValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateValidatorSharesExchRateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4412,10 +6253,10 @@ func (m *MsgAddValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateValidatorSharesExchRateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateValidatorSharesExchRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4439,7 +6280,7 @@ func (m *MsgAddValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeValidatorWeight) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateHostZoneSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4462,10 +6303,10 @@ func (m *MsgChangeValidatorWeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeValidatorWeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateHostZoneSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeValidatorWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateHostZoneSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4534,7 +6375,7 @@ func (m *MsgChangeValidatorWeight) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4562,13 +6403,13 @@ func (m *MsgChangeValidatorWeight) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			m.Pipeline = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			m.Weight = 0
+			m.Interval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4578,7 +6419,26 @@ func (m *MsgChangeValidatorWeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Weight |= uint64(b&0x7F) << shift
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4604,7 +6464,7 @@ func (m *MsgChangeValidatorWeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeValidatorWeightResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateHostZoneScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4627,10 +6487,10 @@ func (m *MsgChangeValidatorWeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeValidatorWeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateHostZoneScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeValidatorWeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateHostZoneScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4654,7 +6514,7 @@ func (m *MsgChangeValidatorWeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteValidator) Unmarshal(dAtA []byte) error {
+func (m *MsgSetWithdrawalAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4677,10 +6537,10 @@ func (m *MsgDeleteValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetWithdrawalAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetWithdrawalAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4747,38 +6607,6 @@ func (m *MsgDeleteValidator) Unmarshal(dAtA []byte) error {
 			}
 			m.HostZone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4800,7 +6628,7 @@ func (m *MsgDeleteValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetWithdrawalAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4823,10 +6651,10 @@ func (m *MsgDeleteValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetWithdrawalAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetWithdrawalAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4850,7 +6678,7 @@ func (m *MsgDeleteValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRestoreInterchainAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgWindDownHostZone) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4873,10 +6701,10 @@ func (m *MsgRestoreInterchainAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRestoreInterchainAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWindDownHostZone: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRestoreInterchainAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWindDownHostZone: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4913,7 +6741,7 @@ func (m *MsgRestoreInterchainAccount) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4941,27 +6769,8 @@ func (m *MsgRestoreInterchainAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.HostZone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
-			}
-			m.AccountType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AccountType |= ICAAccountType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4983,7 +6792,7 @@ func (m *MsgRestoreInterchainAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRestoreInterchainAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWindDownHostZoneResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5006,10 +6815,10 @@ func (m *MsgRestoreInterchainAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRestoreInterchainAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWindDownHostZoneResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRestoreInterchainAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWindDownHostZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5033,7 +6842,7 @@ func (m *MsgRestoreInterchainAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateValidatorSharesExchRate) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateMaxLightClientAge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5056,10 +6865,10 @@ func (m *MsgUpdateValidatorSharesExchRate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateValidatorSharesExchRate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateMaxLightClientAge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateValidatorSharesExchRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateMaxLightClientAge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5096,7 +6905,7 @@ func (m *MsgUpdateValidatorSharesExchRate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5124,13 +6933,13 @@ func (m *MsgUpdateValidatorSharesExchRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.HostZone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valoper", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLightClientAgeNanos", wireType)
 			}
-			var stringLen uint64
+			m.MaxLightClientAgeNanos = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5140,24 +6949,11 @@ func (m *MsgUpdateValidatorSharesExchRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxLightClientAgeNanos |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Valoper = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5179,7 +6975,7 @@ func (m *MsgUpdateValidatorSharesExchRate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateValidatorSharesExchRateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateMaxLightClientAgeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5202,10 +6998,10 @@ func (m *MsgUpdateValidatorSharesExchRateResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateValidatorSharesExchRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateMaxLightClientAgeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateValidatorSharesExchRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateMaxLightClientAgeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
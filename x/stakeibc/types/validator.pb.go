@@ -30,16 +30,21 @@ type Validator_ValidatorStatus int32
 const (
 	Validator_Active   Validator_ValidatorStatus = 0
 	Validator_Inactive Validator_ValidatorStatus = 1
+	// the validator is being deleted, its stake is being redelegated to the
+	// remaining validators
+	Validator_Removing Validator_ValidatorStatus = 2
 )
 
 var Validator_ValidatorStatus_name = map[int32]string{
 	0: "Active",
 	1: "Inactive",
+	2: "Removing",
 }
 
 var Validator_ValidatorStatus_value = map[string]int32{
 	"Active":   0,
 	"Inactive": 1,
+	"Removing": 2,
 }
 
 func (x Validator_ValidatorStatus) String() string {
@@ -96,13 +101,15 @@ func (m *ValidatorExchangeRate) GetEpochNumber() uint64 {
 }
 
 type Validator struct {
-	Name                 string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Address              string                    `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Status               Validator_ValidatorStatus `protobuf:"varint,3,opt,name=status,proto3,enum=Stridelabs.stride.stakeibc.Validator_ValidatorStatus" json:"status,omitempty"`
-	CommissionRate       uint64                    `protobuf:"varint,4,opt,name=commissionRate,proto3" json:"commissionRate,omitempty"`
-	DelegationAmt        uint64                    `protobuf:"varint,5,opt,name=delegationAmt,proto3" json:"delegationAmt,omitempty"`
-	Weight               uint64                    `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
-	InternalExchangeRate *ValidatorExchangeRate    `protobuf:"bytes,7,opt,name=internalExchangeRate,proto3" json:"internalExchangeRate,omitempty"`
+	Name    string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address string                    `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Status  Validator_ValidatorStatus `protobuf:"varint,3,opt,name=status,proto3,enum=Stridelabs.stride.stakeibc.Validator_ValidatorStatus" json:"status,omitempty"`
+	// the validator's commission as a whole percentage, truncated from the
+	// host's rate (e.g. 4.9% is stored as 4), so it's only informational
+	CommissionRate       uint64                                 `protobuf:"varint,4,opt,name=commissionRate,proto3" json:"commissionRate,omitempty"`
+	DelegationAmt        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=delegationAmt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegationAmt"`
+	Weight               uint64                                 `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	InternalExchangeRate *ValidatorExchangeRate                 `protobuf:"bytes,7,opt,name=internalExchangeRate,proto3" json:"internalExchangeRate,omitempty"`
 }
 
 func (m *Validator) Reset()         { *m = Validator{} }
@@ -166,13 +173,6 @@ func (m *Validator) GetCommissionRate() uint64 {
 	return 0
 }
 
-func (m *Validator) GetDelegationAmt() uint64 {
-	if m != nil {
-		return m.DelegationAmt
-	}
-	return 0
-}
-
 func (m *Validator) GetWeight() uint64 {
 	if m != nil {
 		return m.Weight
@@ -187,45 +187,115 @@ func (m *Validator) GetInternalExchangeRate() *ValidatorExchangeRate {
 	return nil
 }
 
+// PendingValidator is a validator awaiting the ICQ of its staking record on the
+// host before it can be added to the host zone
+type PendingValidator struct {
+	ChainId   string    `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Validator Validator `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator"`
+	// when the query expires (in nanoseconds), after which the validator is dropped
+	// if no response was received (e.g. the query timed out or failed)
+	ExpirationTime uint64 `protobuf:"varint,3,opt,name=expirationTime,proto3" json:"expirationTime,omitempty"`
+}
+
+func (m *PendingValidator) Reset()         { *m = PendingValidator{} }
+func (m *PendingValidator) String() string { return proto.CompactTextString(m) }
+func (*PendingValidator) ProtoMessage()    {}
+func (*PendingValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_135ed83653830bac, []int{2}
+}
+func (m *PendingValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingValidator.Merge(m, src)
+}
+func (m *PendingValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingValidator proto.InternalMessageInfo
+
+func (m *PendingValidator) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *PendingValidator) GetValidator() Validator {
+	if m != nil {
+		return m.Validator
+	}
+	return Validator{}
+}
+
+func (m *PendingValidator) GetExpirationTime() uint64 {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("Stridelabs.stride.stakeibc.Validator_ValidatorStatus", Validator_ValidatorStatus_name, Validator_ValidatorStatus_value)
 	proto.RegisterType((*ValidatorExchangeRate)(nil), "Stridelabs.stride.stakeibc.ValidatorExchangeRate")
 	proto.RegisterType((*Validator)(nil), "Stridelabs.stride.stakeibc.Validator")
+	proto.RegisterType((*PendingValidator)(nil), "Stridelabs.stride.stakeibc.PendingValidator")
 }
 
 func init() { proto.RegisterFile("stakeibc/validator.proto", fileDescriptor_135ed83653830bac) }
 
 var fileDescriptor_135ed83653830bac = []byte{
-	// 462 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x4f, 0x6b, 0x13, 0x41,
-	0x14, 0xdf, 0xb1, 0x71, 0x6b, 0x5e, 0xb5, 0xca, 0x10, 0x65, 0xcd, 0x61, 0xbb, 0x04, 0x29, 0x01,
-	0xc9, 0x2e, 0x46, 0xbc, 0x79, 0x49, 0xa8, 0xa0, 0xa0, 0x1e, 0x36, 0xc5, 0x83, 0x17, 0x99, 0x9d,
-	0x7d, 0xec, 0x0e, 0xc9, 0xce, 0x84, 0x9d, 0x49, 0xad, 0xe0, 0x87, 0xf0, 0x03, 0xf8, 0x31, 0x0a,
-	0x7e, 0x85, 0x1e, 0x4b, 0x4f, 0xe2, 0xa1, 0x48, 0xf2, 0x45, 0x24, 0xb3, 0x9b, 0x18, 0x83, 0x4a,
-	0x4f, 0xf3, 0xde, 0xef, 0xfd, 0xfb, 0xbd, 0x37, 0x3f, 0xf0, 0xb4, 0x61, 0x63, 0x14, 0x09, 0x8f,
-	0x4e, 0xd8, 0x44, 0xa4, 0xcc, 0xa8, 0x32, 0x9c, 0x96, 0xca, 0x28, 0xda, 0x1e, 0x99, 0x52, 0xa4,
-	0x38, 0x61, 0x89, 0x0e, 0xb5, 0x35, 0xc3, 0x55, 0x6e, 0xfb, 0x21, 0x57, 0xba, 0x50, 0xfa, 0x83,
-	0xcd, 0x8c, 0x2a, 0xa7, 0x2a, 0x6b, 0xb7, 0x32, 0x95, 0xa9, 0x0a, 0x5f, 0x5a, 0x15, 0xda, 0xf9,
-	0x46, 0xe0, 0xfe, 0xbb, 0xd5, 0x80, 0x17, 0xa7, 0x3c, 0x67, 0x32, 0xc3, 0x98, 0x19, 0xa4, 0x9f,
-	0xa1, 0x2d, 0xa4, 0xc1, 0x52, 0xb2, 0xc9, 0xb1, 0x1a, 0xa3, 0xd4, 0xc7, 0x6a, 0x94, 0xb3, 0x12,
-	0xf5, 0x32, 0xea, 0x91, 0x80, 0x74, 0x9b, 0xc3, 0xe7, 0xe7, 0x57, 0x07, 0xce, 0x8f, 0xab, 0x83,
-	0xc3, 0x4c, 0x98, 0x7c, 0x96, 0x84, 0x5c, 0x15, 0xf5, 0xd0, 0xfa, 0xe9, 0xe9, 0x74, 0x1c, 0x99,
-	0x4f, 0x53, 0xd4, 0xe1, 0x11, 0xf2, 0xcb, 0xb3, 0x1e, 0xd4, 0x9c, 0x8e, 0x90, 0xc7, 0xff, 0xe9,
-	0x4f, 0x03, 0xd8, 0xc3, 0xa9, 0xe2, 0xf9, 0xdb, 0x59, 0x91, 0x60, 0xe9, 0xdd, 0x08, 0x48, 0xb7,
-	0x11, 0x6f, 0x42, 0x9d, 0xaf, 0x3b, 0xd0, 0x5c, 0x33, 0xa7, 0x14, 0x1a, 0x92, 0x15, 0x35, 0xaf,
-	0xd8, 0xda, 0xb4, 0x0f, 0xbb, 0x2c, 0x4d, 0x4b, 0xd4, 0xda, 0xd6, 0x37, 0x87, 0xde, 0xe5, 0x59,
-	0xaf, 0x55, 0x13, 0x18, 0x54, 0x91, 0xe5, 0x2d, 0x65, 0x16, 0xaf, 0x12, 0xe9, 0x1b, 0x70, 0xb5,
-	0x61, 0x66, 0xa6, 0xbd, 0x9d, 0x80, 0x74, 0xf7, 0xfb, 0xcf, 0xc2, 0x7f, 0x5f, 0x3b, 0x5c, 0x8f,
-	0xff, 0x6d, 0x8d, 0x6c, 0x71, 0x5c, 0x37, 0xa1, 0x87, 0xb0, 0xcf, 0x55, 0x51, 0x08, 0xad, 0x85,
-	0x92, 0xf6, 0x70, 0x0d, 0xbb, 0xc9, 0x16, 0x4a, 0x1f, 0xc1, 0x9d, 0x14, 0x27, 0x98, 0x31, 0x23,
-	0x94, 0x1c, 0x14, 0xc6, 0xbb, 0x69, 0xd3, 0xfe, 0x04, 0xe9, 0x03, 0x70, 0x3f, 0xa2, 0xc8, 0x72,
-	0xe3, 0xb9, 0x36, 0x5c, 0x7b, 0x14, 0xa1, 0xb5, 0x3a, 0xe5, 0xe6, 0x17, 0x7a, 0xbb, 0x01, 0xe9,
-	0xee, 0xf5, 0x9f, 0x5c, 0x6b, 0x85, 0xcd, 0xc2, 0xf8, 0xaf, 0xed, 0x3a, 0x8f, 0xe1, 0xee, 0xd6,
-	0x9e, 0x14, 0xc0, 0x1d, 0x70, 0x23, 0x4e, 0xf0, 0x9e, 0x43, 0x6f, 0xc3, 0xad, 0x57, 0x92, 0x55,
-	0x1e, 0x19, 0xbe, 0x3c, 0x9f, 0xfb, 0xe4, 0x62, 0xee, 0x93, 0x9f, 0x73, 0x9f, 0x7c, 0x59, 0xf8,
-	0xce, 0xc5, 0xc2, 0x77, 0xbe, 0x2f, 0x7c, 0xe7, 0x7d, 0xb8, 0x21, 0x96, 0x8a, 0x59, 0xef, 0x35,
-	0x4b, 0x74, 0x54, 0x51, 0x8b, 0x4e, 0xa3, 0xb5, 0xf2, 0xad, 0x70, 0x12, 0xd7, 0x2a, 0xf5, 0xe9,
-	0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x3e, 0x54, 0xdb, 0x12, 0x03, 0x00, 0x00,
+	// 535 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x8a, 0x13, 0x41,
+	0x10, 0x4e, 0xef, 0xc6, 0xc4, 0x74, 0x74, 0x0d, 0x4d, 0x94, 0x31, 0x87, 0xd9, 0x10, 0x70, 0xc9,
+	0x25, 0x33, 0x18, 0xf1, 0x20, 0x78, 0x49, 0x58, 0xc1, 0x80, 0x8a, 0x74, 0x82, 0x07, 0x2f, 0xd2,
+	0x33, 0x53, 0xcc, 0x34, 0xc9, 0x74, 0x87, 0xe9, 0x4e, 0x8c, 0xe0, 0x43, 0xf8, 0x06, 0xbe, 0xc4,
+	0x82, 0xaf, 0xb0, 0xc7, 0x65, 0x4f, 0xb2, 0x87, 0x45, 0x92, 0x17, 0x91, 0xe9, 0x99, 0xfc, 0x18,
+	0x54, 0x76, 0x4f, 0x53, 0x55, 0x5d, 0x3f, 0x5f, 0x7d, 0xdf, 0x14, 0xb6, 0x94, 0x66, 0x63, 0xe0,
+	0x9e, 0xef, 0xce, 0xd9, 0x84, 0x07, 0x4c, 0xcb, 0xc4, 0x99, 0x26, 0x52, 0x4b, 0xd2, 0x18, 0xea,
+	0x84, 0x07, 0x30, 0x61, 0x9e, 0x72, 0x94, 0x31, 0x9d, 0x75, 0x6e, 0xe3, 0xb1, 0x2f, 0x55, 0x2c,
+	0xd5, 0x27, 0x93, 0xe9, 0x66, 0x4e, 0x56, 0xd6, 0xa8, 0x87, 0x32, 0x94, 0x59, 0x3c, 0xb5, 0xb2,
+	0x68, 0xeb, 0x07, 0xc2, 0x0f, 0x3f, 0xac, 0x07, 0xbc, 0x5a, 0xf8, 0x11, 0x13, 0x21, 0x50, 0xa6,
+	0x81, 0x7c, 0xc5, 0x0d, 0x2e, 0x34, 0x24, 0x82, 0x4d, 0x46, 0x72, 0x0c, 0x42, 0x8d, 0xe4, 0x30,
+	0x62, 0x09, 0xa8, 0xf4, 0xd5, 0x42, 0x4d, 0xd4, 0xae, 0xf4, 0x5f, 0x9e, 0x5f, 0x1f, 0x17, 0xae,
+	0xae, 0x8f, 0x4f, 0x42, 0xae, 0xa3, 0x99, 0xe7, 0xf8, 0x32, 0xce, 0x87, 0xe6, 0x9f, 0x8e, 0x0a,
+	0xc6, 0xae, 0xfe, 0x32, 0x05, 0xe5, 0x9c, 0x82, 0x7f, 0x79, 0xd6, 0xc1, 0x39, 0xa6, 0x53, 0xf0,
+	0xe9, 0x7f, 0xfa, 0x93, 0x26, 0xae, 0xc2, 0x54, 0xfa, 0xd1, 0xbb, 0x59, 0xec, 0x41, 0x62, 0x1d,
+	0x34, 0x51, 0xbb, 0x48, 0x77, 0x43, 0xad, 0xab, 0x43, 0x5c, 0xd9, 0x20, 0x27, 0x04, 0x17, 0x05,
+	0x8b, 0x73, 0x5c, 0xd4, 0xd8, 0xa4, 0x8b, 0xcb, 0x2c, 0x08, 0x12, 0x50, 0xca, 0xd4, 0x57, 0xfa,
+	0xd6, 0xe5, 0x59, 0xa7, 0x9e, 0x03, 0xe8, 0x65, 0x2f, 0x29, 0x97, 0x22, 0xa4, 0xeb, 0x44, 0xf2,
+	0x16, 0x97, 0x94, 0x66, 0x7a, 0xa6, 0xac, 0xc3, 0x26, 0x6a, 0x1f, 0x75, 0x9f, 0x3b, 0xff, 0x66,
+	0xdb, 0xd9, 0x8c, 0xdf, 0x5a, 0x43, 0x53, 0x4c, 0xf3, 0x26, 0xe4, 0x04, 0x1f, 0xf9, 0x32, 0x8e,
+	0xb9, 0x52, 0x5c, 0x0a, 0x43, 0x5c, 0xd1, 0x6c, 0xb2, 0x17, 0x25, 0x23, 0x7c, 0x3f, 0x80, 0x09,
+	0x84, 0x4c, 0x73, 0x29, 0x7a, 0xb1, 0xb6, 0xee, 0x18, 0xc0, 0xce, 0x2d, 0xf8, 0x1d, 0x08, 0x4d,
+	0xff, 0x6c, 0x42, 0x1e, 0xe1, 0xd2, 0x67, 0xe0, 0x61, 0xa4, 0xad, 0x92, 0x99, 0x9a, 0x7b, 0x04,
+	0x70, 0x7d, 0x4d, 0xfd, 0xae, 0xe4, 0x56, 0xb9, 0x89, 0xda, 0xd5, 0xee, 0xd3, 0x1b, 0xad, 0xbc,
+	0x5b, 0x48, 0xff, 0xda, 0xae, 0xf5, 0x02, 0x3f, 0xd8, 0xe3, 0x85, 0x60, 0x5c, 0xea, 0xf9, 0x9a,
+	0xcf, 0xa1, 0x56, 0x20, 0xf7, 0xf0, 0xdd, 0x81, 0x60, 0x99, 0x87, 0x52, 0x8f, 0x42, 0x2c, 0xe7,
+	0x5c, 0x84, 0xb5, 0x83, 0xd6, 0x77, 0x84, 0x6b, 0xef, 0x41, 0x04, 0x5c, 0x84, 0x5b, 0x8d, 0x2d,
+	0x5c, 0xf6, 0x23, 0xc6, 0xc5, 0x20, 0xc8, 0x65, 0x5e, 0xbb, 0x64, 0x80, 0x2b, 0x9b, 0x2b, 0x31,
+	0x5a, 0x57, 0xbb, 0x4f, 0x6e, 0xb4, 0x45, 0xbf, 0x98, 0x32, 0x4c, 0xb7, 0xd5, 0xa9, 0x62, 0xb0,
+	0x98, 0xf2, 0xc4, 0x90, 0x38, 0xe2, 0x31, 0x98, 0x1f, 0xa1, 0x48, 0xf7, 0xa2, 0xfd, 0xd7, 0xe7,
+	0x4b, 0x1b, 0x5d, 0x2c, 0x6d, 0xf4, 0x6b, 0x69, 0xa3, 0x6f, 0x2b, 0xbb, 0x70, 0xb1, 0xb2, 0x0b,
+	0x3f, 0x57, 0x76, 0xe1, 0xa3, 0xb3, 0x23, 0x56, 0x86, 0xa1, 0xf3, 0x86, 0x79, 0xca, 0xcd, 0x40,
+	0xb8, 0x0b, 0x77, 0x73, 0xd9, 0x46, 0x38, 0xaf, 0x64, 0x2e, 0xf1, 0xd9, 0xef, 0x01, 0x00, 0x75,
+	0x8c, 0x85, 0xb7, 0xf2, 0x03, 0x00, 0x00,
 }
 
 func (m *ValidatorExchangeRate) Marshal() (dAtA []byte, err error) {
@@ -303,11 +373,16 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	if m.DelegationAmt != 0 {
-		i = encodeVarintValidator(dAtA, i, uint64(m.DelegationAmt))
-		i--
-		dAtA[i] = 0x28
+	{
+		size := m.DelegationAmt.Size()
+		i -= size
+		if _, err := m.DelegationAmt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintValidator(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x2a
 	if m.CommissionRate != 0 {
 		i = encodeVarintValidator(dAtA, i, uint64(m.CommissionRate))
 		i--
@@ -335,6 +410,51 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationTime != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.ExpirationTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Validator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintValidator(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintValidator(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintValidator(dAtA []byte, offset int, v uint64) int {
 	offset -= sovValidator(v)
 	base := offset
@@ -380,9 +500,8 @@ func (m *Validator) Size() (n int) {
 	if m.CommissionRate != 0 {
 		n += 1 + sovValidator(uint64(m.CommissionRate))
 	}
-	if m.DelegationAmt != 0 {
-		n += 1 + sovValidator(uint64(m.DelegationAmt))
-	}
+	l = m.DelegationAmt.Size()
+	n += 1 + l + sovValidator(uint64(l))
 	if m.Weight != 0 {
 		n += 1 + sovValidator(uint64(m.Weight))
 	}
@@ -393,6 +512,24 @@ func (m *Validator) Size() (n int) {
 	return n
 }
 
+func (m *PendingValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	l = m.Validator.Size()
+	n += 1 + l + sovValidator(uint64(l))
+	if m.ExpirationTime != 0 {
+		n += 1 + sovValidator(uint64(m.ExpirationTime))
+	}
+	return n
+}
+
 func sovValidator(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -634,10 +771,10 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 5:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAmt", wireType)
 			}
-			m.DelegationAmt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowValidator
@@ -647,11 +784,26 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DelegationAmt |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegationAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
@@ -728,6 +880,140 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PendingValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			m.ExpirationTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipValidator(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0